@@ -13,6 +13,7 @@
 	"github.com/ehazlett/simplelog"
 	_ "github.com/rancher/norman/controller"
 	"github.com/rancher/norman/pkg/kwrapper/k8s"
+	"github.com/rancher/rancher/pkg/auth/audit"
 	"github.com/rancher/rancher/pkg/data/management"
 	"github.com/rancher/rancher/pkg/logserver"
 	"github.com/rancher/rancher/pkg/rancher"
@@ -30,6 +31,7 @@
 func main() {
 	management.RegisterPasswordResetCommand()
 	management.RegisterEnsureDefaultAdminCommand()
+	audit.RegisterVerifyCommand()
 	if reexec.Init() {
 		return
 	}
@@ -120,6 +122,18 @@ func main() {
 			Usage:       "Log path for Rancher Server API. Default path is /var/log/auditlog/rancher-api-audit.log",
 			Destination: &config.AuditLogPath,
 		},
+		cli.StringFlag{
+			Name:        "audit-policy-file",
+			EnvVar:      "AUDIT_POLICY_FILE",
+			Usage:       "Path to a file with audit policy rules and additional audit sinks (webhook, syslog, kafka)",
+			Destination: &config.AuditPolicyFile,
+		},
+		cli.StringFlag{
+			Name:        "audit-log-chain-key-file",
+			EnvVar:      "AUDIT_LOG_CHAIN_KEY_FILE",
+			Usage:       "Path to a file with a secret that enables the tamper-evident hash chain mode of the audit log",
+			Destination: &config.AuditLogChainKeyFile,
+		},
 		cli.IntFlag{
 			Name:        "audit-log-maxage",
 			Value:       10,
@@ -148,6 +162,18 @@ func main() {
 			Usage:       "Audit log level: 0 - disable audit log, 1 - log event metadata, 2 - log event metadata and request body, 3 - log event metadata, request body and response body",
 			Destination: &config.AuditLevel,
 		},
+		cli.StringFlag{
+			Name:        "secrets-encryption-key-file",
+			EnvVar:      "CATTLE_SECRETS_ENCRYPTION_KEY_FILE",
+			Usage:       "Path to a file with name:base64 AES-256 keys, one per line, used to encrypt cluster and node driver secrets. The first key encrypts new secrets",
+			Destination: &config.SecretsEncryptionKeyFile,
+		},
+		cli.StringFlag{
+			Name:        "secrets-encryption-kms-endpoint",
+			EnvVar:      "CATTLE_SECRETS_ENCRYPTION_KMS_ENDPOINT",
+			Usage:       "Endpoint of a Kubernetes KMS v1 plugin used to encrypt cluster and node driver secrets, e.g. unix:///var/run/kms.sock",
+			Destination: &config.SecretsEncryptionKMSEndpoint,
+		},
 		cli.StringFlag{
 			Name:        "profile-listen-address",
 			Value:       "127.0.0.1:6060",
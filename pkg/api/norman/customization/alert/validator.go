@@ -2,6 +2,8 @@
 
 import (
 	"fmt"
+	"regexp"
+	"time"
 
 	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 
@@ -75,3 +77,41 @@ func ProjectAlertRuleValidator(resquest *types.APIContext, schema *types.Schema,
 
 	return nil
 }
+
+// AlertGroupValidator checks the routes and the silences of cluster and project alert groups.
+func AlertGroupValidator(request *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
+	var field v32.CommonGroupField
+	if err := convert.ToObj(data, &field); err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, fmt.Sprintf("%v", err))
+	}
+
+	for i, route := range field.Routes {
+		for k, v := range route.MatchRegex {
+			if _, err := regexp.Compile(v); err != nil {
+				return httperror.NewFieldAPIError(httperror.InvalidFormat, "routes", fmt.Sprintf("invalid regex for label %s of route %d: %v", k, i, err))
+			}
+		}
+	}
+
+	names := map[string]bool{}
+	for _, silence := range field.Silences {
+		if names[silence.Name] {
+			return httperror.NewFieldAPIError(httperror.NotUnique, "silences", fmt.Sprintf("duplicate silence %s", silence.Name))
+		}
+		names[silence.Name] = true
+
+		startsAt, err := time.Parse(time.RFC3339, silence.StartsAt)
+		if err != nil {
+			return httperror.NewFieldAPIError(httperror.InvalidFormat, "silences", fmt.Sprintf("invalid start of silence %s: %v", silence.Name, err))
+		}
+		endsAt, err := time.Parse(time.RFC3339, silence.EndsAt)
+		if err != nil {
+			return httperror.NewFieldAPIError(httperror.InvalidFormat, "silences", fmt.Sprintf("invalid end of silence %s: %v", silence.Name, err))
+		}
+		if !endsAt.After(startsAt) {
+			return httperror.NewFieldAPIError(httperror.InvalidOption, "silences", fmt.Sprintf("silence %s must end after it starts", silence.Name))
+		}
+	}
+
+	return nil
+}
@@ -8,11 +8,14 @@
 	"github.com/rancher/norman/httperror"
 	"github.com/rancher/norman/types"
 	gaccess "github.com/rancher/rancher/pkg/api/norman/customization/globalnamespaceaccess"
+	"github.com/rancher/rancher/pkg/backuptarget"
 	"github.com/rancher/rancher/pkg/catalog/manager"
 	mgmtclient "github.com/rancher/rancher/pkg/client/generated/management/v3"
 	"github.com/rancher/rancher/pkg/clustermanager"
+	corev1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/user"
+	"github.com/rancher/rancher/pkg/utilization"
 	v1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 )
@@ -34,6 +37,10 @@ type ActionHandler struct {
 	CisBenchmarkVersionLister     v3.CisBenchmarkVersionLister
 	CisConfigClient               v3.CisConfigInterface
 	CisConfigLister               v3.CisConfigLister
+	SecretLister                  corev1.SecretLister
+	Snapshots                     *backuptarget.Snapshots
+	Utilization                   *utilization.Store
+	UserLister                    v3.UserLister
 }
 
 func (a ActionHandler) ClusterActionHandler(actionName string, action *types.Action, apiContext *types.APIContext) error {
@@ -68,6 +75,13 @@ func (a ActionHandler) ClusterActionHandler(actionName string, action *types.Act
 		return a.ImportYamlHandler(actionName, action, apiContext)
 	case v32.ClusterActionExportYaml:
 		return a.ExportYamlHandler(actionName, action, apiContext)
+	case v32.ClusterActionQueryUtilization:
+		return a.QueryUtilizationHandler(actionName, action, apiContext)
+	case v32.ClusterActionImportBundle:
+		if !canUpdateCluster() {
+			return httperror.NewAPIError(httperror.PermissionDenied, "can not access")
+		}
+		return a.ImportBundleHandler(actionName, action, apiContext)
 	case v32.ClusterActionViewMonitoring:
 		return a.viewMonitoring(actionName, action, apiContext)
 	case v32.ClusterActionEditMonitoring:
@@ -95,6 +109,11 @@ func (a ActionHandler) ClusterActionHandler(actionName string, action *types.Act
 			return httperror.NewAPIError(httperror.PermissionDenied, "can not restore etcd backup")
 		}
 		return a.RestoreFromEtcdBackupHandler(actionName, action, apiContext)
+	case v32.ClusterActionRestoreDryRun:
+		if !canUpdateCluster() {
+			return httperror.NewAPIError(httperror.PermissionDenied, "can not restore etcd backup")
+		}
+		return a.RestoreDryRunHandler(actionName, action, apiContext)
 	case v32.ClusterActionRotateCertificates:
 		if !canUpdateCluster() {
 			return httperror.NewAPIError(httperror.PermissionDenied, "can not rotate certificates")
@@ -0,0 +1,736 @@
+package cluster
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	"github.com/rancher/norman/api/access"
+	"github.com/rancher/norman/httperror"
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	clusterclient "github.com/rancher/rancher/pkg/client/generated/cluster/v3"
+	mgmtclient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	projectclient "github.com/rancher/rancher/pkg/client/generated/project/v3"
+	"github.com/rancher/rancher/pkg/generated/compose"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/ref"
+	schema "github.com/rancher/rancher/pkg/schemas/cluster.cattle.io/v3"
+	managementschema "github.com/rancher/rancher/pkg/schemas/management.cattle.io/v3"
+	projectschema "github.com/rancher/rancher/pkg/schemas/project.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/user"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	systemProjectLabel = "authz.management.cattle.io/system-project"
+	// names are only unique within their project or alert group, the keys of the bundle are prefixed with the key of
+	// the resource they belong to
+	bundleKeySeparator = ":"
+)
+
+// bundleRuntimeFields are the fields the API and the controllers set on the resources of a bundle, they are not
+// exported.
+var bundleRuntimeFields = []string{
+	"actions",
+	"appliedFiles",
+	"appliedSpec",
+	"appRevisionId",
+	"baseType",
+	"conditions",
+	"created",
+	"createdTS",
+	"creatorId",
+	"failedSpec",
+	"id",
+	"lastAppliedTemplate",
+	"links",
+	"monitoringStatus",
+	"multiClusterAppId",
+	"namespaceId",
+	"notes",
+	"ownerReferences",
+	"removed",
+	"state",
+	"status",
+	"transitioning",
+	"transitioningMessage",
+	"type",
+	"uuid",
+}
+
+// exportBundle returns the cluster along with its node pools, projects and their quotas, namespaces, role template
+// bindings, notifiers, alerts, loggings and apps. Resources are keyed by name and reference each other by key rather
+// than by ID, so that the bundle can be imported into a cluster of another Rancher. Role template bindings bind the
+// principals of their users and groups, as users and groups are local to a Rancher. Passwords are not exported.
+func (a ActionHandler) exportBundle(apiContext *types.APIContext, cluster *v3.Cluster) (*compose.Config, error) {
+	clusterKey := cluster.Spec.DisplayName
+	bundle := &compose.Config{}
+	bundle.Version = "v3"
+	c := mgmtclient.Cluster{}
+	if err := convert.ToObj(cluster.Spec, &c); err != nil {
+		return nil, err
+	}
+	bundle.Clusters = map[string]mgmtclient.Cluster{}
+	bundle.Clusters[clusterKey] = c
+
+	// if driver is rancherKubernetesEngine, add any nodePool if found
+	if cluster.Status.Driver == v32.ClusterDriverRKE {
+		nodepools, err := a.NodepoolGetter.NodePools(cluster.Name).List(v1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		bundle.NodePools = map[string]mgmtclient.NodePool{}
+		for _, nodepool := range nodepools.Items {
+			n := mgmtclient.NodePool{}
+			if err := convert.ToObj(nodepool.Spec, &n); err != nil {
+				return nil, err
+			}
+			n.ClusterID = clusterKey
+			namespace, id := ref.Parse(nodepool.Spec.NodeTemplateName)
+			nodeTemplate, err := a.NodeTemplateGetter.NodeTemplates(namespace).Get(id, v1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			n.NodeTemplateID = nodeTemplate.Spec.DisplayName
+			bundle.NodePools[nodepool.Name] = n
+		}
+	}
+
+	var projects []mgmtclient.Project
+	if err := listInNamespace(apiContext, &managementschema.Version, mgmtclient.ProjectType, cluster.Name, &projects); err != nil {
+		return nil, err
+	}
+	projectKeys := map[string]string{}
+	bundle.Projects = map[string]mgmtclient.Project{}
+	for _, project := range projects {
+		projectKeys[project.ID] = project.Name
+		project.ClusterID = clusterKey
+		bundle.Projects[project.Name] = project
+	}
+
+	apiContext.SubContext = map[string]string{
+		"/v3/schemas/cluster": cluster.Name,
+	}
+	var namespaces []clusterclient.Namespace
+	if err := access.List(apiContext, &schema.Version, clusterclient.NamespaceType, &types.QueryOptions{}, &namespaces); err != nil {
+		return nil, err
+	}
+	bundle.Namespaces = map[string]clusterclient.Namespace{}
+	for _, ns := range namespaces {
+		projectKey, ok := projectKeys[ns.ProjectID]
+		if !ok {
+			continue
+		}
+		ns.ProjectID = projectKey
+		bundle.Namespaces[ns.Name] = ns
+	}
+
+	var crtbs []mgmtclient.ClusterRoleTemplateBinding
+	var err error
+	if err = listInNamespace(apiContext, &managementschema.Version, mgmtclient.ClusterRoleTemplateBindingType, cluster.Name, &crtbs); err != nil {
+		return nil, err
+	}
+	bundle.ClusterRoleTemplateBindings = map[string]mgmtclient.ClusterRoleTemplateBinding{}
+	for _, crtb := range crtbs {
+		crtb.UserPrincipalID, err = a.userPrincipal(crtb.UserID, crtb.UserPrincipalID)
+		if err != nil {
+			return nil, err
+		}
+		if crtb.UserPrincipalID == "" && crtb.GroupPrincipalID == "" {
+			continue
+		}
+		crtb.UserID, crtb.GroupID = "", ""
+		crtb.ClusterID = clusterKey
+		bundle.ClusterRoleTemplateBindings[crtb.Name] = crtb
+	}
+
+	var notifiers []mgmtclient.Notifier
+	if err := listInNamespace(apiContext, &managementschema.Version, mgmtclient.NotifierType, cluster.Name, &notifiers); err != nil {
+		return nil, err
+	}
+	notifierKeys := map[string]string{}
+	bundle.Notifiers = map[string]mgmtclient.Notifier{}
+	for _, notifier := range notifiers {
+		notifierKeys[notifier.ID] = notifier.Name
+		notifier.ClusterID = clusterKey
+		bundle.Notifiers[notifier.Name] = notifier
+	}
+
+	var clusterAlertGroups []mgmtclient.ClusterAlertGroup
+	if err := listInNamespace(apiContext, &managementschema.Version, mgmtclient.ClusterAlertGroupType, cluster.Name, &clusterAlertGroups); err != nil {
+		return nil, err
+	}
+	groupKeys := map[string]string{}
+	bundle.ClusterAlertGroups = map[string]mgmtclient.ClusterAlertGroup{}
+	for _, group := range clusterAlertGroups {
+		groupKeys[group.ID] = group.Name
+		group.ClusterID = clusterKey
+		group.Recipients = recipientsByKey(group.Recipients, notifierKeys)
+		bundle.ClusterAlertGroups[group.Name] = group
+	}
+
+	var clusterAlertRules []mgmtclient.ClusterAlertRule
+	if err := listInNamespace(apiContext, &managementschema.Version, mgmtclient.ClusterAlertRuleType, cluster.Name, &clusterAlertRules); err != nil {
+		return nil, err
+	}
+	bundle.ClusterAlertRules = map[string]mgmtclient.ClusterAlertRule{}
+	for _, rule := range clusterAlertRules {
+		rule.ClusterID = clusterKey
+		rule.GroupID = groupKeys[rule.GroupID]
+		bundle.ClusterAlertRules[bundleKey(rule.GroupID, rule.Name)] = rule
+	}
+
+	var clusterLoggings []mgmtclient.ClusterLogging
+	if err := listInNamespace(apiContext, &managementschema.Version, mgmtclient.ClusterLoggingType, cluster.Name, &clusterLoggings); err != nil {
+		return nil, err
+	}
+	bundle.ClusterLoggings = map[string]mgmtclient.ClusterLogging{}
+	for _, logging := range clusterLoggings {
+		logging.ClusterID = clusterKey
+		bundle.ClusterLoggings[logging.Name] = logging
+	}
+
+	bundle.ProjectRoleTemplateBindings = map[string]mgmtclient.ProjectRoleTemplateBinding{}
+	bundle.ProjectAlertGroups = map[string]mgmtclient.ProjectAlertGroup{}
+	bundle.ProjectAlertRules = map[string]mgmtclient.ProjectAlertRule{}
+	bundle.ProjectLoggings = map[string]mgmtclient.ProjectLogging{}
+	bundle.Apps = map[string]projectclient.App{}
+	for _, project := range projects {
+		if err := a.exportProject(apiContext, bundle, project, notifierKeys); err != nil {
+			return nil, err
+		}
+	}
+
+	return bundle, nil
+}
+
+// exportProject adds the role template bindings, alerts, loggings and apps of the project to the bundle. Apps of the
+// system project are deployed by Rancher and apps of multi-cluster apps by their multi-cluster app, they are not
+// exported.
+func (a ActionHandler) exportProject(apiContext *types.APIContext, bundle *compose.Config, project mgmtclient.Project, notifierKeys map[string]string) error {
+	_, projectNamespace := ref.Parse(project.ID)
+	projectKey := project.Name
+
+	var prtbs []mgmtclient.ProjectRoleTemplateBinding
+	var err error
+	if err = listInNamespace(apiContext, &managementschema.Version, mgmtclient.ProjectRoleTemplateBindingType, projectNamespace, &prtbs); err != nil {
+		return err
+	}
+	for _, prtb := range prtbs {
+		prtb.UserPrincipalID, err = a.userPrincipal(prtb.UserID, prtb.UserPrincipalID)
+		if err != nil {
+			return err
+		}
+		if prtb.UserPrincipalID == "" && prtb.GroupPrincipalID == "" {
+			continue
+		}
+		prtb.UserID, prtb.GroupID = "", ""
+		prtb.ProjectID = projectKey
+		bundle.ProjectRoleTemplateBindings[bundleKey(projectKey, prtb.Name)] = prtb
+	}
+
+	var groups []mgmtclient.ProjectAlertGroup
+	if err := listInNamespace(apiContext, &managementschema.Version, mgmtclient.ProjectAlertGroupType, projectNamespace, &groups); err != nil {
+		return err
+	}
+	groupKeys := map[string]string{}
+	for _, group := range groups {
+		key := bundleKey(projectKey, group.Name)
+		groupKeys[group.ID] = key
+		group.ProjectID = projectKey
+		group.Recipients = recipientsByKey(group.Recipients, notifierKeys)
+		bundle.ProjectAlertGroups[key] = group
+	}
+
+	var rules []mgmtclient.ProjectAlertRule
+	if err := listInNamespace(apiContext, &managementschema.Version, mgmtclient.ProjectAlertRuleType, projectNamespace, &rules); err != nil {
+		return err
+	}
+	for _, rule := range rules {
+		rule.ProjectID = projectKey
+		rule.GroupID = groupKeys[rule.GroupID]
+		bundle.ProjectAlertRules[bundleKey(rule.GroupID, rule.Name)] = rule
+	}
+
+	var loggings []mgmtclient.ProjectLogging
+	if err := listInNamespace(apiContext, &managementschema.Version, mgmtclient.ProjectLoggingType, projectNamespace, &loggings); err != nil {
+		return err
+	}
+	for _, logging := range loggings {
+		logging.ProjectID = projectKey
+		bundle.ProjectLoggings[bundleKey(projectKey, logging.Name)] = logging
+	}
+
+	if project.Labels[systemProjectLabel] == "true" {
+		return nil
+	}
+	apiContext.SubContext = map[string]string{
+		"/v3/schemas/project": project.ID,
+	}
+	var apps []projectclient.App
+	if err := listInNamespace(apiContext, &projectschema.Version, projectclient.AppType, projectNamespace, &apps); err != nil {
+		return err
+	}
+	for _, app := range apps {
+		if app.ProjectID != project.ID || app.MultiClusterAppID != "" {
+			continue
+		}
+		app.ProjectID = projectKey
+		bundle.Apps[bundleKey(projectKey, app.Name)] = app
+	}
+	return nil
+}
+
+// userPrincipal returns the principal a binding of the user is exported with. The principal of an auth provider is
+// preferred over the local principal of the user, which only exists in this Rancher. Bindings of users that no longer
+// exist have no principal.
+func (a ActionHandler) userPrincipal(userID, principalID string) (string, error) {
+	if principalID != "" || userID == "" {
+		return principalID, nil
+	}
+	user, err := a.UserLister.Get("", userID)
+	if apierrors.IsNotFound(err) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	for _, id := range user.PrincipalIDs {
+		if !strings.HasPrefix(id, "local://") {
+			return id, nil
+		}
+	}
+	return "local://" + user.Name, nil
+}
+
+// encodeBundle encodes the bundle without the runtime fields of its resources, and without the labels and
+// annotations Rancher sets on them.
+func encodeBundle(bundle *compose.Config) (map[string]interface{}, error) {
+	m, err := convert.EncodeToMap(bundle)
+	if err != nil {
+		return nil, err
+	}
+	for _, collection := range m {
+		resources, ok := collection.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, resource := range resources {
+			data, ok := resource.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for _, field := range bundleRuntimeFields {
+				delete(data, field)
+			}
+			for _, field := range []string{"labels", "annotations"} {
+				values := convert.ToMapInterface(data[field])
+				for k := range values {
+					if strings.Contains(k, "cattle.io/") {
+						delete(values, k)
+					}
+				}
+				if len(values) == 0 {
+					delete(data, field)
+				}
+			}
+		}
+	}
+	return m, nil
+}
+
+func recipientsByKey(recipients []mgmtclient.Recipient, notifierKeys map[string]string) []mgmtclient.Recipient {
+	var result []mgmtclient.Recipient
+	for _, recipient := range recipients {
+		recipient.NotifierID = notifierKeys[recipient.NotifierID]
+		result = append(result, recipient)
+	}
+	return result
+}
+
+func bundleKey(parent, name string) string {
+	return parent + bundleKeySeparator + name
+}
+
+func listInNamespace(apiContext *types.APIContext, version *types.APIVersion, typeName, namespace string, into interface{}) error {
+	return access.List(apiContext, version, typeName, &types.QueryOptions{
+		Conditions: []*types.QueryCondition{
+			types.NewConditionFromString("namespaceId", types.ModifierEQ, namespace),
+		},
+	}, into)
+}
+
+// ImportBundleHandler imports the resources of a bundle exported from a cluster into this cluster, replacing the keys
+// the resources reference each other by with the IDs of the resources in this cluster. The cluster and node pools of
+// the bundle are not imported, the cluster the bundle is imported into takes their place. The passwords of the
+// notifiers are not part of the bundle, they are given as input. Resources of the same name that already exist are
+// skipped, so a bundle can be imported again once the errors it reported are fixed.
+func (a ActionHandler) ImportBundleHandler(actionName string, action *types.Action, apiContext *types.APIContext) error {
+	data, err := ioutil.ReadAll(apiContext.Request.Body)
+	if err != nil {
+		return errors.Wrap(err, "reading request body error")
+	}
+
+	input := mgmtclient.ImportBundleInput{}
+	if err = json.Unmarshal(data, &input); err != nil {
+		return errors.Wrap(err, "unmarshaling input error")
+	}
+	bundle := compose.Config{}
+	if err := yaml.Unmarshal([]byte(input.YAML), &bundle); err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, fmt.Sprintf("invalid bundle: %v", err))
+	}
+	m, err := convert.EncodeToMap(bundle)
+	if err != nil {
+		return err
+	}
+
+	i := &bundleImporter{
+		apiContext: apiContext,
+		clusterID:  apiContext.ID,
+		secrets:    input.Secrets,
+		users:      a.UserMgr,
+		ids:        map[string]map[string]string{},
+		existing:   map[string]map[string]string{},
+	}
+	for _, r := range bundleResources {
+		i.importCollection(r, convert.ToMapInterface(m[r.collection]))
+	}
+
+	rtn := map[string]interface{}{
+		"type":    "importBundleOutput",
+		"created": i.output.Created,
+		"skipped": i.output.Skipped,
+		"errors":  i.output.Errors,
+	}
+	if len(i.output.Errors) > 0 {
+		apiContext.WriteResponse(http.StatusBadRequest, rtn)
+	} else {
+		apiContext.WriteResponse(http.StatusOK, rtn)
+	}
+	return nil
+}
+
+type bundleResource struct {
+	// collection is the field of the resources in the bundle
+	collection string
+	version    *types.APIVersion
+	typeName   string
+	// scope is the field that, along with the name, identifies the resource in its namespace
+	scope string
+	// remap replaces the keys the resource references with IDs in the cluster, and returns the namespace of the
+	// resource
+	remap func(i *bundleImporter, data map[string]interface{}) (string, error)
+}
+
+// bundleResources are imported in order, resources are imported after the resources they reference.
+var bundleResources = []bundleResource{
+	{
+		collection: "projects",
+		version:    &managementschema.Version,
+		typeName:   mgmtclient.ProjectType,
+		remap:      (*bundleImporter).remapCluster,
+	},
+	{
+		collection: "namespaces",
+		version:    &schema.Version,
+		typeName:   clusterclient.NamespaceType,
+		remap: func(i *bundleImporter, data map[string]interface{}) (string, error) {
+			_, err := i.remapProject(data)
+			return "", err
+		},
+	},
+	{
+		collection: "clusterRoleTemplateBindings",
+		version:    &managementschema.Version,
+		typeName:   mgmtclient.ClusterRoleTemplateBindingType,
+		remap: func(i *bundleImporter, data map[string]interface{}) (string, error) {
+			if err := i.remapSubject(data); err != nil {
+				return "", err
+			}
+			return i.remapCluster(data)
+		},
+	},
+	{
+		collection: "projectRoleTemplateBindings",
+		version:    &managementschema.Version,
+		typeName:   mgmtclient.ProjectRoleTemplateBindingType,
+		remap: func(i *bundleImporter, data map[string]interface{}) (string, error) {
+			if err := i.remapSubject(data); err != nil {
+				return "", err
+			}
+			return i.remapProject(data)
+		},
+	},
+	{
+		collection: "notifiers",
+		version:    &managementschema.Version,
+		typeName:   mgmtclient.NotifierType,
+		remap: func(i *bundleImporter, data map[string]interface{}) (string, error) {
+			if err := i.remapSecrets(data); err != nil {
+				return "", err
+			}
+			return i.remapCluster(data)
+		},
+	},
+	{
+		collection: "clusterAlertGroups",
+		version:    &managementschema.Version,
+		typeName:   mgmtclient.ClusterAlertGroupType,
+		remap: func(i *bundleImporter, data map[string]interface{}) (string, error) {
+			if err := i.remapRecipients(data); err != nil {
+				return "", err
+			}
+			return i.remapCluster(data)
+		},
+	},
+	{
+		collection: "clusterAlertRules",
+		version:    &managementschema.Version,
+		typeName:   mgmtclient.ClusterAlertRuleType,
+		scope:      "groupId",
+		remap: func(i *bundleImporter, data map[string]interface{}) (string, error) {
+			if err := i.remapReference(data, "groupId", mgmtclient.ClusterAlertGroupType); err != nil {
+				return "", err
+			}
+			return i.remapCluster(data)
+		},
+	},
+	{
+		collection: "projectAlertGroups",
+		version:    &managementschema.Version,
+		typeName:   mgmtclient.ProjectAlertGroupType,
+		remap: func(i *bundleImporter, data map[string]interface{}) (string, error) {
+			if err := i.remapRecipients(data); err != nil {
+				return "", err
+			}
+			return i.remapProject(data)
+		},
+	},
+	{
+		collection: "projectAlertRules",
+		version:    &managementschema.Version,
+		typeName:   mgmtclient.ProjectAlertRuleType,
+		scope:      "groupId",
+		remap: func(i *bundleImporter, data map[string]interface{}) (string, error) {
+			if err := i.remapReference(data, "groupId", mgmtclient.ProjectAlertGroupType); err != nil {
+				return "", err
+			}
+			return i.remapProject(data)
+		},
+	},
+	{
+		collection: "clusterLoggings",
+		version:    &managementschema.Version,
+		typeName:   mgmtclient.ClusterLoggingType,
+		remap:      (*bundleImporter).remapCluster,
+	},
+	{
+		collection: "projectLoggings",
+		version:    &managementschema.Version,
+		typeName:   mgmtclient.ProjectLoggingType,
+		remap:      (*bundleImporter).remapProject,
+	},
+	{
+		collection: "apps",
+		version:    &projectschema.Version,
+		typeName:   projectclient.AppType,
+		remap:      (*bundleImporter).remapProject,
+	},
+}
+
+type bundleImporter struct {
+	apiContext *types.APIContext
+	clusterID  string
+	// secrets are the passwords of the notifiers, by notifier name and field path
+	secrets map[string]string
+	users   user.Manager
+	// ids maps the keys of the bundle to the IDs of the resources in the cluster, by type
+	ids map[string]map[string]string
+	// existing maps the names of the resources of a namespace to their IDs, by type and namespace
+	existing map[string]map[string]string
+	output   v32.ImportBundleOutput
+}
+
+func (i *bundleImporter) importCollection(r bundleResource, resources map[string]interface{}) {
+	var keys []string
+	for key := range resources {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		data := convert.ToMapInterface(resources[key])
+		if err := i.importResource(r, key, data); err != nil {
+			i.output.Errors = append(i.output.Errors, fmt.Sprintf("%s %s: %v", r.typeName, key, err))
+		}
+	}
+}
+
+func (i *bundleImporter) importResource(r bundleResource, key string, data map[string]interface{}) error {
+	name := convert.ToString(data["name"])
+	if name == "" {
+		name = key
+		data["name"] = name
+	}
+	namespace, err := r.remap(i, data)
+	if err != nil {
+		return err
+	}
+	if r.version.Path == projectschema.Version.Path {
+		i.apiContext.SubContext = map[string]string{
+			"/v3/schemas/project": convert.ToString(data["projectId"]),
+		}
+	} else {
+		i.apiContext.SubContext = map[string]string{
+			"/v3/schemas/cluster": i.clusterID,
+		}
+	}
+
+	existing, err := i.existingResources(r, namespace)
+	if err != nil {
+		return err
+	}
+	name = bundleKey(convert.ToString(data[r.scope]), name)
+	if id, ok := existing[name]; ok {
+		i.record(r.typeName, key, id)
+		i.output.Skipped = append(i.output.Skipped, r.typeName+" "+key)
+		return nil
+	}
+
+	created := map[string]interface{}{}
+	if err := access.Create(i.apiContext, r.version, r.typeName, data, &created); err != nil {
+		return err
+	}
+	id := convert.ToString(created["id"])
+	existing[name] = id
+	i.record(r.typeName, key, id)
+	i.output.Created = append(i.output.Created, r.typeName+" "+key)
+	return nil
+}
+
+// existingResources returns the IDs of the resources of the namespace, or of the cluster for namespaces, by name.
+func (i *bundleImporter) existingResources(r bundleResource, namespace string) (map[string]string, error) {
+	cacheKey := bundleKey(r.typeName, namespace)
+	if existing, ok := i.existing[cacheKey]; ok {
+		return existing, nil
+	}
+
+	var resources []map[string]interface{}
+	var err error
+	if namespace == "" {
+		err = access.List(i.apiContext, r.version, r.typeName, &types.QueryOptions{}, &resources)
+	} else {
+		err = listInNamespace(i.apiContext, r.version, r.typeName, namespace, &resources)
+	}
+	if err != nil {
+		return nil, err
+	}
+	existing := map[string]string{}
+	for _, resource := range resources {
+		name := bundleKey(convert.ToString(resource[r.scope]), convert.ToString(resource["name"]))
+		existing[name] = convert.ToString(resource["id"])
+	}
+	i.existing[cacheKey] = existing
+	return existing, nil
+}
+
+func (i *bundleImporter) record(typeName, key, id string) {
+	if i.ids[typeName] == nil {
+		i.ids[typeName] = map[string]string{}
+	}
+	i.ids[typeName][key] = id
+}
+
+func (i *bundleImporter) remapCluster(data map[string]interface{}) (string, error) {
+	data["clusterId"] = i.clusterID
+	return i.clusterID, nil
+}
+
+func (i *bundleImporter) remapProject(data map[string]interface{}) (string, error) {
+	if err := i.remapReference(data, "projectId", mgmtclient.ProjectType); err != nil {
+		return "", err
+	}
+	_, projectNamespace := ref.Parse(convert.ToString(data["projectId"]))
+	return projectNamespace, nil
+}
+
+func (i *bundleImporter) remapRecipients(data map[string]interface{}) error {
+	recipients, _ := data["recipients"].([]interface{})
+	for _, recipient := range recipients {
+		if err := i.remapReference(convert.ToMapInterface(recipient), "notifierId", mgmtclient.NotifierType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// remapSubject binds the principals of the binding. The IDs of users and groups are local to the Rancher the bundle was
+// exported from, and local principals only exist in this Rancher if the bundle was exported from it.
+func (i *bundleImporter) remapSubject(data map[string]interface{}) error {
+	delete(data, "userId")
+	delete(data, "groupId")
+	principalID := convert.ToString(data["userPrincipalId"])
+	if principalID == "" && convert.ToString(data["groupPrincipalId"]) == "" {
+		return fmt.Errorf("binding has no principal to bind")
+	}
+	if !strings.HasPrefix(principalID, "local://") {
+		return nil
+	}
+	user, err := i.users.GetUserByPrincipalID(principalID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return fmt.Errorf("local user %s does not exist in this Rancher", principalID)
+	}
+	return nil
+}
+
+// remapSecrets sets the password fields of the configuration of the notifier from the secrets given as input. The
+// passwords are not exported, a notifier whose configuration requires one is not imported without it.
+func (i *bundleImporter) remapSecrets(data map[string]interface{}) error {
+	notifierSchema := i.apiContext.Schemas.Schema(&managementschema.Version, mgmtclient.NotifierType)
+	if notifierSchema == nil {
+		return fmt.Errorf("schema %s not found", mgmtclient.NotifierType)
+	}
+	name := convert.ToString(data["name"])
+	for configField, config := range data {
+		configData, ok := config.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		configSchema := i.apiContext.Schemas.Schema(&managementschema.Version, notifierSchema.ResourceFields[configField].Type)
+		if configSchema == nil {
+			continue
+		}
+		for fieldName, field := range configSchema.ResourceFields {
+			if field.Type != "password" {
+				continue
+			}
+			secret, ok := i.secrets[bundleKey(name, configField+"."+fieldName)]
+			if ok {
+				configData[fieldName] = secret
+			} else if field.Required {
+				return fmt.Errorf("secret %s is required", bundleKey(name, configField+"."+fieldName))
+			}
+		}
+	}
+	return nil
+}
+
+func (i *bundleImporter) remapReference(data map[string]interface{}, field, typeName string) error {
+	key := convert.ToString(data[field])
+	id, ok := i.ids[typeName][key]
+	if !ok {
+		return fmt.Errorf("%s %s referenced by %s was not imported", typeName, key, field)
+	}
+	data[field] = id
+	return nil
+}
@@ -0,0 +1,209 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/rancher/norman/types"
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	mgmtclient "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	projectclient "github.com/rancher/rancher/pkg/client/generated/project/v3"
+	"github.com/rancher/rancher/pkg/generated/compose"
+	"github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3/fakes"
+	managementschema "github.com/rancher/rancher/pkg/schemas/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/user"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestEncodeBundle(t *testing.T) {
+	assert := assert.New(t)
+
+	bundle := &compose.Config{
+		Projects: map[string]mgmtclient.Project{
+			"Default": {
+				Resource: types.Resource{
+					ID:   "c-abcde:p-abcde",
+					Type: mgmtclient.ProjectType,
+				},
+				Name:      "Default",
+				ClusterID: "test",
+				State:     "active",
+				Labels: map[string]string{
+					"authz.management.cattle.io/default-project": "true",
+				},
+				Annotations: map[string]string{
+					"lifecycle.cattle.io/create.mgmt-project-rbac-remove": "true",
+					"team": "payments",
+				},
+			},
+		},
+		Apps: map[string]projectclient.App{
+			"Default:wordpress": {
+				Name:          "wordpress",
+				ProjectID:     "Default",
+				AppRevisionID: "p-abcde:apprevision-abcde",
+				Answers: map[string]string{
+					"replicas": "2",
+				},
+			},
+		},
+	}
+
+	m, err := encodeBundle(bundle)
+	assert.Nil(err)
+	assert.Equal(map[string]interface{}{
+		"name":      "Default",
+		"clusterId": "test",
+		"annotations": map[string]interface{}{
+			"team": "payments",
+		},
+	}, m["projects"].(map[string]interface{})["Default"])
+	assert.Equal(map[string]interface{}{
+		"name":      "wordpress",
+		"projectId": "Default",
+		"answers": map[string]interface{}{
+			"replicas": "2",
+		},
+	}, m["apps"].(map[string]interface{})["Default:wordpress"])
+}
+
+func TestBundleImporterRemap(t *testing.T) {
+	assert := assert.New(t)
+
+	i := &bundleImporter{
+		clusterID: "c-fghij",
+		ids: map[string]map[string]string{
+			mgmtclient.ProjectType: {
+				"Default": "c-fghij:p-fghij",
+			},
+			mgmtclient.NotifierType: {
+				"slack": "c-fghij:n-fghij",
+			},
+		},
+	}
+
+	group := map[string]interface{}{
+		"name":      "pods",
+		"projectId": "Default",
+		"recipients": []interface{}{
+			map[string]interface{}{"notifierId": "slack"},
+		},
+	}
+	assert.Nil(i.remapRecipients(group))
+	namespace, err := i.remapProject(group)
+	assert.Nil(err)
+	assert.Equal("p-fghij", namespace)
+	assert.Equal(map[string]interface{}{
+		"name":      "pods",
+		"projectId": "c-fghij:p-fghij",
+		"recipients": []interface{}{
+			map[string]interface{}{"notifierId": "c-fghij:n-fghij"},
+		},
+	}, group)
+
+	notifier := map[string]interface{}{"name": "slack", "clusterId": "test"}
+	namespace, err = i.remapCluster(notifier)
+	assert.Nil(err)
+	assert.Equal("c-fghij", namespace)
+	assert.Equal("c-fghij", notifier["clusterId"])
+
+	_, err = i.remapProject(map[string]interface{}{"projectId": "Production"})
+	assert.NotNil(err)
+}
+
+type fakeUserManager struct {
+	user.Manager
+	users map[string]*v3.User
+}
+
+func (m *fakeUserManager) GetUserByPrincipalID(principalName string) (*v3.User, error) {
+	return m.users[principalName], nil
+}
+
+func TestUserPrincipal(t *testing.T) {
+	assert := assert.New(t)
+
+	users := map[string]*v3.User{
+		"u-local":  {ObjectMeta: metav1.ObjectMeta{Name: "u-local"}, PrincipalIDs: []string{"local://u-local"}},
+		"u-github": {ObjectMeta: metav1.ObjectMeta{Name: "u-github"}, PrincipalIDs: []string{"local://u-github", "github_user://1234"}},
+	}
+	a := ActionHandler{
+		UserLister: &fakes.UserListerMock{
+			GetFunc: func(namespace string, name string) (*v3.User, error) {
+				if u, ok := users[name]; ok {
+					return u, nil
+				}
+				return nil, apierrors.NewNotFound(schema.GroupResource{}, name)
+			},
+		},
+	}
+
+	testCases := []struct {
+		userID      string
+		principalID string
+		expected    string
+	}{
+		{"u-github", "", "github_user://1234"},
+		{"u-local", "", "local://u-local"},
+		{"u-github", "local://u-github", "local://u-github"},
+		{"u-deleted", "", ""},
+		{"", "", ""},
+	}
+	for _, tc := range testCases {
+		principalID, err := a.userPrincipal(tc.userID, tc.principalID)
+		assert.Nil(err, tc.userID)
+		assert.Equal(tc.expected, principalID, tc.userID)
+	}
+}
+
+func TestBundleImporterRemapSubject(t *testing.T) {
+	assert := assert.New(t)
+
+	i := &bundleImporter{
+		users: &fakeUserManager{users: map[string]*v3.User{
+			"local://u-abcde": {ObjectMeta: metav1.ObjectMeta{Name: "u-abcde"}},
+		}},
+	}
+
+	binding := map[string]interface{}{"userId": "u-fghij", "userPrincipalId": "github_user://1234"}
+	assert.Nil(i.remapSubject(binding))
+	assert.Equal(map[string]interface{}{"userPrincipalId": "github_user://1234"}, binding)
+
+	assert.Nil(i.remapSubject(map[string]interface{}{"groupId": "g-abcde", "groupPrincipalId": "github_org://5678"}))
+	assert.Nil(i.remapSubject(map[string]interface{}{"userPrincipalId": "local://u-abcde"}))
+	assert.NotNil(i.remapSubject(map[string]interface{}{"userPrincipalId": "local://u-fghij"}))
+	assert.NotNil(i.remapSubject(map[string]interface{}{"userId": "u-fghij"}))
+}
+
+func TestBundleImporterRemapSecrets(t *testing.T) {
+	assert := assert.New(t)
+
+	i := &bundleImporter{
+		apiContext: &types.APIContext{Schemas: managementschema.Schemas},
+		secrets: map[string]string{
+			"ops:wechatConfig.secret": "wechat-secret",
+		},
+	}
+
+	wechat := map[string]interface{}{
+		"name":         "ops",
+		"wechatConfig": map[string]interface{}{"corp": "corp", "agent": "agent"},
+	}
+	assert.Nil(i.remapSecrets(wechat))
+	assert.Equal("wechat-secret", wechat["wechatConfig"].(map[string]interface{})["secret"])
+
+	telegram := map[string]interface{}{
+		"name":           "chat",
+		"telegramConfig": map[string]interface{}{"defaultRecipient": "-100"},
+	}
+	assert.NotNil(i.remapSecrets(telegram))
+
+	smtp := map[string]interface{}{
+		"name":       "mail",
+		"smtpConfig": map[string]interface{}{"host": "smtp.example.com", "username": "rancher"},
+	}
+	assert.Nil(i.remapSecrets(smtp))
+	assert.Nil(smtp["smtpConfig"].(map[string]interface{})["password"])
+}
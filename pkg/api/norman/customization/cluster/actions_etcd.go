@@ -13,6 +13,7 @@
 	"github.com/rancher/norman/api/access"
 	"github.com/rancher/norman/httperror"
 	"github.com/rancher/norman/types"
+	"github.com/rancher/rancher/pkg/backuptarget"
 	client "github.com/rancher/rancher/pkg/client/generated/management/v3"
 	"github.com/rancher/rancher/pkg/controllers/management/etcdbackup"
 	mgmtv3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
@@ -116,6 +117,14 @@ func (a ActionHandler) RestoreFromEtcdBackupHandler(actionName string, action *t
 				input.EtcdBackupID))
 	}
 
+	if backup.Target != nil && backup.Target.Encryption != nil {
+		// the snapshot is decrypted by the provisioner, the key must still exist for the restore to succeed
+		if _, err := backuptarget.EncryptionKey(a.SecretLister, backup.Target.Encryption); err != nil {
+			return httperror.NewAPIError(httperror.InvalidState,
+				fmt.Sprintf("unable to decrypt backup %s: %v", input.EtcdBackupID, err))
+		}
+	}
+
 	if input.RestoreRkeConfig != "" && backup.Status.ClusterObject == "" {
 		// attempting to restore rke config and the backup does not contain data, probably pre 2.4 backup
 		return httperror.NewAPIError(httperror.MethodNotAllowed,
@@ -150,3 +159,63 @@ func (a ActionHandler) RestoreFromEtcdBackupHandler(actionName string, action *t
 	apiContext.WriteResponse(http.StatusCreated, response)
 	return nil
 }
+
+// RestoreDryRunHandler runs the checks of a restore against the snapshot of the backup without restoring it: the
+// snapshot is fetched from where it is stored, checked against its checksum and its etcd database is opened.
+func (a ActionHandler) RestoreDryRunHandler(actionName string, action *types.Action, apiContext *types.APIContext) error {
+	rtn := map[string]interface{}{
+		"type":     "restoreDryRunOutput",
+		"verified": false,
+	}
+
+	data, err := ioutil.ReadAll(apiContext.Request.Body)
+	if err != nil {
+		rtn["message"] = "reading request body error"
+		apiContext.WriteResponse(http.StatusInternalServerError, rtn)
+		return errors.Wrap(err, "failed to read request body")
+	}
+
+	input := client.RestoreDryRunInput{}
+	if err = json.Unmarshal(data, &input); err != nil {
+		rtn["message"] = "failed to parse request content"
+		apiContext.WriteResponse(http.StatusBadRequest, rtn)
+		return errors.Wrap(err, "unmarshaling input error")
+	}
+	// checking access
+	var mgmtCluster client.Cluster
+	if err := access.ByID(apiContext, apiContext.Version, apiContext.Type, apiContext.ID, &mgmtCluster); err != nil {
+		rtn["message"] = "nonexistent Cluster"
+		apiContext.WriteResponse(http.StatusBadRequest, rtn)
+		return errors.Wrapf(err, "failed to get Cluster by ID %s", apiContext.ID)
+	}
+
+	ns, name := ref.Parse(input.EtcdBackupID)
+	if ns == "" || name == "" {
+		return httperror.NewAPIError(httperror.InvalidFormat, fmt.Sprintf("invalid input id %s", input.EtcdBackupID))
+	}
+	backup, err := a.BackupClient.GetNamespaced(ns, name, v1.GetOptions{})
+	if err != nil {
+		rtn["message"] = "error getting backup config"
+		apiContext.WriteResponse(http.StatusInternalServerError, rtn)
+		return errors.Wrapf(err, "failed to get backup config by ID %s", input.EtcdBackupID)
+	}
+	if backup.Spec.ClusterID != apiContext.ID {
+		return httperror.NewAPIError(httperror.InvalidOption,
+			fmt.Sprintf("backup %s does not belong to cluster %s", input.EtcdBackupID, apiContext.ID))
+	}
+
+	info, err := a.Snapshots.Verify(apiContext.Request.Context(), apiContext.ID, backup)
+	if info != nil {
+		rtn["checksum"] = info.Checksum
+		rtn["size"] = info.Size
+		rtn["keyCount"] = info.KeyCount
+	}
+	if err != nil {
+		rtn["message"] = err.Error()
+	} else {
+		rtn["verified"] = true
+		rtn["message"] = fmt.Sprintf("snapshot matches its checksum and holds %d keys", info.KeyCount)
+	}
+	apiContext.WriteResponse(http.StatusOK, rtn)
+	return nil
+}
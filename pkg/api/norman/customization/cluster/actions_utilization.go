@@ -0,0 +1,109 @@
+package cluster
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rancher/norman/api/access"
+	"github.com/rancher/norman/httperror"
+	"github.com/rancher/norman/types"
+	client "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	"github.com/rancher/rancher/pkg/ref"
+	"github.com/rancher/rancher/pkg/utilization"
+)
+
+// QueryUtilizationHandler returns the utilization history of the cluster, or of one of its node pools or projects, for
+// capacity planning.
+func (a ActionHandler) QueryUtilizationHandler(actionName string, action *types.Action, apiContext *types.APIContext) error {
+	data, err := ioutil.ReadAll(apiContext.Request.Body)
+	if err != nil {
+		return errors.Wrap(err, "failed to read request body")
+	}
+	input := client.ClusterUtilizationInput{}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &input); err != nil {
+			return httperror.NewAPIError(httperror.InvalidBodyContent, fmt.Sprintf("failed to parse request content: %v", err))
+		}
+	}
+
+	// checking access
+	var mgmtCluster client.Cluster
+	if err := access.ByID(apiContext, apiContext.Version, apiContext.Type, apiContext.ID, &mgmtCluster); err != nil {
+		return errors.Wrapf(err, "failed to get Cluster by ID %s", apiContext.ID)
+	}
+
+	kind, name := utilization.KindCluster, apiContext.ID
+	switch {
+	case input.NodePoolID != "" && input.ProjectID != "":
+		return httperror.NewAPIError(httperror.InvalidOption, "only one of nodePoolId and projectId can be set")
+	case input.NodePoolID != "":
+		if kind, name, err = a.utilizationObject(apiContext, client.NodePoolType, utilization.KindNodePool, input.NodePoolID); err != nil {
+			return err
+		}
+	case input.ProjectID != "":
+		if kind, name, err = a.utilizationObject(apiContext, client.ProjectType, utilization.KindProject, input.ProjectID); err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+	to := now
+	from := now.Add(-24 * time.Hour)
+	if input.From != "" {
+		if from, err = time.Parse(time.RFC3339, input.From); err != nil {
+			return httperror.NewFieldAPIError(httperror.InvalidFormat, client.ClusterUtilizationInputFieldFrom, err.Error())
+		}
+	}
+	if input.To != "" {
+		if to, err = time.Parse(time.RFC3339, input.To); err != nil {
+			return httperror.NewFieldAPIError(httperror.InvalidFormat, client.ClusterUtilizationInputFieldTo, err.Error())
+		}
+	}
+	if !from.Before(to) {
+		return httperror.NewAPIError(httperror.InvalidOption, "from must be before to")
+	}
+
+	series, err := a.Utilization.Get(apiContext.ID, kind, name)
+	if err != nil {
+		return err
+	}
+	resolution, points, err := series.Query(from, to, input.Resolution, now)
+	if err != nil {
+		return httperror.NewFieldAPIError(httperror.InvalidOption, client.ClusterUtilizationInputFieldResolution, err.Error())
+	}
+
+	output := client.ClusterUtilizationOutput{
+		Resolution: resolution,
+		Points:     []client.UtilizationPoint{},
+	}
+	for _, p := range points {
+		output.Points = append(output.Points, client.UtilizationPoint{
+			Timestamp: p.Time.Format(time.RFC3339),
+			Average:   p.Average,
+			Max:       p.Max,
+		})
+	}
+	apiContext.WriteResponse(http.StatusOK, map[string]interface{}{
+		"type":       "clusterUtilizationOutput",
+		"resolution": output.Resolution,
+		"points":     output.Points,
+	})
+	return nil
+}
+
+// utilizationObject checks that the node pool or the project is one of the cluster the user can access.
+func (a ActionHandler) utilizationObject(apiContext *types.APIContext, schemaType, kind, id string) (string, string, error) {
+	clusterName, name := ref.Parse(id)
+	if clusterName != apiContext.ID || name == "" {
+		return "", "", httperror.NewAPIError(httperror.InvalidOption, fmt.Sprintf("%s does not belong to cluster %s", id, apiContext.ID))
+	}
+	obj := map[string]interface{}{}
+	if err := access.ByID(apiContext, apiContext.Version, schemaType, id, &obj); err != nil {
+		return "", "", err
+	}
+	return kind, name, nil
+}
@@ -60,12 +60,15 @@ func (f *Formatter) Formatter(request *types.APIContext, resource *types.RawReso
 	resource.Links["shell"] = shellLink
 	resource.AddAction(request, v32.ClusterActionGenerateKubeconfig)
 	resource.AddAction(request, v32.ClusterActionImportYaml)
+	resource.AddAction(request, v32.ClusterActionExportYaml)
+	resource.AddAction(request, v32.ClusterActionImportBundle)
+	resource.AddAction(request, v32.ClusterActionQueryUtilization)
 	if _, ok := resource.Values["rancherKubernetesEngineConfig"]; ok {
-		resource.AddAction(request, v32.ClusterActionExportYaml)
 		resource.AddAction(request, v32.ClusterActionRotateCertificates)
 		if _, ok := values.GetValue(resource.Values, "rancherKubernetesEngineConfig", "services", "etcd", "backupConfig"); ok {
 			resource.AddAction(request, v32.ClusterActionBackupEtcd)
 			resource.AddAction(request, v32.ClusterActionRestoreFromEtcdBackup)
+			resource.AddAction(request, v32.ClusterActionRestoreDryRun)
 		}
 		isActiveCluster := false
 		if resource.Values["state"] == "active" {
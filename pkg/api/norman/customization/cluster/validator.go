@@ -1,9 +1,12 @@
 package cluster
 
 import (
+	"crypto/x509"
 	"fmt"
 	"net/http"
+	"net/url"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
@@ -14,16 +17,20 @@
 	"github.com/rancher/norman/types/convert"
 	gaccess "github.com/rancher/rancher/pkg/api/norman/customization/globalnamespaceaccess"
 	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/backuptarget"
 	mgmtclient "github.com/rancher/rancher/pkg/client/generated/management/v3"
 	"github.com/rancher/rancher/pkg/controllers/management/k3sbasedupgrade"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/cis"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/kontainer-engine/service"
 	"github.com/rancher/rancher/pkg/namespace"
+	"github.com/rancher/rancher/pkg/project"
+	"github.com/rancher/rancher/pkg/resourcequota"
 	mgmtSchema "github.com/rancher/rancher/pkg/schemas/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/settings"
 	"github.com/robfig/cron"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 type Validator struct {
@@ -38,6 +45,7 @@ type Validator struct {
 	CisConfigLister               v3.CisConfigLister
 	CisBenchmarkVersionClient     v3.CisBenchmarkVersionInterface
 	CisBenchmarkVersionLister     v3.CisBenchmarkVersionLister
+	ProjectLister                 v3.ProjectLister
 }
 
 func (v *Validator) Validator(request *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
@@ -71,6 +79,26 @@ func (v *Validator) Validator(request *types.APIContext, schema *types.Schema, d
 		return err
 	}
 
+	if err := validateEtcdBackupTarget(&clusterSpec); err != nil {
+		return err
+	}
+
+	if err := validateEtcdBackupRetention(&clusterSpec); err != nil {
+		return err
+	}
+
+	if err := v.validateResourceQuota(request, &clusterSpec); err != nil {
+		return err
+	}
+
+	if err := validateNodeDrainPolicies(&clusterSpec); err != nil {
+		return err
+	}
+
+	if err := validateHealthProbes(&clusterSpec); err != nil {
+		return err
+	}
+
 	if err := v.validateEKSConfig(request, data, &clusterSpec); err != nil {
 		return err
 	}
@@ -143,6 +171,169 @@ func validateScheduledClusterScan(spec *mgmtclient.Cluster) error {
 	return nil
 }
 
+func validateEtcdBackupTarget(spec *v32.ClusterSpec) error {
+	if spec.EtcdBackupTarget == nil {
+		return nil
+	}
+	rkeConfig := spec.RancherKubernetesEngineConfig
+	if rkeConfig == nil || rkeConfig.Services.Etcd.BackupConfig == nil {
+		return httperror.NewFieldAPIError(httperror.InvalidState, "EtcdBackupTarget", "Can only set an etcd backup target for RKE clusters with an etcd backup config")
+	}
+	if rkeConfig.Services.Etcd.BackupConfig.S3BackupConfig != nil {
+		return httperror.NewFieldAPIError(httperror.InvalidOption, "EtcdBackupTarget", "Can not set both an etcd backup target and an S3 backup config")
+	}
+	if err := backuptarget.Validate(spec.EtcdBackupTarget); err != nil {
+		return httperror.NewFieldAPIError(httperror.InvalidOption, "EtcdBackupTarget", err.Error())
+	}
+	return nil
+}
+
+func validateEtcdBackupRetention(spec *v32.ClusterSpec) error {
+	retention := spec.EtcdBackupRetention
+	if retention == nil {
+		return nil
+	}
+	rkeConfig := spec.RancherKubernetesEngineConfig
+	if rkeConfig == nil || rkeConfig.Services.Etcd.BackupConfig == nil {
+		return httperror.NewFieldAPIError(httperror.InvalidState, "EtcdBackupRetention", "Can only set an etcd backup retention for RKE clusters with an etcd backup config")
+	}
+	if retention.Hourly < 0 || retention.Daily < 0 || retention.Weekly < 0 || retention.Monthly < 0 || retention.Manual < 0 {
+		return httperror.NewFieldAPIError(httperror.MinLimitExceeded, "EtcdBackupRetention", "Retention counts can not be negative")
+	}
+	// an empty policy would remove every recurring backup
+	if retention.Hourly+retention.Daily+retention.Weekly+retention.Monthly == 0 {
+		return httperror.NewFieldAPIError(httperror.InvalidOption, "EtcdBackupRetention", "Etcd backup retention must keep at least one hourly, daily, weekly or monthly backup")
+	}
+	return nil
+}
+
+func validateNodeDrainPolicies(spec *v32.ClusterSpec) error {
+	names := map[string]bool{}
+	for _, policy := range spec.NodeDrainPolicies {
+		if policy.Name == "" {
+			return httperror.NewFieldAPIError(httperror.MissingRequired, "nodeDrainPolicies", "Drain policy name is required")
+		}
+		if names[policy.Name] {
+			return httperror.NewFieldAPIError(httperror.NotUnique, "nodeDrainPolicies", fmt.Sprintf("Drain policy %s is defined more than once", policy.Name))
+		}
+		names[policy.Name] = true
+		if policy.MaxWaitSeconds < 0 {
+			return httperror.NewFieldAPIError(httperror.MinLimitExceeded, "nodeDrainPolicies", fmt.Sprintf("Drain policy %s max wait can not be negative", policy.Name))
+		}
+		hooks := append(append([]v32.NodeDrainHook{}, policy.PreDrainHooks...), policy.PostDrainHooks...)
+		for _, hook := range hooks {
+			if err := validateNodeDrainHook(hook); err != nil {
+				return httperror.NewFieldAPIError(httperror.InvalidOption, "nodeDrainPolicies", fmt.Sprintf("Drain policy %s: %v", policy.Name, err))
+			}
+		}
+	}
+	if spec.DefaultNodeDrainPolicy != "" && !names[spec.DefaultNodeDrainPolicy] {
+		return httperror.NewFieldAPIError(httperror.InvalidReference, "defaultNodeDrainPolicy", fmt.Sprintf("Drain policy %s is not defined", spec.DefaultNodeDrainPolicy))
+	}
+	return nil
+}
+
+func validateNodeDrainHook(hook v32.NodeDrainHook) error {
+	if hook.Name == "" {
+		return fmt.Errorf("hook name is required")
+	}
+	u, err := url.Parse(hook.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("hook %s URL must be an http or https URL", hook.Name)
+	}
+	if hook.CACerts != "" && !x509.NewCertPool().AppendCertsFromPEM([]byte(hook.CACerts)) {
+		return fmt.Errorf("hook %s CA certificates are invalid", hook.Name)
+	}
+	if hook.TimeoutSeconds < 0 {
+		return fmt.Errorf("hook %s timeout can not be negative", hook.Name)
+	}
+	return nil
+}
+
+func validateHealthProbes(spec *v32.ClusterSpec) error {
+	names := map[string]bool{}
+	for _, probe := range spec.HealthProbes {
+		if probe.Name == "" {
+			return httperror.NewFieldAPIError(httperror.MissingRequired, "healthProbes", "Health probe name is required")
+		}
+		if names[probe.Name] {
+			return httperror.NewFieldAPIError(httperror.NotUnique, "healthProbes", fmt.Sprintf("Health probe %s is defined more than once", probe.Name))
+		}
+		names[probe.Name] = true
+
+		checks := 0
+		if probe.DNS != nil {
+			checks++
+			if probe.DNS.Hostname == "" {
+				return httperror.NewFieldAPIError(httperror.MissingRequired, "healthProbes", fmt.Sprintf("Health probe %s: hostname is required", probe.Name))
+			}
+		}
+		if probe.HTTP != nil {
+			checks++
+			u, err := url.Parse(probe.HTTP.URL)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return httperror.NewFieldAPIError(httperror.InvalidOption, "healthProbes", fmt.Sprintf("Health probe %s: URL must be an http or https URL", probe.Name))
+			}
+		}
+		if probe.PodToPod != nil {
+			checks++
+		}
+		if checks != 1 {
+			return httperror.NewFieldAPIError(httperror.InvalidOption, "healthProbes", fmt.Sprintf("Health probe %s must set exactly one of dns, http and podToPod", probe.Name))
+		}
+	}
+	return nil
+}
+
+// validateResourceQuota checks that the quota pool of the cluster still holds the quota of its projects, which all need
+// a quota but the system project.
+func (v *Validator) validateResourceQuota(request *types.APIContext, spec *v32.ClusterSpec) error {
+	clusterQuota := spec.ResourceQuota
+	if clusterQuota == nil {
+		return nil
+	}
+	if err := resourcequota.ValidateLimit(&clusterQuota.Limit); err != nil {
+		return httperror.NewFieldAPIError(httperror.InvalidOption, "resourceQuota", err.Error())
+	}
+	if err := resourcequota.ValidateOvercommitRatio(clusterQuota.OvercommitRatio); err != nil {
+		return httperror.NewFieldAPIError(httperror.InvalidOption, "resourceQuota", err.Error())
+	}
+	if request.ID == "" {
+		return nil
+	}
+
+	clusterLimit, err := resourcequota.ApplyOvercommit(&clusterQuota.Limit, clusterQuota.OvercommitRatio)
+	if err != nil {
+		return err
+	}
+	projects, err := v.ProjectLister.List(request.ID, labels.NewSelector())
+	if err != nil {
+		return err
+	}
+	var projectLimits []*v32.ResourceQuotaLimit
+	var withoutQuota []string
+	systemProject := labels.Set(project.SystemProjectLabel).AsSelector()
+	for _, p := range projects {
+		if p.Spec.ResourceQuota != nil {
+			projectLimits = append(projectLimits, &p.Spec.ResourceQuota.Limit)
+		} else if !systemProject.Matches(labels.Set(p.Labels)) {
+			withoutQuota = append(withoutQuota, p.Spec.DisplayName)
+		}
+	}
+	if len(withoutQuota) > 0 {
+		sort.Strings(withoutQuota)
+		return httperror.NewFieldAPIError(httperror.InvalidOption, "resourceQuota", fmt.Sprintf("requires a resource quota on projects: %s", strings.Join(withoutQuota, ", ")))
+	}
+	isFit, msg, err := resourcequota.IsQuotaFit(&v32.ResourceQuotaLimit{}, projectLimits, clusterLimit)
+	if err != nil {
+		return err
+	}
+	if !isFit {
+		return httperror.NewFieldAPIError(httperror.MaxLimitExceeded, "resourceQuota", fmt.Sprintf("is below the quota of the projects on fields: %s", msg))
+	}
+	return nil
+}
+
 func (v *Validator) validateLocalClusterAuthEndpoint(request *types.APIContext, spec *v32.ClusterSpec) error {
 	if !spec.LocalClusterAuthEndpoint.Enabled {
 		return nil
@@ -4,6 +4,7 @@
 	"encoding/json"
 	"testing"
 
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	mgmtclient "github.com/rancher/rancher/pkg/client/generated/management/v3"
 	"github.com/sirupsen/logrus"
 )
@@ -191,3 +192,72 @@ func TestClusterTemplateValidateScheduledClusterScan(t *testing.T) {
 		t.FailNow()
 	}
 }
+
+func TestValidateNodeDrainPolicies(t *testing.T) {
+	hook := v32.NodeDrainHook{Name: "lb", URL: "https://lb.example.com/deregister"}
+	spec := v32.ClusterSpec{
+		NodeDrainPolicies: []v32.NodeDrainPolicy{
+			{Name: "pdb", MaxWaitSeconds: 600, PreDrainHooks: []v32.NodeDrainHook{hook}},
+			{Name: "fast", IgnorePodDisruptionBudgets: true},
+		},
+		DefaultNodeDrainPolicy: "pdb",
+	}
+	if err := validateNodeDrainPolicies(&spec); err != nil {
+		logrus.Errorf("not expecting error, got: %v", err)
+		t.FailNow()
+	}
+
+	spec.DefaultNodeDrainPolicy = "missing"
+	if err := validateNodeDrainPolicies(&spec); err == nil {
+		logrus.Errorf("expected error for undefined default policy")
+		t.FailNow()
+	}
+	spec.DefaultNodeDrainPolicy = ""
+
+	spec.NodeDrainPolicies[1].Name = "pdb"
+	if err := validateNodeDrainPolicies(&spec); err == nil {
+		logrus.Errorf("expected error for duplicate policy")
+		t.FailNow()
+	}
+	spec.NodeDrainPolicies[1].Name = "fast"
+
+	spec.NodeDrainPolicies[0].PreDrainHooks[0].URL = "ftp://lb.example.com"
+	if err := validateNodeDrainPolicies(&spec); err == nil {
+		logrus.Errorf("expected error for invalid hook URL")
+		t.FailNow()
+	}
+}
+
+func TestValidateHealthProbes(t *testing.T) {
+	spec := v32.ClusterSpec{
+		HealthProbes: []v32.ClusterHealthProbe{
+			{Name: "dns", DNS: &v32.DNSHealthProbe{Hostname: "kubernetes.default.svc.cluster.local"}},
+			{Name: "ingress", HTTP: &v32.HTTPHealthProbe{URL: "http://ingress-nginx.ingress-nginx.svc/healthz"}},
+			{Name: "cni", PodToPod: &v32.PodToPodHealthProbe{Port: 8090}},
+		},
+	}
+	if err := validateHealthProbes(&spec); err != nil {
+		logrus.Errorf("not expecting error, got: %v", err)
+		t.FailNow()
+	}
+
+	spec.HealthProbes[2].DNS = spec.HealthProbes[0].DNS
+	if err := validateHealthProbes(&spec); err == nil {
+		logrus.Errorf("expected error for probe with two checks")
+		t.FailNow()
+	}
+	spec.HealthProbes[2].DNS = nil
+
+	spec.HealthProbes[1].Name = "dns"
+	if err := validateHealthProbes(&spec); err == nil {
+		logrus.Errorf("expected error for duplicate probe")
+		t.FailNow()
+	}
+	spec.HealthProbes[1].Name = "ingress"
+
+	spec.HealthProbes[1].HTTP.URL = "ingress-nginx"
+	if err := validateHealthProbes(&spec); err == nil {
+		logrus.Errorf("expected error for invalid URL")
+		t.FailNow()
+	}
+}
@@ -2,12 +2,14 @@
 
 import (
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/rancher/norman/api/access"
 	"github.com/rancher/norman/httperror"
 	"github.com/rancher/norman/types"
 	"github.com/rancher/norman/types/convert"
+	v32 "github.com/rancher/rancher/pkg/apis/project.cattle.io/v3"
 	client "github.com/rancher/rancher/pkg/client/generated/project/v3"
 	"github.com/rancher/rancher/pkg/clustermanager"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
@@ -18,6 +20,7 @@
 
 const (
 	executionStateField = "executionState"
+	actionApprove       = "approve"
 	actionRerun         = "rerun"
 	actionStop          = "stop"
 	linkLog             = "log"
@@ -39,14 +42,24 @@ func (h *ExecutionHandler) ExecutionFormatter(apiContext *types.APIContext, reso
 		if e := convert.ToString(resource.Values[executionStateField]); !utils.IsFinishState(e) {
 			resource.AddAction(apiContext, actionStop)
 		}
+		if e := convert.ToString(resource.Values[executionStateField]); e == utils.StateAwaitingApproval {
+			resource.AddAction(apiContext, actionApprove)
+		}
 	}
 	resource.Links[linkLog] = apiContext.URLBuilder.Link(linkLog, resource)
+	for _, artifact := range convert.ToMapSlice(resource.Values[client.PipelineExecutionFieldArtifacts]) {
+		link := artifactLink(artifact)
+		resource.Links[link] = apiContext.URLBuilder.Link(link, resource)
+	}
 }
 
 func (h *ExecutionHandler) LinkHandler(apiContext *types.APIContext, next types.RequestHandler) error {
 	if apiContext.Link == linkLog {
 		return h.handleLog(apiContext)
 	}
+	if strings.HasPrefix(apiContext.Link, linkArtifactPrefix) {
+		return h.handleArtifact(apiContext)
+	}
 
 	return httperror.NewAPIError(httperror.NotFound, "Link not found")
 
@@ -57,6 +70,8 @@ func (h *ExecutionHandler) ActionHandler(actionName string, action *types.Action
 		return httperror.NewAPIError(httperror.NotFound, "not found")
 	}
 	switch actionName {
+	case actionApprove:
+		return h.approve(apiContext)
 	case actionRerun:
 		return h.rerun(apiContext)
 	case actionStop:
@@ -89,6 +104,11 @@ func (h *ExecutionHandler) rerun(apiContext *types.APIContext) error {
 	toCreate.Status.Started = time.Now().Format(time.RFC3339)
 	toCreate.Status.Ended = ""
 	toCreate.Status.Conditions = nil
+	toCreate.Status.Artifacts = nil
+	toCreate.Status.ReportedState = ""
+	//a rerun by a project member approves the execution
+	toCreate.Status.ApprovedBy = apiContext.Request.Header.Get("Impersonate-User")
+	v32.PipelineExecutionConditionApproved.True(toCreate)
 	for i := 0; i < len(toCreate.Status.Stages); i++ {
 		stage := &toCreate.Status.Stages[i]
 		stage.State = utils.StateWaiting
@@ -113,6 +133,28 @@ func (h *ExecutionHandler) rerun(apiContext *types.APIContext) error {
 	return nil
 }
 
+func (h *ExecutionHandler) approve(apiContext *types.APIContext) error {
+	ns, name := ref.Parse(apiContext.ID)
+	execution, err := h.PipelineExecutionLister.Get(ns, name)
+	if err != nil {
+		return err
+	}
+
+	if execution.Status.ExecutionState != utils.StateAwaitingApproval {
+		return httperror.NewAPIError(httperror.InvalidAction, "pipeline execution is not awaiting approval")
+	}
+
+	toUpdate := execution.DeepCopy()
+	toUpdate.Status.ExecutionState = utils.StateWaiting
+	toUpdate.Status.ApprovedBy = apiContext.Request.Header.Get("Impersonate-User")
+	v32.PipelineExecutionConditionApproved.True(toUpdate)
+	v32.PipelineExecutionConditionApproved.Message(toUpdate, "approved by "+toUpdate.Status.ApprovedBy)
+	if _, err := h.PipelineExecutions.Update(toUpdate); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (h *ExecutionHandler) stop(apiContext *types.APIContext) error {
 	ns, name := ref.Parse(apiContext.ID)
 	execution, err := h.PipelineExecutionLister.Get(ns, name)
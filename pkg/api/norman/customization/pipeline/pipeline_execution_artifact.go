@@ -0,0 +1,89 @@
+package pipeline
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/rancher/norman/api/access"
+	"github.com/rancher/norman/httperror"
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+	client "github.com/rancher/rancher/pkg/client/generated/project/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/pipeline/engine"
+	"github.com/rancher/rancher/pkg/ref"
+)
+
+const linkArtifactPrefix = "artifact-"
+
+func artifactLink(artifact map[string]interface{}) string {
+	return fmt.Sprintf("%s%s-%s-%s", linkArtifactPrefix,
+		convert.ToString(artifact[client.ArtifactStatusFieldStage]),
+		convert.ToString(artifact[client.ArtifactStatusFieldStep]),
+		convert.ToString(artifact[client.ArtifactStatusFieldName]))
+}
+
+// parseArtifactLink returns the stage, step and name of the artifact a link downloads
+func parseArtifactLink(link string) (int, int, string, error) {
+	parts := strings.SplitN(strings.TrimPrefix(link, linkArtifactPrefix), "-", 3)
+	if len(parts) != 3 || parts[2] == "" {
+		return 0, 0, "", fmt.Errorf("invalid artifact link %s", link)
+	}
+	stage, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, "", err
+	}
+	step, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, "", err
+	}
+	return stage, step, parts[2], nil
+}
+
+func (h *ExecutionHandler) handleArtifact(apiContext *types.APIContext) error {
+	stage, step, artifactName, err := parseArtifactLink(apiContext.Link)
+	if err != nil {
+		return httperror.WrapAPIError(err, httperror.NotFound, "Link not found")
+	}
+	data := map[string]interface{}{}
+	if err := access.ByID(apiContext, apiContext.Version, client.PipelineExecutionType, apiContext.ID, &data); err != nil {
+		return err
+	}
+	ns, name := ref.Parse(apiContext.ID)
+	execution, err := h.PipelineExecutionLister.Get(ns, name)
+	if err != nil {
+		return err
+	}
+	if !hasArtifact(execution, stage, step, artifactName) {
+		return httperror.NewAPIError(httperror.NotFound, "artifact not found")
+	}
+	clusterName, _ := ref.Parse(execution.Spec.ProjectName)
+	userContext, err := h.ClusterManager.UserContext(clusterName)
+	if err != nil {
+		return err
+	}
+
+	reader, err := engine.New(userContext, false).GetArtifact(execution, stage, step, artifactName)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	apiContext.Response.Header().Set("Content-Type", "application/gzip")
+	apiContext.Response.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s.tgz", artifactName))
+	apiContext.Response.WriteHeader(http.StatusOK)
+	_, err = io.Copy(apiContext.Response, reader)
+	return err
+}
+
+func hasArtifact(execution *v3.PipelineExecution, stage int, step int, name string) bool {
+	for _, artifact := range execution.Status.Artifacts {
+		if artifact.Stage == stage && artifact.Step == step && artifact.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -96,6 +96,9 @@ func (h *ExecutionHandler) handleLog(apiContext *types.APIContext) error {
 			c.WriteControl(websocket.CloseMessage, []byte{}, time.Now().Add(writeWait))
 			return nil
 		}
+		if execution.Status.Stages[stage].Steps[step].Ended == "" {
+			log = completeLines(log)
+		}
 		newLog := getNewLog(prevLog, log)
 		prevLog = log
 		if newLog != "" {
@@ -127,9 +130,25 @@ func writeData(c *websocket.Conn, buf []byte) error {
 	return nil
 }
 
+// completeLines cuts the line that is still being written off the log of a running step. Secrets
+// are masked in the log as a whole, a secret that is partially written is not masked yet.
+func completeLines(log string) string {
+	return log[:strings.LastIndex(log, "\n")+1]
+}
+
 func getNewLog(prevLog string, currLog string) string {
 	if len(prevLog) < longLogThreshold {
-		return strings.TrimPrefix(currLog, prevLog)
+		// lines that were sent may change once a multi-line secret they are part of is
+		// written completely and masked as a whole, but masking keeps the lines of the log
+		offset := 0
+		for i := strings.Count(prevLog, "\n"); i > 0; i-- {
+			idx := strings.Index(currLog[offset:], "\n")
+			if idx < 0 {
+				return ""
+			}
+			offset += idx + 1
+		}
+		return currLog[offset:]
 	}
 	//long logs from Jenkins are trimmed so we use previous log tail to do comparison
 	prevLogTail := prevLog[len(prevLog)-checkTailLength:]
@@ -0,0 +1,34 @@
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamSecretSplitAcrossPolls(t *testing.T) {
+	assert := assert.New(t)
+	key := "-----BEGIN KEY-----\nMIIBszCCAVmgAwIBAgIU\n-----END KEY-----"
+	secrets := []string{"s3cr3t-token", key}
+
+	polls := []string{
+		"+ echo $TOKEN\ns3cr3t-to",
+		"+ echo $TOKEN\ns3cr3t-token\n+ cat key.pem\n-----BEGIN KEY-----\nMIIBsz",
+		"+ echo $TOKEN\ns3cr3t-token\n+ cat key.pem\n" + key + "\ndone",
+	}
+
+	sent, prevLog := "", ""
+	for i, log := range polls {
+		log = utils.MaskSecrets(log, secrets)
+		if ended := i == len(polls)-1; !ended {
+			log = completeLines(log)
+		}
+		sent += getNewLog(prevLog, log)
+		prevLog = log
+	}
+
+	assert.NotContains(sent, "s3cr3t")
+	assert.NotContains(sent, "MIIBsz")
+	assert.Equal("+ echo $TOKEN\n********\n+ cat key.pem\n-----BEGIN KEY-----\n********\n********\ndone", sent)
+}
@@ -5,7 +5,6 @@
 	"fmt"
 	"strings"
 
-	"github.com/mitchellh/mapstructure"
 	"github.com/rancher/norman/api/access"
 	"github.com/rancher/norman/httperror"
 	"github.com/rancher/norman/types"
@@ -15,6 +14,7 @@
 	mgmtclient "github.com/rancher/rancher/pkg/client/generated/management/v3"
 	"github.com/rancher/rancher/pkg/clustermanager"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	pkgproject "github.com/rancher/rancher/pkg/project"
 	"github.com/rancher/rancher/pkg/resourcequota"
 	mgmtschema "github.com/rancher/rancher/pkg/schemas/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/types/config"
@@ -29,6 +29,7 @@
 type projectStore struct {
 	types.Store
 	projectLister      v3.ProjectLister
+	projects           v3.ProjectInterface
 	roleTemplateLister v3.RoleTemplateLister
 	scaledContext      *config.ScaledContext
 	clusterLister      v3.ClusterLister
@@ -38,6 +39,7 @@ func SetProjectStore(schema *types.Schema, mgmt *config.ScaledContext) {
 	store := &projectStore{
 		Store:              schema.Store,
 		projectLister:      mgmt.Management.Projects("").Controller().Lister(),
+		projects:           mgmt.Management.Projects(""),
 		roleTemplateLister: mgmt.Management.RoleTemplates("").Controller().Lister(),
 		scaledContext:      mgmt,
 		clusterLister:      mgmt.Management.Clusters("").Controller().Lister(),
@@ -51,6 +53,12 @@ func (s *projectStore) Create(apiContext *types.APIContext, schema *types.Schema
 		return nil, err
 	}
 
+	// the quota pool of the cluster is checked and taken under the lock of the cluster, so that concurrent requests
+	// do not both take the rest of the pool
+	mu := resourcequota.GetProjectLock(clusterName(data, ""))
+	mu.Lock()
+	defer mu.Unlock()
+
 	if err := s.validateResourceQuota(apiContext, data, ""); err != nil {
 		return nil, err
 	}
@@ -61,6 +69,10 @@ func (s *projectStore) Create(apiContext *types.APIContext, schema *types.Schema
 }
 
 func (s *projectStore) Update(apiContext *types.APIContext, schema *types.Schema, data map[string]interface{}, id string) (map[string]interface{}, error) {
+	mu := resourcequota.GetProjectLock(clusterName(data, id))
+	mu.Lock()
+	defer mu.Unlock()
+
 	if err := s.validateResourceQuota(apiContext, data, id); err != nil {
 		return nil, err
 	}
@@ -118,6 +130,17 @@ func (s *projectStore) validateResourceQuota(apiContext *types.APIContext, data
 		}
 		return httperror.NewFieldAPIError(httperror.MissingRequired, quotaField, "")
 	} else if !quotaOk {
+		// projects without a quota would escape the quota pool of their cluster, only the system project may
+		if isSystemProject(data) {
+			return nil
+		}
+		cluster, err := s.getCluster(data, id)
+		if err != nil {
+			return err
+		}
+		if cluster != nil && cluster.Spec.ResourceQuota != nil {
+			return httperror.NewFieldAPIError(httperror.MissingRequired, quotaField, "is required as the cluster has a resource quota")
+		}
 		return nil
 	}
 
@@ -130,22 +153,39 @@ func (s *projectStore) validateResourceQuota(apiContext *types.APIContext, data
 		return err
 	}
 
+	if err := resourcequota.ValidateOvercommitRatio(projectQuota.OvercommitRatio); err != nil {
+		return httperror.NewFieldAPIError(httperror.InvalidOption, quotaField, err.Error())
+	}
 	projectQuotaLimit, err := limitToLimit(projectQuota.Limit)
 	if err != nil {
 		return err
 	}
+	if err := resourcequota.ValidateLimit(projectQuotaLimit); err != nil {
+		return httperror.NewFieldAPIError(httperror.InvalidOption, quotaField, err.Error())
+	}
+	if err := s.validateClusterQuota(data, projectQuotaLimit, id); err != nil {
+		return err
+	}
+	// namespaces share the project limit raised by the overcommit ratio
+	projectQuotaLimit, err = resourcequota.ApplyOvercommit(projectQuotaLimit, projectQuota.OvercommitRatio)
+	if err != nil {
+		return err
+	}
 	nsQuotaLimit, err := limitToLimit(nsQuota.Limit)
 	if err != nil {
 		return err
 	}
+	if err := resourcequota.ValidateLimit(nsQuotaLimit); err != nil {
+		return httperror.NewFieldAPIError(httperror.InvalidOption, namespaceQuotaField, err.Error())
+	}
 
 	// limits in namespace default quota should include all limits defined in the project quota
-	projectQuotaLimitMap, err := convert.EncodeToMap(projectQuotaLimit)
+	projectQuotaLimitMap, err := resourcequota.LimitToMap(projectQuotaLimit)
 	if err != nil {
 		return err
 	}
 
-	nsQuotaLimitMap, err := convert.EncodeToMap(nsQuotaLimit)
+	nsQuotaLimitMap, err := resourcequota.LimitToMap(nsQuotaLimit)
 	if err != nil {
 		return err
 	}
@@ -184,21 +224,25 @@ func (s *projectStore) isQuotaFit(apiContext *types.APIContext, nsQuotaLimit *v3
 
 	// check if fields were added or removed
 	// and update project's namespaces accordingly
-	defaultQuotaLimitMap, err := convert.EncodeToMap(nsQuotaLimit)
+	defaultQuotaLimitMap, err := resourcequota.LimitToMap(nsQuotaLimit)
 	if err != nil {
 		return err
 	}
 
-	usedQuotaLimitMap := map[string]interface{}{}
+	usedQuotaLimitMap := map[string]string{}
 	if project.ResourceQuota != nil && project.ResourceQuota.UsedLimit != nil {
-		usedQuotaLimitMap, err = convert.EncodeToMap(project.ResourceQuota.UsedLimit)
+		usedLimit, err := limitToLimit(project.ResourceQuota.UsedLimit)
+		if err != nil {
+			return err
+		}
+		usedQuotaLimitMap, err = resourcequota.LimitToMap(usedLimit)
 		if err != nil {
 			return err
 		}
 	}
 
-	limitToAdd := map[string]interface{}{}
-	limitToRemove := map[string]interface{}{}
+	limitToAdd := map[string]string{}
+	limitToRemove := map[string]string{}
 	for key, value := range defaultQuotaLimitMap {
 		if _, ok := usedQuotaLimitMap[key]; !ok {
 			limitToAdd[key] = value
@@ -216,13 +260,7 @@ func (s *projectStore) isQuotaFit(apiContext *types.APIContext, nsQuotaLimit *v3
 		delete(usedQuotaLimitMap, key)
 	}
 
-	var usedLimitToCheck mgmtclient.ResourceQuotaLimit
-	err = convert.ToObj(usedQuotaLimitMap, &usedLimitToCheck)
-	if err != nil {
-		return err
-	}
-
-	usedQuotaLimit, err := limitToLimit(&usedLimitToCheck)
+	usedQuotaLimit, err := resourcequota.MapToLimit(usedQuotaLimitMap)
 	if err != nil {
 		return err
 	}
@@ -240,11 +278,7 @@ func (s *projectStore) isQuotaFit(apiContext *types.APIContext, nsQuotaLimit *v3
 	}
 
 	// check if default quota is enough to set on namespaces
-	toAppend := &mgmtclient.ResourceQuotaLimit{}
-	if err := mapstructure.Decode(limitToAdd, toAppend); err != nil {
-		return err
-	}
-	converted, err := limitToLimit(toAppend)
+	converted, err := resourcequota.MapToLimit(limitToAdd)
 	if err != nil {
 		return err
 	}
@@ -274,6 +308,85 @@ func (s *projectStore) isQuotaFit(apiContext *types.APIContext, nsQuotaLimit *v3
 	return nil
 }
 
+// validateClusterQuota checks that the project quota fits in the quota pool of its cluster, if the cluster has one,
+// along with the quota of the other projects of the cluster.
+func (s *projectStore) validateClusterQuota(data map[string]interface{}, projectQuotaLimit *v32.ResourceQuotaLimit, id string) error {
+	cluster, err := s.getCluster(data, id)
+	if err != nil {
+		return err
+	}
+	if cluster == nil || cluster.Spec.ResourceQuota == nil {
+		return nil
+	}
+
+	clusterQuotaLimitMap, err := resourcequota.LimitToMap(&cluster.Spec.ResourceQuota.Limit)
+	if err != nil {
+		return err
+	}
+	projectQuotaLimitMap, err := resourcequota.LimitToMap(projectQuotaLimit)
+	if err != nil {
+		return err
+	}
+	for k := range clusterQuotaLimitMap {
+		if _, ok := projectQuotaLimitMap[k]; !ok {
+			return httperror.NewFieldAPIError(httperror.MissingRequired, quotaField, fmt.Sprintf("misses %s defined on the cluster resource quota", k))
+		}
+	}
+
+	clusterQuotaLimit, err := resourcequota.ApplyOvercommit(&cluster.Spec.ResourceQuota.Limit, cluster.Spec.ResourceQuota.OvercommitRatio)
+	if err != nil {
+		return err
+	}
+
+	// the caller holds the lock of the cluster, the other projects are listed from the API server rather than from
+	// the cache, which may not have seen a project created under the lock yet
+	projects, err := s.projects.ListNamespaced(cluster.Name, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	var projectLimits []*v32.ResourceQuotaLimit
+	for _, project := range projects.Items {
+		if project.Spec.ResourceQuota == nil || fmt.Sprintf("%s:%s", project.Namespace, project.Name) == id {
+			continue
+		}
+		projectLimits = append(projectLimits, &project.Spec.ResourceQuota.Limit)
+	}
+
+	isFit, msg, err := resourcequota.IsQuotaFit(projectQuotaLimit, projectLimits, clusterQuotaLimit)
+	if err != nil {
+		return err
+	}
+	if !isFit {
+		return httperror.NewFieldAPIError(httperror.MaxLimitExceeded, quotaField, fmt.Sprintf("exceeds the cluster resource quota on fields: %s",
+			msg))
+	}
+	return nil
+}
+
+func isSystemProject(data map[string]interface{}) bool {
+	projectLabels := labels.Set{}
+	for k, v := range convert.ToMapInterface(data["labels"]) {
+		projectLabels[k] = convert.ToString(v)
+	}
+	return labels.Set(pkgproject.SystemProjectLabel).AsSelector().Matches(projectLabels)
+}
+
+// getCluster returns the cluster of the project, or nil when the request does not name one
+func (s *projectStore) getCluster(data map[string]interface{}, id string) (*v3.Cluster, error) {
+	name := clusterName(data, id)
+	if name == "" {
+		return nil, nil
+	}
+	return s.clusterLister.Get("", name)
+}
+
+func clusterName(data map[string]interface{}, id string) string {
+	if id != "" {
+		return strings.Split(id, ":")[0]
+	}
+	return convert.ToString(data["clusterId"])
+}
+
 func (s *projectStore) getNamespacesCount(apiContext *types.APIContext, project mgmtclient.Project) (int, error) {
 	cluster, err := s.clusterLister.Get("", project.ClusterID)
 	if err != nil {
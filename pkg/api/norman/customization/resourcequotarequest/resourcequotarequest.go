@@ -0,0 +1,170 @@
+package resourcequotarequest
+
+import (
+	"net/http"
+	"reflect"
+
+	"github.com/rancher/norman/api/access"
+	"github.com/rancher/norman/httperror"
+	"github.com/rancher/norman/parse"
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	client "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/ref"
+	"github.com/rancher/rancher/pkg/resourcequota"
+	managementschema "github.com/rancher/rancher/pkg/schemas/management.cattle.io/v3"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// statusFields are only set by the approve and deny actions and by the controller applying the request
+var statusFields = []string{
+	client.ResourceQuotaRequestFieldPhase,
+	client.ResourceQuotaRequestFieldReviewedBy,
+	client.ResourceQuotaRequestFieldReviewMessage,
+	client.ResourceQuotaRequestFieldMessage,
+}
+
+type Store struct {
+	types.Store
+}
+
+func (s *Store) Create(apiContext *types.APIContext, schema *types.Schema, data map[string]interface{}) (map[string]interface{}, error) {
+	for _, field := range statusFields {
+		delete(data, field)
+	}
+	data[client.ResourceQuotaRequestFieldPhase] = v32.ResourceQuotaRequestPhasePending
+	return s.Store.Create(apiContext, schema, data)
+}
+
+// specFields are what a request is reviewed for
+var specFields = []string{
+	client.ResourceQuotaRequestFieldNamespaceName,
+	client.ResourceQuotaRequestFieldSourceNamespaceName,
+	client.ResourceQuotaRequestFieldLimit,
+}
+
+// Update only lets the spec of pending requests change. A change puts the request back to pending, so that a review
+// made concurrently for the previous spec is not applied to the new one.
+func (s *Store) Update(apiContext *types.APIContext, schema *types.Schema, data map[string]interface{}, id string) (map[string]interface{}, error) {
+	for _, field := range statusFields {
+		delete(data, field)
+	}
+	existing, err := s.Store.ByID(apiContext, schema, id)
+	if err != nil {
+		return nil, err
+	}
+	if specChanged(existing, data) {
+		if convert.ToString(existing[client.ResourceQuotaRequestFieldPhase]) != v32.ResourceQuotaRequestPhasePending {
+			return nil, httperror.NewAPIError(httperror.InvalidState, "request can only be changed while it is pending")
+		}
+		data[client.ResourceQuotaRequestFieldPhase] = v32.ResourceQuotaRequestPhasePending
+	}
+	return s.Store.Update(apiContext, schema, data, id)
+}
+
+func specChanged(existing, data map[string]interface{}) bool {
+	for _, field := range specFields {
+		value, ok := data[field]
+		if !ok {
+			continue
+		}
+		if field == client.ResourceQuotaRequestFieldLimit {
+			var limit, existingLimit v32.ResourceQuotaLimit
+			if convert.ToObj(value, &limit) != nil || convert.ToObj(existing[field], &existingLimit) != nil {
+				return true
+			}
+			if !reflect.DeepEqual(limit, existingLimit) {
+				return true
+			}
+			continue
+		}
+		if convert.ToString(value) != convert.ToString(existing[field]) {
+			return true
+		}
+	}
+	return false
+}
+
+func Validator(request *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
+	var limit v32.ResourceQuotaLimit
+	if err := convert.ToObj(data[client.ResourceQuotaRequestFieldLimit], &limit); err != nil {
+		return httperror.WrapAPIError(err, httperror.InvalidBodyContent, "invalid limit")
+	}
+	if err := resourcequota.ValidateLimit(&limit); err != nil {
+		return httperror.NewFieldAPIError(httperror.InvalidOption, client.ResourceQuotaRequestFieldLimit, err.Error())
+	}
+	return nil
+}
+
+func Formatter(apiContext *types.APIContext, resource *types.RawResource) {
+	if convert.ToString(resource.Values[client.ResourceQuotaRequestFieldPhase]) != v32.ResourceQuotaRequestPhasePending {
+		return
+	}
+	if canReview(apiContext, convert.ToString(resource.Values[client.ResourceQuotaRequestFieldProjectID])) {
+		resource.AddAction(apiContext, "approve")
+		resource.AddAction(apiContext, "deny")
+	}
+}
+
+type Handler struct {
+	ResourceQuotaRequests v3.ResourceQuotaRequestInterface
+}
+
+func (h *Handler) ActionHandler(actionName string, action *types.Action, apiContext *types.APIContext) error {
+	ns, name := ref.Parse(apiContext.ID)
+	request, err := h.ResourceQuotaRequests.GetNamespaced(ns, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if !canReview(apiContext, request.Spec.ProjectName) {
+		return httperror.NewAPIError(httperror.PermissionDenied, "can not review resource quota requests of the project")
+	}
+	if request.Status.Phase != v32.ResourceQuotaRequestPhasePending {
+		return httperror.NewAPIError(httperror.ActionNotAvailable, "request is not pending")
+	}
+
+	input := v32.ResourceQuotaRequestReviewInput{}
+	actionInput, err := parse.ReadBody(apiContext.Request)
+	if err != nil {
+		return err
+	}
+	if err := convert.ToObj(actionInput, &input); err != nil {
+		return err
+	}
+
+	switch actionName {
+	case "approve":
+		request.Status.Phase = v32.ResourceQuotaRequestPhaseApproved
+	case "deny":
+		request.Status.Phase = v32.ResourceQuotaRequestPhaseDenied
+	default:
+		return httperror.NewAPIError(httperror.InvalidAction, "invalid action: "+actionName)
+	}
+	request.Status.ReviewedBy = apiContext.Request.Header.Get("Impersonate-User")
+	request.Status.ReviewMessage = input.Message
+
+	if _, err := h.ResourceQuotaRequests.Update(request); err != nil {
+		logrus.Errorf("Error while updating resource quota request %s: %v", apiContext.ID, err)
+		return err
+	}
+
+	data := map[string]interface{}{}
+	if err := access.ByID(apiContext, apiContext.Version, apiContext.Type, apiContext.ID, &data); err != nil {
+		return err
+	}
+	apiContext.WriteResponse(http.StatusOK, data)
+	return nil
+}
+
+// canReview tells whether the user can update the project of the request, project owners review the requests of their
+// project.
+func canReview(apiContext *types.APIContext, projectID string) bool {
+	project := map[string]interface{}{
+		"id": projectID,
+	}
+	projectSchema := apiContext.Schemas.Schema(&managementschema.Version, client.ProjectType)
+	return apiContext.AccessControl.CanDo(v3.ProjectGroupVersionKind.Group, v3.ProjectResource.Name, "update", apiContext, project, projectSchema) == nil
+}
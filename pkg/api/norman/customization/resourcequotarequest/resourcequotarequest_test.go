@@ -0,0 +1,27 @@
+package resourcequotarequest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpecChanged(t *testing.T) {
+	assert := assert.New(t)
+
+	existing := map[string]interface{}{
+		"namespaceName": "p-abc:web",
+		"limit":         map[string]interface{}{"limitsCpu": "2000m", "extended": map[string]interface{}{"requests.nvidia.com/gpu": "1"}},
+		"reason":        "launch",
+		"phase":         "approved",
+	}
+	assert.False(specChanged(existing, map[string]interface{}{"reason": "bigger launch"}))
+	assert.False(specChanged(existing, map[string]interface{}{
+		"limit": map[string]interface{}{"limitsCpu": "2000m", "extended": map[string]interface{}{"requests.nvidia.com/gpu": "1"}},
+	}))
+	assert.True(specChanged(existing, map[string]interface{}{"limit": map[string]interface{}{"limitsCpu": "4000m"}}))
+	assert.True(specChanged(existing, map[string]interface{}{
+		"limit": map[string]interface{}{"limitsCpu": "2000m", "extended": map[string]interface{}{"requests.nvidia.com/gpu": "2"}},
+	}))
+	assert.True(specChanged(existing, map[string]interface{}{"sourceNamespaceName": "p-abc:batch"}))
+}
@@ -2,6 +2,7 @@
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -81,6 +82,16 @@ func Validator(request *types.APIContext, schema *types.Schema, data map[string]
 		_, err = providerrefresh.ParseMaxAge(newValueString)
 	case "auth-user-info-resync-cron":
 		_, err = providerrefresh.ParseCron(newValueString)
+	case "auth-token-max-ttl-minutes-cluster", "auth-token-max-ttl-minutes-scoped":
+		if newValueString != "" {
+			_, err = tokens.ParseTokenTTL(newValueString)
+		}
+	case "auth-token-max-idle-days":
+		var days int
+		days, err = strconv.Atoi(newValueString)
+		if err == nil && days < 0 {
+			err = fmt.Errorf("auth-token-max-idle-days can not be negative")
+		}
 	case "kubeconfig-token-ttl-minutes":
 		generateToken := strings.EqualFold(settings.KubeconfigGenerateToken.Get(), "true")
 		if generateToken {
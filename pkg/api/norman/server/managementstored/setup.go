@@ -35,6 +35,7 @@
 	psptBinding "github.com/rancher/rancher/pkg/api/norman/customization/podsecuritypolicybinding"
 	"github.com/rancher/rancher/pkg/api/norman/customization/podsecuritypolicytemplate"
 	projectaction "github.com/rancher/rancher/pkg/api/norman/customization/project"
+	"github.com/rancher/rancher/pkg/api/norman/customization/resourcequotarequest"
 	"github.com/rancher/rancher/pkg/api/norman/customization/roletemplate"
 	"github.com/rancher/rancher/pkg/api/norman/customization/roletemplatebinding"
 	"github.com/rancher/rancher/pkg/api/norman/customization/secret"
@@ -61,18 +62,22 @@
 	"github.com/rancher/rancher/pkg/auth/api/user"
 	"github.com/rancher/rancher/pkg/auth/providerrefresh"
 	"github.com/rancher/rancher/pkg/auth/tokens"
+	"github.com/rancher/rancher/pkg/backuptarget"
 	client "github.com/rancher/rancher/pkg/client/generated/management/v3"
 	projectclient "github.com/rancher/rancher/pkg/client/generated/project/v3"
 	"github.com/rancher/rancher/pkg/clustermanager"
 	"github.com/rancher/rancher/pkg/clusterrouter"
+	"github.com/rancher/rancher/pkg/controllers/management/clusterprovisioner"
 	"github.com/rancher/rancher/pkg/controllers/management/compose/common"
 	md "github.com/rancher/rancher/pkg/controllers/management/kontainerdrivermetadata"
 	"github.com/rancher/rancher/pkg/namespace"
 	"github.com/rancher/rancher/pkg/nodeconfig"
 	sourcecodeproviders "github.com/rancher/rancher/pkg/pipeline/providers"
+	"github.com/rancher/rancher/pkg/rkedialerfactory"
 	managementschema "github.com/rancher/rancher/pkg/schemas/management.cattle.io/v3"
 	projectschema "github.com/rancher/rancher/pkg/schemas/project.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/rancher/rancher/pkg/utilization"
 )
 
 func Setup(ctx context.Context, apiContext *config.ScaledContext, clusterManager *clustermanager.Manager,
@@ -102,6 +107,7 @@ func Setup(ctx context.Context, apiContext *config.ScaledContext, clusterManager
 		client.EtcdBackupType,
 		client.FeatureType,
 		client.FleetWorkspaceType,
+		client.GitSyncType,
 		client.GlobalRoleBindingType,
 		client.GlobalRoleType,
 		client.GroupMemberType,
@@ -127,6 +133,7 @@ func Setup(ctx context.Context, apiContext *config.ScaledContext, clusterManager
 		client.ProjectNetworkPolicyType,
 		client.ProjectRoleTemplateBindingType,
 		client.ProjectType,
+		client.ResourceQuotaRequestType,
 		client.RkeK8sSystemImageType,
 		client.RkeK8sServiceOptionType,
 		client.RkeAddonType,
@@ -182,6 +189,7 @@ func Setup(ctx context.Context, apiContext *config.ScaledContext, clusterManager
 	Alert(schemas, apiContext)
 	Pipeline(schemas, apiContext, clusterManager)
 	Project(schemas, apiContext)
+	ResourceQuotaRequests(schemas, apiContext)
 	ProjectRoleTemplateBinding(schemas, apiContext)
 	TemplateContent(schemas)
 	PodSecurityPolicyTemplate(schemas, apiContext)
@@ -254,6 +262,12 @@ func Clusters(schemas *types.Schemas, managementContext *config.ScaledContext, c
 	clusterStore := cluster.GetClusterStore(schema, managementContext, clusterManager, k8sProxy)
 	schema.Store = clusterStore
 
+	dockerDialer := &rkedialerfactory.RKEDialerFactory{
+		Factory: managementContext.Dialer,
+		Docker:  true,
+		Ctx:     managementContext.RunContext,
+	}
+	store := clusterprovisioner.NewPersistentStore(managementContext.Core.Namespaces(""), managementContext.Core, managementContext.SecretsEncryptionKeys)
 	handler := ccluster.ActionHandler{
 		NodepoolGetter:                managementContext.Management,
 		ClusterClient:                 managementContext.Management.Clusters(""),
@@ -271,6 +285,10 @@ func Clusters(schemas *types.Schemas, managementContext *config.ScaledContext, c
 		CisConfigLister:               managementContext.Management.CisConfigs("").Controller().Lister(),
 		CisBenchmarkVersionClient:     managementContext.Management.CisBenchmarkVersions(""),
 		CisBenchmarkVersionLister:     managementContext.Management.CisBenchmarkVersions("").Controller().Lister(),
+		SecretLister:                  managementContext.Core.Secrets("").Controller().Lister(),
+		Snapshots:                     backuptarget.NewSnapshots(store, dockerDialer.Build, managementContext.Core.Secrets("").Controller().Lister()),
+		Utilization:                   utilization.NewStore(managementContext.Core.ConfigMaps("")),
+		UserLister:                    managementContext.Management.Users("").Controller().Lister(),
 	}
 
 	schema.ActionHandler = handler.ClusterActionHandler
@@ -287,6 +305,7 @@ func Clusters(schemas *types.Schemas, managementContext *config.ScaledContext, c
 		CisConfigLister:               managementContext.Management.CisConfigs(namespace.GlobalNamespace).Controller().Lister(),
 		CisBenchmarkVersionClient:     managementContext.Management.CisBenchmarkVersions(namespace.GlobalNamespace),
 		CisBenchmarkVersionLister:     managementContext.Management.CisBenchmarkVersions(namespace.GlobalNamespace).Controller().Lister(),
+		ProjectLister:                 managementContext.Management.Projects("").Controller().Lister(),
 	}
 	schema.Validator = clusterValidator.Validator
 
@@ -477,7 +496,7 @@ func Preference(schemas *types.Schemas, management *config.ScaledContext) {
 }
 
 func NodeTypes(schemas *types.Schemas, management *config.ScaledContext) error {
-	secretStore, err := nodeconfig.NewStore(management.Core.Namespaces(""), management.Core)
+	secretStore, err := nodeconfig.NewStore(management.Core.Namespaces(""), management.Core, management.SecretsEncryptionKeys)
 	if err != nil {
 		return err
 	}
@@ -591,6 +610,12 @@ func Alert(schemas *types.Schemas, management *config.ScaledContext) {
 	schema.Validator = alert.ProjectAlertRuleValidator
 	schema.ActionHandler = handler.ProjectAlertRuleActionHandler
 
+	schema = schemas.Schema(&managementschema.Version, client.ClusterAlertGroupType)
+	schema.Validator = alert.AlertGroupValidator
+
+	schema = schemas.Schema(&managementschema.Version, client.ProjectAlertGroupType)
+	schema.Validator = alert.AlertGroupValidator
+
 	//old schema just for migrate
 	schema = schemas.Schema(&managementschema.Version, client.ClusterAlertType)
 	schema = schemas.Schema(&managementschema.Version, client.ProjectAlertType)
@@ -677,6 +702,19 @@ func Project(schemas *types.Schemas, management *config.ScaledContext) {
 	schema.ActionHandler = handler.Actions
 }
 
+func ResourceQuotaRequests(schemas *types.Schemas, management *config.ScaledContext) {
+	schema := schemas.Schema(&managementschema.Version, client.ResourceQuotaRequestType)
+	schema.Store = &resourcequotarequest.Store{
+		Store: schema.Store,
+	}
+	schema.Formatter = resourcequotarequest.Formatter
+	schema.Validator = resourcequotarequest.Validator
+	handler := &resourcequotarequest.Handler{
+		ResourceQuotaRequests: management.Management.ResourceQuotaRequests(""),
+	}
+	schema.ActionHandler = handler.ActionHandler
+}
+
 func PodSecurityPolicyTemplate(schemas *types.Schemas, management *config.ScaledContext) {
 	schema := schemas.Schema(&managementschema.Version, client.PodSecurityPolicyTemplateType)
 	schema.Formatter = podsecuritypolicytemplate.NewFormatter(management)
@@ -101,18 +101,25 @@ func (p *Store) validateResourceQuota(apiContext *types.APIContext, schema *type
 	if err != nil {
 		return err
 	}
+	projectQuotaLimit, err = resourcequota.ApplyOvercommit(projectQuotaLimit, project.ResourceQuota.OvercommitRatio)
+	if err != nil {
+		return err
+	}
 	nsQuotaLimit, err := limitToLimit(nsQuota.Limit)
 	if err != nil {
 		return err
 	}
+	if err := resourcequota.ValidateLimit(nsQuotaLimit); err != nil {
+		return httperror.NewFieldAPIError(httperror.InvalidOption, quotaField, err.Error())
+	}
 
 	// limits in namespace should include all limits defined on a project
-	projectQuotaLimitMap, err := convert.EncodeToMap(projectQuotaLimit)
+	projectQuotaLimitMap, err := resourcequota.LimitToMap(projectQuotaLimit)
 	if err != nil {
 		return err
 	}
 
-	nsQuotaLimitMap, err := convert.EncodeToMap(nsQuotaLimit)
+	nsQuotaLimitMap, err := resourcequota.LimitToMap(nsQuotaLimit)
 	if err != nil {
 		return err
 	}
@@ -89,7 +89,7 @@ func (p *ProjectAlertSpec) ObjClusterName() string {
 type Recipient struct {
 	Recipient    string `json:"recipient,omitempty"`
 	NotifierName string `json:"notifierName,omitempty" norman:"required,type=reference[notifier]"`
-	NotifierType string `json:"notifierType,omitempty" norman:"required,options=slack|email|pagerduty|webhook|wechat|dingtalk|msteams"`
+	NotifierType string `json:"notifierType,omitempty" norman:"required,options=slack|email|pagerduty|webhook|wechat|dingtalk|msteams|opsgenie|mattermost|telegram|httptemplate"`
 }
 
 type TargetNode struct {
@@ -221,6 +221,7 @@ type ClusterAlertRuleSpec struct {
 	SystemServiceRule *SystemServiceRule `json:"systemServiceRule,omitempty"`
 	MetricRule        *MetricRule        `json:"metricRule,omitempty"`
 	ClusterScanRule   *ClusterScanRule   `json:"clusterScanRule,omitempty"`
+	EtcdBackupRule    *EtcdBackupRule    `json:"etcdBackupRule,omitempty"`
 }
 
 func (c *ClusterAlertRuleSpec) ObjClusterName() string {
@@ -265,11 +266,39 @@ func (p *ProjectAlertRuleSpec) ObjClusterName() string {
 }
 
 type CommonGroupField struct {
-	DisplayName string `json:"displayName,omitempty" norman:"required"`
-	Description string `json:"description,omitempty"`
+	DisplayName string           `json:"displayName,omitempty" norman:"required"`
+	Description string           `json:"description,omitempty"`
+	Routes      []AlertRoute     `json:"routes,omitempty"`
+	Escalation  *AlertEscalation `json:"escalation,omitempty"`
+	Silences    []AlertSilence   `json:"silences,omitempty"`
 	TimingField
 }
 
+// AlertRoute sends the alerts of a group that match it to its own recipients instead of the recipients of the group.
+// Routes are evaluated in order, the first matching route wins unless it continues to the next routes.
+type AlertRoute struct {
+	Severity   string            `json:"severity,omitempty" norman:"options=info|critical|warning"`
+	Match      map[string]string `json:"match,omitempty"`
+	MatchRegex map[string]string `json:"matchRegex,omitempty"`
+	Recipients []Recipient       `json:"recipients,omitempty" norman:"required"`
+	Continue   bool              `json:"continue,omitempty"`
+}
+
+// AlertEscalation sends the alerts of a group that are not resolved within the given time to further recipients.
+type AlertEscalation struct {
+	AfterMinutes int         `json:"afterMinutes,omitempty" norman:"required,min=1,default=30"`
+	Recipients   []Recipient `json:"recipients,omitempty" norman:"required"`
+}
+
+// AlertSilence mutes the alerts of a group that match it between StartsAt and EndsAt, both in RFC 3339 format.
+type AlertSilence struct {
+	Name     string            `json:"name,omitempty" norman:"required"`
+	Match    map[string]string `json:"match,omitempty"`
+	StartsAt string            `json:"startsAt,omitempty" norman:"required"`
+	EndsAt   string            `json:"endsAt,omitempty" norman:"required"`
+	Comment  string            `json:"comment,omitempty"`
+}
+
 type CommonRuleField struct {
 	DisplayName string `json:"displayName,omitempty"`
 	Severity    string `json:"severity,omitempty" norman:"required,options=info|critical|warning,default=critical"`
@@ -282,6 +311,12 @@ type ClusterScanRule struct {
 	FailuresOnly bool               `json:"failuresOnly,omitempty"`
 }
 
+// EtcdBackupRule alerts when the verification of an etcd backup of the cluster fails.
+type EtcdBackupRule struct {
+	// recurring backups are always considered, manual backups only if set
+	IncludeManual bool `json:"includeManual,omitempty"`
+}
+
 type MetricRule struct {
 	Expression     string  `json:"expression,omitempty" norman:"required"`
 	Description    string  `json:"description,omitempty"`
@@ -350,16 +385,20 @@ func (n *Notifier) ObjClusterName() string {
 type NotifierSpec struct {
 	ClusterName string `json:"clusterName" norman:"type=reference[cluster]"`
 
-	DisplayName     string           `json:"displayName,omitempty" norman:"required"`
-	Description     string           `json:"description,omitempty"`
-	SendResolved    bool             `json:"sendResolved,omitempty"`
-	SMTPConfig      *SMTPConfig      `json:"smtpConfig,omitempty"`
-	SlackConfig     *SlackConfig     `json:"slackConfig,omitempty"`
-	PagerdutyConfig *PagerdutyConfig `json:"pagerdutyConfig,omitempty"`
-	WebhookConfig   *WebhookConfig   `json:"webhookConfig,omitempty"`
-	WechatConfig    *WechatConfig    `json:"wechatConfig,omitempty"`
-	DingtalkConfig  *DingtalkConfig  `json:"dingtalkConfig,omitempty"`
-	MSTeamsConfig   *MSTeamsConfig   `json:"msteamsConfig,omitempty"`
+	DisplayName        string              `json:"displayName,omitempty" norman:"required"`
+	Description        string              `json:"description,omitempty"`
+	SendResolved       bool                `json:"sendResolved,omitempty"`
+	SMTPConfig         *SMTPConfig         `json:"smtpConfig,omitempty"`
+	SlackConfig        *SlackConfig        `json:"slackConfig,omitempty"`
+	PagerdutyConfig    *PagerdutyConfig    `json:"pagerdutyConfig,omitempty"`
+	WebhookConfig      *WebhookConfig      `json:"webhookConfig,omitempty"`
+	WechatConfig       *WechatConfig       `json:"wechatConfig,omitempty"`
+	DingtalkConfig     *DingtalkConfig     `json:"dingtalkConfig,omitempty"`
+	MSTeamsConfig      *MSTeamsConfig      `json:"msteamsConfig,omitempty"`
+	OpsgenieConfig     *OpsgenieConfig     `json:"opsgenieConfig,omitempty"`
+	MattermostConfig   *MattermostConfig   `json:"mattermostConfig,omitempty"`
+	TelegramConfig     *TelegramConfig     `json:"telegramConfig,omitempty"`
+	HTTPTemplateConfig *HTTPTemplateConfig `json:"httpTemplateConfig,omitempty"`
 }
 
 func (n *NotifierSpec) ObjClusterName() string {
@@ -367,14 +406,18 @@ func (n *NotifierSpec) ObjClusterName() string {
 }
 
 type Notification struct {
-	Message         string           `json:"message,omitempty"`
-	SMTPConfig      *SMTPConfig      `json:"smtpConfig,omitempty"`
-	SlackConfig     *SlackConfig     `json:"slackConfig,omitempty"`
-	PagerdutyConfig *PagerdutyConfig `json:"pagerdutyConfig,omitempty"`
-	WebhookConfig   *WebhookConfig   `json:"webhookConfig,omitempty"`
-	WechatConfig    *WechatConfig    `json:"wechatConfig,omitempty"`
-	DingtalkConfig  *DingtalkConfig  `json:"dingtalkConfig,omitempty"`
-	MSTeamsConfig   *MSTeamsConfig   `json:"msteamsConfig,omitempty"`
+	Message            string              `json:"message,omitempty"`
+	SMTPConfig         *SMTPConfig         `json:"smtpConfig,omitempty"`
+	SlackConfig        *SlackConfig        `json:"slackConfig,omitempty"`
+	PagerdutyConfig    *PagerdutyConfig    `json:"pagerdutyConfig,omitempty"`
+	WebhookConfig      *WebhookConfig      `json:"webhookConfig,omitempty"`
+	WechatConfig       *WechatConfig       `json:"wechatConfig,omitempty"`
+	DingtalkConfig     *DingtalkConfig     `json:"dingtalkConfig,omitempty"`
+	MSTeamsConfig      *MSTeamsConfig      `json:"msteamsConfig,omitempty"`
+	OpsgenieConfig     *OpsgenieConfig     `json:"opsgenieConfig,omitempty"`
+	MattermostConfig   *MattermostConfig   `json:"mattermostConfig,omitempty"`
+	TelegramConfig     *TelegramConfig     `json:"telegramConfig,omitempty"`
+	HTTPTemplateConfig *HTTPTemplateConfig `json:"httpTemplateConfig,omitempty"`
 }
 
 type SMTPConfig struct {
@@ -424,6 +467,39 @@ type MSTeamsConfig struct {
 	*HTTPClientConfig
 }
 
+type OpsgenieConfig struct {
+	APIKey           string `json:"apiKey,omitempty" norman:"type=password,required"`
+	APIURL           string `json:"apiUrl,omitempty"`
+	DefaultRecipient string `json:"defaultRecipient,omitempty"`
+	*HTTPClientConfig
+}
+
+type MattermostConfig struct {
+	DefaultRecipient string `json:"defaultRecipient,omitempty"`
+	URL              string `json:"url,omitempty" norman:"required"`
+	*HTTPClientConfig
+}
+
+type TelegramConfig struct {
+	BotToken         string `json:"botToken,omitempty" norman:"type=password,required"`
+	DefaultRecipient string `json:"defaultRecipient,omitempty" norman:"required"`
+	APIURL           string `json:"apiUrl,omitempty"`
+	*HTTPClientConfig
+}
+
+// HTTPTemplateConfig sends alerts to any HTTP endpoint. The body of the request is rendered from a Go template over
+// the alert, and is signed with HMAC-SHA256 when a secret is set.
+type HTTPTemplateConfig struct {
+	URL             string            `json:"url,omitempty" norman:"required"`
+	Method          string            `json:"method,omitempty" norman:"options=POST|PUT|PATCH,default=POST"`
+	Headers         map[string]string `json:"headers,omitempty"`
+	ContentType     string            `json:"contentType,omitempty" norman:"default=application/json"`
+	BodyTemplate    string            `json:"bodyTemplate,omitempty" norman:"required"`
+	Secret          string            `json:"secret,omitempty" norman:"type=password"`
+	SignatureHeader string            `json:"signatureHeader,omitempty" norman:"default=X-Rancher-Signature"`
+	*HTTPClientConfig
+}
+
 type NotifierStatus struct {
 }
 
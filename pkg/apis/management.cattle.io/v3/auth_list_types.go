@@ -54,6 +54,16 @@ type GoogleOauthConfigList struct {
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// OIDCConfigList is a list of OIDCConfig resources
+type OIDCConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []OIDCConfig `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // LdapConfigList is a list of LdapConfig resources
 type LdapConfigList struct {
 	metav1.TypeMeta `json:",inline"`
@@ -32,6 +32,18 @@ type Token struct {
 	Current         bool              `json:"current"`
 	ClusterName     string            `json:"clusterName,omitempty" norman:"noupdate,type=reference[cluster]"`
 	Enabled         *bool             `json:"enabled,omitempty" norman:"default=true"`
+	Scopes          []TokenScope      `json:"scopes,omitempty" norman:"noupdate"`
+	EffectiveScopes []TokenScope      `json:"effectiveScopes,omitempty" norman:"nocreate,noupdate"`
+	LastUsedAt      string            `json:"lastUsedAt,omitempty" norman:"nocreate,noupdate"`
+	LastUsedFrom    string            `json:"lastUsedFrom,omitempty" norman:"nocreate,noupdate"`
+}
+
+// TokenScope restricts a token to the verbs on the resources. Resources are API paths without the version, such as
+// projects/*/apps, where * matches a single path segment and the paths below a resource belong to it. Verbs are get,
+// create, update, patch, delete or *.
+type TokenScope struct {
+	Resources []string `json:"resources" norman:"required"`
+	Verbs     []string `json:"verbs" norman:"required"`
 }
 
 func (t *Token) ObjClusterName() string {
@@ -239,6 +251,33 @@ type GoogleOauthConfigApplyInput struct {
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+type OIDCConfig struct {
+	AuthConfig `json:",inline" mapstructure:",squash"`
+
+	Issuer        string `json:"issuer,omitempty" norman:"required,notnullable"`
+	ClientID      string `json:"clientId,omitempty" norman:"required,notnullable"`
+	ClientSecret  string `json:"clientSecret,omitempty" norman:"required,type=password,notnullable"`
+	RancherURL    string `json:"rancherUrl,omitempty" norman:"required,notnullable"`
+	Scopes        string `json:"scopes,omitempty" norman:"default=openid profile email"`
+	UsernameClaim string `json:"usernameClaim,omitempty" norman:"default=preferred_username"`
+	GroupsClaim   string `json:"groupsClaim,omitempty" norman:"default=groups"`
+	// AuthEndpoint is read from the discovery document of the issuer when the config is applied
+	AuthEndpoint string `json:"authEndpoint,omitempty" norman:"nocreate,noupdate"`
+}
+
+type OIDCConfigTestOutput struct {
+	RedirectURL string `json:"redirectUrl"`
+}
+
+type OIDCConfigApplyInput struct {
+	OIDCConfig   OIDCConfig `json:"oidcConfig,omitempty"`
+	Code         string     `json:"code,omitempty"`
+	CodeVerifier string     `json:"codeVerifier,omitempty"`
+	Enabled      bool       `json:"enabled,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 type AzureADConfig struct {
 	AuthConfig `json:",inline" mapstructure:",squash"`
 
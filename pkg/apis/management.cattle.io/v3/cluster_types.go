@@ -25,6 +25,7 @@ func init() {
 const (
 	ClusterActionGenerateKubeconfig    = "generateKubeconfig"
 	ClusterActionImportYaml            = "importYaml"
+	ClusterActionImportBundle          = "importBundle"
 	ClusterActionExportYaml            = "exportYaml"
 	ClusterActionViewMonitoring        = "viewMonitoring"
 	ClusterActionEditMonitoring        = "editMonitoring"
@@ -32,9 +33,11 @@ func init() {
 	ClusterActionDisableMonitoring     = "disableMonitoring"
 	ClusterActionBackupEtcd            = "backupEtcd"
 	ClusterActionRestoreFromEtcdBackup = "restoreFromEtcdBackup"
+	ClusterActionRestoreDryRun         = "restoreDryRun"
 	ClusterActionRotateCertificates    = "rotateCertificates"
 	ClusterActionRunSecurityScan       = "runSecurityScan"
 	ClusterActionSaveAsTemplate        = "saveAsTemplate"
+	ClusterActionQueryUtilization      = "queryUtilization"
 
 	// ClusterConditionReady Cluster ready to serve API (healthy when true, unhealthy when false)
 	ClusterConditionReady          condition.Cond = "Ready"
@@ -68,6 +71,8 @@ func init() {
 	ClusterConditionPrometheusOperatorDeployed condition.Cond = "PrometheusOperatorDeployed"
 	ClusterConditionMonitoringEnabled          condition.Cond = "MonitoringEnabled"
 	ClusterConditionAlertingEnabled            condition.Cond = "AlertingEnabled"
+	// ClusterConditionHealthy is true when none of the health probes of the cluster failed FailureThreshold times in a row
+	ClusterConditionHealthy condition.Cond = "Healthy"
 
 	ClusterDriverImported = "imported"
 	ClusterDriverLocal    = "local"
@@ -110,6 +115,8 @@ type ClusterSpecBase struct {
 	WindowsPreferedCluster               bool                                    `json:"windowsPreferedCluster" norman:"noupdate"`
 	LocalClusterAuthEndpoint             LocalClusterAuthEndpoint                `json:"localClusterAuthEndpoint,omitempty"`
 	ScheduledClusterScan                 *ScheduledClusterScan                   `json:"scheduledClusterScan,omitempty"`
+	EtcdBackupTarget                     *EtcdBackupTarget                       `json:"etcdBackupTarget,omitempty"`
+	EtcdBackupRetention                  *EtcdBackupRetention                    `json:"etcdBackupRetention,omitempty"`
 }
 
 type ClusterSpec struct {
@@ -130,6 +137,10 @@ type ClusterSpec struct {
 	ClusterTemplateAnswers              Answer                      `json:"answers,omitempty"`
 	ClusterTemplateQuestions            []Question                  `json:"questions,omitempty" norman:"nocreate,noupdate"`
 	FleetWorkspaceName                  string                      `json:"fleetWorkspaceName,omitempty"`
+	ResourceQuota                       *ClusterResourceQuota       `json:"resourceQuota,omitempty"`
+	NodeDrainPolicies                   []NodeDrainPolicy           `json:"nodeDrainPolicies,omitempty"`
+	DefaultNodeDrainPolicy              string                      `json:"defaultNodeDrainPolicy,omitempty"`
+	HealthProbes                        []ClusterHealthProbe        `json:"healthProbes,omitempty"`
 }
 
 type ImportedConfig struct {
@@ -169,6 +180,7 @@ type ClusterStatus struct {
 	ScheduledClusterScanStatus           *ScheduledClusterScanStatus `json:"scheduledClusterScanStatus,omitempty"`
 	CurrentCisRunName                    string                      `json:"currentCisRunName,omitempty"`
 	EKSStatus                            EKSStatus                   `json:"eksStatus,omitempty" norman:"nocreate,noupdate"`
+	HealthProbeStatuses                  []ClusterHealthProbeStatus  `json:"healthProbeStatuses,omitempty" norman:"nocreate,noupdate"`
 }
 
 type ClusterComponentStatus struct {
@@ -176,6 +188,50 @@ type ClusterComponentStatus struct {
 	Conditions []v1.ComponentCondition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,2,rep,name=conditions"`
 }
 
+// ClusterHealthProbe is a check of the cluster run through the cluster agent, exactly one of DNS, HTTP and PodToPod
+// is set.
+type ClusterHealthProbe struct {
+	Name             string               `json:"name" norman:"required"`
+	IntervalSeconds  int                  `json:"intervalSeconds,omitempty" norman:"min=15,max=86400,default=60"`
+	TimeoutSeconds   int                  `json:"timeoutSeconds,omitempty" norman:"min=1,max=60,default=10"`
+	FailureThreshold int                  `json:"failureThreshold,omitempty" norman:"min=1,max=100,default=3"`
+	DNS              *DNSHealthProbe      `json:"dns,omitempty"`
+	HTTP             *HTTPHealthProbe     `json:"http,omitempty"`
+	PodToPod         *PodToPodHealthProbe `json:"podToPod,omitempty"`
+}
+
+// DNSHealthProbe resolves Hostname with the DNS server of the cluster, or with Server when it is set.
+type DNSHealthProbe struct {
+	Hostname string `json:"hostname" norman:"required"`
+	Server   string `json:"server,omitempty"`
+}
+
+// HTTPHealthProbe expects a 2xx or 3xx status to a GET of URL, usually a service of the cluster.
+type HTTPHealthProbe struct {
+	URL                string `json:"url" norman:"required"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty"`
+}
+
+// PodToPodHealthProbe reaches the pod of a DaemonSet on every node of the cluster from the cluster agent.
+type PodToPodHealthProbe struct {
+	Port int `json:"port,omitempty" norman:"min=1,max=65535,default=8090"`
+}
+
+type ClusterHealthProbeStatus struct {
+	Name                string                     `json:"name"`
+	Healthy             bool                       `json:"healthy"`
+	ConsecutiveFailures int                        `json:"consecutiveFailures,omitempty"`
+	LastProbeTime       string                     `json:"lastProbeTime,omitempty"`
+	History             []ClusterHealthProbeResult `json:"history,omitempty"`
+}
+
+type ClusterHealthProbeResult struct {
+	Time      string `json:"time"`
+	Success   bool   `json:"success"`
+	LatencyMs int64  `json:"latencyMs,omitempty"`
+	Message   string `json:"message,omitempty"`
+}
+
 type ClusterCondition struct {
 	// Type of cluster condition.
 	Type ClusterConditionType `json:"type"`
@@ -278,6 +334,21 @@ type ImportYamlOutput struct {
 	Message string `json:"message,omitempty"`
 }
 
+type ImportBundleInput struct {
+	YAML string `json:"yaml,omitempty"`
+	// Secrets are the password fields of the notifiers of the bundle, which are not exported. They are keyed by the
+	// name of the notifier and the path of the field, such as "ops:wechatConfig.secret".
+	Secrets map[string]string `json:"secrets,omitempty"`
+}
+
+// ImportBundleOutput lists the resources of an exported bundle that were created in the cluster, the ones that were
+// skipped because a resource of the same name already exists, and the errors of the ones that could not be created.
+type ImportBundleOutput struct {
+	Created []string `json:"created,omitempty"`
+	Skipped []string `json:"skipped,omitempty"`
+	Errors  []string `json:"errors,omitempty"`
+}
+
 type Capabilities struct {
 	LoadBalancerCapabilities LoadBalancerCapabilities `json:"loadBalancerCapabilities,omitempty"`
 	IngressCapabilities      []IngressCapabilities    `json:"ingressCapabilities,omitempty"`
@@ -314,6 +385,44 @@ type RestoreFromEtcdBackupInput struct {
 	RestoreRkeConfig string `json:"restoreRkeConfig,omitempty"`
 }
 
+type RestoreDryRunInput struct {
+	EtcdBackupName string `json:"etcdBackupName,omitempty" norman:"type=reference[etcdBackup]"`
+}
+
+// RestoreDryRunOutput is the result of the checks a restore runs against the snapshot of a backup.
+type RestoreDryRunOutput struct {
+	Verified bool   `json:"verified"`
+	Message  string `json:"message,omitempty"`
+	Checksum string `json:"checksum,omitempty"`
+	Size     int64  `json:"size,omitempty"`
+	KeyCount int64  `json:"keyCount,omitempty"`
+}
+
+// ClusterUtilizationInput selects the utilization history to query, the history of the cluster is returned unless a
+// node pool or a project of the cluster is set.
+type ClusterUtilizationInput struct {
+	NodePoolName string `json:"nodePoolName,omitempty" norman:"type=reference[nodePool]"`
+	ProjectName  string `json:"projectName,omitempty" norman:"type=reference[project]"`
+	// From and To are RFC 3339 times, the last day is returned when they are not set
+	From string `json:"from,omitempty"`
+	To   string `json:"to,omitempty"`
+	// Resolution is picked from the time range when it is not set
+	Resolution string `json:"resolution,omitempty" norman:"type=enum,options=1h|1d"`
+}
+
+type ClusterUtilizationOutput struct {
+	Resolution string             `json:"resolution,omitempty"`
+	Points     []UtilizationPoint `json:"points,omitempty"`
+}
+
+// UtilizationPoint holds the average and the maximum of each metric over a period of the resolution of the query,
+// cpu is in cores and memory in bytes.
+type UtilizationPoint struct {
+	Timestamp string             `json:"timestamp,omitempty"`
+	Average   map[string]float64 `json:"average,omitempty"`
+	Max       map[string]float64 `json:"max,omitempty"`
+}
+
 type RotateCertificateInput struct {
 	CACertificates bool     `json:"caCertificates,omitempty"`
 	Services       []string `json:"services,omitempty" norman:"type=enum,options=etcd|kubelet|kube-apiserver|kube-proxy|kube-scheduler|kube-controller-manager"`
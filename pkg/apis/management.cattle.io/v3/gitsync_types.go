@@ -0,0 +1,92 @@
+package v3
+
+import (
+	"github.com/rancher/norman/condition"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GitSync reconciles the projects, role templates, role template bindings, notifiers, alerts, apps and pipelines of a
+// Git repository with the objects of Rancher. Objects are applied with the permissions of the creator of the GitSync.
+type GitSync struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object’s metadata. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Specification of the desired behavior of the the cluster. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#spec-and-status
+	Spec   GitSyncSpec   `json:"spec,omitempty"`
+	Status GitSyncStatus `json:"status,omitempty"`
+}
+
+type GitSyncSpec struct {
+	// Repo is the http(s) or ssh URL of the repository
+	Repo string `json:"repo,omitempty" norman:"required"`
+	// Branch is the branch of the repository to follow
+	Branch string `json:"branch,omitempty" norman:"default=master"`
+	// Paths are the directories of the repository to read manifests from, the whole repository when empty
+	Paths []string `json:"paths,omitempty"`
+	// ClientSecretName is the name of the secret in the cattle-global-data namespace to authenticate with, of type
+	// kubernetes.io/basic-auth or kubernetes.io/ssh-auth
+	ClientSecretName      string `json:"clientSecretName,omitempty"`
+	InsecureSkipTLSVerify bool   `json:"insecureSkipTLSVerify,omitempty"`
+	// IntervalSeconds is the time between two syncs, drift is corrected and reported at every sync
+	IntervalSeconds int `json:"intervalSeconds,omitempty" norman:"default=300,min=15"`
+	// Prune removes the objects the sync created once their manifest is removed from the repository
+	Prune bool `json:"prune,omitempty"`
+	// DryRun reports the changes and the drift of a sync without changing any object
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+type GitSyncStatus struct {
+	// Commit is the commit of the last sync
+	Commit       string `json:"commit,omitempty"`
+	LastSyncTime string `json:"lastSyncTime,omitempty"`
+	// SpecHash is the hash of the spec of the last sync, a changed spec is synced without waiting for the interval
+	SpecHash   string             `json:"specHash,omitempty"`
+	Resources  []GitSyncResource  `json:"resources,omitempty"`
+	Conditions []GitSyncCondition `json:"conditions,omitempty"`
+}
+
+// GitSyncResource is the state of an object of the repository after the last sync.
+type GitSyncResource struct {
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind,omitempty"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name,omitempty"`
+	// Path is the file of the repository the manifest of the object is in
+	Path string `json:"path,omitempty"`
+	// Hash is the hash of the manifest last applied, a changed object whose manifest did not change has drifted
+	Hash string `json:"hash,omitempty"`
+	// Created tells whether the sync created the object, only those objects are pruned
+	Created bool `json:"created,omitempty"`
+	// Drifted tells whether the object was changed outside of the repository since the previous sync
+	Drifted bool   `json:"drifted,omitempty"`
+	State   string `json:"state,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+var (
+	GitSyncConditionDownloaded condition.Cond = "Downloaded"
+	GitSyncConditionSynced     condition.Cond = "Synced"
+	GitSyncConditionDrifted    condition.Cond = "Drifted"
+)
+
+type GitSyncCondition struct {
+	// Type of cluster condition.
+	Type string `json:"type"`
+	// Status of the condition, one of True, False, Unknown.
+	Status v1.ConditionStatus `json:"status"`
+	// The last time this condition was updated.
+	LastUpdateTime string `json:"lastUpdateTime,omitempty"`
+	// Last time the condition transitioned from one status to another.
+	LastTransitionTime string `json:"lastTransitionTime,omitempty"`
+	// The reason for the condition's last transition.
+	Reason string `json:"reason,omitempty"`
+	// Human-readable message indicating details about last transition
+	Message string `json:"message,omitempty"`
+}
@@ -237,6 +237,7 @@ type NodeSpec struct {
 	UpdateTaintsFromAPI      *bool           `json:"updateTaintsFromAPI,omitempty"`
 	DesiredNodeUnschedulable string          `json:"desiredNodeUnschedulable,omitempty"`
 	NodeDrainInput           *NodeDrainInput `json:"nodeDrainInput,omitempty"`
+	NodeDrainPolicyName      string          `json:"nodeDrainPolicyName,omitempty"`
 	MetadataUpdate           MetadataUpdate  `json:"metadataUpdate,omitempty"`
 }
 
@@ -339,6 +340,32 @@ type PublicEndpoint struct {
 
 type NodeDrainInput = rketypes.NodeDrainInput
 
+// NodeDrainPolicy is a named way to drain the nodes of a cluster, it is used instead of kubectl drain with the options
+// of the drain of the node.
+type NodeDrainPolicy struct {
+	Name string `json:"name" norman:"required"`
+	// MaxWaitSeconds is how long the drain waits for the pods to be evicted, pod disruption budgets included
+	MaxWaitSeconds int `json:"maxWaitSeconds,omitempty" norman:"min=0,max=86400,default=600"`
+	// IgnorePodDisruptionBudgets deletes the pods instead of evicting them
+	IgnorePodDisruptionBudgets bool `json:"ignorePodDisruptionBudgets,omitempty"`
+	// OrderByPriority evicts the pods of the lowest priority first, the pods of a priority are evicted once the pods
+	// of the lower priorities are gone
+	OrderByPriority bool            `json:"orderByPriority,omitempty"`
+	SkipNamespaces  []string        `json:"skipNamespaces,omitempty"`
+	PreDrainHooks   []NodeDrainHook `json:"preDrainHooks,omitempty"`
+	PostDrainHooks  []NodeDrainHook `json:"postDrainHooks,omitempty"`
+}
+
+// NodeDrainHook is a webhook called before or after the drain of a node, the drain fails when the hook does not
+// answer with a 2xx status unless IgnoreFailure is set.
+type NodeDrainHook struct {
+	Name           string `json:"name" norman:"required"`
+	URL            string `json:"url" norman:"required"`
+	CACerts        string `json:"caCerts,omitempty"`
+	TimeoutSeconds int    `json:"timeoutSeconds,omitempty" norman:"min=1,max=3600,default=30"`
+	IgnoreFailure  bool   `json:"ignoreFailure,omitempty"`
+}
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
@@ -0,0 +1,69 @@
+package v3
+
+import (
+	"strings"
+
+	"github.com/rancher/norman/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ResourceQuotaRequestPhasePending  = "pending"
+	ResourceQuotaRequestPhaseApproved = "approved"
+	ResourceQuotaRequestPhaseDenied   = "denied"
+	ResourceQuotaRequestPhaseApplied  = "applied"
+	ResourceQuotaRequestPhaseFailed   = "failed"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ResourceQuotaRequest asks for a new quota for a namespace of a project, the quota is applied once a project owner
+// approves the request.
+type ResourceQuotaRequest struct {
+	types.Namespaced
+
+	metav1.TypeMeta `json:",inline"`
+	// Standard object’s metadata. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResourceQuotaRequestSpec   `json:"spec"`
+	Status ResourceQuotaRequestStatus `json:"status"`
+}
+
+func (r *ResourceQuotaRequest) ObjClusterName() string {
+	return r.Spec.ObjClusterName()
+}
+
+type ResourceQuotaRequestSpec struct {
+	ProjectName string `json:"projectName" norman:"type=reference[project],required,noupdate"`
+	// NamespaceName is the namespace of the project the quota is requested for
+	NamespaceName string `json:"namespaceName" norman:"required,noupdate"`
+	// Limit is the quota requested for the namespace, it replaces the quota of the namespace
+	Limit ResourceQuotaLimit `json:"limit" norman:"required"`
+	// SourceNamespaceName is the namespace of the project the quota is moved from, its quota is lowered by what the
+	// namespace is granted on top of its current quota
+	SourceNamespaceName string `json:"sourceNamespaceName,omitempty"`
+	Reason              string `json:"reason,omitempty"`
+}
+
+func (r *ResourceQuotaRequestSpec) ObjClusterName() string {
+	if parts := strings.SplitN(r.ProjectName, ":", 2); len(parts) == 2 {
+		return parts[0]
+	}
+	return ""
+}
+
+type ResourceQuotaRequestStatus struct {
+	// Phase is one of pending, approved, denied, applied or failed
+	Phase         string `json:"phase,omitempty"`
+	ReviewedBy    string `json:"reviewedBy,omitempty"`
+	ReviewMessage string `json:"reviewMessage,omitempty"`
+	// Message tells why an approved request failed to apply
+	Message string `json:"message,omitempty"`
+}
+
+type ResourceQuotaRequestReviewInput struct {
+	Message string `json:"message,omitempty"`
+}
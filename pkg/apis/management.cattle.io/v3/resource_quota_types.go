@@ -3,6 +3,18 @@
 type ProjectResourceQuota struct {
 	Limit     ResourceQuotaLimit `json:"limit,omitempty"`
 	UsedLimit ResourceQuotaLimit `json:"usedLimit,omitempty"`
+	// OvercommitRatio lets the quotas of the namespaces of the project add up to more than the project limit, keyed by
+	// limit field, e.g. {"limitsCpu": "1.5"} allots up to 150% of the CPU limit of the project to its namespaces
+	OvercommitRatio map[string]string `json:"overcommitRatio,omitempty"`
+}
+
+// ClusterResourceQuota is the pool the quotas of the projects of a cluster are drawn from.
+type ClusterResourceQuota struct {
+	Limit     ResourceQuotaLimit `json:"limit,omitempty"`
+	UsedLimit ResourceQuotaLimit `json:"usedLimit,omitempty"`
+	// OvercommitRatio lets the quotas of the projects of the cluster add up to more than the cluster limit, keyed by
+	// limit field
+	OvercommitRatio map[string]string `json:"overcommitRatio,omitempty"`
 }
 
 type NamespaceResourceQuota struct {
@@ -23,6 +35,10 @@ type ResourceQuotaLimit struct {
 	RequestsStorage        string `json:"requestsStorage,omitempty"`
 	LimitsCPU              string `json:"limitsCpu,omitempty"`
 	LimitsMemory           string `json:"limitsMemory,omitempty"`
+	// Extended holds the quota of the resources that have no field above keyed by their Kubernetes resource name, such
+	// as requests.nvidia.com/gpu, requests.ephemeral-storage, gold.storageclass.storage.k8s.io/requests.storage or
+	// count/deployments.apps
+	Extended map[string]string `json:"extended,omitempty"`
 }
 
 type ContainerResourceLimit struct {
@@ -1,11 +1,18 @@
 package v3
 
 import (
+	"github.com/rancher/norman/condition"
 	"github.com/rancher/norman/types"
 	rketypes "github.com/rancher/rke/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	// BackupConditionVerified is set by the periodic verification of the snapshot of the backup
+	BackupConditionVerified condition.Cond = "Verified"
+	BackupConditionAlerted  condition.Cond = Alerted
+)
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
@@ -19,6 +26,73 @@ type EtcdBackup struct {
 	Spec rketypes.EtcdBackupSpec `json:"spec"`
 	// backup status
 	Status rketypes.EtcdBackupStatus `yaml:"status" json:"status,omitempty"`
+	// target the snapshot was uploaded to by Rancher
+	Target *EtcdBackupTarget `json:"target,omitempty" norman:"noupdate"`
+	// checksum and size of the snapshot, and the result of its last verification
+	SnapshotInfo *EtcdSnapshotInfo `json:"snapshotInfo,omitempty" norman:"nocreate,noupdate"`
+	// pinned backups are never removed by the rotation of backups
+	Pinned bool `json:"pinned,omitempty"`
+}
+
+// EtcdBackupRetention replaces the retention of the backup config of a cluster with a grandfather-father-son policy:
+// the newest recurring backup of each of the last Hourly hours, Daily days, Weekly weeks and Monthly months is kept, a
+// backup kept by any of them is not removed. Snapshots in S3 are rotated the same way.
+type EtcdBackupRetention struct {
+	Hourly  int `json:"hourly,omitempty" norman:"min=0"`
+	Daily   int `json:"daily,omitempty" norman:"min=0"`
+	Weekly  int `json:"weekly,omitempty" norman:"min=0"`
+	Monthly int `json:"monthly,omitempty" norman:"min=0"`
+	// number of manual backups that are not pinned to keep, all of them are kept if 0
+	Manual int `json:"manual,omitempty" norman:"min=0"`
+}
+
+type EtcdSnapshotInfo struct {
+	// hex encoded SHA-256 of the snapshot, recorded when the backup completes
+	Checksum string `json:"checksum,omitempty"`
+	Size     int64  `json:"size,omitempty"`
+	// number of keys in the etcd database of the snapshot, counted by the last verification
+	KeyCount int64 `json:"keyCount,omitempty"`
+	// time of the last verification of the snapshot, whose result is in the Verified condition of the backup
+	LastVerified string `json:"lastVerified,omitempty"`
+}
+
+// EtcdBackupTarget is where Rancher uploads the etcd snapshots of a cluster. Unlike the S3 target of RKE, the snapshot
+// is copied from the etcd nodes by Rancher, so that it can be encrypted before it leaves the cluster. Exactly one of the
+// S3, SFTP and local configs must be set.
+type EtcdBackupTarget struct {
+	// S3 or S3-compatible target, a custom endpoint is used for S3-compatible storage
+	S3Config *rketypes.S3BackupConfig `json:"s3Config,omitempty"`
+	// SFTP target
+	SFTPConfig *SFTPBackupConfig `json:"sftpConfig,omitempty"`
+	// directory of the Rancher server, usually an NFS mount
+	LocalConfig *LocalBackupConfig `json:"localConfig,omitempty"`
+	// client-side encryption of the snapshot
+	Encryption *BackupEncryptionConfig `json:"encryption,omitempty"`
+}
+
+type SFTPBackupConfig struct {
+	Host     string `json:"host,omitempty" norman:"required"`
+	Port     int    `json:"port,omitempty" norman:"default=22"`
+	Username string `json:"username,omitempty" norman:"required"`
+	// password or private key used to authenticate
+	Password   string `json:"password,omitempty" norman:"type=password"`
+	PrivateKey string `json:"privateKey,omitempty" norman:"type=password"`
+	// public key of the server in authorized_keys format, the connection is refused if the server presents another key
+	HostKey string `json:"hostKey,omitempty" norman:"required"`
+	// Folder to place the files
+	Folder string `json:"folder,omitempty"`
+}
+
+type LocalBackupConfig struct {
+	Path string `json:"path,omitempty" norman:"required"`
+}
+
+// BackupEncryptionConfig references the key the snapshot is encrypted with using AES-256-GCM. The key is derived from the
+// value of the secret key, which should hold at least 32 random bytes.
+type BackupEncryptionConfig struct {
+	// secret in the namespace:name form
+	SecretName string `json:"secretName,omitempty" norman:"required"`
+	Key        string `json:"key,omitempty" norman:"default=key"`
 }
 
 // +genclient
@@ -246,6 +246,85 @@ func (in *AlertCommonSpec) DeepCopy() *AlertCommonSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertEscalation) DeepCopyInto(out *AlertEscalation) {
+	*out = *in
+	if in.Recipients != nil {
+		in, out := &in.Recipients, &out.Recipients
+		*out = make([]Recipient, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertEscalation.
+func (in *AlertEscalation) DeepCopy() *AlertEscalation {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertEscalation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertRoute) DeepCopyInto(out *AlertRoute) {
+	*out = *in
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MatchRegex != nil {
+		in, out := &in.MatchRegex, &out.MatchRegex
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Recipients != nil {
+		in, out := &in.Recipients, &out.Recipients
+		*out = make([]Recipient, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRoute.
+func (in *AlertRoute) DeepCopy() *AlertRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertSilence) DeepCopyInto(out *AlertSilence) {
+	*out = *in
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertSilence.
+func (in *AlertSilence) DeepCopy() *AlertSilence {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertSilence)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlertStatus) DeepCopyInto(out *AlertStatus) {
 	*out = *in
@@ -634,6 +713,22 @@ func (in *AzureADProviderList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupEncryptionConfig) DeepCopyInto(out *BackupEncryptionConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupEncryptionConfig.
+func (in *BackupEncryptionConfig) DeepCopy() *BackupEncryptionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupEncryptionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BasicLogin) DeepCopyInto(out *BasicLogin) {
 	*out = *in
@@ -1476,6 +1571,11 @@ func (in *ClusterAlertRuleSpec) DeepCopyInto(out *ClusterAlertRuleSpec) {
 		*out = new(ClusterScanRule)
 		**out = **in
 	}
+	if in.EtcdBackupRule != nil {
+		in, out := &in.EtcdBackupRule, &out.EtcdBackupRule
+		*out = new(EtcdBackupRule)
+		**out = **in
+	}
 	return
 }
 
@@ -1625,7 +1725,7 @@ func (in *ClusterGroupSpec) DeepCopyInto(out *ClusterGroupSpec) {
 		*out = make([]Recipient, len(*in))
 		copy(*out, *in)
 	}
-	out.CommonGroupField = in.CommonGroupField
+	in.CommonGroupField.DeepCopyInto(&out.CommonGroupField)
 	return
 }
 
@@ -1639,6 +1739,74 @@ func (in *ClusterGroupSpec) DeepCopy() *ClusterGroupSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterHealthProbe) DeepCopyInto(out *ClusterHealthProbe) {
+	*out = *in
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(DNSHealthProbe)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPHealthProbe)
+		**out = **in
+	}
+	if in.PodToPod != nil {
+		in, out := &in.PodToPod, &out.PodToPod
+		*out = new(PodToPodHealthProbe)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterHealthProbe.
+func (in *ClusterHealthProbe) DeepCopy() *ClusterHealthProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterHealthProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterHealthProbeResult) DeepCopyInto(out *ClusterHealthProbeResult) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterHealthProbeResult.
+func (in *ClusterHealthProbeResult) DeepCopy() *ClusterHealthProbeResult {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterHealthProbeResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterHealthProbeStatus) DeepCopyInto(out *ClusterHealthProbeStatus) {
+	*out = *in
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]ClusterHealthProbeResult, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterHealthProbeStatus.
+func (in *ClusterHealthProbeStatus) DeepCopy() *ClusterHealthProbeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterHealthProbeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterList) DeepCopyInto(out *ClusterList) {
 	*out = *in
@@ -1972,6 +2140,31 @@ func (in *ClusterRegistrationTokenStatus) DeepCopy() *ClusterRegistrationTokenSt
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterResourceQuota) DeepCopyInto(out *ClusterResourceQuota) {
+	*out = *in
+	in.Limit.DeepCopyInto(&out.Limit)
+	in.UsedLimit.DeepCopyInto(&out.UsedLimit)
+	if in.OvercommitRatio != nil {
+		in, out := &in.OvercommitRatio, &out.OvercommitRatio
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceQuota.
+func (in *ClusterResourceQuota) DeepCopy() *ClusterResourceQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterResourceQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterRoleTemplateBinding) DeepCopyInto(out *ClusterRoleTemplateBinding) {
 	*out = *in
@@ -2238,6 +2431,25 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ResourceQuota != nil {
+		in, out := &in.ResourceQuota, &out.ResourceQuota
+		*out = new(ClusterResourceQuota)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeDrainPolicies != nil {
+		in, out := &in.NodeDrainPolicies, &out.NodeDrainPolicies
+		*out = make([]NodeDrainPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HealthProbes != nil {
+		in, out := &in.HealthProbes, &out.HealthProbes
+		*out = make([]ClusterHealthProbe, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2270,6 +2482,16 @@ func (in *ClusterSpecBase) DeepCopyInto(out *ClusterSpecBase) {
 		*out = new(ScheduledClusterScan)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.EtcdBackupTarget != nil {
+		in, out := &in.EtcdBackupTarget, &out.EtcdBackupTarget
+		*out = new(EtcdBackupTarget)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EtcdBackupRetention != nil {
+		in, out := &in.EtcdBackupRetention, &out.EtcdBackupRetention
+		*out = new(EtcdBackupRetention)
+		**out = **in
+	}
 	return
 }
 
@@ -2363,6 +2585,13 @@ func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 		**out = **in
 	}
 	in.EKSStatus.DeepCopyInto(&out.EKSStatus)
+	if in.HealthProbeStatuses != nil {
+		in, out := &in.HealthProbeStatuses, &out.HealthProbeStatuses
+		*out = make([]ClusterHealthProbeStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2615,9 +2844,67 @@ func (in *ClusterUpgradeStrategy) DeepCopy() *ClusterUpgradeStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterUtilizationInput) DeepCopyInto(out *ClusterUtilizationInput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterUtilizationInput.
+func (in *ClusterUtilizationInput) DeepCopy() *ClusterUtilizationInput {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterUtilizationInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterUtilizationOutput) DeepCopyInto(out *ClusterUtilizationOutput) {
+	*out = *in
+	if in.Points != nil {
+		in, out := &in.Points, &out.Points
+		*out = make([]UtilizationPoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterUtilizationOutput.
+func (in *ClusterUtilizationOutput) DeepCopy() *ClusterUtilizationOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterUtilizationOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CommonGroupField) DeepCopyInto(out *CommonGroupField) {
 	*out = *in
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]AlertRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Escalation != nil {
+		in, out := &in.Escalation, &out.Escalation
+		*out = new(AlertEscalation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Silences != nil {
+		in, out := &in.Silences, &out.Silences
+		*out = make([]AlertSilence, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.TimingField = in.TimingField
 	return
 }
@@ -2891,6 +3178,22 @@ func (in *CustomTargetConfig) DeepCopy() *CustomTargetConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSHealthProbe) DeepCopyInto(out *DNSHealthProbe) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSHealthProbe.
+func (in *DNSHealthProbe) DeepCopy() *DNSHealthProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSHealthProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DingtalkConfig) DeepCopyInto(out *DingtalkConfig) {
 	*out = *in
@@ -3136,6 +3439,16 @@ func (in *EtcdBackup) DeepCopyInto(out *EtcdBackup) {
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(EtcdBackupTarget)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SnapshotInfo != nil {
+		in, out := &in.SnapshotInfo, &out.SnapshotInfo
+		*out = new(EtcdSnapshotInfo)
+		**out = **in
+	}
 	return
 }
 
@@ -3191,82 +3504,166 @@ func (in *EtcdBackupList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EventRule) DeepCopyInto(out *EventRule) {
+func (in *EtcdBackupRetention) DeepCopyInto(out *EtcdBackupRetention) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventRule.
-func (in *EventRule) DeepCopy() *EventRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackupRetention.
+func (in *EtcdBackupRetention) DeepCopy() *EtcdBackupRetention {
 	if in == nil {
 		return nil
 	}
-	out := new(EventRule)
+	out := new(EtcdBackupRetention)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExportOutput) DeepCopyInto(out *ExportOutput) {
+func (in *EtcdBackupRule) DeepCopyInto(out *EtcdBackupRule) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportOutput.
-func (in *ExportOutput) DeepCopy() *ExportOutput {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackupRule.
+func (in *EtcdBackupRule) DeepCopy() *EtcdBackupRule {
 	if in == nil {
 		return nil
 	}
-	out := new(ExportOutput)
+	out := new(EtcdBackupRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Feature) DeepCopyInto(out *Feature) {
+func (in *EtcdBackupTarget) DeepCopyInto(out *EtcdBackupTarget) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	if in.S3Config != nil {
+		in, out := &in.S3Config, &out.S3Config
+		*out = new(types.S3BackupConfig)
+		**out = **in
+	}
+	if in.SFTPConfig != nil {
+		in, out := &in.SFTPConfig, &out.SFTPConfig
+		*out = new(SFTPBackupConfig)
+		**out = **in
+	}
+	if in.LocalConfig != nil {
+		in, out := &in.LocalConfig, &out.LocalConfig
+		*out = new(LocalBackupConfig)
+		**out = **in
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(BackupEncryptionConfig)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Feature.
-func (in *Feature) DeepCopy() *Feature {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackupTarget.
+func (in *EtcdBackupTarget) DeepCopy() *EtcdBackupTarget {
 	if in == nil {
 		return nil
 	}
-	out := new(Feature)
+	out := new(EtcdBackupTarget)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Feature) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FeatureList) DeepCopyInto(out *FeatureList) {
+func (in *EtcdSnapshotInfo) DeepCopyInto(out *EtcdSnapshotInfo) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Feature, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureList.
-func (in *FeatureList) DeepCopy() *FeatureList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdSnapshotInfo.
+func (in *EtcdSnapshotInfo) DeepCopy() *EtcdSnapshotInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdSnapshotInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventRule) DeepCopyInto(out *EventRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventRule.
+func (in *EventRule) DeepCopy() *EventRule {
+	if in == nil {
+		return nil
+	}
+	out := new(EventRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExportOutput) DeepCopyInto(out *ExportOutput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportOutput.
+func (in *ExportOutput) DeepCopy() *ExportOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(ExportOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Feature) DeepCopyInto(out *Feature) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Feature.
+func (in *Feature) DeepCopy() *Feature {
+	if in == nil {
+		return nil
+	}
+	out := new(Feature)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Feature) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeatureList) DeepCopyInto(out *FeatureList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Feature, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureList.
+func (in *FeatureList) DeepCopy() *FeatureList {
 	if in == nil {
 		return nil
 	}
@@ -3623,6 +4020,146 @@ func (in *GenericLogin) DeepCopy() *GenericLogin {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitSync) DeepCopyInto(out *GitSync) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSync.
+func (in *GitSync) DeepCopy() *GitSync {
+	if in == nil {
+		return nil
+	}
+	out := new(GitSync)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GitSync) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitSyncCondition) DeepCopyInto(out *GitSyncCondition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSyncCondition.
+func (in *GitSyncCondition) DeepCopy() *GitSyncCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(GitSyncCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitSyncList) DeepCopyInto(out *GitSyncList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GitSync, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSyncList.
+func (in *GitSyncList) DeepCopy() *GitSyncList {
+	if in == nil {
+		return nil
+	}
+	out := new(GitSyncList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GitSyncList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitSyncResource) DeepCopyInto(out *GitSyncResource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSyncResource.
+func (in *GitSyncResource) DeepCopy() *GitSyncResource {
+	if in == nil {
+		return nil
+	}
+	out := new(GitSyncResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitSyncSpec) DeepCopyInto(out *GitSyncSpec) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSyncSpec.
+func (in *GitSyncSpec) DeepCopy() *GitSyncSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GitSyncSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitSyncStatus) DeepCopyInto(out *GitSyncStatus) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]GitSyncResource, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]GitSyncCondition, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSyncStatus.
+func (in *GitSyncStatus) DeepCopy() *GitSyncStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GitSyncStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GithubConfig) DeepCopyInto(out *GithubConfig) {
 	*out = *in
@@ -4453,6 +4990,104 @@ func (in *HTTPClientConfig) DeepCopy() *HTTPClientConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHealthProbe) DeepCopyInto(out *HTTPHealthProbe) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHealthProbe.
+func (in *HTTPHealthProbe) DeepCopy() *HTTPHealthProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHealthProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPTemplateConfig) DeepCopyInto(out *HTTPTemplateConfig) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.HTTPClientConfig != nil {
+		in, out := &in.HTTPClientConfig, &out.HTTPClientConfig
+		*out = new(HTTPClientConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPTemplateConfig.
+func (in *HTTPTemplateConfig) DeepCopy() *HTTPTemplateConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPTemplateConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportBundleInput) DeepCopyInto(out *ImportBundleInput) {
+	*out = *in
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportBundleInput.
+func (in *ImportBundleInput) DeepCopy() *ImportBundleInput {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportBundleInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportBundleOutput) DeepCopyInto(out *ImportBundleOutput) {
+	*out = *in
+	if in.Created != nil {
+		in, out := &in.Created, &out.Created
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Skipped != nil {
+		in, out := &in.Skipped, &out.Skipped
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Errors != nil {
+		in, out := &in.Errors, &out.Errors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportBundleOutput.
+func (in *ImportBundleOutput) DeepCopy() *ImportBundleOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportBundleOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImportClusterYamlInput) DeepCopyInto(out *ImportClusterYamlInput) {
 	*out = *in
@@ -4843,6 +5478,22 @@ func (in *LoadBalancerCapabilities) DeepCopy() *LoadBalancerCapabilities {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalBackupConfig) DeepCopyInto(out *LocalBackupConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalBackupConfig.
+func (in *LocalBackupConfig) DeepCopy() *LocalBackupConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalBackupConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalClusterAuthEndpoint) DeepCopyInto(out *LocalClusterAuthEndpoint) {
 	*out = *in
@@ -5123,6 +5774,27 @@ func (in MapStringInterface) DeepCopyInto(out *MapStringInterface) {
 	}
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MattermostConfig) DeepCopyInto(out *MattermostConfig) {
+	*out = *in
+	if in.HTTPClientConfig != nil {
+		in, out := &in.HTTPClientConfig, &out.HTTPClientConfig
+		*out = new(HTTPClientConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MattermostConfig.
+func (in *MattermostConfig) DeepCopy() *MattermostConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MattermostConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Member) DeepCopyInto(out *Member) {
 	*out = *in
@@ -5562,7 +6234,7 @@ func (in *MultiClusterAppStatus) DeepCopy() *MultiClusterAppStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespaceResourceQuota) DeepCopyInto(out *NamespaceResourceQuota) {
 	*out = *in
-	out.Limit = in.Limit
+	in.Limit.DeepCopyInto(&out.Limit)
 	return
 }
 
@@ -5673,6 +6345,53 @@ func (in *NodeCondition) DeepCopy() *NodeCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeDrainHook) DeepCopyInto(out *NodeDrainHook) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeDrainHook.
+func (in *NodeDrainHook) DeepCopy() *NodeDrainHook {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDrainHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeDrainPolicy) DeepCopyInto(out *NodeDrainPolicy) {
+	*out = *in
+	if in.SkipNamespaces != nil {
+		in, out := &in.SkipNamespaces, &out.SkipNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PreDrainHooks != nil {
+		in, out := &in.PreDrainHooks, &out.PreDrainHooks
+		*out = make([]NodeDrainHook, len(*in))
+		copy(*out, *in)
+	}
+	if in.PostDrainHooks != nil {
+		in, out := &in.PostDrainHooks, &out.PostDrainHooks
+		*out = make([]NodeDrainHook, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeDrainPolicy.
+func (in *NodeDrainPolicy) DeepCopy() *NodeDrainPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDrainPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeDriver) DeepCopyInto(out *NodeDriver) {
 	*out = *in
@@ -6251,6 +6970,26 @@ func (in *Notification) DeepCopyInto(out *Notification) {
 		*out = new(MSTeamsConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OpsgenieConfig != nil {
+		in, out := &in.OpsgenieConfig, &out.OpsgenieConfig
+		*out = new(OpsgenieConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MattermostConfig != nil {
+		in, out := &in.MattermostConfig, &out.MattermostConfig
+		*out = new(MattermostConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TelegramConfig != nil {
+		in, out := &in.TelegramConfig, &out.TelegramConfig
+		*out = new(TelegramConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPTemplateConfig != nil {
+		in, out := &in.HTTPTemplateConfig, &out.HTTPTemplateConfig
+		*out = new(HTTPTemplateConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6364,6 +7103,26 @@ func (in *NotifierSpec) DeepCopyInto(out *NotifierSpec) {
 		*out = new(MSTeamsConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OpsgenieConfig != nil {
+		in, out := &in.OpsgenieConfig, &out.OpsgenieConfig
+		*out = new(OpsgenieConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MattermostConfig != nil {
+		in, out := &in.MattermostConfig, &out.MattermostConfig
+		*out = new(MattermostConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TelegramConfig != nil {
+		in, out := &in.TelegramConfig, &out.TelegramConfig
+		*out = new(TelegramConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPTemplateConfig != nil {
+		in, out := &in.HTTPTemplateConfig, &out.HTTPTemplateConfig
+		*out = new(HTTPTemplateConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6372,27 +7131,195 @@ func (in *NotifierSpec) DeepCopy() *NotifierSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NotifierSpec)
+	out := new(NotifierSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotifierStatus) DeepCopyInto(out *NotifierStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotifierStatus.
+func (in *NotifierStatus) DeepCopy() *NotifierStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NotifierStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCConfig) DeepCopyInto(out *OIDCConfig) {
+	*out = *in
+	in.AuthConfig.DeepCopyInto(&out.AuthConfig)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCConfig.
+func (in *OIDCConfig) DeepCopy() *OIDCConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OIDCConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCConfigApplyInput) DeepCopyInto(out *OIDCConfigApplyInput) {
+	*out = *in
+	in.OIDCConfig.DeepCopyInto(&out.OIDCConfig)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCConfigApplyInput.
+func (in *OIDCConfigApplyInput) DeepCopy() *OIDCConfigApplyInput {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCConfigApplyInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCConfigList) DeepCopyInto(out *OIDCConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OIDCConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCConfigList.
+func (in *OIDCConfigList) DeepCopy() *OIDCConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OIDCConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCConfigTestOutput) DeepCopyInto(out *OIDCConfigTestOutput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCConfigTestOutput.
+func (in *OIDCConfigTestOutput) DeepCopy() *OIDCConfigTestOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCConfigTestOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCLogin) DeepCopyInto(out *OIDCLogin) {
+	*out = *in
+	out.GenericLogin = in.GenericLogin
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCLogin.
+func (in *OIDCLogin) DeepCopy() *OIDCLogin {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCLogin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCProvider) DeepCopyInto(out *OIDCProvider) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.AuthProvider.DeepCopyInto(&out.AuthProvider)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCProvider.
+func (in *OIDCProvider) DeepCopy() *OIDCProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCProvider)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OIDCProvider) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NotifierStatus) DeepCopyInto(out *NotifierStatus) {
+func (in *OIDCProviderList) DeepCopyInto(out *OIDCProviderList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OIDCProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotifierStatus.
-func (in *NotifierStatus) DeepCopy() *NotifierStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCProviderList.
+func (in *OIDCProviderList) DeepCopy() *OIDCProviderList {
 	if in == nil {
 		return nil
 	}
-	out := new(NotifierStatus)
+	out := new(OIDCProviderList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OIDCProviderList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OKTAConfig) DeepCopyInto(out *OKTAConfig) {
 	*out = *in
@@ -6521,6 +7448,27 @@ func (in *OpenLdapTestAndApplyInput) DeepCopy() *OpenLdapTestAndApplyInput {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsgenieConfig) DeepCopyInto(out *OpsgenieConfig) {
+	*out = *in
+	if in.HTTPClientConfig != nil {
+		in, out := &in.HTTPClientConfig, &out.HTTPClientConfig
+		*out = new(HTTPClientConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsgenieConfig.
+func (in *OpsgenieConfig) DeepCopy() *OpsgenieConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsgenieConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PagerdutyConfig) DeepCopyInto(out *PagerdutyConfig) {
 	*out = *in
@@ -6712,6 +7660,22 @@ func (in *PodSecurityPolicyTemplateProjectBindingList) DeepCopyObject() runtime.
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodToPodHealthProbe) DeepCopyInto(out *PodToPodHealthProbe) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodToPodHealthProbe.
+func (in *PodToPodHealthProbe) DeepCopy() *PodToPodHealthProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(PodToPodHealthProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Preference) DeepCopyInto(out *Preference) {
 	*out = *in
@@ -7218,7 +8182,7 @@ func (in *ProjectGroupSpec) DeepCopyInto(out *ProjectGroupSpec) {
 		*out = make([]Recipient, len(*in))
 		copy(*out, *in)
 	}
-	out.CommonGroupField = in.CommonGroupField
+	in.CommonGroupField.DeepCopyInto(&out.CommonGroupField)
 	return
 }
 
@@ -7562,8 +8526,15 @@ func (in *ProjectNetworkPolicyStatus) DeepCopy() *ProjectNetworkPolicyStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectResourceQuota) DeepCopyInto(out *ProjectResourceQuota) {
 	*out = *in
-	out.Limit = in.Limit
-	out.UsedLimit = in.UsedLimit
+	in.Limit.DeepCopyInto(&out.Limit)
+	in.UsedLimit.DeepCopyInto(&out.UsedLimit)
+	if in.OvercommitRatio != nil {
+		in, out := &in.OvercommitRatio, &out.OvercommitRatio
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -7643,12 +8614,12 @@ func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 	if in.ResourceQuota != nil {
 		in, out := &in.ResourceQuota, &out.ResourceQuota
 		*out = new(ProjectResourceQuota)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.NamespaceDefaultResourceQuota != nil {
 		in, out := &in.NamespaceDefaultResourceQuota, &out.NamespaceDefaultResourceQuota
 		*out = new(NamespaceResourceQuota)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ContainerDefaultResourceLimit != nil {
 		in, out := &in.ContainerDefaultResourceLimit, &out.ContainerDefaultResourceLimit
@@ -7977,6 +8948,13 @@ func (in *Recipient) DeepCopy() *Recipient {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceQuotaLimit) DeepCopyInto(out *ResourceQuotaLimit) {
 	*out = *in
+	if in.Extended != nil {
+		in, out := &in.Extended, &out.Extended
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -7990,6 +8968,149 @@ func (in *ResourceQuotaLimit) DeepCopy() *ResourceQuotaLimit {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceQuotaRequest) DeepCopyInto(out *ResourceQuotaRequest) {
+	*out = *in
+	out.Namespaced = in.Namespaced
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceQuotaRequest.
+func (in *ResourceQuotaRequest) DeepCopy() *ResourceQuotaRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceQuotaRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceQuotaRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceQuotaRequestList) DeepCopyInto(out *ResourceQuotaRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourceQuotaRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceQuotaRequestList.
+func (in *ResourceQuotaRequestList) DeepCopy() *ResourceQuotaRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceQuotaRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceQuotaRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceQuotaRequestReviewInput) DeepCopyInto(out *ResourceQuotaRequestReviewInput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceQuotaRequestReviewInput.
+func (in *ResourceQuotaRequestReviewInput) DeepCopy() *ResourceQuotaRequestReviewInput {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceQuotaRequestReviewInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceQuotaRequestSpec) DeepCopyInto(out *ResourceQuotaRequestSpec) {
+	*out = *in
+	in.Limit.DeepCopyInto(&out.Limit)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceQuotaRequestSpec.
+func (in *ResourceQuotaRequestSpec) DeepCopy() *ResourceQuotaRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceQuotaRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceQuotaRequestStatus) DeepCopyInto(out *ResourceQuotaRequestStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceQuotaRequestStatus.
+func (in *ResourceQuotaRequestStatus) DeepCopy() *ResourceQuotaRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceQuotaRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreDryRunInput) DeepCopyInto(out *RestoreDryRunInput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreDryRunInput.
+func (in *RestoreDryRunInput) DeepCopy() *RestoreDryRunInput {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreDryRunInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreDryRunOutput) DeepCopyInto(out *RestoreDryRunOutput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreDryRunOutput.
+func (in *RestoreDryRunOutput) DeepCopy() *RestoreDryRunOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreDryRunOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RestoreFromEtcdBackupInput) DeepCopyInto(out *RestoreFromEtcdBackupInput) {
 	*out = *in
@@ -8345,6 +9466,22 @@ func (in *Route53ProviderConfig) DeepCopy() *Route53ProviderConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SFTPBackupConfig) DeepCopyInto(out *SFTPBackupConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SFTPBackupConfig.
+func (in *SFTPBackupConfig) DeepCopy() *SFTPBackupConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SFTPBackupConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SMTPConfig) DeepCopyInto(out *SMTPConfig) {
 	*out = *in
@@ -9040,6 +10177,27 @@ func (in *TargetWorkload) DeepCopy() *TargetWorkload {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TelegramConfig) DeepCopyInto(out *TelegramConfig) {
+	*out = *in
+	if in.HTTPClientConfig != nil {
+		in, out := &in.HTTPClientConfig, &out.HTTPClientConfig
+		*out = new(HTTPClientConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TelegramConfig.
+func (in *TelegramConfig) DeepCopy() *TelegramConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TelegramConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Template) DeepCopyInto(out *Template) {
 	*out = *in
@@ -9391,6 +10549,20 @@ func (in *Token) DeepCopyInto(out *Token) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]TokenScope, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EffectiveScopes != nil {
+		in, out := &in.EffectiveScopes, &out.EffectiveScopes
+		*out = make([]TokenScope, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -9445,6 +10617,32 @@ func (in *TokenList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenScope) DeepCopyInto(out *TokenScope) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Verbs != nil {
+		in, out := &in.Verbs, &out.Verbs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenScope.
+func (in *TokenScope) DeepCopy() *TokenScope {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenScope)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UpdateGlobalDNSTargetsInput) DeepCopyInto(out *UpdateGlobalDNSTargetsInput) {
 	*out = *in
@@ -9705,6 +10903,36 @@ func (in *UserStatus) DeepCopy() *UserStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UtilizationPoint) DeepCopyInto(out *UtilizationPoint) {
+	*out = *in
+	if in.Average != nil {
+		in, out := &in.Average, &out.Average
+		*out = make(map[string]float64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		*out = make(map[string]float64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UtilizationPoint.
+func (in *UtilizationPoint) DeepCopy() *UtilizationPoint {
+	if in == nil {
+		return nil
+	}
+	out := new(UtilizationPoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Values) DeepCopyInto(out *Values) {
 	*out = *in
@@ -502,6 +502,23 @@ func NewFreeIpaProvider(namespace, name string, obj FreeIpaProvider) *FreeIpaPro
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// GitSyncList is a list of GitSync resources
+type GitSyncList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []GitSync `json:"items"`
+}
+
+func NewGitSync(namespace, name string, obj GitSync) *GitSync {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("GitSync").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // GithubProviderList is a list of GithubProvider resources
 type GithubProviderList struct {
 	metav1.TypeMeta `json:",inline"`
@@ -808,6 +825,23 @@ func NewNotifier(namespace, name string, obj Notifier) *Notifier {
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// OIDCProviderList is a list of OIDCProvider resources
+type OIDCProviderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []OIDCProvider `json:"items"`
+}
+
+func NewOIDCProvider(namespace, name string, obj OIDCProvider) *OIDCProvider {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("OIDCProvider").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // OpenLdapProviderList is a list of OpenLdapProvider resources
 type OpenLdapProviderList struct {
 	metav1.TypeMeta `json:",inline"`
@@ -1029,6 +1063,23 @@ func NewProjectRoleTemplateBinding(namespace, name string, obj ProjectRoleTempla
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// ResourceQuotaRequestList is a list of ResourceQuotaRequest resources
+type ResourceQuotaRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ResourceQuotaRequest `json:"items"`
+}
+
+func NewResourceQuotaRequest(namespace, name string, obj ResourceQuotaRequest) *ResourceQuotaRequest {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("ResourceQuotaRequest").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // RkeAddonList is a list of RkeAddon resources
 type RkeAddonList struct {
 	metav1.TypeMeta `json:",inline"`
@@ -57,6 +57,7 @@
 	FeatureResourceName                                 = "features"
 	FleetWorkspaceResourceName                          = "fleetworkspaces"
 	FreeIpaProviderResourceName                         = "freeipaproviders"
+	GitSyncResourceName                                 = "gitsyncs"
 	GithubProviderResourceName                          = "githubproviders"
 	GlobalDnsResourceName                               = "globaldnses"
 	GlobalDnsProviderResourceName                       = "globaldnsproviders"
@@ -75,6 +76,7 @@
 	NodePoolResourceName                                = "nodepools"
 	NodeTemplateResourceName                            = "nodetemplates"
 	NotifierResourceName                                = "notifiers"
+	OIDCProviderResourceName                            = "oidcproviders"
 	OpenLdapProviderResourceName                        = "openldapproviders"
 	PodSecurityPolicyTemplateResourceName               = "podsecuritypolicytemplates"
 	PodSecurityPolicyTemplateProjectBindingResourceName = "podsecuritypolicytemplateprojectbindings"
@@ -89,6 +91,7 @@
 	ProjectMonitorGraphResourceName                     = "projectmonitorgraphs"
 	ProjectNetworkPolicyResourceName                    = "projectnetworkpolicies"
 	ProjectRoleTemplateBindingResourceName              = "projectroletemplatebindings"
+	ResourceQuotaRequestResourceName                    = "resourcequotarequests"
 	RkeAddonResourceName                                = "rkeaddons"
 	RkeK8sServiceOptionResourceName                     = "rkek8sserviceoptions"
 	RkeK8sSystemImageResourceName                       = "rkek8ssystemimages"
@@ -183,6 +186,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&FleetWorkspaceList{},
 		&FreeIpaProvider{},
 		&FreeIpaProviderList{},
+		&GitSync{},
+		&GitSyncList{},
 		&GithubProvider{},
 		&GithubProviderList{},
 		&GlobalDns{},
@@ -219,6 +224,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&NodeTemplateList{},
 		&Notifier{},
 		&NotifierList{},
+		&OIDCProvider{},
+		&OIDCProviderList{},
 		&OpenLdapProvider{},
 		&OpenLdapProviderList{},
 		&PodSecurityPolicyTemplate{},
@@ -247,6 +254,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ProjectNetworkPolicyList{},
 		&ProjectRoleTemplateBinding{},
 		&ProjectRoleTemplateBindingList{},
+		&ResourceQuotaRequest{},
+		&ResourceQuotaRequestList{},
 		&RkeAddon{},
 		&RkeAddonList{},
 		&RkeK8sServiceOption{},
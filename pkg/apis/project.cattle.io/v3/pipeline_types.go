@@ -18,6 +18,7 @@
 	PipelineExecutionConditionInitialized condition.Cond = "Initialized"
 	PipelineExecutionConditionBuilt       condition.Cond = "Built"
 	PipelineExecutionConditionNotified    condition.Cond = "Notified"
+	PipelineExecutionConditionApproved    condition.Cond = "Approved"
 )
 
 // +genclient
@@ -29,7 +30,7 @@ type SourceCodeProvider struct {
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
 	ProjectName string `json:"projectName" norman:"type=reference[project]"`
-	Type        string `json:"type" norman:"options=github|gitlab|bitbucketcloud|bitbucketserver"`
+	Type        string `json:"type" norman:"options=github|gitlab|bitbucketcloud|bitbucketserver|gitea|git"`
 }
 
 func (s *SourceCodeProvider) ObjClusterName() string {
@@ -65,6 +66,14 @@ type BitbucketServerProvider struct {
 	OauthProvider `json:",inline"`
 }
 
+type GiteaProvider struct {
+	OauthProvider `json:",inline"`
+}
+
+type GitProvider struct {
+	SourceCodeProvider `json:",inline"`
+}
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
@@ -75,7 +84,7 @@ type SourceCodeProviderConfig struct {
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
 	ProjectName string `json:"projectName" norman:"required,type=reference[project]"`
-	Type        string `json:"type" norman:"noupdate,options=github|gitlab|bitbucketcloud|bitbucketserver"`
+	Type        string `json:"type" norman:"noupdate,options=github|gitlab|bitbucketcloud|bitbucketserver|gitea|git"`
 	Enabled     bool   `json:"enabled,omitempty"`
 }
 
@@ -140,6 +149,35 @@ type BitbucketServerPipelineConfig struct {
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+type GiteaPipelineConfig struct {
+	SourceCodeProviderConfig `json:",inline" mapstructure:",squash"`
+
+	Hostname     string `json:"hostname,omitempty" norman:"noupdate"`
+	TLS          bool   `json:"tls,omitempty" norman:"notnullable,default=true" norman:"noupdate"`
+	ClientID     string `json:"clientId,omitempty" norman:"noupdate"`
+	ClientSecret string `json:"clientSecret,omitempty" norman:"noupdate,type=password"`
+	RedirectURL  string `json:"redirectUrl,omitempty" norman:"noupdate"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GitPipelineConfig enables pipelines for plain git repositories reached over HTTPS or SSH.
+// Without a webhook API, repositories are polled for new commits and tags every PollInterval seconds.
+type GitPipelineConfig struct {
+	SourceCodeProviderConfig `json:",inline" mapstructure:",squash"`
+
+	PollInterval int `json:"pollInterval,omitempty" norman:"default=60,min=15"`
+	// AllowedHosts are the hosts repositories may be fetched from, as host names or *.domain wildcards
+	AllowedHosts []string `json:"allowedHosts,omitempty"`
+	// KnownHosts are the SSH host keys of the allowed hosts in known_hosts format, SSH repositories
+	// on hosts without a known key are refused
+	KnownHosts string `json:"knownHosts,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 type Pipeline struct {
 	types.Namespaced
 
@@ -237,6 +275,10 @@ type PipelineStatus struct {
 	WebHookID            string                `json:"webhookId,omitempty" yaml:"webhookId,omitempty"`
 	Token                string                `json:"token,omitempty" yaml:"token,omitempty" norman:"writeOnly,noupdate"`
 	SourceCodeCredential *SourceCodeCredential `json:"sourceCodeCredential,omitempty" yaml:"sourceCodeCredential,omitempty"`
+	// PolledRefs holds the last seen commit of each branch and tag of repositories that are polled
+	PolledRefs map[string]string `json:"polledRefs,omitempty" yaml:"polledRefs,omitempty"`
+	// LastPolled is the time the polled refs were last recorded, it is empty until the first poll
+	LastPolled string `json:"lastPolled,omitempty" yaml:"lastPolled,omitempty"`
 }
 
 type PipelineSpec struct {
@@ -297,6 +339,11 @@ type Stage struct {
 	Steps []Step `json:"steps,omitempty" yaml:"steps,omitempty" norman:"required"`
 
 	When *Constraints `json:"when,omitempty" yaml:"when,omitempty"`
+
+	// Cache, Artifacts and Matrix apply to every step of the stage
+	Cache     *CacheConfig        `json:"cache,omitempty" yaml:"cache,omitempty"`
+	Artifacts []ArtifactConfig    `json:"artifacts,omitempty" yaml:"artifacts,omitempty"`
+	Matrix    map[string][]string `json:"matrix,omitempty" yaml:"matrix,omitempty"`
 }
 
 type Step struct {
@@ -315,6 +362,25 @@ type Step struct {
 	MemoryRequest string            `json:"memoryRequest,omitempty" yaml:"memoryRequest,omitempty"`
 	MemoryLimit   string            `json:"memoryLimit,omitempty" yaml:"memoryLimit,omitempty"`
 	When          *Constraints      `json:"when,omitempty" yaml:"when,omitempty"`
+
+	Cache     *CacheConfig        `json:"cache,omitempty" yaml:"cache,omitempty"`
+	Artifacts []ArtifactConfig    `json:"artifacts,omitempty" yaml:"artifacts,omitempty"`
+	Matrix    map[string][]string `json:"matrix,omitempty" yaml:"matrix,omitempty"`
+}
+
+// CacheConfig restores paths of the workspace saved under the key by previous executions
+// of the pipeline on the same branch before a step runs, and saves them again once it succeeds.
+// Pull requests do not save caches, and steps of a stage with a cache run one after another.
+type CacheConfig struct {
+	Key   string   `json:"key,omitempty" yaml:"key,omitempty" norman:"required"`
+	Paths []string `json:"paths,omitempty" yaml:"paths,omitempty" norman:"required"`
+}
+
+// ArtifactConfig archives paths of the workspace once a step succeeds. Artifacts stay
+// in the workspace for later stages and can be downloaded from the execution.
+type ArtifactConfig struct {
+	Name  string   `json:"name,omitempty" yaml:"name,omitempty" norman:"required"`
+	Paths []string `json:"paths,omitempty" yaml:"paths,omitempty" norman:"required"`
 }
 
 type Constraints struct {
@@ -386,6 +452,8 @@ type PipelineExecutionSpec struct {
 	Author          string         `json:"author,omitempty"`
 	AvatarURL       string         `json:"avatarUrl,omitempty"`
 	Email           string         `json:"email,omitempty"`
+	// FromFork is set for pull requests opened from a fork of the repository
+	FromFork bool `json:"fromFork,omitempty"`
 }
 
 func (p *PipelineExecutionSpec) ObjClusterName() string {
@@ -398,10 +466,21 @@ func (p *PipelineExecutionSpec) ObjClusterName() string {
 type PipelineExecutionStatus struct {
 	Conditions []PipelineCondition `json:"conditions,omitempty"`
 
-	ExecutionState string        `json:"executionState,omitempty"`
-	Started        string        `json:"started,omitempty"`
-	Ended          string        `json:"ended,omitempty"`
-	Stages         []StageStatus `json:"stages,omitempty"`
+	ExecutionState string           `json:"executionState,omitempty"`
+	Started        string           `json:"started,omitempty"`
+	Ended          string           `json:"ended,omitempty"`
+	Stages         []StageStatus    `json:"stages,omitempty"`
+	Artifacts      []ArtifactStatus `json:"artifacts,omitempty"`
+	ApprovedBy     string           `json:"approvedBy,omitempty"`
+	// ReportedState is the execution state last reported as a commit status to the source code provider
+	ReportedState string `json:"reportedState,omitempty"`
+}
+
+type ArtifactStatus struct {
+	Name    string `json:"name,omitempty"`
+	Stage   int    `json:"stage"`
+	Step    int    `json:"step"`
+	Created string `json:"created,omitempty"`
 }
 
 type StageStatus struct {
@@ -419,7 +498,7 @@ type StepStatus struct {
 
 type SourceCodeCredentialSpec struct {
 	ProjectName    string `json:"projectName" norman:"type=reference[project]"`
-	SourceCodeType string `json:"sourceCodeType,omitempty" norman:"required,options=github|gitlab|bitbucketcloud|bitbucketserver"`
+	SourceCodeType string `json:"sourceCodeType,omitempty" norman:"required,options=github|gitlab|bitbucketcloud|bitbucketserver|gitea|git"`
 	UserName       string `json:"userName" norman:"required,type=reference[user]"`
 	DisplayName    string `json:"displayName,omitempty" norman:"required"`
 	AvatarURL      string `json:"avatarUrl,omitempty"`
@@ -445,7 +524,7 @@ type SourceCodeCredentialStatus struct {
 
 type SourceCodeRepositorySpec struct {
 	ProjectName              string   `json:"projectName" norman:"type=reference[project]"`
-	SourceCodeType           string   `json:"sourceCodeType,omitempty" norman:"required,options=github|gitlab|bitbucketcloud|bitbucketserver"`
+	SourceCodeType           string   `json:"sourceCodeType,omitempty" norman:"required,options=github|gitlab|bitbucketcloud|bitbucketserver|gitea|git"`
 	UserName                 string   `json:"userName" norman:"required,type=reference[user]"`
 	SourceCodeCredentialName string   `json:"sourceCodeCredentialName,omitempty" norman:"required,type=reference[sourceCodeCredential]"`
 	URL                      string   `json:"url,omitempty"`
@@ -476,7 +555,7 @@ type RunPipelineInput struct {
 
 type AuthAppInput struct {
 	InheritGlobal  bool   `json:"inheritGlobal,omitempty"`
-	SourceCodeType string `json:"sourceCodeType,omitempty" norman:"type=string,required,options=github|gitlab|bitbucketcloud|bitbucketserver"`
+	SourceCodeType string `json:"sourceCodeType,omitempty" norman:"type=string,required,options=github|gitlab|bitbucketcloud|bitbucketserver|gitea|git"`
 	RedirectURL    string `json:"redirectUrl,omitempty" norman:"type=string"`
 	TLS            bool   `json:"tls,omitempty"`
 	Host           string `json:"host,omitempty"`
@@ -486,7 +565,7 @@ type AuthAppInput struct {
 }
 
 type AuthUserInput struct {
-	SourceCodeType string `json:"sourceCodeType,omitempty" norman:"type=string,required,options=github|gitlab|bitbucketcloud|bitbucketserver"`
+	SourceCodeType string `json:"sourceCodeType,omitempty" norman:"type=string,required,options=github|gitlab|bitbucketcloud|bitbucketserver|gitea|git"`
 	RedirectURL    string `json:"redirectUrl,omitempty" norman:"type=string"`
 	Code           string `json:"code,omitempty" norman:"type=string,required"`
 }
@@ -528,6 +607,24 @@ type BitbucketCloudApplyInput struct {
 	OauthApplyInput
 }
 
+type GiteaApplyInput struct {
+	OauthApplyInput
+}
+
+type GitApplyInput struct {
+	PollInterval int      `json:"pollInterval,omitempty" norman:"default=60,min=15"`
+	AllowedHosts []string `json:"allowedHosts,omitempty" norman:"required"`
+	KnownHosts   string   `json:"knownHosts,omitempty"`
+}
+
+// GitLoginInput holds the credentials used for the repositories of the generic git provider.
+// Username and Password are used for HTTPS repositories, SSHPrivateKey for SSH ones.
+type GitLoginInput struct {
+	Username      string `json:"username,omitempty" norman:"type=string,required"`
+	Password      string `json:"password,omitempty" norman:"type=password"`
+	SSHPrivateKey string `json:"sshPrivateKey,omitempty" norman:"type=password"`
+}
+
 type BitbucketServerApplyInput struct {
 	OAuthToken    string `json:"oauthToken,omitempty"`
 	OAuthVerifier string `json:"oauthVerifier,omitempty"`
@@ -337,6 +337,43 @@ func (in *ApplyYamlConfig) DeepCopy() *ApplyYamlConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactConfig) DeepCopyInto(out *ArtifactConfig) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactConfig.
+func (in *ArtifactConfig) DeepCopy() *ArtifactConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactStatus) DeepCopyInto(out *ArtifactStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactStatus.
+func (in *ArtifactStatus) DeepCopy() *ArtifactStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthAppInput) DeepCopyInto(out *AuthAppInput) {
 	*out = *in
@@ -578,6 +615,27 @@ func (in *BitbucketServerRequestLoginOutput) DeepCopy() *BitbucketServerRequestL
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheConfig) DeepCopyInto(out *CacheConfig) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheConfig.
+func (in *CacheConfig) DeepCopy() *CacheConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Certificate) DeepCopyInto(out *Certificate) {
 	*out = *in
@@ -794,6 +852,149 @@ func (in *EnvFrom) DeepCopy() *EnvFrom {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitApplyInput) DeepCopyInto(out *GitApplyInput) {
+	*out = *in
+	if in.AllowedHosts != nil {
+		in, out := &in.AllowedHosts, &out.AllowedHosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitApplyInput.
+func (in *GitApplyInput) DeepCopy() *GitApplyInput {
+	if in == nil {
+		return nil
+	}
+	out := new(GitApplyInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitLoginInput) DeepCopyInto(out *GitLoginInput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitLoginInput.
+func (in *GitLoginInput) DeepCopy() *GitLoginInput {
+	if in == nil {
+		return nil
+	}
+	out := new(GitLoginInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitPipelineConfig) DeepCopyInto(out *GitPipelineConfig) {
+	*out = *in
+	in.SourceCodeProviderConfig.DeepCopyInto(&out.SourceCodeProviderConfig)
+	if in.AllowedHosts != nil {
+		in, out := &in.AllowedHosts, &out.AllowedHosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitPipelineConfig.
+func (in *GitPipelineConfig) DeepCopy() *GitPipelineConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GitPipelineConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GitPipelineConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitProvider) DeepCopyInto(out *GitProvider) {
+	*out = *in
+	in.SourceCodeProvider.DeepCopyInto(&out.SourceCodeProvider)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitProvider.
+func (in *GitProvider) DeepCopy() *GitProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(GitProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GiteaApplyInput) DeepCopyInto(out *GiteaApplyInput) {
+	*out = *in
+	out.OauthApplyInput = in.OauthApplyInput
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GiteaApplyInput.
+func (in *GiteaApplyInput) DeepCopy() *GiteaApplyInput {
+	if in == nil {
+		return nil
+	}
+	out := new(GiteaApplyInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GiteaPipelineConfig) DeepCopyInto(out *GiteaPipelineConfig) {
+	*out = *in
+	in.SourceCodeProviderConfig.DeepCopyInto(&out.SourceCodeProviderConfig)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GiteaPipelineConfig.
+func (in *GiteaPipelineConfig) DeepCopy() *GiteaPipelineConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GiteaPipelineConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GiteaPipelineConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GiteaProvider) DeepCopyInto(out *GiteaProvider) {
+	*out = *in
+	in.OauthProvider.DeepCopyInto(&out.OauthProvider)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GiteaProvider.
+func (in *GiteaProvider) DeepCopy() *GiteaProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(GiteaProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GithubApplyInput) DeepCopyInto(out *GithubApplyInput) {
 	*out = *in
@@ -1437,6 +1638,11 @@ func (in *PipelineExecutionStatus) DeepCopyInto(out *PipelineExecutionStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Artifacts != nil {
+		in, out := &in.Artifacts, &out.Artifacts
+		*out = make([]ArtifactStatus, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1593,6 +1799,13 @@ func (in *PipelineStatus) DeepCopyInto(out *PipelineStatus) {
 		*out = new(SourceCodeCredential)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PolledRefs != nil {
+		in, out := &in.PolledRefs, &out.PolledRefs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -2253,6 +2466,33 @@ func (in *Stage) DeepCopyInto(out *Stage) {
 		*out = new(Constraints)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(CacheConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Artifacts != nil {
+		in, out := &in.Artifacts, &out.Artifacts
+		*out = make([]ArtifactConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Matrix != nil {
+		in, out := &in.Matrix, &out.Matrix
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
 	return
 }
 
@@ -2337,6 +2577,33 @@ func (in *Step) DeepCopyInto(out *Step) {
 		*out = new(Constraints)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(CacheConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Artifacts != nil {
+		in, out := &in.Artifacts, &out.Artifacts
+		*out = make([]ArtifactConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Matrix != nil {
+		in, out := &in.Matrix, &out.Matrix
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
 	return
 }
 
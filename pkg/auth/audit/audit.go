@@ -37,6 +37,7 @@
 type auditLog struct {
 	log     *log
 	writer  *LogWriter
+	req     *http.Request
 	reqBody []byte
 }
 
@@ -79,9 +80,10 @@ func FromContext(ctx context.Context) (*User, bool) {
 	return u, ok
 }
 
-func newAuditLog(writer *LogWriter, req *http.Request) (*auditLog, error) {
+func newAuditLog(writer *LogWriter, user *User, req *http.Request) (*auditLog, error) {
 	auditLog := &auditLog{
 		writer: writer,
+		req:    req,
 		log: &log{
 			AuditID:          k8stypes.UID(uuid.NewRandom().String()),
 			RequestURI:       req.RequestURI,
@@ -91,8 +93,9 @@ func newAuditLog(writer *LogWriter, req *http.Request) (*auditLog, error) {
 		},
 	}
 
+	level := writer.Policy.requestLevel(writer.Level, user, req)
 	contentType := req.Header.Get("Content-Type")
-	if writer.Level >= levelRequest && bodyMethods[req.Method] && contentType == contentTypeJSON {
+	if level >= levelRequest && bodyMethods[req.Method] && contentType == contentTypeJSON {
 		reqBody, err := readBodyWithoutLosingContent(req)
 		if err != nil {
 			return nil, err
@@ -103,6 +106,11 @@ func newAuditLog(writer *LogWriter, req *http.Request) (*auditLog, error) {
 }
 
 func (a *auditLog) write(userInfo *User, reqHeaders, resHeaders http.Header, resCode int, resBody []byte) error {
+	level := a.writer.Policy.responseLevel(a.writer.Level, userInfo, a.req, resCode)
+	if level == levelNull {
+		return nil
+	}
+
 	a.log.User = userInfo
 	a.log.ResponseTimestamp = time.Now().Format(time.RFC3339)
 	a.log.RequestHeader = filterOutHeaders(reqHeaders, sensitiveRequestHeader)
@@ -116,13 +124,13 @@ func (a *auditLog) write(userInfo *User, reqHeaders, resHeaders http.Header, res
 	}
 
 	buffer.Write(bytes.TrimSuffix(alByte, []byte("}")))
-	if a.writer.Level >= levelRequest && len(a.reqBody) > 0 {
+	if level >= levelRequest && len(a.reqBody) > 0 {
 		buffer.WriteString(`,"requestBody":`)
-		buffer.Write(bytes.TrimSuffix(a.reqBody, []byte("\n")))
+		buffer.Write(redactBody(a.writer.redactions, a.req, bytes.TrimSuffix(a.reqBody, []byte("\n"))))
 	}
-	if a.writer.Level >= levelRequestResponse && resHeaders.Get("Content-Type") == contentTypeJSON && len(resBody) > 0 {
+	if level >= levelRequestResponse && resHeaders.Get("Content-Type") == contentTypeJSON && len(resBody) > 0 {
 		buffer.WriteString(`,"responseBody":`)
-		buffer.Write(bytes.TrimSuffix(resBody, []byte("\n")))
+		buffer.Write(redactBody(a.writer.redactions, a.req, bytes.TrimSuffix(resBody, []byte("\n"))))
 	}
 	buffer.WriteString("}")
 
@@ -133,8 +141,7 @@ func (a *auditLog) write(userInfo *User, reqHeaders, resHeaders http.Header, res
 	}
 
 	compactBuffer.WriteString("\n")
-	_, err = a.writer.Output.Write(compactBuffer.Bytes())
-	return err
+	return a.writer.Write(compactBuffer.Bytes())
 }
 
 func readBodyWithoutLosingContent(req *http.Request) ([]byte, error) {
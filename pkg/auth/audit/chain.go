@@ -0,0 +1,305 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	lumberjack "gopkg.in/natefinch/lumberjack.v2"
+)
+
+const (
+	megabyte       = 1024 * 1024
+	defaultMaxSize = 100
+	hmacField      = `,"hmac":"`
+)
+
+// chainLink holds the fields hash chain mode appends to every audit record. Each record carries the HMAC of the
+// record before it and its own HMAC over everything else, keyed with a key derived from the chain secret and the
+// epoch. The epoch is incremented, and therefore the key rotated, every time the log file is rotated.
+type chainLink struct {
+	Seq      uint64 `json:"seq"`
+	Epoch    uint64 `json:"chainEpoch"`
+	PrevHMAC string `json:"prevHmac"`
+	HMAC     string `json:"hmac"`
+}
+
+type hashChain struct {
+	secret  []byte
+	output  *lumberjack.Logger
+	maxSize int64
+	size    int64
+	last    *chainLink
+}
+
+func newHashChain(secretPath string, output *lumberjack.Logger) (*hashChain, error) {
+	if output == nil {
+		return nil, errors.New("audit log hash chain requires an audit log path")
+	}
+
+	secret, err := readChainSecret(secretPath)
+	if err != nil {
+		return nil, err
+	}
+
+	maxSize := int64(output.MaxSize)
+	if maxSize == 0 {
+		maxSize = defaultMaxSize
+	}
+	chain := &hashChain{
+		secret:  secret,
+		output:  output,
+		maxSize: maxSize * megabyte,
+	}
+
+	// continue the chain from the current file, or from the newest rotated file when the current one is empty
+	if info, err := os.Stat(output.Filename); err == nil && info.Size() > 0 {
+		link, err := lastLink(output.Filename)
+		if err != nil {
+			return nil, err
+		}
+		if link == nil {
+			// the file was written before hash chain mode was enabled, keep it out of the chain
+			if err := output.Rotate(); err != nil {
+				return nil, err
+			}
+		} else {
+			chain.last = link
+			chain.size = info.Size()
+		}
+	} else if backups, err := backupFiles(output.Filename); err == nil && len(backups) > 0 {
+		link, err := lastLink(backups[len(backups)-1])
+		if err != nil {
+			return nil, err
+		}
+		if link != nil {
+			link.Epoch++
+			chain.last = link
+		}
+	}
+
+	return chain, nil
+}
+
+func readChainSecret(path string) ([]byte, error) {
+	secret, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read audit log chain key file %s", path)
+	}
+	secret = bytes.TrimSpace(secret)
+	if len(secret) == 0 {
+		return nil, errors.Errorf("audit log chain key file %s is empty", path)
+	}
+	return secret, nil
+}
+
+// append links entry to the chain and writes it, rotating the file and the chain key first if the entry would
+// not fit. It is not safe for concurrent use.
+func (c *hashChain) append(entry []byte) ([]byte, error) {
+	next := chainLink{}
+	if c.last != nil {
+		next.Seq = c.last.Seq + 1
+		next.Epoch = c.last.Epoch
+		next.PrevHMAC = c.last.HMAC
+	}
+
+	linked := c.link(entry, &next)
+	if c.size > 0 && c.size+int64(len(linked)) >= c.maxSize {
+		if err := c.output.Rotate(); err != nil {
+			return nil, err
+		}
+		c.size = 0
+		next.Epoch++
+		linked = c.link(entry, &next)
+	}
+
+	n, err := c.output.Write(linked)
+	c.size += int64(n)
+	if err != nil {
+		return nil, err
+	}
+	c.last = &next
+	return linked, nil
+}
+
+func (c *hashChain) link(entry []byte, link *chainLink) []byte {
+	var buffer bytes.Buffer
+	buffer.Write(bytes.TrimSuffix(bytes.TrimSuffix(entry, []byte("\n")), []byte("}")))
+	fmt.Fprintf(&buffer, `,"seq":%d,"chainEpoch":%d,"prevHmac":"%s"`, link.Seq, link.Epoch, link.PrevHMAC)
+
+	link.HMAC = signRecord(chainKey(c.secret, link.Epoch), buffer.Bytes())
+	buffer.WriteString(hmacField + link.HMAC + "\"}\n")
+	return buffer.Bytes()
+}
+
+func chainKey(secret []byte, epoch uint64) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte("rancher-audit-chain/" + strconv.FormatUint(epoch, 10)))
+	return mac.Sum(nil)
+}
+
+func signRecord(key, body []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(body)
+	mac.Write([]byte("}"))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func lastLink(path string) (*chainLink, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := bytes.Split(bytes.TrimRight(content, "\n"), []byte("\n"))
+	last := lines[len(lines)-1]
+	if !bytes.Contains(last, []byte(hmacField)) {
+		return nil, nil
+	}
+
+	link := &chainLink{}
+	if err := json.Unmarshal(last, link); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse last audit record of %s", path)
+	}
+	return link, nil
+}
+
+// backupFiles returns the files lumberjack rotated away from path, oldest first.
+func backupFiles(path string) ([]string, error) {
+	dir := filepath.Dir(path)
+	filename := filepath.Base(path)
+	ext := filepath.Ext(filename)
+	prefix := filename[:len(filename)-len(ext)] + "-"
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var backups []string
+	for _, f := range files {
+		if f.IsDir() || !strings.HasPrefix(f.Name(), prefix) || !strings.HasSuffix(f.Name(), ext) {
+			continue
+		}
+		backups = append(backups, filepath.Join(dir, f.Name()))
+	}
+	// the timestamp format used by lumberjack sorts lexically
+	sort.Strings(backups)
+	return backups, nil
+}
+
+// BrokenLinkError reports the first record that does not verify.
+type BrokenLinkError struct {
+	File   string
+	Line   int
+	Reason string
+}
+
+func (e *BrokenLinkError) Error() string {
+	return fmt.Sprintf("audit log hash chain broken at %s:%d: %s", e.File, e.Line, e.Reason)
+}
+
+// VerifyChain walks the rotated audit log files of path and then path itself, and returns the number of verified
+// records or a *BrokenLinkError for the first record that was modified, removed or reordered. A record with
+// sequence number zero and no previous HMAC starts a new chain, as written when hash chain mode is enabled. Records
+// from before the first chained record are skipped.
+func VerifyChain(path string, secret []byte) (int, error) {
+	files, err := backupFiles(path)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := os.Stat(path); err == nil {
+		files = append(files, path)
+	}
+
+	var (
+		last  *chainLink
+		count int
+	)
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			return count, err
+		}
+
+		scanner := bufio.NewScanner(f)
+		scanner.Buffer(make([]byte, 64*1024), 64*megabyte)
+		lineNumber := 0
+		firstInFile := true
+		for scanner.Scan() {
+			lineNumber++
+			line := scanner.Bytes()
+			if len(line) == 0 {
+				continue
+			}
+
+			if last == nil && !bytes.Contains(line, []byte(hmacField)) {
+				// written before hash chain mode was enabled
+				continue
+			}
+
+			link, reason := verifyRecord(secret, line, last, firstInFile)
+			if reason != "" {
+				f.Close()
+				return count, &BrokenLinkError{File: file, Line: lineNumber, Reason: reason}
+			}
+			last = link
+			firstInFile = false
+			count++
+		}
+		err = scanner.Err()
+		f.Close()
+		if err != nil {
+			return count, err
+		}
+	}
+
+	return count, nil
+}
+
+func verifyRecord(secret, line []byte, last *chainLink, firstInFile bool) (*chainLink, string) {
+	i := bytes.LastIndex(line, []byte(hmacField))
+	if i < 0 {
+		return nil, "record is not part of the hash chain"
+	}
+
+	link := &chainLink{}
+	if err := json.Unmarshal(line, link); err != nil {
+		return nil, "record is not valid json: " + err.Error()
+	}
+	if !bytes.Equal(line[i:], []byte(hmacField+link.HMAC+"\"}")) {
+		return nil, "hmac is not the last field of the record"
+	}
+	expected := signRecord(chainKey(secret, link.Epoch), line[:i])
+	if !hmac.Equal([]byte(expected), []byte(link.HMAC)) {
+		return nil, "record hmac does not match its content"
+	}
+
+	if last == nil || (link.Seq == 0 && link.PrevHMAC == "") {
+		return link, ""
+	}
+	if link.Seq != last.Seq+1 {
+		return nil, fmt.Sprintf("expected sequence number %d, found %d", last.Seq+1, link.Seq)
+	}
+	if link.PrevHMAC != last.HMAC {
+		return nil, "previous hmac does not match the preceding record"
+	}
+	if firstInFile && link.Epoch != last.Epoch+1 {
+		return nil, fmt.Sprintf("expected chain key epoch %d after rotation, found %d", last.Epoch+1, link.Epoch)
+	}
+	if !firstInFile && link.Epoch != last.Epoch {
+		return nil, fmt.Sprintf("chain key epoch changed from %d to %d without rotation", last.Epoch, link.Epoch)
+	}
+	return link, ""
+}
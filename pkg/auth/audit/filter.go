@@ -38,7 +38,7 @@ func (h auditHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	context := context.WithValue(req.Context(), userKey, user)
 	req = req.WithContext(context)
 
-	auditLog, err := newAuditLog(h.auditWriter, req)
+	auditLog, err := newAuditLog(h.auditWriter, user, req)
 	if err != nil {
 		util.ReturnHTTPError(rw, req, 500, err.Error())
 		return
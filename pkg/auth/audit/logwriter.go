@@ -2,13 +2,21 @@
 
 import (
 	"context"
+	"sync"
 
+	"github.com/sirupsen/logrus"
 	lumberjack "gopkg.in/natefinch/lumberjack.v2"
 )
 
 type LogWriter struct {
 	Level  int
 	Output *lumberjack.Logger
+	Policy *Policy
+	Sinks  []Sink
+
+	redactions []RedactionRule
+	chainLock  sync.Mutex
+	chain      *hashChain
 }
 
 func (l *LogWriter) Start(ctx context.Context) {
@@ -17,22 +25,91 @@ func (l *LogWriter) Start(ctx context.Context) {
 	}
 	go func() {
 		<-ctx.Done()
-		l.Output.Close()
+		if l.Output != nil {
+			l.Output.Close()
+		}
+		for _, sink := range l.Sinks {
+			sink.Close()
+		}
 	}()
 }
 
-func NewLogWriter(path string, level, maxAge, maxBackup, maxSize int) *LogWriter {
-	if path == "" || level == levelNull {
-		return nil
+// Write sends one serialized audit record to the log file and every configured sink. A failing sink does not
+// keep the record from reaching the others. In hash chain mode the sinks receive the chained record.
+func (l *LogWriter) Write(entry []byte) error {
+	var err error
+	if l.chain != nil {
+		l.chainLock.Lock()
+		var linked []byte
+		linked, err = l.chain.append(entry)
+		l.chainLock.Unlock()
+		if err != nil {
+			return err
+		}
+		entry = linked
+	} else if l.Output != nil {
+		_, err = l.Output.Write(entry)
+	}
+	for _, sink := range l.Sinks {
+		if sinkErr := sink.Write(entry); sinkErr != nil {
+			logrus.Warnf("failed to write audit record: %v", sinkErr)
+		}
+	}
+	return err
+}
+
+func NewLogWriter(path, policyPath, chainKeyPath string, level, maxAge, maxBackup, maxSize int) (*LogWriter, error) {
+	if level == levelNull {
+		return nil, nil
 	}
 
-	return &LogWriter{
-		Level: level,
-		Output: &lumberjack.Logger{
+	policy, err := LoadPolicy(policyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := DefaultRedactionRules
+	if policy != nil {
+		rules = append(append([]RedactionRule{}, rules...), policy.Redactions...)
+	}
+	redactions, err := compileRedactionRules(rules)
+	if err != nil {
+		return nil, err
+	}
+
+	writer := &LogWriter{
+		Level:      level,
+		Policy:     policy,
+		redactions: redactions,
+	}
+	if path != "" {
+		writer.Output = &lumberjack.Logger{
 			Filename:   path,
 			MaxAge:     maxAge,
 			MaxBackups: maxBackup,
 			MaxSize:    maxSize,
-		},
+		}
+	}
+
+	if chainKeyPath != "" {
+		writer.chain, err = newHashChain(chainKeyPath, writer.Output)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if policy != nil {
+		for _, config := range policy.Sinks {
+			sink, err := NewSink(config)
+			if err != nil {
+				return nil, err
+			}
+			writer.Sinks = append(writer.Sinks, sink)
+		}
+	}
+
+	if writer.Output == nil && len(writer.Sinks) == 0 {
+		return nil, nil
 	}
+	return writer, nil
 }
@@ -0,0 +1,146 @@
+package audit
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+)
+
+// Policy is the content of the file passed with --audit-policy-file. Rules are evaluated in order and the
+// first matching rule decides the level of a request, falling back to the global audit level when none match.
+// Redactions are applied on top of DefaultRedactionRules.
+type Policy struct {
+	Rules      []PolicyRule    `json:"rules,omitempty"`
+	Sinks      []SinkConfig    `json:"sinks,omitempty"`
+	Redactions []RedactionRule `json:"redactions,omitempty"`
+}
+
+// PolicyRule matches requests the same way a Kubernetes audit policy rule does. Empty fields match everything.
+type PolicyRule struct {
+	Level         int      `json:"level"`
+	Users         []string `json:"users,omitempty"`
+	Groups        []string `json:"groups,omitempty"`
+	Verbs         []string `json:"verbs,omitempty"`
+	URIPrefixes   []string `json:"uriPrefixes,omitempty"`
+	ResponseCodes []int    `json:"responseCodes,omitempty"`
+}
+
+func LoadPolicy(path string) (*Policy, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read audit policy file %s", path)
+	}
+
+	policy := &Policy{}
+	if err := yaml.Unmarshal(content, policy); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse audit policy file %s", path)
+	}
+
+	for i, rule := range policy.Rules {
+		if rule.Level < levelNull || rule.Level > levelRequestResponse {
+			return nil, errors.Errorf("invalid level %d in audit policy rule %d", rule.Level, i)
+		}
+	}
+	return policy, nil
+}
+
+// requestLevel returns the highest level any rule could assign to the request before its response code is known,
+// so that the request body is only buffered when a later decision might need it.
+func (p *Policy) requestLevel(defaultLevel int, user *User, req *http.Request) int {
+	if p == nil || len(p.Rules) == 0 {
+		return defaultLevel
+	}
+
+	level := levelNull
+	for _, rule := range p.Rules {
+		if !rule.matchRequest(user, req) {
+			continue
+		}
+		if rule.Level > level {
+			level = rule.Level
+		}
+		if len(rule.ResponseCodes) == 0 {
+			return level
+		}
+	}
+	if defaultLevel > level {
+		level = defaultLevel
+	}
+	return level
+}
+
+// responseLevel returns the level of the first rule matching the completed request.
+func (p *Policy) responseLevel(defaultLevel int, user *User, req *http.Request, code int) int {
+	if p == nil {
+		return defaultLevel
+	}
+
+	for _, rule := range p.Rules {
+		if rule.matchRequest(user, req) && rule.matchResponseCode(code) {
+			return rule.Level
+		}
+	}
+	return defaultLevel
+}
+
+func (r *PolicyRule) matchRequest(user *User, req *http.Request) bool {
+	if len(r.Users) > 0 && (user == nil || !isExist(r.Users, user.Name)) {
+		return false
+	}
+	if len(r.Groups) > 0 && (user == nil || !anyExist(r.Groups, user.Group)) {
+		return false
+	}
+	if len(r.Verbs) > 0 && !isExistFold(r.Verbs, req.Method) {
+		return false
+	}
+	if len(r.URIPrefixes) > 0 {
+		matched := false
+		for _, prefix := range r.URIPrefixes {
+			if strings.HasPrefix(req.RequestURI, prefix) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *PolicyRule) matchResponseCode(code int) bool {
+	if len(r.ResponseCodes) == 0 {
+		return true
+	}
+	for _, c := range r.ResponseCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+func anyExist(array []string, keys []string) bool {
+	for _, key := range keys {
+		if isExist(array, key) {
+			return true
+		}
+	}
+	return false
+}
+
+func isExistFold(array []string, key string) bool {
+	for _, v := range array {
+		if strings.EqualFold(v, key) {
+			return true
+		}
+	}
+	return false
+}
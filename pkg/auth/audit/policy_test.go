@@ -0,0 +1,55 @@
+package audit
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicyLevels(t *testing.T) {
+	assert := assert.New(t)
+
+	policy := &Policy{
+		Rules: []PolicyRule{
+			{
+				Level:       levelNull,
+				URIPrefixes: []string{"/v3/settings"},
+				Verbs:       []string{"get"},
+			},
+			{
+				Level:         levelRequestResponse,
+				Groups:        []string{"system:authenticated"},
+				URIPrefixes:   []string{"/v3/clusters"},
+				ResponseCodes: []int{http.StatusForbidden},
+			},
+			{
+				Level: levelMetadata,
+				Users: []string{"u-bot"},
+			},
+		},
+	}
+
+	settingsGet := &http.Request{Method: http.MethodGet, RequestURI: "/v3/settings/server-url"}
+	settingsPut := &http.Request{Method: http.MethodPut, RequestURI: "/v3/settings/server-url"}
+	clusterPost := &http.Request{Method: http.MethodPost, RequestURI: "/v3/clusters"}
+
+	admin := &User{Name: "admin", Group: []string{"system:authenticated"}}
+	bot := &User{Name: "u-bot", Group: []string{"system:authenticated"}}
+
+	assert.Equal(levelNull, policy.requestLevel(levelRequest, admin, settingsGet))
+	assert.Equal(levelNull, policy.responseLevel(levelRequest, admin, settingsGet, http.StatusOK))
+	assert.Equal(levelRequest, policy.responseLevel(levelRequest, admin, settingsPut, http.StatusOK))
+
+	// the body must be buffered because a forbidden response would be logged with it
+	assert.Equal(levelRequestResponse, policy.requestLevel(levelRequest, admin, clusterPost))
+	assert.Equal(levelRequestResponse, policy.responseLevel(levelRequest, admin, clusterPost, http.StatusForbidden))
+	assert.Equal(levelRequest, policy.responseLevel(levelRequest, admin, clusterPost, http.StatusCreated))
+
+	assert.Equal(levelRequestResponse, policy.requestLevel(levelRequest, bot, clusterPost))
+	assert.Equal(levelMetadata, policy.responseLevel(levelRequest, bot, clusterPost, http.StatusCreated))
+
+	var nilPolicy *Policy
+	assert.Equal(levelRequest, nilPolicy.requestLevel(levelRequest, admin, settingsGet))
+	assert.Equal(levelRequest, nilPolicy.responseLevel(levelRequest, admin, settingsGet, http.StatusOK))
+}
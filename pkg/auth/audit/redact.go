@@ -0,0 +1,259 @@
+package audit
+
+import (
+	"encoding/json"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const redacted = "[redacted]"
+
+// RedactionRule removes the values selected by Paths from request and response bodies of the given schema type and
+// action before they are audited. An empty Type or Action matches any. Paths use a JSONPath subset: "$.a.b",
+// "$.a[*].b", "$.a[0]", "$..b" for recursive descent and "*" globs in keys, e.g. "$.*credentialConfig.*".
+type RedactionRule struct {
+	Type   string   `json:"type,omitempty"`
+	Action string   `json:"action,omitempty"`
+	Paths  []string `json:"paths"`
+
+	compiled [][]pathToken
+}
+
+// DefaultRedactionRules cover every secret-bearing field of the management v3 types and are always applied in
+// addition to the rules of the audit policy.
+var DefaultRedactionRules = []RedactionRule{
+	{Type: "token", Paths: []string{"$..token"}},
+	{Type: "user", Paths: []string{"$..password"}},
+	{Type: "user", Action: "setpassword", Paths: []string{"$.newPassword"}},
+	{Type: "user", Action: "changepassword", Paths: []string{"$.currentPassword", "$.newPassword"}},
+	{Action: "login", Paths: []string{"$.password", "$.token"}},
+	{Type: "authConfig", Paths: []string{"$..clientSecret", "$..oauthCredential", "$..serviceAccountCredential",
+		"$..applicationSecret", "$..serviceAccountPassword", "$..spKey", "$..code"}},
+	{Type: "cloudCredential", Paths: []string{"$.*credentialConfig.*"}},
+	{Type: "nodeTemplate", Paths: []string{"$..secretKey", "$..password", "$..apiKey", "$..apiToken", "$..accessToken",
+		"$..token", "$..clientSecret", "$..sshKey"}},
+	{Type: "cluster", Paths: []string{"$..kubeConfig", "$..sshKey", "$..secretKey", "$..privateKey", "$..clientSecret",
+		"$..serviceAccountKey", "$..credential", "$..password"}},
+	{Type: "clusterTemplateRevision", Paths: []string{"$..kubeConfig", "$..sshKey", "$..secretKey", "$..privateKey",
+		"$..clientSecret", "$..serviceAccountKey", "$..credential", "$..password"}},
+	{Type: "clusterRegistrationToken", Paths: []string{"$..token", "$..command", "$..insecureCommand", "$..nodeCommand",
+		"$..windowsNodeCommand", "$..manifestUrl"}},
+	{Type: "etcdBackup", Paths: []string{"$..secretKey", "$..privateKey", "$..password"}},
+	{Type: "cluster", Action: "generateKubeconfig", Paths: []string{"$.config"}},
+	{Type: "catalog", Paths: []string{"$..password"}},
+	{Type: "clusterCatalog", Paths: []string{"$..password"}},
+	{Type: "projectCatalog", Paths: []string{"$..password"}},
+	{Type: "globalDnsProvider", Paths: []string{"$..secretKey", "$..apiKey"}},
+	{Type: "notifier", Paths: []string{"$..password", "$..secret", "$..serviceKey", "$..apiKey", "$..botToken"}},
+	{Type: "clusterLogging", Paths: []string{"$..authPassword", "$..token", "$..saslPassword", "$..password",
+		"$..sharedKey", "$..clientKey"}},
+	{Type: "projectLogging", Paths: []string{"$..authPassword", "$..token", "$..saslPassword", "$..password",
+		"$..sharedKey", "$..clientKey"}},
+}
+
+type pathToken struct {
+	descend  bool
+	key      string
+	index    int
+	anyIndex bool
+	isIndex  bool
+}
+
+func compileRedactionRules(rules []RedactionRule) ([]RedactionRule, error) {
+	result := make([]RedactionRule, 0, len(rules))
+	for _, rule := range rules {
+		rule.compiled = nil
+		for _, p := range rule.Paths {
+			tokens, err := parsePath(p)
+			if err != nil {
+				return nil, err
+			}
+			rule.compiled = append(rule.compiled, tokens)
+		}
+		result = append(result, rule)
+	}
+	return result, nil
+}
+
+func parsePath(p string) ([]pathToken, error) {
+	if !strings.HasPrefix(p, "$") {
+		return nil, errors.Errorf("redaction path %q must start with $", p)
+	}
+
+	var tokens []pathToken
+	rest := p[1:]
+	for rest != "" {
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			tokens = append(tokens, pathToken{descend: true})
+			rest = rest[1:]
+		case strings.HasPrefix(rest, "."):
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, errors.Errorf("empty key in redaction path %q", p)
+			}
+			tokens = append(tokens, pathToken{key: rest[:end]})
+			rest = rest[end:]
+		case strings.HasPrefix(rest, "["):
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				return nil, errors.Errorf("unterminated index in redaction path %q", p)
+			}
+			index := rest[1:end]
+			if index == "*" {
+				tokens = append(tokens, pathToken{isIndex: true, anyIndex: true})
+			} else {
+				i, err := strconv.Atoi(index)
+				if err != nil {
+					return nil, errors.Errorf("invalid index %q in redaction path %q", index, p)
+				}
+				tokens = append(tokens, pathToken{isIndex: true, index: i})
+			}
+			rest = rest[end+1:]
+		default:
+			return nil, errors.Errorf("invalid redaction path %q", p)
+		}
+	}
+
+	if len(tokens) == 0 || tokens[len(tokens)-1].descend {
+		return nil, errors.Errorf("redaction path %q does not select a field", p)
+	}
+	return tokens, nil
+}
+
+// redactBody applies the rules matching the request to a JSON body. A body that should be redacted but cannot be
+// parsed is dropped entirely rather than logged verbatim.
+func redactBody(rules []RedactionRule, req *http.Request, body []byte) []byte {
+	schemaType, action := schemaTypeAndAction(req)
+
+	var matched []RedactionRule
+	for _, rule := range rules {
+		if rule.matches(schemaType, action) {
+			matched = append(matched, rule)
+		}
+	}
+	if len(matched) == 0 {
+		return body
+	}
+
+	var data interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return []byte(`"` + redacted + `"`)
+	}
+	for _, rule := range matched {
+		for _, tokens := range rule.compiled {
+			data = redactValue(data, tokens)
+		}
+	}
+
+	result, err := json.Marshal(data)
+	if err != nil {
+		return []byte(`"` + redacted + `"`)
+	}
+	return result
+}
+
+func redactValue(node interface{}, tokens []pathToken) interface{} {
+	token, rest := tokens[0], tokens[1:]
+
+	if token.descend {
+		node = redactValue(node, rest)
+		switch n := node.(type) {
+		case map[string]interface{}:
+			for k, v := range n {
+				n[k] = redactValue(v, tokens)
+			}
+		case []interface{}:
+			for i, v := range n {
+				n[i] = redactValue(v, tokens)
+			}
+		}
+		return node
+	}
+
+	if token.isIndex {
+		n, ok := node.([]interface{})
+		if !ok {
+			return node
+		}
+		for i := range n {
+			if !token.anyIndex && i != token.index {
+				continue
+			}
+			if len(rest) == 0 {
+				n[i] = redacted
+			} else {
+				n[i] = redactValue(n[i], rest)
+			}
+		}
+		return node
+	}
+
+	n, ok := node.(map[string]interface{})
+	if !ok {
+		return node
+	}
+	for k, v := range n {
+		if matched, _ := path.Match(token.key, k); !matched {
+			continue
+		}
+		if len(rest) == 0 {
+			n[k] = redacted
+		} else {
+			n[k] = redactValue(v, rest)
+		}
+	}
+	return node
+}
+
+func (r *RedactionRule) matches(schemaType, action string) bool {
+	if r.Action != "" && !strings.EqualFold(r.Action, action) {
+		return false
+	}
+	if r.Type == "" {
+		return true
+	}
+	return strings.EqualFold(r.Type, schemaType) || strings.EqualFold(r.Type+"s", schemaType)
+}
+
+// schemaTypeAndAction derives the norman collection and action from a URI such as /v3/users/u-abc?action=setpassword
+// or /v3/project/c-abc:p-xyz/apps/p-xyz:app.
+func schemaTypeAndAction(req *http.Request) (string, string) {
+	uri := req.RequestURI
+	if req.URL != nil {
+		uri = req.URL.RequestURI()
+	}
+
+	query := ""
+	if i := strings.Index(uri, "?"); i >= 0 {
+		uri, query = uri[:i], uri[i+1:]
+	}
+
+	action := ""
+	for _, param := range strings.Split(query, "&") {
+		if strings.HasPrefix(param, "action=") {
+			action = strings.TrimPrefix(param, "action=")
+		}
+	}
+
+	var segments []string
+	for _, s := range strings.Split(strings.Trim(uri, "/"), "/") {
+		if s != "" {
+			segments = append(segments, s)
+		}
+	}
+	if len(segments) < 2 || !strings.HasPrefix(segments[0], "v3") {
+		return "", action
+	}
+
+	segments = segments[1:]
+	return segments[((len(segments)-1)/2)*2], action
+}
@@ -0,0 +1,93 @@
+package audit
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactBody(t *testing.T) {
+	assert := assert.New(t)
+
+	rules, err := compileRedactionRules(append(DefaultRedactionRules, RedactionRule{
+		Type:  "secret",
+		Paths: []string{"$.data", "$.items[0].name"},
+	}))
+	assert.Nil(err)
+
+	testCases := []struct {
+		uri      string
+		body     string
+		expected string
+	}{
+		{
+			uri:      "/v3/users/u-abc?action=setpassword",
+			body:     `{"newPassword":"hunter2"}`,
+			expected: `{"newPassword":"[redacted]"}`,
+		},
+		{
+			uri:      "/v3/tokens",
+			body:     `{"data":[{"name":"token-abc","token":"kubeconfig-u-abc:xyz"}],"type":"collection"}`,
+			expected: `{"data":[{"name":"token-abc","token":"[redacted]"}],"type":"collection"}`,
+		},
+		{
+			uri:      "/v3/cloudcredentials",
+			body:     `{"amazonec2credentialConfig":{"accessKey":"AKIA","secretKey":"s3cr3t"},"name":"aws"}`,
+			expected: `{"amazonec2credentialConfig":{"accessKey":"[redacted]","secretKey":"[redacted]"},"name":"aws"}`,
+		},
+		{
+			uri:      "/v3/clustertemplaterevisions/cattle-global-data:ctr-abc",
+			body:     `{"clusterConfig":{"rancherKubernetesEngineConfig":{"cloudProvider":{"awsCloudProvider":{"global":{"kubernetesClusterTag":"c1"}},"vsphereCloudProvider":{"global":{"password":"vs"}}},"privateRegistries":[{"url":"r.example.com","password":"reg"}]}}}`,
+			expected: `{"clusterConfig":{"rancherKubernetesEngineConfig":{"cloudProvider":{"awsCloudProvider":{"global":{"kubernetesClusterTag":"c1"}},"vsphereCloudProvider":{"global":{"password":"[redacted]"}}},"privateRegistries":[{"url":"r.example.com","password":"[redacted]"}]}}}`,
+		},
+		{
+			uri:      "/v3/clusterregistrationtokens",
+			body:     `{"data":[{"clusterId":"c-abc","token":"abc123","command":"kubectl apply -f https://rancher.example.com/v3/import/abc123.yaml","insecureCommand":"curl --insecure -sfL https://rancher.example.com/v3/import/abc123.yaml | kubectl apply -f -","nodeCommand":"docker run rancher/rancher-agent --token abc123","windowsNodeCommand":"docker run rancher/rancher-agent --token abc123","manifestUrl":"https://rancher.example.com/v3/import/abc123.yaml"}]}`,
+			expected: `{"data":[{"clusterId":"c-abc","token":"[redacted]","command":"[redacted]","insecureCommand":"[redacted]","nodeCommand":"[redacted]","windowsNodeCommand":"[redacted]","manifestUrl":"[redacted]"}]}`,
+		},
+		{
+			uri:      "/v3-public/localProviders/local?action=login",
+			body:     `{"username":"admin","password":"admin"}`,
+			expected: `{"password":"[redacted]","username":"admin"}`,
+		},
+		{
+			uri:      "/v3/project/c-abc:p-xyz/secrets/p-xyz:foo",
+			body:     `{"data":{"key":"dmFsdWU="},"items":[{"name":"a"},{"name":"b"}]}`,
+			expected: `{"data":"[redacted]","items":[{"name":"[redacted]"},{"name":"b"}]}`,
+		},
+		{
+			uri:      "/v3/settings/server-url",
+			body:     `{"value":"https://rancher.example.com"}`,
+			expected: `{"value":"https://rancher.example.com"}`,
+		},
+		{
+			uri:      "/v3/users",
+			body:     `not json`,
+			expected: `"[redacted]"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		u, err := url.ParseRequestURI(tc.uri)
+		assert.Nil(err)
+		req := &http.Request{Method: http.MethodPost, RequestURI: tc.uri, URL: u}
+
+		result := redactBody(rules, req, []byte(tc.body))
+		assert.JSONEq(tc.expected, string(result), tc.uri)
+	}
+}
+
+func TestParsePath(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, p := range []string{"$.a", "$..a", "$.a[*].b", "$.a[2]", "$.*Config.*"} {
+		_, err := parsePath(p)
+		assert.Nil(err, p)
+	}
+	for _, p := range []string{"a", "$", "$..", "$.a[", "$.a[x]", "$.a..", "$a"} {
+		_, err := parsePath(p)
+		assert.NotNil(err, p)
+	}
+}
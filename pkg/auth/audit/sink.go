@@ -0,0 +1,270 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	kafka "github.com/segmentio/kafka-go"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	SinkTypeWebhook = "webhook"
+	SinkTypeSyslog  = "syslog"
+	SinkTypeKafka   = "kafka"
+
+	defaultSinkBufferSize = 1000
+	sinkWriteTimeout      = 10 * time.Second
+)
+
+// Sink receives every audit record in its final serialized form, one JSON document per call.
+type Sink interface {
+	Write(entry []byte) error
+	Close() error
+}
+
+// SinkConfig describes an additional destination for audit records next to the audit log file.
+type SinkConfig struct {
+	Type string `json:"type"`
+	// BufferSize is the number of records queued for a remote sink before new records are dropped.
+	BufferSize int `json:"bufferSize,omitempty"`
+
+	// webhook
+	URL     string            `json:"url,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// syslog
+	Network string `json:"network,omitempty"`
+	Address string `json:"address,omitempty"`
+	Tag     string `json:"tag,omitempty"`
+
+	// kafka
+	Brokers []string `json:"brokers,omitempty"`
+	Topic   string   `json:"topic,omitempty"`
+}
+
+func NewSink(config SinkConfig) (Sink, error) {
+	var (
+		sink Sink
+		err  error
+	)
+
+	switch config.Type {
+	case SinkTypeWebhook:
+		sink, err = newWebhookSink(config)
+	case SinkTypeSyslog:
+		sink, err = newSyslogSink(config)
+	case SinkTypeKafka:
+		sink, err = newKafkaSink(config)
+	default:
+		return nil, fmt.Errorf("unknown audit sink type %q", config.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return newAsyncSink(sink, config.BufferSize), nil
+}
+
+// asyncSink keeps slow remote destinations out of the request path. Records are dropped, not blocked on, once
+// the buffer is full.
+type asyncSink struct {
+	sync.RWMutex
+	sink    Sink
+	entries chan []byte
+	done    chan struct{}
+	closed  bool
+}
+
+func newAsyncSink(sink Sink, size int) *asyncSink {
+	if size <= 0 {
+		size = defaultSinkBufferSize
+	}
+	a := &asyncSink{
+		sink:    sink,
+		entries: make(chan []byte, size),
+		done:    make(chan struct{}),
+	}
+	go a.run()
+	return a
+}
+
+func (a *asyncSink) run() {
+	defer close(a.done)
+	for entry := range a.entries {
+		if err := a.sink.Write(entry); err != nil {
+			logrus.Warnf("failed to write audit record to %T: %v", a.sink, err)
+		}
+	}
+}
+
+func (a *asyncSink) Write(entry []byte) error {
+	a.RLock()
+	defer a.RUnlock()
+	if a.closed {
+		return nil
+	}
+
+	select {
+	case a.entries <- entry:
+		return nil
+	default:
+		return fmt.Errorf("audit sink %T buffer is full, dropping record", a.sink)
+	}
+}
+
+func (a *asyncSink) Close() error {
+	a.Lock()
+	if a.closed {
+		a.Unlock()
+		return nil
+	}
+	a.closed = true
+	close(a.entries)
+	a.Unlock()
+
+	<-a.done
+	return a.sink.Close()
+}
+
+type webhookSink struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func newWebhookSink(config SinkConfig) (*webhookSink, error) {
+	if config.URL == "" {
+		return nil, errors.New("url is required for webhook audit sink")
+	}
+	return &webhookSink{
+		url:     config.URL,
+		headers: config.Headers,
+		client:  &http.Client{Timeout: sinkWriteTimeout},
+	}, nil
+}
+
+func (w *webhookSink) Write(entry []byte) error {
+	req, err := http.NewRequest(http.MethodPost, w.url, bytes.NewReader(entry))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentTypeJSON)
+	for k, v := range w.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook %s responded with status %d", w.url, resp.StatusCode)
+	}
+	return nil
+}
+
+func (w *webhookSink) Close() error {
+	return nil
+}
+
+// syslogSink writes RFC 5424 messages with facility local0 and severity info. It talks to the socket directly
+// instead of through log/syslog, which is not available on Windows.
+type syslogSink struct {
+	network  string
+	address  string
+	tag      string
+	hostname string
+	conn     net.Conn
+}
+
+func newSyslogSink(config SinkConfig) (*syslogSink, error) {
+	if config.Address == "" {
+		return nil, errors.New("address is required for syslog audit sink")
+	}
+	network := config.Network
+	if network == "" {
+		network = "udp"
+	}
+	tag := config.Tag
+	if tag == "" {
+		tag = "rancher-audit"
+	}
+	hostname, _ := os.Hostname()
+	if hostname == "" {
+		hostname = "-"
+	}
+	return &syslogSink{
+		network:  network,
+		address:  config.Address,
+		tag:      tag,
+		hostname: hostname,
+	}, nil
+}
+
+func (s *syslogSink) Write(entry []byte) error {
+	if s.conn == nil {
+		conn, err := net.DialTimeout(s.network, s.address, sinkWriteTimeout)
+		if err != nil {
+			return err
+		}
+		s.conn = conn
+	}
+
+	// <134> is facility local0 (16) * 8 + severity info (6)
+	msg := fmt.Sprintf("<134>1 %s %s %s - - - %s", time.Now().Format(time.RFC3339), s.hostname, s.tag, bytes.TrimSuffix(entry, []byte("\n")))
+	if s.network != "udp" {
+		msg += "\n"
+	}
+
+	s.conn.SetWriteDeadline(time.Now().Add(sinkWriteTimeout))
+	if _, err := s.conn.Write([]byte(msg)); err != nil {
+		s.conn.Close()
+		s.conn = nil
+		return err
+	}
+	return nil
+}
+
+func (s *syslogSink) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+	return s.conn.Close()
+}
+
+type kafkaSink struct {
+	writer *kafka.Writer
+}
+
+func newKafkaSink(config SinkConfig) (*kafkaSink, error) {
+	if len(config.Brokers) == 0 || config.Topic == "" {
+		return nil, errors.New("brokers and topic are required for kafka audit sink")
+	}
+	return &kafkaSink{
+		writer: kafka.NewWriter(kafka.WriterConfig{
+			Brokers:      config.Brokers,
+			Topic:        config.Topic,
+			WriteTimeout: sinkWriteTimeout,
+		}),
+	}, nil
+}
+
+func (k *kafkaSink) Write(entry []byte) error {
+	return k.writer.WriteMessages(context.Background(), kafka.Message{
+		Value: entry,
+		Time:  time.Now(),
+	})
+}
+
+func (k *kafkaSink) Close() error {
+	return k.writer.Close()
+}
@@ -0,0 +1,56 @@
+package audit
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/docker/docker/pkg/reexec"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+func RegisterVerifyCommand() {
+	reexec.Register("/usr/bin/verify-audit-log", verifyAuditLog)
+	reexec.Register("verify-audit-log", verifyAuditLog)
+}
+
+func verifyAuditLog() {
+	app := cli.NewApp()
+	app.Description = "Verify the hash chain of the Rancher API audit log and its rotated files"
+	app.Flags = []cli.Flag{
+		cli.StringFlag{
+			Name:   "audit-log-path",
+			EnvVar: "AUDIT_LOG_PATH",
+			Value:  "/var/log/auditlog/rancher-api-audit.log",
+			Usage:  "Log path for Rancher Server API",
+		},
+		cli.StringFlag{
+			Name:   "audit-log-chain-key-file",
+			EnvVar: "AUDIT_LOG_CHAIN_KEY_FILE",
+			Usage:  "Path to the file with the secret the hash chain was written with",
+		},
+	}
+
+	app.Action = func(c *cli.Context) error {
+		if c.String("audit-log-chain-key-file") == "" {
+			return errors.New("--audit-log-chain-key-file is required")
+		}
+		secret, err := readChainSecret(c.String("audit-log-chain-key-file"))
+		if err != nil {
+			return err
+		}
+
+		count, err := VerifyChain(c.String("audit-log-path"), secret)
+		if err != nil {
+			return errors.Wrapf(err, "%d records verified before failure", count)
+		}
+		fmt.Fprintf(os.Stdout, "%d audit records verified\n", count)
+		return nil
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -284,11 +284,6 @@ func (m *userManager) newTokenForKubeconfig(clusterName, tokenName, description,
 		return nil, fmt.Errorf("failed to generate token key %v", err)
 	}
 
-	tokenTTL, err := tokens.ValidateMaxTTL(ttl)
-	if err != nil {
-		return nil, fmt.Errorf("failed to validate token ttl %v", err)
-	}
-
 	token := &v3.Token{
 		ObjectMeta: v1.ObjectMeta{
 			Name: tokenName,
@@ -305,6 +300,10 @@ func (m *userManager) newTokenForKubeconfig(clusterName, tokenName, description,
 		ClusterName:  clusterName,
 	}
 
+	tokenTTL, err := tokens.ValidateMaxTTL(ttl, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate token ttl %v", err)
+	}
 	if tokenTTL.Minutes() > 0 {
 		token.TTLMillis = tokenTTL.Milliseconds()
 	}
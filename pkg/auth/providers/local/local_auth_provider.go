@@ -28,6 +28,8 @@
 	userSearchIndex       = "authn.management.cattle.io/user-search-index"
 	groupSearchIndex      = "authn.management.cattle.io/group-search-index"
 	searchIndexDefaultLen = 6
+	// groups provisioned through SCIM belong to another auth provider
+	scimProviderLabel = "scim.cattle.io/provider"
 )
 
 type Provider struct {
@@ -310,6 +312,9 @@ func (l *Provider) listAllUsersAndGroups(searchKey string) ([]*v3.User, []*v3.Gr
 		return localUsers, localGroups, err
 	}
 	for _, group := range allGroups {
+		if group.Labels[scimProviderLabel] != "" {
+			continue
+		}
 		if !(strings.HasPrefix(group.ObjectMeta.Name, searchKey) || strings.HasPrefix(group.DisplayName, searchKey)) {
 			continue
 		}
@@ -400,7 +405,7 @@ func userSearchIndexer(obj interface{}) ([]string, error) {
 
 func groupSearchIndexer(obj interface{}) ([]string, error) {
 	group, ok := obj.(*v3.Group)
-	if !ok {
+	if !ok || group.Labels[scimProviderLabel] != "" {
 		return []string{}, nil
 	}
 	var fieldIndexes []string
@@ -0,0 +1,335 @@
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/pkg/errors"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"golang.org/x/oauth2"
+)
+
+const (
+	discoveryPath     = "/.well-known/openid-configuration"
+	discoveryCacheTTL = 10 * time.Minute
+)
+
+// discoveryDocument holds the provider metadata published by the issuer, see
+// https://openid.net/specs/openid-connect-discovery-1_0.html#ProviderMetadata
+type discoveryDocument struct {
+	Issuer                string   `json:"issuer"`
+	AuthorizationEndpoint string   `json:"authorization_endpoint"`
+	TokenEndpoint         string   `json:"token_endpoint"`
+	UserInfoEndpoint      string   `json:"userinfo_endpoint"`
+	JWKSURI               string   `json:"jwks_uri"`
+	CodeChallengeMethods  []string `json:"code_challenge_methods_supported"`
+}
+
+type cachedDocument struct {
+	doc     *discoveryDocument
+	fetched time.Time
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type OIDCClient struct {
+	httpClient *http.Client
+
+	cacheLock sync.Mutex
+	documents map[string]cachedDocument
+}
+
+func newOIDCClient(httpClient *http.Client) *OIDCClient {
+	return &OIDCClient{
+		httpClient: httpClient,
+		documents:  map[string]cachedDocument{},
+	}
+}
+
+// discover returns the discovery document of the issuer, cached for a few minutes since it is read on every login
+// and group refresh.
+func (c *OIDCClient) discover(issuer string) (*discoveryDocument, error) {
+	issuer = strings.TrimSuffix(issuer, "/")
+
+	c.cacheLock.Lock()
+	cached, ok := c.documents[issuer]
+	c.cacheLock.Unlock()
+	if ok && time.Since(cached.fetched) < discoveryCacheTTL {
+		return cached.doc, nil
+	}
+
+	doc := &discoveryDocument{}
+	if err := c.getJSON(issuer+discoveryPath, doc); err != nil {
+		return nil, errors.Wrapf(err, "failed to discover OpenID provider %s", issuer)
+	}
+	if strings.TrimSuffix(doc.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("issuer %s of the discovery document does not match the configured issuer %s", doc.Issuer, issuer)
+	}
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JWKSURI == "" {
+		return nil, fmt.Errorf("discovery document of %s is missing the authorization, token or jwks endpoint", issuer)
+	}
+	if len(doc.CodeChallengeMethods) > 0 && !contains(doc.CodeChallengeMethods, "S256") {
+		return nil, fmt.Errorf("OpenID provider %s does not support the S256 PKCE code challenge method", issuer)
+	}
+
+	c.cacheLock.Lock()
+	c.documents[issuer] = cachedDocument{doc: doc, fetched: time.Now()}
+	c.cacheLock.Unlock()
+	return doc, nil
+}
+
+func (c *OIDCClient) oauth2Config(config *v32.OIDCConfig, doc *discoveryDocument) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     config.ClientID,
+		ClientSecret: config.ClientSecret,
+		RedirectURL:  config.RancherURL,
+		Scopes:       scopes(config),
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  doc.AuthorizationEndpoint,
+			TokenURL: doc.TokenEndpoint,
+		},
+	}
+}
+
+// context returns ctx with the http client set for the requests made by the oauth2 package.
+func (c *OIDCClient) context(ctx context.Context) context.Context {
+	return context.WithValue(ctx, oauth2.HTTPClient, c.httpClient)
+}
+
+// exchange redeems the authorization code together with the PKCE code verifier and returns the token and the
+// verified claims of its ID token.
+func (c *OIDCClient) exchange(ctx context.Context, config *v32.OIDCConfig, doc *discoveryDocument, code, codeVerifier string) (*oauth2.Token, jwt.MapClaims, error) {
+	token, err := c.oauth2Config(config, doc).Exchange(c.context(ctx), code, oauth2.SetAuthURLParam("code_verifier", codeVerifier))
+	if err != nil {
+		return nil, nil, err
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, nil, fmt.Errorf("token response of %s has no id_token", config.Issuer)
+	}
+	claims, err := c.verifyIDToken(config, doc, rawIDToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	return token, claims, nil
+}
+
+// verifyIDToken checks the signature of the ID token against the keys published by the issuer, and that it was
+// issued by the configured issuer for our client.
+func (c *OIDCClient) verifyIDToken(config *v32.OIDCConfig, doc *discoveryDocument, rawIDToken string) (jwt.MapClaims, error) {
+	keys, err := c.getKeys(doc.JWKSURI)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(rawIDToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, ok := keys[kid]
+		if !ok && kid == "" && len(keys) == 1 {
+			for _, k := range keys {
+				key = k
+			}
+			ok = true
+		}
+		if !ok {
+			return nil, fmt.Errorf("signing key %s not found", kid)
+		}
+		switch token.Method.(type) {
+		case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS:
+			if _, isRSA := key.(*rsa.PublicKey); !isRSA {
+				return nil, fmt.Errorf("unexpected signing method %s for key %s", token.Method.Alg(), kid)
+			}
+		case *jwt.SigningMethodECDSA:
+			if _, isEC := key.(*ecdsa.PublicKey); !isEC {
+				return nil, fmt.Errorf("unexpected signing method %s for key %s", token.Method.Alg(), kid)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported signing method %s", token.Method.Alg())
+		}
+		return key, nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid id_token")
+	}
+
+	if iss, _ := claims["iss"].(string); strings.TrimSuffix(iss, "/") != strings.TrimSuffix(doc.Issuer, "/") {
+		return nil, fmt.Errorf("invalid id_token: issued by %s", iss)
+	}
+	if !hasAudience(claims, config.ClientID) {
+		return nil, fmt.Errorf("invalid id_token: not issued for client %s", config.ClientID)
+	}
+	if azp, ok := claims["azp"].(string); ok && azp != config.ClientID {
+		return nil, fmt.Errorf("invalid id_token: authorized party %s", azp)
+	}
+	if sub, _ := claims["sub"].(string); sub == "" {
+		return nil, errors.New("invalid id_token: no subject")
+	}
+	return claims, nil
+}
+
+// getUserInfo reads the claims of the user from the userinfo endpoint, refreshing the access token if needed.
+func (c *OIDCClient) getUserInfo(ctx context.Context, doc *discoveryDocument, tokenSource oauth2.TokenSource) (map[string]interface{}, error) {
+	if doc.UserInfoEndpoint == "" {
+		return nil, fmt.Errorf("OpenID provider %s has no userinfo endpoint", doc.Issuer)
+	}
+	req, err := http.NewRequest(http.MethodGet, doc.UserInfoEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	client := oauth2.NewClient(c.context(ctx), tokenSource)
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("userinfo request failed with %s: %s", resp.Status, body)
+	}
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), "application/jwt") {
+		return nil, errors.New("signed userinfo responses are not supported")
+	}
+
+	claims := map[string]interface{}{}
+	return claims, json.Unmarshal(body, &claims)
+}
+
+func (c *OIDCClient) getKeys(jwksURI string) (map[string]interface{}, error) {
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := c.getJSON(jwksURI, &jwks); err != nil {
+		return nil, errors.Wrap(err, "failed to read signing keys")
+	}
+
+	keys := map[string]interface{}{}
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse signing key %s", jwk.Kid)
+		}
+		if key != nil {
+			keys[jwk.Kid] = key
+		}
+	}
+	return keys, nil
+}
+
+func (c *OIDCClient) getJSON(url string, result interface{}) error {
+	resp, err := c.httpClient.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("request to %s failed with %s", url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+// publicKey returns the RSA or EC key, or nil for other key types.
+func (k jsonWebKey) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %s", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+	return nil, nil
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+func hasAudience(claims jwt.MapClaims, clientID string) bool {
+	switch aud := claims["aud"].(type) {
+	case string:
+		return aud == clientID
+	case []interface{}:
+		for _, a := range aud {
+			if a == clientID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func scopes(config *v32.OIDCConfig) []string {
+	result := strings.Fields(config.Scopes)
+	if !contains(result, "openid") {
+		result = append([]string{"openid"}, result...)
+	}
+	return result
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
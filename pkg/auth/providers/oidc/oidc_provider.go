@@ -0,0 +1,455 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rancher/norman/httperror"
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/auth/providers/common"
+	"github.com/rancher/rancher/pkg/auth/tokens"
+	client "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	publicclient "github.com/rancher/rancher/pkg/client/generated/management/v3public"
+	corev1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/rancher/rancher/pkg/user"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/oauth2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	Name      = "oidc"
+	userType  = "user"
+	groupType = "group"
+)
+
+type oidcProvider struct {
+	authConfigs v3.AuthConfigInterface
+	secrets     corev1.SecretInterface
+	oidcClient  *OIDCClient
+	userMGR     user.Manager
+	tokenMGR    *tokens.Manager
+	ctx         context.Context
+}
+
+func Configure(ctx context.Context, mgmtCtx *config.ScaledContext, userMGR user.Manager, tokenMGR *tokens.Manager) common.AuthProvider {
+	return &oidcProvider{
+		ctx:         ctx,
+		authConfigs: mgmtCtx.Management.AuthConfigs(""),
+		secrets:     mgmtCtx.Core.Secrets(""),
+		oidcClient: newOIDCClient(&http.Client{
+			Timeout: time.Second * 30,
+		}),
+		userMGR:  userMGR,
+		tokenMGR: tokenMGR,
+	}
+}
+
+func (o *oidcProvider) GetName() string {
+	return Name
+}
+
+func (o *oidcProvider) CustomizeSchema(schema *types.Schema) {
+	schema.ActionHandler = o.actionHandler
+	schema.Formatter = o.formatter
+}
+
+func (o *oidcProvider) TransformToAuthProvider(authConfig map[string]interface{}) (map[string]interface{}, error) {
+	p := common.TransformToAuthProvider(authConfig)
+	p[publicclient.OIDCProviderFieldRedirectURL] = formOIDCRedirectURLFromMap(authConfig)
+	return p, nil
+}
+
+func (o *oidcProvider) AuthenticateUser(ctx context.Context, input interface{}) (v3.Principal, []v3.Principal, string, error) {
+	login, ok := input.(*v32.OIDCLogin)
+	if !ok {
+		return v3.Principal{}, nil, "", fmt.Errorf("unexpected input type")
+	}
+	return o.loginUser(ctx, login, nil)
+}
+
+// loginUser exchanges the code for a token, reads the user and group principals from its claims and returns them
+// along with the marshaled token, which is stored to refresh the groups later.
+func (o *oidcProvider) loginUser(ctx context.Context, login *v32.OIDCLogin, config *v32.OIDCConfig) (v3.Principal, []v3.Principal, string, error) {
+	var err error
+	if config == nil {
+		config, err = o.getOIDCConfigCR()
+		if err != nil {
+			return v3.Principal{}, nil, "", err
+		}
+	}
+
+	userPrincipal, groupPrincipals, token, err := o.authenticate(ctx, config, login.Code, login.CodeVerifier)
+	if err != nil {
+		return v3.Principal{}, nil, "", err
+	}
+
+	logrus.Debugf("[OIDC] loginUser: Checking user's access to Rancher")
+	allowed, err := o.userMGR.CheckAccess(config.AccessMode, config.AllowedPrincipalIDs, userPrincipal.Name, groupPrincipals)
+	if err != nil {
+		return userPrincipal, groupPrincipals, "", err
+	}
+	if !allowed {
+		return userPrincipal, groupPrincipals, "", httperror.NewAPIError(httperror.Unauthorized, "unauthorized")
+	}
+
+	// the refresh token is kept so that groups can be re-read from the userinfo endpoint after the access token expired
+	oauthToken, err := json.Marshal(token)
+	if err != nil {
+		return userPrincipal, groupPrincipals, "", err
+	}
+	return userPrincipal, groupPrincipals, string(oauthToken), nil
+}
+
+func (o *oidcProvider) authenticate(ctx context.Context, config *v32.OIDCConfig, code, codeVerifier string) (v3.Principal, []v3.Principal, *oauth2.Token, error) {
+	if !validCodeVerifier(codeVerifier) {
+		return v3.Principal{}, nil, nil, httperror.NewAPIError(httperror.InvalidBodyContent, "invalid PKCE code verifier")
+	}
+	doc, err := o.oidcClient.discover(config.Issuer)
+	if err != nil {
+		return v3.Principal{}, nil, nil, err
+	}
+
+	logrus.Debugf("[OIDC] authenticate: Using code to get oauth token")
+	token, claims, err := o.oidcClient.exchange(ctx, config, doc, code, codeVerifier)
+	if err != nil {
+		return v3.Principal{}, nil, nil, err
+	}
+
+	if doc.UserInfoEndpoint != "" {
+		userInfo, err := o.oidcClient.getUserInfo(ctx, doc, o.oidcClient.oauth2Config(config, doc).TokenSource(o.oidcClient.context(ctx), token))
+		if err != nil {
+			return v3.Principal{}, nil, nil, err
+		}
+		if err := mergeClaims(claims, userInfo); err != nil {
+			return v3.Principal{}, nil, nil, err
+		}
+	}
+
+	userPrincipal, groupPrincipals := o.claimsToPrincipals(config, claims)
+	return userPrincipal, groupPrincipals, token, nil
+}
+
+func (o *oidcProvider) SearchPrincipals(searchKey, principalType string, token v3.Token) ([]v3.Principal, error) {
+	// the OpenID Connect protocol has no way to look up users or groups, the principal is returned as entered so
+	// that access can be granted to users and groups which haven't logged in yet
+	var principals []v3.Principal
+	if principalType == "" || principalType == userType {
+		principals = append(principals, o.toPrincipal(userType, searchKey, searchKey, searchKey, &token))
+	}
+	if principalType == "" || principalType == groupType {
+		principals = append(principals, o.toPrincipal(groupType, searchKey, searchKey, searchKey, &token))
+	}
+	return principals, nil
+}
+
+func (o *oidcProvider) GetPrincipal(principalID string, token v3.Token) (v3.Principal, error) {
+	externalID, principalType, err := getUIDFromPrincipalID(principalID)
+	if err != nil {
+		return v3.Principal{}, err
+	}
+	if principalType != userType && principalType != groupType {
+		return v3.Principal{}, fmt.Errorf("cannot get the OIDC principal due to invalid type %v", principalType)
+	}
+
+	// the principals of the logged in user carry the claims they were created from
+	if principalID == token.UserPrincipal.Name {
+		p := token.UserPrincipal
+		p.Me = true
+		return p, nil
+	}
+	for _, p := range token.GroupPrincipals {
+		if p.Name == principalID {
+			p.MemberOf = true
+			return p, nil
+		}
+	}
+	return o.toPrincipal(principalType, externalID, externalID, externalID, &token), nil
+}
+
+func (o *oidcProvider) RefetchGroupPrincipals(principalID string, secret string) ([]v3.Principal, error) {
+	if secret == "" {
+		return nil, fmt.Errorf("no oauth token stored for %s", principalID)
+	}
+	var storedToken oauth2.Token
+	if err := json.Unmarshal([]byte(secret), &storedToken); err != nil {
+		return nil, err
+	}
+	subject, _, err := getUIDFromPrincipalID(principalID)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := o.getOIDCConfigCR()
+	if err != nil {
+		return nil, err
+	}
+	doc, err := o.oidcClient.discover(config.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenSource := o.oidcClient.oauth2Config(config, doc).TokenSource(o.oidcClient.context(o.ctx), &storedToken)
+	claims, err := o.oidcClient.getUserInfo(o.ctx, doc, tokenSource)
+	if err != nil {
+		return nil, err
+	}
+	if sub, _ := claims["sub"].(string); sub != subject {
+		return nil, fmt.Errorf("userinfo subject %s does not match principal %s", sub, principalID)
+	}
+	logrus.Debugf("[OIDC] RefetchGroupPrincipals: Read userinfo of %s", principalID)
+
+	if token, err := tokenSource.Token(); err == nil && token.AccessToken != storedToken.AccessToken {
+		o.updateStoredToken(principalID, token)
+	}
+
+	_, groupPrincipals := o.claimsToPrincipals(config, claims)
+	return groupPrincipals, nil
+}
+
+// updateStoredToken saves a refreshed token, as providers may rotate the refresh token as well.
+func (o *oidcProvider) updateStoredToken(principalID string, token *oauth2.Token) {
+	u, err := o.userMGR.GetUserByPrincipalID(principalID)
+	if err != nil || u == nil {
+		logrus.Warnf("[OIDC] Failed to find user of %s to store refreshed token: %v", principalID, err)
+		return
+	}
+	oauthToken, err := json.Marshal(token)
+	if err != nil {
+		return
+	}
+	if err := o.tokenMGR.UpdateSecret(u.Name, Name, string(oauthToken)); err != nil && !apierrors.IsNotFound(err) {
+		logrus.Warnf("[OIDC] Failed to store refreshed token of %s: %v", u.Name, err)
+	}
+}
+
+func (o *oidcProvider) CanAccessWithGroupProviders(userPrincipalID string, groupPrincipals []v3.Principal) (bool, error) {
+	config, err := o.getOIDCConfigCR()
+	if err != nil {
+		logrus.Errorf("Error fetching OIDC config: %v", err)
+		return false, err
+	}
+	allowed, err := o.userMGR.CheckAccess(config.AccessMode, config.AllowedPrincipalIDs, userPrincipalID, groupPrincipals)
+	if err != nil {
+		return false, err
+	}
+	return allowed, nil
+}
+
+// claimsToPrincipals maps the subject to the user principal and every value of the groups claim to a group
+// principal.
+func (o *oidcProvider) claimsToPrincipals(config *v32.OIDCConfig, claims map[string]interface{}) (v3.Principal, []v3.Principal) {
+	subject, _ := claims["sub"].(string)
+	loginName := stringClaim(claims, config.UsernameClaim, "preferred_username", "email")
+	if loginName == "" {
+		loginName = subject
+	}
+	displayName := stringClaim(claims, "name")
+	if displayName == "" {
+		displayName = loginName
+	}
+
+	userPrincipal := o.toPrincipal(userType, subject, displayName, loginName, nil)
+	userPrincipal.Me = true
+	if picture, ok := claims["picture"].(string); ok {
+		userPrincipal.ProfilePicture = picture
+	}
+
+	var groupPrincipals []v3.Principal
+	for _, group := range groupsClaim(claims, config.GroupsClaim) {
+		groupPrincipal := o.toPrincipal(groupType, group, group, group, nil)
+		groupPrincipal.MemberOf = true
+		groupPrincipals = append(groupPrincipals, groupPrincipal)
+	}
+	return userPrincipal, groupPrincipals
+}
+
+func (o *oidcProvider) toPrincipal(principalType, externalID, displayName, loginName string, token *v3.Token) v3.Principal {
+	princ := v3.Principal{
+		ObjectMeta:  metav1.ObjectMeta{Name: Name + "_" + principalType + "://" + externalID},
+		DisplayName: displayName,
+		LoginName:   loginName,
+		Provider:    Name,
+		Me:          false,
+	}
+
+	if principalType == userType {
+		princ.PrincipalType = "user"
+		if token != nil {
+			princ.Me = token.UserPrincipal.Name == princ.Name
+		}
+	} else {
+		princ.PrincipalType = "group"
+		if token != nil {
+			princ.MemberOf = o.tokenMGR.IsMemberOf(*token, princ)
+		}
+	}
+	return princ
+}
+
+func (o *oidcProvider) getOIDCConfigCR() (*v32.OIDCConfig, error) {
+	authConfigObj, err := o.authConfigs.ObjectClient().UnstructuredClient().Get(Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve OIDCConfig, error: %v", err)
+	}
+	u, ok := authConfigObj.(runtime.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("failed to retrieve OIDCConfig, cannot read k8s Unstructured data")
+	}
+	storedOIDCConfigMap := u.UnstructuredContent()
+
+	storedOIDCConfig := &v32.OIDCConfig{}
+	mapstructure.Decode(storedOIDCConfigMap, storedOIDCConfig)
+
+	metadataMap, ok := storedOIDCConfigMap["metadata"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("failed to retrieve OIDCConfig metadata, cannot read k8s Unstructured data")
+	}
+
+	typemeta := &metav1.ObjectMeta{}
+	mapstructure.Decode(metadataMap, typemeta)
+	storedOIDCConfig.ObjectMeta = *typemeta
+
+	if storedOIDCConfig.ClientSecret != "" {
+		value, err := common.ReadFromSecret(o.secrets, storedOIDCConfig.ClientSecret, strings.ToLower(client.OIDCConfigFieldClientSecret))
+		if err != nil {
+			return nil, err
+		}
+		storedOIDCConfig.ClientSecret = value
+	}
+	return storedOIDCConfig, nil
+}
+
+func (o *oidcProvider) saveOIDCConfigCR(config *v32.OIDCConfig) error {
+	storedOIDCConfig, err := o.getOIDCConfigCR()
+	if err != nil {
+		return err
+	}
+	config.APIVersion = "management.cattle.io/v3"
+	config.Kind = v3.AuthConfigGroupVersionKind.Kind
+	config.Type = client.OIDCConfigType
+	config.ObjectMeta = storedOIDCConfig.ObjectMeta
+
+	secretInfo := convert.ToString(config.ClientSecret)
+	field := strings.ToLower(client.OIDCConfigFieldClientSecret)
+	if err := common.CreateOrUpdateSecrets(o.secrets, secretInfo, field, strings.ToLower(config.Type)); err != nil {
+		return err
+	}
+	config.ClientSecret = common.GetName(config.Type, field)
+
+	_, err = o.authConfigs.ObjectClient().Update(config.ObjectMeta.Name, config)
+	return err
+}
+
+// formOIDCRedirectURL returns the authorization URL the UI redirects to. The UI appends the state and the PKCE
+// code challenge, as it keeps the matching code verifier until the user returns.
+func formOIDCRedirectURL(config *v32.OIDCConfig) string {
+	return formOIDCRedirectURLFromMap(map[string]interface{}{
+		client.OIDCConfigFieldAuthEndpoint: config.AuthEndpoint,
+		client.OIDCConfigFieldClientID:     config.ClientID,
+		client.OIDCConfigFieldRancherURL:   config.RancherURL,
+		client.OIDCConfigFieldScopes:       config.Scopes,
+	})
+}
+
+func formOIDCRedirectURLFromMap(config map[string]interface{}) string {
+	authEndpoint := convert.ToString(config[client.OIDCConfigFieldAuthEndpoint])
+	if authEndpoint == "" {
+		return ""
+	}
+	params := url.Values{}
+	params.Set("client_id", convert.ToString(config[client.OIDCConfigFieldClientID]))
+	params.Set("redirect_uri", convert.ToString(config[client.OIDCConfigFieldRancherURL]))
+	params.Set("response_type", "code")
+	params.Set("scope", strings.Join(scopes(&v32.OIDCConfig{Scopes: convert.ToString(config[client.OIDCConfigFieldScopes])}), " "))
+	params.Set("code_challenge_method", "S256")
+
+	separator := "?"
+	if strings.Contains(authEndpoint, "?") {
+		separator = "&"
+	}
+	return authEndpoint + separator + params.Encode()
+}
+
+// mergeClaims adds the userinfo claims to the ID token claims. Both must be about the same subject.
+func mergeClaims(claims, userInfo map[string]interface{}) error {
+	if sub, _ := userInfo["sub"].(string); sub != claims["sub"] {
+		return fmt.Errorf("userinfo subject %s does not match the id_token subject %v", sub, claims["sub"])
+	}
+	for k, v := range userInfo {
+		claims[k] = v
+	}
+	return nil
+}
+
+// stringClaim returns the first non-empty claim of names.
+func stringClaim(claims map[string]interface{}, names ...string) string {
+	for _, name := range names {
+		if name == "" {
+			continue
+		}
+		if value, ok := claims[name].(string); ok && value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// groupsClaim returns the groups claim, which is a list of strings for most providers and a single string for some.
+func groupsClaim(claims map[string]interface{}, name string) []string {
+	if name == "" {
+		return nil
+	}
+	var groups []string
+	switch value := claims[name].(type) {
+	case string:
+		if value != "" {
+			groups = append(groups, value)
+		}
+	case []interface{}:
+		for _, v := range value {
+			if group, ok := v.(string); ok && group != "" {
+				groups = append(groups, group)
+			}
+		}
+	}
+	return groups
+}
+
+// validCodeVerifier checks the PKCE code verifier format of https://tools.ietf.org/html/rfc7636#section-4.1
+func validCodeVerifier(verifier string) bool {
+	if len(verifier) < 43 || len(verifier) > 128 {
+		return false
+	}
+	for _, c := range verifier {
+		if !(c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || strings.ContainsRune("-._~", c)) {
+			return false
+		}
+	}
+	return true
+}
+
+func getUIDFromPrincipalID(principalID string) (string, string, error) {
+	parts := strings.SplitN(principalID, ":", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid id %v", principalID)
+	}
+	externalID := strings.TrimPrefix(parts[1], "//")
+	parts = strings.SplitN(parts[0], "_", 2)
+	if len(parts) != 2 || parts[0] != Name {
+		return "", "", fmt.Errorf("invalid id %v", principalID)
+	}
+	return externalID, parts[1], nil
+}
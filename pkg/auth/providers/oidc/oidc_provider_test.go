@@ -0,0 +1,198 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testClientID = "rancher"
+	testVerifier = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+)
+
+type testIdentityProvider struct {
+	*httptest.Server
+	key      *rsa.PrivateKey
+	audience string
+	groups   []string
+}
+
+func newTestIdentityProvider(t *testing.T) *testIdentityProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	idp := &testIdentityProvider{
+		key:      key,
+		audience: testClientID,
+		groups:   []string{"admins", "devs"},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(discoveryPath, func(rw http.ResponseWriter, req *http.Request) {
+		json.NewEncoder(rw).Encode(discoveryDocument{
+			Issuer:                idp.URL,
+			AuthorizationEndpoint: idp.URL + "/authorize",
+			TokenEndpoint:         idp.URL + "/token",
+			UserInfoEndpoint:      idp.URL + "/userinfo",
+			JWKSURI:               idp.URL + "/keys",
+			CodeChallengeMethods:  []string{"S256"},
+		})
+	})
+	mux.HandleFunc("/keys", func(rw http.ResponseWriter, req *http.Request) {
+		json.NewEncoder(rw).Encode(map[string]interface{}{
+			"keys": []jsonWebKey{{
+				Kty: "RSA",
+				Kid: "key1",
+				Use: "sig",
+				N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(rw http.ResponseWriter, req *http.Request) {
+		req.ParseForm()
+		// the challenge the UI sent with the authorization request is the hash of the verifier
+		challenge := sha256.Sum256([]byte(req.Form.Get("code_verifier")))
+		if req.Form.Get("code") != base64.RawURLEncoding.EncodeToString(challenge[:]) {
+			http.Error(rw, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(rw).Encode(map[string]interface{}{
+			"access_token":  "access",
+			"refresh_token": "refresh",
+			"token_type":    "Bearer",
+			"expires_in":    3600,
+			"id_token":      idp.idToken(t),
+		})
+	})
+	mux.HandleFunc("/userinfo", func(rw http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "Bearer access" {
+			http.Error(rw, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(rw).Encode(map[string]interface{}{
+			"sub":                "1234",
+			"preferred_username": "jdoe",
+			"name":               "Jane Doe",
+			"groups":             idp.groups,
+		})
+	})
+	idp.Server = httptest.NewServer(mux)
+	return idp
+}
+
+func (idp *testIdentityProvider) idToken(t *testing.T) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss": idp.URL,
+		"sub": "1234",
+		"aud": []string{idp.audience},
+		"exp": time.Now().Add(time.Hour).Unix(),
+		"iat": time.Now().Unix(),
+	})
+	token.Header["kid"] = "key1"
+	signed, err := token.SignedString(idp.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func TestAuthenticate(t *testing.T) {
+	assert := assert.New(t)
+	idp := newTestIdentityProvider(t)
+	defer idp.Close()
+
+	o := &oidcProvider{
+		ctx:        context.Background(),
+		oidcClient: newOIDCClient(idp.Client()),
+	}
+	config := &v32.OIDCConfig{
+		Issuer:        idp.URL,
+		ClientID:      testClientID,
+		ClientSecret:  "secret",
+		RancherURL:    "https://rancher.example.com/verify-auth",
+		UsernameClaim: "preferred_username",
+		GroupsClaim:   "groups",
+	}
+	challenge := sha256.Sum256([]byte(testVerifier))
+	code := base64.RawURLEncoding.EncodeToString(challenge[:])
+
+	userPrincipal, groupPrincipals, token, err := o.authenticate(context.Background(), config, code, testVerifier)
+	if assert.Nil(err) {
+		assert.Equal("oidc_user://1234", userPrincipal.Name)
+		assert.Equal("jdoe", userPrincipal.LoginName)
+		assert.Equal("Jane Doe", userPrincipal.DisplayName)
+		assert.True(userPrincipal.Me)
+		if assert.Len(groupPrincipals, 2) {
+			assert.Equal("oidc_group://admins", groupPrincipals[0].Name)
+			assert.Equal("group", groupPrincipals[0].PrincipalType)
+			assert.True(groupPrincipals[0].MemberOf)
+		}
+		assert.Equal("refresh", token.RefreshToken)
+	}
+
+	// a verifier that does not match the code challenge is rejected by the provider
+	_, _, _, err = o.authenticate(context.Background(), config, code, strings.Repeat("a", 43))
+	assert.NotNil(err)
+
+	// malformed verifiers are rejected before calling the provider
+	_, _, _, err = o.authenticate(context.Background(), config, code, "short")
+	assert.NotNil(err)
+
+	// id tokens issued for other clients are rejected
+	idp.audience = "other"
+	_, _, _, err = o.authenticate(context.Background(), config, code, testVerifier)
+	assert.NotNil(err)
+}
+
+func TestRedirectURL(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("", formOIDCRedirectURL(&v32.OIDCConfig{}))
+
+	redirect, err := url.Parse(formOIDCRedirectURL(&v32.OIDCConfig{
+		AuthEndpoint: "https://idp.example.com/authorize",
+		ClientID:     testClientID,
+		RancherURL:   "https://rancher.example.com/verify-auth",
+		Scopes:       "profile email groups",
+	}))
+	if assert.Nil(err) {
+		assert.Equal("idp.example.com", redirect.Host)
+		query := redirect.Query()
+		assert.Equal(testClientID, query.Get("client_id"))
+		assert.Equal("code", query.Get("response_type"))
+		assert.Equal("openid profile email groups", query.Get("scope"))
+		assert.Equal("S256", query.Get("code_challenge_method"))
+		assert.Equal("https://rancher.example.com/verify-auth", query.Get("redirect_uri"))
+	}
+}
+
+func TestGroupsClaim(t *testing.T) {
+	assert := assert.New(t)
+
+	claims := map[string]interface{}{
+		"groups": []interface{}{"a", "", 1, "b"},
+		"role":   "admin",
+	}
+	assert.Equal([]string{"a", "b"}, groupsClaim(claims, "groups"))
+	assert.Equal([]string{"admin"}, groupsClaim(claims, "role"))
+	assert.Nil(groupsClaim(claims, "missing"))
+	assert.Nil(groupsClaim(claims, ""))
+}
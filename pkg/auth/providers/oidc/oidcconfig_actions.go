@@ -0,0 +1,111 @@
+package oidc
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/rancher/norman/httperror"
+	"github.com/rancher/norman/types"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/auth/providers/common"
+	client "github.com/rancher/rancher/pkg/client/generated/management/v3"
+)
+
+func (o *oidcProvider) formatter(apiContext *types.APIContext, resource *types.RawResource) {
+	common.AddCommonActions(apiContext, resource)
+	resource.AddAction(apiContext, "configureTest")
+	resource.AddAction(apiContext, "testAndApply")
+}
+
+func (o *oidcProvider) actionHandler(actionName string, action *types.Action, request *types.APIContext) error {
+	handled, err := common.HandleCommonAction(actionName, action, request, Name, o.authConfigs)
+	if err != nil {
+		return err
+	}
+	if handled {
+		return nil
+	}
+
+	if actionName == "configureTest" {
+		return o.configureTest(actionName, action, request)
+	} else if actionName == "testAndApply" {
+		return o.testAndApply(actionName, action, request)
+	}
+	return httperror.NewAPIError(httperror.ActionNotAvailable, "")
+}
+
+func (o *oidcProvider) configureTest(actionName string, action *types.Action, request *types.APIContext) error {
+	oidcConfig := &v32.OIDCConfig{}
+	if err := json.NewDecoder(request.Request.Body).Decode(oidcConfig); err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent,
+			fmt.Sprintf("[OIDC] configureTest: Failed to parse body: %v", err))
+	}
+
+	doc, err := o.oidcClient.discover(oidcConfig.Issuer)
+	if err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent,
+			fmt.Sprintf("[OIDC] configureTest: Failed to discover the OpenID provider: %v", err))
+	}
+	oidcConfig.AuthEndpoint = doc.AuthorizationEndpoint
+
+	data := map[string]interface{}{
+		"redirectUrl": formOIDCRedirectURL(oidcConfig),
+		"type":        "oidcConfigTestOutput",
+	}
+	request.WriteResponse(http.StatusOK, data)
+	return nil
+}
+
+func (o *oidcProvider) testAndApply(actionName string, action *types.Action, request *types.APIContext) error {
+	oidcConfigApplyInput := &v32.OIDCConfigApplyInput{}
+	if err := json.NewDecoder(request.Request.Body).Decode(oidcConfigApplyInput); err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent,
+			fmt.Sprintf("[OIDC] testAndApply: Failed to parse body: %v", err))
+	}
+
+	oidcConfig := oidcConfigApplyInput.OIDCConfig
+	oidcLogin := &v32.OIDCLogin{
+		Code:         oidcConfigApplyInput.Code,
+		CodeVerifier: oidcConfigApplyInput.CodeVerifier,
+	}
+
+	if oidcConfig.ClientSecret != "" {
+		value, err := common.ReadFromSecret(o.secrets, oidcConfig.ClientSecret,
+			strings.ToLower(client.OIDCConfigFieldClientSecret))
+		if err != nil {
+			return err
+		}
+		oidcConfig.ClientSecret = value
+	}
+
+	doc, err := o.oidcClient.discover(oidcConfig.Issuer)
+	if err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent,
+			fmt.Sprintf("[OIDC] testAndApply: Failed to discover the OpenID provider: %v", err))
+	}
+	oidcConfig.AuthEndpoint = doc.AuthorizationEndpoint
+
+	//Call provider to testLogin
+	userPrincipal, groupPrincipals, providerInfo, err := o.loginUser(request.Request.Context(), oidcLogin, &oidcConfig)
+	if err != nil {
+		if httperror.IsAPIError(err) {
+			return err
+		}
+		return fmt.Errorf("[OIDC] testAndApply: server error while authenticating: %v", err)
+	}
+	//if this works, save oidc CR adding enabled flag
+	user, err := o.userMGR.SetPrincipalOnCurrentUser(request, userPrincipal)
+	if err != nil {
+		return err
+	}
+
+	oidcConfig.Enabled = oidcConfigApplyInput.Enabled
+	err = o.saveOIDCConfigCR(&oidcConfig)
+	if err != nil {
+		return httperror.NewAPIError(httperror.ServerError, fmt.Sprintf("[OIDC] testAndApply: Failed to save oidc config: %v", err))
+	}
+
+	return o.tokenMGR.CreateTokenAndSetCookie(user.Name, userPrincipal, groupPrincipals, providerInfo, 0, "Token via OIDC Configuration", request)
+}
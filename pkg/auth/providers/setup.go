@@ -25,6 +25,7 @@
 	client.OKTAConfigType,
 	client.ShibbolethConfigType,
 	client.GoogleOauthConfigType,
+	client.OIDCConfigType,
 }
 
 func SetupAuthConfig(ctx context.Context, management *config.ScaledContext, schemas *types.Schemas) {
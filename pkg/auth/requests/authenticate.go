@@ -54,6 +54,7 @@ func NewAuthenticator(ctx context.Context, clusterRouter ClusterRouter, mgmtCtx
 		userLister:          mgmtCtx.Management.Users("").Controller().Lister(),
 		clusterRouter:       clusterRouter,
 		userAuthRefresher:   providerrefresh.NewUserAuthRefresher(ctx, mgmtCtx),
+		activityRecorder:    tokens.NewActivityRecorder(ctx, mgmtCtx),
 	}
 }
 
@@ -66,6 +67,7 @@ type tokenAuthenticator struct {
 	userLister          v3.UserLister
 	clusterRouter       ClusterRouter
 	userAuthRefresher   providerrefresh.UserAuthRefresher
+	activityRecorder    *tokens.ActivityRecorder
 }
 
 const (
@@ -90,9 +92,16 @@ func (a *tokenAuthenticator) Authenticate(req *http.Request) (bool, string, []st
 	if token.Enabled != nil && !*token.Enabled {
 		return false, "", []string{}, errors.Wrapf(ErrMustAuthenticate, "user's token is not enabled")
 	}
+	if tokens.IsIdle(*token) {
+		return false, "", []string{}, errors.Wrapf(ErrMustAuthenticate, "user's token has been idle for too long")
+	}
 	if token.ClusterName != "" && token.ClusterName != a.clusterRouter(req) {
 		return false, "", []string{}, errors.Wrapf(ErrMustAuthenticate, "clusterID does not match")
 	}
+	// scoped tokens are checked before RBAC, which only knows about the user
+	if !tokens.ScopesAllow(token.Scopes, req) {
+		return false, "", []string{}, errors.Wrapf(ErrMustAuthenticate, "token scope does not allow %s %s", req.Method, req.URL.Path)
+	}
 
 	attribs, err := a.userAttributeLister.Get("", token.UserID)
 	if err != nil && !apierrors.IsNotFound(err) {
@@ -136,6 +145,7 @@ func (a *tokenAuthenticator) Authenticate(req *http.Request) (bool, string, []st
 	if !strings.HasPrefix(token.UserID, "system:") {
 		go a.userAuthRefresher.TriggerUserRefresh(token.UserID, false)
 	}
+	a.activityRecorder.Record(token, req)
 
 	return true, token.UserID, groups, nil
 }
@@ -0,0 +1,52 @@
+package scim
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	// only the equality filters identity providers use to look up resources before creating them are supported
+	filterRegexp       = regexp.MustCompile(`^\s*([A-Za-z.]+)\s+(?i:eq)\s+("(?:[^"\\]|\\.)*")\s*$`)
+	memberFilterRegexp = regexp.MustCompile(`^(?i:members)\[\s*(?i:value)\s+(?i:eq)\s+("(?:[^"\\]|\\.)*")\s*\]$`)
+)
+
+// parseFilter returns the lower cased attribute and the value of a filter such as userName eq "jdoe".
+func parseFilter(filter string) (string, string, error) {
+	if filter == "" {
+		return "", "", nil
+	}
+	match := filterRegexp.FindStringSubmatch(filter)
+	if match == nil {
+		return "", "", fmt.Errorf("unsupported filter %s", filter)
+	}
+	value, err := strconv.Unquote(match[2])
+	if err != nil {
+		return "", "", fmt.Errorf("invalid value in filter %s", filter)
+	}
+	return strings.ToLower(match[1]), value, nil
+}
+
+// memberFilter returns the user of a path such as members[value eq "u-abc"].
+func memberFilter(path string) (string, bool) {
+	match := memberFilterRegexp.FindStringSubmatch(path)
+	if match == nil {
+		return "", false
+	}
+	value, err := strconv.Unquote(match[1])
+	if err != nil {
+		return "", false
+	}
+	return value, true
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,501 @@
+package scim
+
+import (
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/auth/tokens"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// Group is the SCIM core group resource of https://tools.ietf.org/html/rfc7643#section-4.2. Members can only be
+// users provisioned for the same auth provider.
+type Group struct {
+	Schemas     []string `json:"schemas"`
+	ID          string   `json:"id,omitempty"`
+	ExternalID  string   `json:"externalId,omitempty"`
+	DisplayName string   `json:"displayName"`
+	Members     []Member `json:"members,omitempty"`
+	Meta        *Meta    `json:"meta,omitempty"`
+}
+
+type Member struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+func (h *handler) listGroups(rw http.ResponseWriter, req *http.Request) {
+	provider := mux.Vars(req)["provider"]
+	attr, value, err := parseFilter(req.URL.Query().Get("filter"))
+	if err != nil {
+		writeError(rw, http.StatusBadRequest, "invalidFilter", err.Error())
+		return
+	}
+
+	groups, err := h.groupLister.List("", labels.SelectorFromSet(labels.Set{providerLabel: provider}))
+	if err != nil {
+		writeServerError(rw, err)
+		return
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Name < groups[j].Name
+	})
+
+	var members map[string][]string
+	if !membersExcluded(req) {
+		if members, err = h.groupMembers(provider); err != nil {
+			writeServerError(rw, err)
+			return
+		}
+	}
+
+	resources := []interface{}{}
+	for _, g := range groups {
+		scimGroup := toSCIMGroup(req, g, members[g.Annotations[principalAnnotation]])
+		if attr != "" && !groupMatches(scimGroup, attr, value) {
+			continue
+		}
+		resources = append(resources, scimGroup)
+	}
+	writeResponse(rw, http.StatusOK, listResponse(req, resources))
+}
+
+func (h *handler) getGroup(rw http.ResponseWriter, req *http.Request) {
+	g, err := h.getProvisionedGroup(req)
+	if err != nil {
+		writeServerError(rw, err)
+		return
+	}
+	var members map[string][]string
+	if !membersExcluded(req) {
+		if members, err = h.groupMembers(mux.Vars(req)["provider"]); err != nil {
+			writeServerError(rw, err)
+			return
+		}
+	}
+	writeResponse(rw, http.StatusOK, toSCIMGroup(req, g, members[g.Annotations[principalAnnotation]]))
+}
+
+func (h *handler) createGroup(rw http.ResponseWriter, req *http.Request) {
+	provider := mux.Vars(req)["provider"]
+	scimGroup := Group{}
+	if !decode(rw, req, &scimGroup) {
+		return
+	}
+	if scimGroup.DisplayName == "" {
+		writeError(rw, http.StatusBadRequest, "invalidValue", "displayName is required")
+		return
+	}
+
+	principal := groupPrincipalID(provider, scimGroup)
+	g, err := h.groups.Create(&v3.Group{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   groupName(principal),
+			Labels: map[string]string{providerLabel: provider},
+			Annotations: map[string]string{
+				principalAnnotation:  principal,
+				externalIDAnnotation: scimGroup.ExternalID,
+			},
+		},
+		DisplayName: scimGroup.DisplayName,
+	})
+	if apierrors.IsAlreadyExists(err) {
+		writeError(rw, http.StatusConflict, "uniqueness", fmt.Sprintf("group %s already exists", scimGroup.DisplayName))
+		return
+	} else if err != nil {
+		writeServerError(rw, err)
+		return
+	}
+
+	members, err := h.syncMembers(provider, g, nil, memberIDs(scimGroup.Members))
+	if err != nil {
+		writeServerError(rw, err)
+		return
+	}
+	logrus.Infof("[SCIM] Provisioned group %s of %s as %s", scimGroup.DisplayName, provider, g.Name)
+	writeResponse(rw, http.StatusCreated, toSCIMGroup(req, g, members))
+}
+
+func (h *handler) replaceGroup(rw http.ResponseWriter, req *http.Request) {
+	g, err := h.getProvisionedGroup(req)
+	if err != nil {
+		writeServerError(rw, err)
+		return
+	}
+	scimGroup := Group{}
+	if !decode(rw, req, &scimGroup) {
+		return
+	}
+	h.writeUpdatedGroup(rw, req, g, scimGroup.ExternalID, scimGroup.DisplayName, memberIDs(scimGroup.Members))
+}
+
+func (h *handler) patchGroup(rw http.ResponseWriter, req *http.Request) {
+	provider := mux.Vars(req)["provider"]
+	g, err := h.getProvisionedGroup(req)
+	if err != nil {
+		writeServerError(rw, err)
+		return
+	}
+	patch := PatchRequest{}
+	if !decode(rw, req, &patch) {
+		return
+	}
+
+	members, err := h.groupMembers(provider)
+	if err != nil {
+		writeServerError(rw, err)
+		return
+	}
+	scimGroup := toSCIMGroup(req, g, members[g.Annotations[principalAnnotation]])
+	for _, op := range patch.Operations {
+		if err := patchGroupAttribute(&scimGroup, op); err != nil {
+			writeError(rw, http.StatusBadRequest, "invalidValue", err.Error())
+			return
+		}
+	}
+	h.writeUpdatedGroup(rw, req, g, scimGroup.ExternalID, scimGroup.DisplayName, memberIDs(scimGroup.Members))
+}
+
+// writeUpdatedGroup renames the group and sets its members. The principal of a group never changes, so the
+// externalId can not be changed either.
+func (h *handler) writeUpdatedGroup(rw http.ResponseWriter, req *http.Request, g *v3.Group, externalID, displayName string, members []string) {
+	provider := mux.Vars(req)["provider"]
+	if displayName == "" {
+		writeError(rw, http.StatusBadRequest, "invalidValue", "displayName is required")
+		return
+	}
+	if externalID != g.Annotations[externalIDAnnotation] {
+		writeError(rw, http.StatusBadRequest, "mutability", "the externalId of a group can not be changed")
+		return
+	}
+
+	var err error
+	if g.DisplayName != displayName {
+		g = g.DeepCopy()
+		g.DisplayName = displayName
+		if g, err = h.groups.Update(g); err != nil {
+			writeServerError(rw, err)
+			return
+		}
+	}
+
+	current, err := h.groupMembers(provider)
+	if err != nil {
+		writeServerError(rw, err)
+		return
+	}
+	members, err = h.syncMembers(provider, g, current[g.Annotations[principalAnnotation]], members)
+	if err != nil {
+		writeServerError(rw, err)
+		return
+	}
+	writeResponse(rw, http.StatusOK, toSCIMGroup(req, g, members))
+}
+
+func (h *handler) deleteGroup(rw http.ResponseWriter, req *http.Request) {
+	provider := mux.Vars(req)["provider"]
+	g, err := h.getProvisionedGroup(req)
+	if err != nil {
+		writeServerError(rw, err)
+		return
+	}
+
+	members, err := h.groupMembers(provider)
+	if err != nil {
+		writeServerError(rw, err)
+		return
+	}
+	if _, err := h.syncMembers(provider, g, members[g.Annotations[principalAnnotation]], nil); err != nil {
+		writeServerError(rw, err)
+		return
+	}
+	if err := h.groups.Delete(g.Name, nil); err != nil && !apierrors.IsNotFound(err) {
+		writeServerError(rw, err)
+		return
+	}
+	logrus.Infof("[SCIM] Deprovisioned group %s", g.Name)
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// syncMembers makes the desired users members of the group and removes everybody else. Memberships are kept as
+// group principals in the user attributes, next to the groups the providers store on login, so that the
+// authentication filter and the bindings pick them up without the user logging in again. They are kept under a key
+// of their own, because logins and refreshes replace the groups of the provider.
+func (h *handler) syncMembers(provider string, g *v3.Group, current, desired []string) ([]string, error) {
+	principal := v32.Principal{
+		ObjectMeta:    metav1.ObjectMeta{Name: g.Annotations[principalAnnotation]},
+		DisplayName:   g.DisplayName,
+		PrincipalType: "group",
+		MemberOf:      true,
+		Provider:      provider,
+	}
+
+	isDesired := map[string]bool{}
+	for _, userID := range desired {
+		u, err := h.userLister.Get("", userID)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, apierrors.NewBadRequest(fmt.Sprintf("member %s does not exist", userID))
+			}
+			return nil, err
+		}
+		if u.Labels[providerLabel] != provider {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("member %s is not provisioned for %s", userID, provider))
+		}
+		isDesired[userID] = true
+	}
+
+	for userID := range isDesired {
+		if err := h.setMembership(userID, provider, principal, true); err != nil {
+			return nil, err
+		}
+	}
+	for _, userID := range current {
+		if isDesired[userID] {
+			continue
+		}
+		if err := h.setMembership(userID, provider, principal, false); err != nil {
+			return nil, err
+		}
+	}
+
+	members := make([]string, 0, len(isDesired))
+	for userID := range isDesired {
+		members = append(members, userID)
+	}
+	sort.Strings(members)
+	return members, nil
+}
+
+func (h *handler) setMembership(userID, provider string, principal v32.Principal, member bool) error {
+	attribs, needCreate, err := h.tokenMGR.EnsureAndGetUserAttribute(userID)
+	if err != nil {
+		if !member && apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	key := tokens.ProvisionedGroupsKey(provider)
+	var items []v32.Principal
+	found, renamed := false, false
+	for _, p := range attribs.GroupPrincipals[key].Items {
+		if p.Name == principal.Name {
+			found = true
+			renamed = p.DisplayName != principal.DisplayName
+			continue
+		}
+		items = append(items, p)
+	}
+	if member {
+		// the display name is kept up to date when the group is renamed
+		if found && !renamed && !needCreate {
+			return nil
+		}
+		items = append(items, principal)
+	} else if !found {
+		return nil
+	}
+
+	if attribs.GroupPrincipals == nil {
+		attribs.GroupPrincipals = map[string]v32.Principals{}
+	}
+	attribs.GroupPrincipals[key] = v32.Principals{Items: items}
+	if needCreate {
+		_, err = h.userAttributes.Create(attribs)
+	} else {
+		_, err = h.userAttributes.Update(attribs)
+	}
+	return err
+}
+
+// groupMembers returns the users of every group principal of the provider.
+func (h *handler) groupMembers(provider string) (map[string][]string, error) {
+	attribs, err := h.userAttributeLister.List("", labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	members := map[string][]string{}
+	for _, attrib := range attribs {
+		for _, p := range attrib.GroupPrincipals[tokens.ProvisionedGroupsKey(provider)].Items {
+			members[p.Name] = append(members[p.Name], attrib.Name)
+		}
+	}
+	for _, userIDs := range members {
+		sort.Strings(userIDs)
+	}
+	return members, nil
+}
+
+func (h *handler) getProvisionedGroup(req *http.Request) (*v3.Group, error) {
+	vars := mux.Vars(req)
+	g, err := h.groupLister.Get("", vars["id"])
+	if err != nil {
+		return nil, err
+	}
+	if g.Labels[providerLabel] != vars["provider"] {
+		return nil, apierrors.NewNotFound(v3.GroupGroupVersionResource.GroupResource(), vars["id"])
+	}
+	return g, nil
+}
+
+func toSCIMGroup(req *http.Request, g *v3.Group, members []string) Group {
+	scimGroup := Group{
+		Schemas:     []string{groupSchema},
+		ID:          g.Name,
+		ExternalID:  g.Annotations[externalIDAnnotation],
+		DisplayName: g.DisplayName,
+		Meta: &Meta{
+			ResourceType: "Group",
+			Created:      g.CreationTimestamp.Format(time.RFC3339),
+			Location:     location(req, "Groups", g.Name),
+		},
+	}
+	for _, userID := range members {
+		scimGroup.Members = append(scimGroup.Members, Member{
+			Value: userID,
+			Ref:   location(req, "Users", userID),
+		})
+	}
+	return scimGroup
+}
+
+// patchGroupAttribute applies an operation to the display name or the members of the group.
+func patchGroupAttribute(scimGroup *Group, op PatchOperation) error {
+	operation := strings.ToLower(op.Op)
+	if operation != "add" && operation != "replace" && operation != "remove" {
+		return fmt.Errorf("unsupported operation %s", op.Op)
+	}
+
+	if op.Path == "" {
+		if operation == "remove" {
+			return fmt.Errorf("remove requires a path")
+		}
+		values := map[string]json.RawMessage{}
+		if err := json.Unmarshal(op.Value, &values); err != nil {
+			return fmt.Errorf("invalid value: %v", err)
+		}
+		for path, value := range values {
+			if err := patchGroupAttribute(scimGroup, PatchOperation{Op: op.Op, Path: path, Value: value}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if strings.EqualFold(op.Path, "displayName") {
+		if operation == "remove" {
+			return fmt.Errorf("displayName is required")
+		}
+		return json.Unmarshal(op.Value, &scimGroup.DisplayName)
+	}
+	if strings.EqualFold(op.Path, "externalId") {
+		scimGroup.ExternalID = ""
+		if operation == "remove" {
+			return nil
+		}
+		return json.Unmarshal(op.Value, &scimGroup.ExternalID)
+	}
+
+	// members[value eq "u-abc"] selects a single member
+	if userID, ok := memberFilter(op.Path); ok {
+		if operation != "remove" {
+			return fmt.Errorf("unsupported operation %s for %s", op.Op, op.Path)
+		}
+		scimGroup.Members = removeMembers(scimGroup.Members, []string{userID})
+		return nil
+	}
+	if !strings.EqualFold(op.Path, "members") {
+		return nil
+	}
+
+	var members []Member
+	if len(op.Value) > 0 {
+		if err := json.Unmarshal(op.Value, &members); err != nil {
+			return fmt.Errorf("invalid members: %v", err)
+		}
+	}
+	switch operation {
+	case "add":
+		scimGroup.Members = append(scimGroup.Members, members...)
+	case "replace":
+		scimGroup.Members = members
+	case "remove":
+		if len(op.Value) == 0 {
+			scimGroup.Members = nil
+		} else {
+			scimGroup.Members = removeMembers(scimGroup.Members, memberIDs(members))
+		}
+	}
+	return nil
+}
+
+func removeMembers(members []Member, userIDs []string) []Member {
+	var result []Member
+	for _, m := range members {
+		if !containsString(userIDs, m.Value) {
+			result = append(result, m)
+		}
+	}
+	return result
+}
+
+func memberIDs(members []Member) []string {
+	var userIDs []string
+	for _, m := range members {
+		if m.Value != "" && !containsString(userIDs, m.Value) {
+			userIDs = append(userIDs, m.Value)
+		}
+	}
+	return userIDs
+}
+
+func membersExcluded(req *http.Request) bool {
+	for _, attr := range strings.Split(req.URL.Query().Get("excludedAttributes"), ",") {
+		if strings.EqualFold(strings.TrimSpace(attr), "members") {
+			return true
+		}
+	}
+	return false
+}
+
+func groupMatches(scimGroup Group, attr, value string) bool {
+	switch attr {
+	case "displayname":
+		return strings.EqualFold(scimGroup.DisplayName, value)
+	case "externalid":
+		return scimGroup.ExternalID == value
+	case "id":
+		return scimGroup.ID == value
+	}
+	return false
+}
+
+// groupPrincipalID is the principal the auth provider gives the group on login.
+func groupPrincipalID(provider string, scimGroup Group) string {
+	if scimGroup.ExternalID != "" {
+		return principalID(provider, "group", scimGroup.ExternalID)
+	}
+	return principalID(provider, "group", scimGroup.DisplayName)
+}
+
+// groupName hashes the principal the same way users are named, so that a principal can only be provisioned once.
+func groupName(principal string) string {
+	hasher := sha256.New()
+	hasher.Write([]byte(principal))
+	sha := base32.StdEncoding.WithPadding(-1).EncodeToString(hasher.Sum(nil))[:10]
+	return "g-" + strings.ToLower(sha)
+}
@@ -0,0 +1,264 @@
+package scim
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/rancher/rancher/pkg/auth/providers"
+	"github.com/rancher/rancher/pkg/auth/providers/common"
+	"github.com/rancher/rancher/pkg/auth/providers/local"
+	"github.com/rancher/rancher/pkg/auth/tokens"
+	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/rancher/rancher/pkg/user"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+const (
+	PathPrefix = "/v3-public/scim"
+
+	contentType = "application/scim+json"
+
+	userSchema                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	groupSchema                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	listResponseSchema          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	patchOpSchema               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	errorSchema                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	serviceProviderConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+
+	// providerLabel marks the users and groups provisioned through SCIM with the auth provider they belong to
+	providerLabel        = "scim.cattle.io/provider"
+	userNameAnnotation   = "scim.cattle.io/user-name"
+	externalIDAnnotation = "scim.cattle.io/external-id"
+	principalAnnotation  = "scim.cattle.io/principal-id"
+
+	// the bearer token of the identity provider is read from the field of the secret
+	// cattle-global-data/scim-<provider>
+	tokenSecretPrefix = "scim-"
+	tokenSecretField  = "token"
+
+	defaultCount = 100
+	maxCount     = 200
+)
+
+type tokenManager interface {
+	EnsureAndGetUserAttribute(userID string) (*v3.UserAttribute, bool, error)
+	DeleteUserTokens(userID string) error
+}
+
+type handler struct {
+	users               v3.UserInterface
+	userLister          v3.UserLister
+	groups              v3.GroupInterface
+	groupLister         v3.GroupLister
+	userAttributes      v3.UserAttributeInterface
+	userAttributeLister v3.UserAttributeLister
+	secretLister        v1.SecretLister
+	userMGR             user.Manager
+	tokenMGR            tokenManager
+}
+
+// NewHandler returns a SCIM 2.0 server for every auth provider except local, under
+// /v3-public/scim/<provider>/v2. Identity providers create, update and deactivate users and maintain group
+// memberships through it, so that Rancher knows about them before they log in and stops trusting them as soon as
+// they are deprovisioned. The SCIM externalId, or the userName if there is none, must be the ID the auth provider
+// uses in its principals.
+func NewHandler(ctx context.Context, mgmt *config.ScaledContext) http.Handler {
+	h := &handler{
+		users:               mgmt.Management.Users(""),
+		userLister:          mgmt.Management.Users("").Controller().Lister(),
+		groups:              mgmt.Management.Groups(""),
+		groupLister:         mgmt.Management.Groups("").Controller().Lister(),
+		userAttributes:      mgmt.Management.UserAttributes(""),
+		userAttributeLister: mgmt.Management.UserAttributes("").Controller().Lister(),
+		secretLister:        mgmt.Core.Secrets("").Controller().Lister(),
+		userMGR:             mgmt.UserManager,
+		tokenMGR:            tokens.NewManager(ctx, mgmt),
+	}
+	return h.router()
+}
+
+func (h *handler) router() *mux.Router {
+	router := mux.NewRouter()
+	router.UseEncodedPath()
+
+	r := router.PathPrefix(PathPrefix + "/{provider}/v2").Subrouter()
+	r.Use(h.authenticate)
+	r.Methods(http.MethodGet).Path("/ServiceProviderConfig").HandlerFunc(h.serviceProviderConfig)
+	r.Methods(http.MethodGet).Path("/Users").HandlerFunc(h.listUsers)
+	r.Methods(http.MethodPost).Path("/Users").HandlerFunc(h.createUser)
+	r.Methods(http.MethodGet).Path("/Users/{id}").HandlerFunc(h.getUser)
+	r.Methods(http.MethodPut).Path("/Users/{id}").HandlerFunc(h.replaceUser)
+	r.Methods(http.MethodPatch).Path("/Users/{id}").HandlerFunc(h.patchUser)
+	r.Methods(http.MethodDelete).Path("/Users/{id}").HandlerFunc(h.deleteUser)
+	r.Methods(http.MethodGet).Path("/Groups").HandlerFunc(h.listGroups)
+	r.Methods(http.MethodPost).Path("/Groups").HandlerFunc(h.createGroup)
+	r.Methods(http.MethodGet).Path("/Groups/{id}").HandlerFunc(h.getGroup)
+	r.Methods(http.MethodPut).Path("/Groups/{id}").HandlerFunc(h.replaceGroup)
+	r.Methods(http.MethodPatch).Path("/Groups/{id}").HandlerFunc(h.patchGroup)
+	r.Methods(http.MethodDelete).Path("/Groups/{id}").HandlerFunc(h.deleteGroup)
+	r.NotFoundHandler = http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		writeError(rw, http.StatusNotFound, "", "resource type not supported")
+	})
+	return router
+}
+
+// authenticate checks the bearer token against the secret configured for the provider.
+func (h *handler) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		provider := mux.Vars(req)["provider"]
+		if !providers.ProviderNames[provider] || provider == local.Name {
+			writeError(rw, http.StatusNotFound, "", fmt.Sprintf("unknown auth provider %s", provider))
+			return
+		}
+
+		secret, err := h.secretLister.Get(common.SecretsNamespace, tokenSecretPrefix+provider)
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				logrus.Errorf("[SCIM] Failed to read token of %s: %v", provider, err)
+			}
+			writeError(rw, http.StatusUnauthorized, "", "SCIM provisioning is not enabled for this auth provider")
+			return
+		}
+		expected := secret.Data[tokenSecretField]
+		token := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+		if len(expected) == 0 || subtle.ConstantTimeCompare([]byte(token), expected) != 1 {
+			writeError(rw, http.StatusUnauthorized, "", "invalid bearer token")
+			return
+		}
+
+		next.ServeHTTP(rw, req)
+	})
+}
+
+func (h *handler) serviceProviderConfig(rw http.ResponseWriter, req *http.Request) {
+	writeResponse(rw, http.StatusOK, map[string]interface{}{
+		"schemas":        []string{serviceProviderConfigSchema},
+		"patch":          map[string]bool{"supported": true},
+		"bulk":           map[string]interface{}{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]interface{}{"supported": true, "maxResults": maxCount},
+		"changePassword": map[string]bool{"supported": false},
+		"sort":           map[string]bool{"supported": false},
+		"etag":           map[string]bool{"supported": false},
+		"authenticationSchemes": []map[string]string{{
+			"type":        "oauthbearertoken",
+			"name":        "OAuth Bearer Token",
+			"description": "Authentication with the token stored in the scim-<provider> secret",
+		}},
+	})
+}
+
+// Meta is the resource metadata of https://tools.ietf.org/html/rfc7643#section-3.1
+type Meta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created,omitempty"`
+	Location     string `json:"location,omitempty"`
+}
+
+type ListResponse struct {
+	Schemas      []string      `json:"schemas"`
+	TotalResults int           `json:"totalResults"`
+	StartIndex   int           `json:"startIndex"`
+	ItemsPerPage int           `json:"itemsPerPage"`
+	Resources    []interface{} `json:"Resources"`
+}
+
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// listResponse returns the page of resources requested by the startIndex and count parameters.
+func listResponse(req *http.Request, resources []interface{}) ListResponse {
+	startIndex, err := strconv.Atoi(req.URL.Query().Get("startIndex"))
+	if err != nil || startIndex < 1 {
+		startIndex = 1
+	}
+	count, err := strconv.Atoi(req.URL.Query().Get("count"))
+	if err != nil || count < 0 {
+		count = defaultCount
+	}
+	if count > maxCount {
+		count = maxCount
+	}
+
+	page := []interface{}{}
+	if startIndex <= len(resources) {
+		end := startIndex - 1 + count
+		if end > len(resources) {
+			end = len(resources)
+		}
+		page = resources[startIndex-1 : end]
+	}
+	return ListResponse{
+		Schemas:      []string{listResponseSchema},
+		TotalResults: len(resources),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	}
+}
+
+func location(req *http.Request, resourceType, id string) string {
+	return fmt.Sprintf("%s/%s/v2/%s/%s", PathPrefix, mux.Vars(req)["provider"], resourceType, id)
+}
+
+func principalID(provider, principalType, externalID string) string {
+	return provider + "_" + principalType + "://" + externalID
+}
+
+func decode(rw http.ResponseWriter, req *http.Request, into interface{}) bool {
+	if err := json.NewDecoder(req.Body).Decode(into); err != nil {
+		writeError(rw, http.StatusBadRequest, "invalidSyntax", fmt.Sprintf("failed to parse body: %v", err))
+		return false
+	}
+	return true
+}
+
+func writeResponse(rw http.ResponseWriter, status int, body interface{}) {
+	rw.Header().Set("Content-Type", contentType)
+	rw.WriteHeader(status)
+	if err := json.NewEncoder(rw).Encode(body); err != nil {
+		logrus.Errorf("[SCIM] Failed to write response: %v", err)
+	}
+}
+
+func writeError(rw http.ResponseWriter, status int, scimType, detail string) {
+	writeResponse(rw, status, Error{
+		Schemas:  []string{errorSchema},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	})
+}
+
+// writeServerError reports errors of the Rancher API, keeping their status if they have one.
+func writeServerError(rw http.ResponseWriter, err error) {
+	if status, ok := err.(apierrors.APIStatus); ok && status.Status().Code >= http.StatusBadRequest && status.Status().Code < http.StatusInternalServerError {
+		writeError(rw, int(status.Status().Code), "", err.Error())
+		return
+	}
+	logrus.Errorf("[SCIM] %v", err)
+	writeError(rw, http.StatusInternalServerError, "", err.Error())
+}
@@ -0,0 +1,362 @@
+package scim
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/rancher/rancher/pkg/auth/providers"
+	"github.com/rancher/rancher/pkg/auth/providers/common"
+	corefakes "github.com/rancher/rancher/pkg/generated/norman/core/v1/fakes"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3/fakes"
+	"github.com/rancher/rancher/pkg/user"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const testToken = "scim-token"
+
+type testStore struct {
+	users          map[string]*v3.User
+	groups         map[string]*v3.Group
+	userAttributes map[string]*v3.UserAttribute
+	revoked        []string
+}
+
+type fakeUserManager struct {
+	user.Manager
+	store *testStore
+}
+
+func (m *fakeUserManager) EnsureUser(principalName, displayName string) (*v3.User, error) {
+	for _, u := range m.store.users {
+		if containsString(u.PrincipalIDs, principalName) {
+			return u.DeepCopy(), nil
+		}
+	}
+	u := &v3.User{
+		ObjectMeta:   metav1.ObjectMeta{Name: "u-" + principalName[len(principalName)-4:]},
+		DisplayName:  displayName,
+		PrincipalIDs: []string{principalName},
+	}
+	m.store.users[u.Name] = u
+	return u.DeepCopy(), nil
+}
+
+func (s *testStore) EnsureAndGetUserAttribute(userID string) (*v3.UserAttribute, bool, error) {
+	if attribs, ok := s.userAttributes[userID]; ok {
+		return attribs.DeepCopy(), false, nil
+	}
+	if _, ok := s.users[userID]; !ok {
+		return nil, false, apierrors.NewNotFound(v3.UserGroupVersionResource.GroupResource(), userID)
+	}
+	return &v3.UserAttribute{ObjectMeta: metav1.ObjectMeta{Name: userID}}, true, nil
+}
+
+func (s *testStore) DeleteUserTokens(userID string) error {
+	s.revoked = append(s.revoked, userID)
+	return nil
+}
+
+func newTestHandler() (*testStore, http.Handler) {
+	for _, provider := range []string{"local", "github", "openldap"} {
+		providers.ProviderNames[provider] = true
+	}
+	s := &testStore{
+		users:          map[string]*v3.User{},
+		groups:         map[string]*v3.Group{},
+		userAttributes: map[string]*v3.UserAttribute{},
+	}
+	h := &handler{
+		users: &fakes.UserInterfaceMock{
+			UpdateFunc: func(in1 *v3.User) (*v3.User, error) {
+				s.users[in1.Name] = in1.DeepCopy()
+				return in1, nil
+			},
+			DeleteFunc: func(name string, options *metav1.DeleteOptions) error {
+				delete(s.users, name)
+				return nil
+			},
+		},
+		userLister: &fakes.UserListerMock{
+			GetFunc: func(namespace string, name string) (*v3.User, error) {
+				if u, ok := s.users[name]; ok {
+					return u, nil
+				}
+				return nil, apierrors.NewNotFound(v3.UserGroupVersionResource.GroupResource(), name)
+			},
+			ListFunc: func(namespace string, selector labels.Selector) ([]*v3.User, error) {
+				var users []*v3.User
+				for _, u := range s.users {
+					if selector.Matches(labels.Set(u.Labels)) {
+						users = append(users, u)
+					}
+				}
+				return users, nil
+			},
+		},
+		groups: &fakes.GroupInterfaceMock{
+			CreateFunc: func(in1 *v3.Group) (*v3.Group, error) {
+				if _, ok := s.groups[in1.Name]; ok {
+					return nil, apierrors.NewAlreadyExists(v3.GroupGroupVersionResource.GroupResource(), in1.Name)
+				}
+				s.groups[in1.Name] = in1.DeepCopy()
+				return in1, nil
+			},
+			UpdateFunc: func(in1 *v3.Group) (*v3.Group, error) {
+				s.groups[in1.Name] = in1.DeepCopy()
+				return in1, nil
+			},
+			DeleteFunc: func(name string, options *metav1.DeleteOptions) error {
+				delete(s.groups, name)
+				return nil
+			},
+		},
+		groupLister: &fakes.GroupListerMock{
+			GetFunc: func(namespace string, name string) (*v3.Group, error) {
+				if g, ok := s.groups[name]; ok {
+					return g, nil
+				}
+				return nil, apierrors.NewNotFound(v3.GroupGroupVersionResource.GroupResource(), name)
+			},
+			ListFunc: func(namespace string, selector labels.Selector) ([]*v3.Group, error) {
+				var groups []*v3.Group
+				for _, g := range s.groups {
+					if selector.Matches(labels.Set(g.Labels)) {
+						groups = append(groups, g)
+					}
+				}
+				return groups, nil
+			},
+		},
+		userAttributes: &fakes.UserAttributeInterfaceMock{
+			CreateFunc: func(in1 *v3.UserAttribute) (*v3.UserAttribute, error) {
+				s.userAttributes[in1.Name] = in1.DeepCopy()
+				return in1, nil
+			},
+			UpdateFunc: func(in1 *v3.UserAttribute) (*v3.UserAttribute, error) {
+				s.userAttributes[in1.Name] = in1.DeepCopy()
+				return in1, nil
+			},
+		},
+		userAttributeLister: &fakes.UserAttributeListerMock{
+			ListFunc: func(namespace string, selector labels.Selector) ([]*v3.UserAttribute, error) {
+				var attribs []*v3.UserAttribute
+				for _, a := range s.userAttributes {
+					attribs = append(attribs, a)
+				}
+				return attribs, nil
+			},
+		},
+		secretLister: &corefakes.SecretListerMock{
+			GetFunc: func(namespace string, name string) (*corev1.Secret, error) {
+				if namespace == common.SecretsNamespace && name == "scim-github" {
+					return &corev1.Secret{Data: map[string][]byte{"token": []byte(testToken)}}, nil
+				}
+				return nil, apierrors.NewNotFound(corev1.Resource("secrets"), name)
+			},
+		},
+		tokenMGR: s,
+	}
+	h.userMGR = &fakeUserManager{store: s}
+	return s, h.router()
+}
+
+func do(router http.Handler, method, path, token string, body interface{}) *httptest.ResponseRecorder {
+	var data []byte
+	if body != nil {
+		data, _ = json.Marshal(body)
+	}
+	req := httptest.NewRequest(method, path, bytes.NewReader(data))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rw := httptest.NewRecorder()
+	router.ServeHTTP(rw, req)
+	return rw
+}
+
+func TestAuthentication(t *testing.T) {
+	assert := assert.New(t)
+	_, router := newTestHandler()
+
+	assert.Equal(http.StatusUnauthorized, do(router, http.MethodGet, "/v3-public/scim/github/v2/Users", "", nil).Code)
+	assert.Equal(http.StatusUnauthorized, do(router, http.MethodGet, "/v3-public/scim/github/v2/Users", "wrong", nil).Code)
+	// the token of one provider can not be used for another one
+	assert.Equal(http.StatusUnauthorized, do(router, http.MethodGet, "/v3-public/scim/openldap/v2/Users", testToken, nil).Code)
+	assert.Equal(http.StatusNotFound, do(router, http.MethodGet, "/v3-public/scim/local/v2/Users", testToken, nil).Code)
+	assert.Equal(http.StatusOK, do(router, http.MethodGet, "/v3-public/scim/github/v2/Users", testToken, nil).Code)
+}
+
+func TestUserLifecycle(t *testing.T) {
+	assert := assert.New(t)
+	s, router := newTestHandler()
+
+	rw := do(router, http.MethodPost, "/v3-public/scim/github/v2/Users", testToken, User{
+		Schemas:    []string{userSchema},
+		UserName:   "jdoe",
+		ExternalID: "1234",
+		Name:       &Name{GivenName: "Jane", FamilyName: "Doe"},
+	})
+	assert.Equal(http.StatusCreated, rw.Code)
+	assert.Equal(contentType, rw.Header().Get("Content-Type"))
+	created := User{}
+	assert.Nil(json.Unmarshal(rw.Body.Bytes(), &created))
+	assert.Equal("u-1234", created.ID)
+	assert.True(*created.Active)
+	u := s.users["u-1234"]
+	assert.Equal("github", u.Labels[providerLabel])
+	assert.Equal("Jane Doe", u.DisplayName)
+	assert.Equal([]string{"github_user://1234"}, u.PrincipalIDs)
+
+	// provisioning the same user twice is a conflict
+	rw = do(router, http.MethodPost, "/v3-public/scim/github/v2/Users", testToken, User{UserName: "JDOE", ExternalID: "5678"})
+	assert.Equal(http.StatusConflict, rw.Code)
+
+	rw = do(router, http.MethodGet, "/v3-public/scim/github/v2/Users?filter="+url.QueryEscape(`userName eq "JDoe"`), testToken, nil)
+	list := ListResponse{}
+	assert.Nil(json.Unmarshal(rw.Body.Bytes(), &list))
+	assert.Equal(1, list.TotalResults)
+	rw = do(router, http.MethodGet, "/v3-public/scim/github/v2/Users?filter="+url.QueryEscape(`userName eq "other"`), testToken, nil)
+	assert.Nil(json.Unmarshal(rw.Body.Bytes(), &list))
+	assert.Equal(0, list.TotalResults)
+
+	// deactivating a user disables it and revokes its tokens
+	rw = do(router, http.MethodPatch, "/v3-public/scim/github/v2/Users/u-1234", testToken, PatchRequest{
+		Schemas:    []string{patchOpSchema},
+		Operations: []PatchOperation{{Op: "Replace", Path: "active", Value: json.RawMessage(`"False"`)}},
+	})
+	assert.Equal(http.StatusOK, rw.Code)
+	assert.False(*s.users["u-1234"].Enabled)
+	assert.Equal([]string{"u-1234"}, s.revoked)
+
+	// the externalId is the principal of the user and can not change
+	rw = do(router, http.MethodPut, "/v3-public/scim/github/v2/Users/u-1234", testToken, User{UserName: "jdoe", ExternalID: "9999"})
+	assert.Equal(http.StatusBadRequest, rw.Code)
+	rw = do(router, http.MethodPut, "/v3-public/scim/github/v2/Users/u-1234", testToken, User{UserName: "jdoe"})
+	assert.Equal(http.StatusBadRequest, rw.Code)
+
+	// users of other providers are not visible
+	assert.Equal(http.StatusNotFound, do(router, http.MethodGet, "/v3-public/scim/github/v2/Users/u-other", testToken, nil).Code)
+
+	assert.Equal(http.StatusNoContent, do(router, http.MethodDelete, "/v3-public/scim/github/v2/Users/u-1234", testToken, nil).Code)
+	assert.NotContains(s.users, "u-1234")
+	assert.Equal([]string{"u-1234", "u-1234"}, s.revoked)
+}
+
+func TestRenameUserWithoutExternalID(t *testing.T) {
+	assert := assert.New(t)
+	s, router := newTestHandler()
+
+	for _, name := range []string{"jdoe", "asmith"} {
+		rw := do(router, http.MethodPost, "/v3-public/scim/github/v2/Users", testToken, User{UserName: name})
+		assert.Equal(http.StatusCreated, rw.Code)
+	}
+
+	// the userName is the principal of a user without externalId, which is kept when the user is renamed
+	rw := do(router, http.MethodPatch, "/v3-public/scim/github/v2/Users/u-jdoe", testToken, PatchRequest{
+		Operations: []PatchOperation{{Op: "replace", Path: "userName", Value: json.RawMessage(`"jane.doe"`)}},
+	})
+	assert.Equal(http.StatusOK, rw.Code)
+	u := s.users["u-jdoe"]
+	assert.Equal("jane.doe", u.Annotations[userNameAnnotation])
+	assert.Equal([]string{"github_user://jdoe"}, u.PrincipalIDs)
+
+	// the userName of another user is taken
+	rw = do(router, http.MethodPut, "/v3-public/scim/github/v2/Users/u-jdoe", testToken, User{UserName: "ASmith"})
+	assert.Equal(http.StatusConflict, rw.Code)
+	// an externalId can not be given later on
+	rw = do(router, http.MethodPut, "/v3-public/scim/github/v2/Users/u-jdoe", testToken, User{UserName: "jane.doe", ExternalID: "1234"})
+	assert.Equal(http.StatusBadRequest, rw.Code)
+}
+
+func TestGroupMembership(t *testing.T) {
+	assert := assert.New(t)
+	s, router := newTestHandler()
+
+	for _, id := range []string{"1111", "2222"} {
+		rw := do(router, http.MethodPost, "/v3-public/scim/github/v2/Users", testToken, User{UserName: "user" + id, ExternalID: id})
+		assert.Equal(http.StatusCreated, rw.Code)
+	}
+
+	rw := do(router, http.MethodPost, "/v3-public/scim/github/v2/Groups", testToken, Group{
+		DisplayName: "admins",
+		ExternalID:  "42",
+		Members:     []Member{{Value: "u-1111"}},
+	})
+	assert.Equal(http.StatusCreated, rw.Code)
+	created := Group{}
+	assert.Nil(json.Unmarshal(rw.Body.Bytes(), &created))
+	assert.Len(created.Members, 1)
+	items := s.userAttributes["u-1111"].GroupPrincipals["scim:github"].Items
+	if assert.Len(items, 1) {
+		assert.Equal("github_group://42", items[0].Name)
+		assert.Equal("admins", items[0].DisplayName)
+		assert.True(items[0].MemberOf)
+	}
+	// logins and refreshes replace the groups of the provider, memberships are kept apart from them
+	assert.Empty(s.userAttributes["u-1111"].GroupPrincipals["github"].Items)
+
+	// members must be provisioned users
+	rw = do(router, http.MethodPatch, "/v3-public/scim/github/v2/Groups/"+created.ID, testToken, PatchRequest{
+		Operations: []PatchOperation{{Op: "add", Path: "members", Value: json.RawMessage(`[{"value":"u-missing"}]`)}},
+	})
+	assert.Equal(http.StatusBadRequest, rw.Code)
+
+	rw = do(router, http.MethodPatch, "/v3-public/scim/github/v2/Groups/"+created.ID, testToken, PatchRequest{
+		Operations: []PatchOperation{
+			{Op: "add", Path: "members", Value: json.RawMessage(`[{"value":"u-2222"}]`)},
+			{Op: "remove", Path: `members[value eq "u-1111"]`},
+			{Op: "replace", Value: json.RawMessage(`{"displayName":"operators"}`)},
+		},
+	})
+	assert.Equal(http.StatusOK, rw.Code)
+	assert.Empty(s.userAttributes["u-1111"].GroupPrincipals["scim:github"].Items)
+	items = s.userAttributes["u-2222"].GroupPrincipals["scim:github"].Items
+	if assert.Len(items, 1) {
+		assert.Equal("operators", items[0].DisplayName)
+	}
+
+	rw = do(router, http.MethodGet, "/v3-public/scim/github/v2/Groups?excludedAttributes=members", testToken, nil)
+	list := ListResponse{}
+	assert.Nil(json.Unmarshal(rw.Body.Bytes(), &list))
+	if assert.Len(list.Resources, 1) {
+		assert.NotContains(list.Resources[0], "members")
+	}
+
+	assert.Equal(http.StatusNoContent, do(router, http.MethodDelete, "/v3-public/scim/github/v2/Groups/"+created.ID, testToken, nil).Code)
+	assert.Empty(s.groups)
+	assert.Empty(s.userAttributes["u-2222"].GroupPrincipals["scim:github"].Items)
+}
+
+func TestParseFilter(t *testing.T) {
+	assert := assert.New(t)
+
+	attr, value, err := parseFilter(`userName eq "jdoe@example.com"`)
+	assert.Nil(err)
+	assert.Equal("username", attr)
+	assert.Equal("jdoe@example.com", value)
+
+	attr, value, err = parseFilter(`externalId EQ "a \"b\""`)
+	assert.Nil(err)
+	assert.Equal("externalid", attr)
+	assert.Equal(`a "b"`, value)
+
+	_, _, err = parseFilter(`userName sw "j"`)
+	assert.NotNil(err)
+	_, _, err = parseFilter(`userName eq "a" or userName eq "b"`)
+	assert.NotNil(err)
+
+	userID, ok := memberFilter(`members[value eq "u-abc"]`)
+	assert.True(ok)
+	assert.Equal("u-abc", userID)
+	_, ok = memberFilter("members")
+	assert.False(ok)
+}
@@ -0,0 +1,370 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// User is the SCIM core user resource of https://tools.ietf.org/html/rfc7643#section-4.1, limited to the attributes
+// Rancher keeps.
+type User struct {
+	Schemas     []string `json:"schemas"`
+	ID          string   `json:"id,omitempty"`
+	ExternalID  string   `json:"externalId,omitempty"`
+	UserName    string   `json:"userName"`
+	Name        *Name    `json:"name,omitempty"`
+	DisplayName string   `json:"displayName,omitempty"`
+	Active      *bool    `json:"active,omitempty"`
+	Meta        *Meta    `json:"meta,omitempty"`
+}
+
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+func (h *handler) listUsers(rw http.ResponseWriter, req *http.Request) {
+	provider := mux.Vars(req)["provider"]
+	attr, value, err := parseFilter(req.URL.Query().Get("filter"))
+	if err != nil {
+		writeError(rw, http.StatusBadRequest, "invalidFilter", err.Error())
+		return
+	}
+
+	users, err := h.userLister.List("", labels.SelectorFromSet(labels.Set{providerLabel: provider}))
+	if err != nil {
+		writeServerError(rw, err)
+		return
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].Name < users[j].Name
+	})
+
+	resources := []interface{}{}
+	for _, u := range users {
+		scimUser := toSCIMUser(req, u)
+		if attr != "" && !userMatches(scimUser, attr, value) {
+			continue
+		}
+		resources = append(resources, scimUser)
+	}
+	writeResponse(rw, http.StatusOK, listResponse(req, resources))
+}
+
+func (h *handler) getUser(rw http.ResponseWriter, req *http.Request) {
+	u, err := h.getProvisionedUser(req)
+	if err != nil {
+		writeServerError(rw, err)
+		return
+	}
+	writeResponse(rw, http.StatusOK, toSCIMUser(req, u))
+}
+
+func (h *handler) createUser(rw http.ResponseWriter, req *http.Request) {
+	provider := mux.Vars(req)["provider"]
+	scimUser := User{}
+	if !decode(rw, req, &scimUser) {
+		return
+	}
+	if scimUser.UserName == "" {
+		writeError(rw, http.StatusBadRequest, "invalidValue", "userName is required")
+		return
+	}
+
+	users, err := h.userLister.List("", labels.SelectorFromSet(labels.Set{providerLabel: provider}))
+	if err != nil {
+		writeServerError(rw, err)
+		return
+	}
+	for _, u := range users {
+		if strings.EqualFold(u.Annotations[userNameAnnotation], scimUser.UserName) {
+			writeError(rw, http.StatusConflict, "uniqueness", fmt.Sprintf("user %s already exists", scimUser.UserName))
+			return
+		}
+	}
+
+	// users that already logged in are adopted, as long as another SCIM client did not provision them
+	u, err := h.userMGR.EnsureUser(userPrincipalID(provider, scimUser), displayName(scimUser))
+	if err != nil {
+		writeServerError(rw, err)
+		return
+	}
+	if u.Labels[providerLabel] != "" {
+		writeError(rw, http.StatusConflict, "uniqueness", fmt.Sprintf("user %s already exists", scimUser.UserName))
+		return
+	}
+
+	u, err = h.updateUser(provider, u.DeepCopy(), scimUser)
+	if err != nil {
+		writeServerError(rw, err)
+		return
+	}
+	logrus.Infof("[SCIM] Provisioned user %s of %s as %s", scimUser.UserName, provider, u.Name)
+	writeResponse(rw, http.StatusCreated, toSCIMUser(req, u))
+}
+
+func (h *handler) replaceUser(rw http.ResponseWriter, req *http.Request) {
+	u, err := h.getProvisionedUser(req)
+	if err != nil {
+		writeServerError(rw, err)
+		return
+	}
+	scimUser := User{}
+	if !decode(rw, req, &scimUser) {
+		return
+	}
+	h.writeUpdatedUser(rw, req, u, scimUser)
+}
+
+func (h *handler) patchUser(rw http.ResponseWriter, req *http.Request) {
+	u, err := h.getProvisionedUser(req)
+	if err != nil {
+		writeServerError(rw, err)
+		return
+	}
+	patch := PatchRequest{}
+	if !decode(rw, req, &patch) {
+		return
+	}
+
+	scimUser := toSCIMUser(req, u)
+	for _, op := range patch.Operations {
+		if err := patchUserAttribute(&scimUser, op); err != nil {
+			writeError(rw, http.StatusBadRequest, "invalidValue", err.Error())
+			return
+		}
+	}
+	h.writeUpdatedUser(rw, req, u, scimUser)
+}
+
+func (h *handler) writeUpdatedUser(rw http.ResponseWriter, req *http.Request, u *v3.User, scimUser User) {
+	provider := mux.Vars(req)["provider"]
+	if scimUser.UserName == "" {
+		writeError(rw, http.StatusBadRequest, "invalidValue", "userName is required")
+		return
+	}
+	// the principal of a user never changes, it is kept when a user without externalId is renamed
+	if scimUser.ExternalID != u.Annotations[externalIDAnnotation] {
+		writeError(rw, http.StatusBadRequest, "mutability", "the externalId of a user can not be changed")
+		return
+	}
+	if !strings.EqualFold(scimUser.UserName, u.Annotations[userNameAnnotation]) {
+		users, err := h.userLister.List("", labels.SelectorFromSet(labels.Set{providerLabel: provider}))
+		if err != nil {
+			writeServerError(rw, err)
+			return
+		}
+		for _, other := range users {
+			if other.Name != u.Name && strings.EqualFold(other.Annotations[userNameAnnotation], scimUser.UserName) {
+				writeError(rw, http.StatusConflict, "uniqueness", fmt.Sprintf("user %s already exists", scimUser.UserName))
+				return
+			}
+		}
+	}
+
+	u, err := h.updateUser(provider, u.DeepCopy(), scimUser)
+	if err != nil {
+		writeServerError(rw, err)
+		return
+	}
+	writeResponse(rw, http.StatusOK, toSCIMUser(req, u))
+}
+
+// deleteUser removes the user along with its tokens and attributes. The bindings of the user are cleaned up by the
+// user controller.
+func (h *handler) deleteUser(rw http.ResponseWriter, req *http.Request) {
+	u, err := h.getProvisionedUser(req)
+	if err != nil {
+		writeServerError(rw, err)
+		return
+	}
+	if err := h.tokenMGR.DeleteUserTokens(u.Name); err != nil {
+		writeServerError(rw, err)
+		return
+	}
+	if err := h.users.Delete(u.Name, nil); err != nil && !apierrors.IsNotFound(err) {
+		writeServerError(rw, err)
+		return
+	}
+	logrus.Infof("[SCIM] Deprovisioned user %s", u.Name)
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// updateUser saves the SCIM attributes on the user. Deactivated users lose their tokens right away, the
+// authentication filter already rejects disabled users but their tokens would stay valid once they are enabled again.
+func (h *handler) updateUser(provider string, u *v3.User, scimUser User) (*v3.User, error) {
+	if u.Labels == nil {
+		u.Labels = map[string]string{}
+	}
+	if u.Annotations == nil {
+		u.Annotations = map[string]string{}
+	}
+	u.Labels[providerLabel] = provider
+	u.Annotations[userNameAnnotation] = scimUser.UserName
+	u.Annotations[externalIDAnnotation] = scimUser.ExternalID
+	u.DisplayName = displayName(scimUser)
+	active := scimUser.Active == nil || *scimUser.Active
+	u.Enabled = &active
+
+	u, err := h.users.Update(u)
+	if err != nil {
+		return nil, err
+	}
+	if !active {
+		if err := h.tokenMGR.DeleteUserTokens(u.Name); err != nil {
+			return nil, err
+		}
+	}
+	return u, nil
+}
+
+func (h *handler) getProvisionedUser(req *http.Request) (*v3.User, error) {
+	vars := mux.Vars(req)
+	u, err := h.userLister.Get("", vars["id"])
+	if err != nil {
+		return nil, err
+	}
+	if u.Labels[providerLabel] != vars["provider"] {
+		return nil, apierrors.NewNotFound(v3.UserGroupVersionResource.GroupResource(), vars["id"])
+	}
+	return u, nil
+}
+
+func toSCIMUser(req *http.Request, u *v3.User) User {
+	active := u.Enabled == nil || *u.Enabled
+	return User{
+		Schemas:     []string{userSchema},
+		ID:          u.Name,
+		ExternalID:  u.Annotations[externalIDAnnotation],
+		UserName:    u.Annotations[userNameAnnotation],
+		DisplayName: u.DisplayName,
+		Active:      &active,
+		Meta: &Meta{
+			ResourceType: "User",
+			Created:      u.CreationTimestamp.Format(time.RFC3339),
+			Location:     location(req, "Users", u.Name),
+		},
+	}
+}
+
+// patchUserAttribute applies an operation to the attributes Rancher keeps, other attributes are ignored.
+func patchUserAttribute(scimUser *User, op PatchOperation) error {
+	operation := strings.ToLower(op.Op)
+	if operation != "add" && operation != "replace" && operation != "remove" {
+		return fmt.Errorf("unsupported operation %s", op.Op)
+	}
+
+	if op.Path == "" {
+		if operation == "remove" {
+			return fmt.Errorf("remove requires a path")
+		}
+		values := map[string]json.RawMessage{}
+		if err := json.Unmarshal(op.Value, &values); err != nil {
+			return fmt.Errorf("invalid value: %v", err)
+		}
+		for path, value := range values {
+			if err := patchUserAttribute(scimUser, PatchOperation{Op: op.Op, Path: path, Value: value}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	switch strings.ToLower(op.Path) {
+	case "active":
+		active := true
+		if operation != "remove" {
+			var err error
+			if active, err = boolValue(op.Value); err != nil {
+				return err
+			}
+		}
+		scimUser.Active = &active
+		return nil
+	case "username":
+		if operation == "remove" {
+			return fmt.Errorf("userName is required")
+		}
+		return json.Unmarshal(op.Value, &scimUser.UserName)
+	case "externalid":
+		scimUser.ExternalID = ""
+		if operation == "remove" {
+			return nil
+		}
+		return json.Unmarshal(op.Value, &scimUser.ExternalID)
+	case "displayname":
+		scimUser.DisplayName = ""
+		if operation == "remove" {
+			return nil
+		}
+		return json.Unmarshal(op.Value, &scimUser.DisplayName)
+	}
+	return nil
+}
+
+// boolValue reads booleans that some identity providers send as strings, such as "False".
+func boolValue(value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return false, fmt.Errorf("invalid boolean %s", string(value))
+	}
+	switch strings.ToLower(s) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+	return false, fmt.Errorf("invalid boolean %s", s)
+}
+
+func userMatches(scimUser User, attr, value string) bool {
+	switch attr {
+	case "username":
+		return strings.EqualFold(scimUser.UserName, value)
+	case "externalid":
+		return scimUser.ExternalID == value
+	case "id":
+		return scimUser.ID == value
+	case "displayname":
+		return scimUser.DisplayName == value
+	}
+	return false
+}
+
+// userPrincipalID is the principal the auth provider gives the user when they log in.
+func userPrincipalID(provider string, scimUser User) string {
+	if scimUser.ExternalID != "" {
+		return principalID(provider, "user", scimUser.ExternalID)
+	}
+	return principalID(provider, "user", scimUser.UserName)
+}
+
+func displayName(scimUser User) string {
+	if scimUser.DisplayName != "" {
+		return scimUser.DisplayName
+	}
+	if scimUser.Name != nil {
+		if scimUser.Name.Formatted != "" {
+			return scimUser.Name.Formatted
+		}
+		if name := strings.TrimSpace(scimUser.Name.GivenName + " " + scimUser.Name.FamilyName); name != "" {
+			return name
+		}
+	}
+	return scimUser.UserName
+}
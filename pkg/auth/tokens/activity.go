@@ -0,0 +1,160 @@
+package tokens
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// activityInterval is how often the activity of a token is written at most, a token used on every request is
+	// written once per interval instead of on every request.
+	activityInterval = time.Minute
+)
+
+type activity struct {
+	usedAt time.Time
+	from   string
+}
+
+// ActivityRecorder keeps the last use of tokens in memory and writes it to the tokens in batches.
+type ActivityRecorder struct {
+	sync.Mutex
+	pending     map[string]activity
+	tokenLister v3.TokenLister
+	tokens      v3.TokenInterface
+}
+
+func NewActivityRecorder(ctx context.Context, apiContext *config.ScaledContext) *ActivityRecorder {
+	r := &ActivityRecorder{
+		pending:     map[string]activity{},
+		tokenLister: apiContext.Management.Tokens("").Controller().Lister(),
+		tokens:      apiContext.Management.Tokens(""),
+	}
+	go wait.JitterUntil(r.flush, activityInterval, .1, false, ctx.Done())
+	return r
+}
+
+// Record notes that the token was used by the request. Nothing is recorded when the token already shows a use from
+// the same address within the last interval.
+func (r *ActivityRecorder) Record(token *v3.Token, req *http.Request) {
+	now := time.Now()
+	from := SourceIP(req)
+	if lastUsed, err := time.Parse(time.RFC3339, token.LastUsedAt); err == nil && token.LastUsedFrom == from && now.Sub(lastUsed) < activityInterval {
+		return
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	r.pending[token.Name] = activity{usedAt: now, from: from}
+}
+
+func (r *ActivityRecorder) flush() {
+	r.Lock()
+	pending := r.pending
+	r.pending = map[string]activity{}
+	r.Unlock()
+
+	for name, a := range pending {
+		if err := r.update(name, a); err != nil {
+			logrus.Debugf("Failed to record activity of token %v: %v", name, err)
+			r.retry(name, a)
+		}
+	}
+}
+
+func (r *ActivityRecorder) update(name string, a activity) error {
+	token, err := r.tokenLister.Get("", name)
+	if apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if lastUsed, err := time.Parse(time.RFC3339, token.LastUsedAt); err == nil && token.LastUsedFrom == a.from && !lastUsed.Before(a.usedAt.Truncate(time.Second)) {
+		return nil
+	}
+	token = token.DeepCopy()
+	token.LastUsedAt = a.usedAt.UTC().Format(time.RFC3339)
+	token.LastUsedFrom = a.from
+	_, err = r.tokens.Update(token)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// retry keeps the activity for the next flush, unless the token was used again in the meantime.
+func (r *ActivityRecorder) retry(name string, a activity) {
+	r.Lock()
+	defer r.Unlock()
+	if _, ok := r.pending[name]; !ok {
+		r.pending[name] = a
+	}
+}
+
+// SourceIP returns the address of the client of the request. The address of the connection is used unless it is a
+// trusted proxy, then X-Forwarded-For is walked from the right to the first address that is not a trusted proxy. The
+// addresses left of it are set by the client and are not used.
+func SourceIP(req *http.Request) string {
+	ip, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		ip = req.RemoteAddr
+	}
+	trusted := trustedProxies()
+	forwarded := strings.Split(req.Header.Get("X-Forwarded-For"), ",")
+	for i := len(forwarded) - 1; i >= 0 && isTrusted(ip, trusted); i-- {
+		if addr := strings.TrimSpace(forwarded[i]); addr != "" {
+			ip = addr
+		}
+	}
+	return ip
+}
+
+// trustedProxies returns the networks of the trusted-proxies setting, a single address is a network of its own.
+func trustedProxies() []*net.IPNet {
+	var result []*net.IPNet
+	for _, value := range strings.Split(settings.TrustedProxies.Get(), ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		if !strings.Contains(value, "/") {
+			if ip := net.ParseIP(value); ip != nil && ip.To4() != nil {
+				value += "/32"
+			} else {
+				value += "/128"
+			}
+		}
+		_, network, err := net.ParseCIDR(value)
+		if err != nil {
+			logrus.Debugf("Ignoring invalid trusted proxy %s: %v", value, err)
+			continue
+		}
+		result = append(result, network)
+	}
+	return result
+}
+
+func isTrusted(addr string, trusted []*net.IPNet) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, network := range trusted {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
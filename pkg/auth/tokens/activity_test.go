@@ -0,0 +1,117 @@
+package tokens
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3/fakes"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestActivityRecorder(t *testing.T) {
+	assert := assert.New(t)
+
+	stored := map[string]*v3.Token{
+		"token-1": {ObjectMeta: metav1.ObjectMeta{Name: "token-1"}},
+	}
+	var updates int
+	r := &ActivityRecorder{
+		pending: map[string]activity{},
+		tokenLister: &fakes.TokenListerMock{
+			GetFunc: func(namespace string, name string) (*v3.Token, error) {
+				if token, ok := stored[name]; ok {
+					return token, nil
+				}
+				return nil, apierrors.NewNotFound(v3.TokenGroupVersionResource.GroupResource(), name)
+			},
+		},
+		tokens: &fakes.TokenInterfaceMock{
+			UpdateFunc: func(in1 *v3.Token) (*v3.Token, error) {
+				updates++
+				stored[in1.Name] = in1
+				return in1, nil
+			},
+		},
+	}
+
+	req := httptest.NewRequest("GET", "/v3/clusters", nil)
+	req.RemoteAddr = "10.0.0.1:51234"
+	for i := 0; i < 10; i++ {
+		r.Record(stored["token-1"], req)
+	}
+	r.Record(&v3.Token{ObjectMeta: metav1.ObjectMeta{Name: "token-deleted"}}, req)
+	r.flush()
+
+	// uses are batched into a single write per token
+	assert.Equal(1, updates)
+	assert.Equal("10.0.0.1", stored["token-1"].LastUsedFrom)
+	assert.NotEmpty(stored["token-1"].LastUsedAt)
+	assert.Empty(r.pending)
+
+	// a token that was just written is not recorded again for the same address
+	r.Record(stored["token-1"], req)
+	assert.Empty(r.pending)
+	req.RemoteAddr = "10.0.0.2:51234"
+	r.Record(stored["token-1"], req)
+	r.flush()
+	assert.Equal("10.0.0.2", stored["token-1"].LastUsedFrom)
+}
+
+func TestSourceIP(t *testing.T) {
+	assert := assert.New(t)
+	defer settings.TrustedProxies.Set(settings.TrustedProxies.Default)
+
+	testCases := []struct {
+		name      string
+		trusted   string
+		remote    string
+		forwarded string
+		expected  string
+	}{
+		{"no proxy", "", "10.0.0.1:51234", "", "10.0.0.1"},
+		{"untrusted proxy", "", "10.0.0.1:51234", "192.168.0.7", "10.0.0.1"},
+		{"trusted proxy", "10.0.0.0/8", "10.0.0.1:51234", "192.168.0.7", "192.168.0.7"},
+		{"spoofed entry", "10.0.0.0/8", "10.0.0.1:51234", "1.2.3.4, 192.168.0.7", "192.168.0.7"},
+		{"trusted chain", "10.0.0.0/8, 172.16.0.5", "10.0.0.1:51234", "1.2.3.4, 192.168.0.7, 172.16.0.5, 10.0.0.9", "192.168.0.7"},
+		{"only proxies", "10.0.0.0/8", "10.0.0.1:51234", "10.0.0.9", "10.0.0.9"},
+		{"ipv6", "fd00::/8", "[fd00::1]:51234", "2001:db8::1", "2001:db8::1"},
+		{"no header", "10.0.0.0/8", "10.0.0.1:51234", "", "10.0.0.1"},
+	}
+	for _, tc := range testCases {
+		assert.Nil(settings.TrustedProxies.Set(tc.trusted))
+		req := httptest.NewRequest("GET", "/v3/clusters", nil)
+		req.RemoteAddr = tc.remote
+		if tc.forwarded != "" {
+			req.Header.Set("X-Forwarded-For", tc.forwarded)
+		}
+		assert.Equal(tc.expected, SourceIP(req), tc.name)
+	}
+}
+
+func TestIsIdle(t *testing.T) {
+	assert := assert.New(t)
+	defer settings.AuthTokenMaxIdleDays.Set(settings.AuthTokenMaxIdleDays.Default)
+
+	old := metav1.NewTime(time.Now().Add(-10 * 24 * time.Hour))
+	unused := v3.Token{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: old}}
+	recentlyUsed := v3.Token{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: old},
+		LastUsedAt: time.Now().Add(-time.Hour).UTC().Format(time.RFC3339),
+	}
+	agent := v3.Token{ObjectMeta: metav1.ObjectMeta{
+		CreationTimestamp: old,
+		Labels:            map[string]string{TokenKindLabel: "agent"},
+	}}
+
+	assert.False(IsIdle(unused))
+
+	settings.AuthTokenMaxIdleDays.Set("7")
+	assert.True(IsIdle(unused))
+	assert.False(IsIdle(recentlyUsed))
+	assert.False(IsIdle(agent))
+}
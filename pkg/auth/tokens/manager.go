@@ -101,21 +101,34 @@ func (m *Manager) createDerivedToken(jsonInput clientv3.Token, tokenAuthValue st
 		return v3.Token{}, 401, err
 	}
 
-	tokenTTL, err := ValidateMaxTTL(time.Duration(int64(jsonInput.TTLMillis)) * time.Millisecond)
-	if err != nil {
-		return v3.Token{}, 500, fmt.Errorf("error validating max-ttl %v", err)
+	scopes := toTokenScopes(jsonInput.Scopes)
+	if err := ValidateScopes(scopes); err != nil {
+		return v3.Token{}, 422, err
+	}
+	// tokens derived from a scoped token get the same scope unless they ask for a narrower one
+	if len(scopes) == 0 {
+		scopes = token.Scopes
+	} else if !ScopesCover(token.Scopes, scopes) {
+		return v3.Token{}, 403, fmt.Errorf("token scope exceeds the scope of the current token")
 	}
 
 	derivedToken := v3.Token{
 		UserPrincipal: token.UserPrincipal,
 		IsDerived:     true,
-		TTLMillis:     tokenTTL.Milliseconds(),
 		UserID:        token.UserID,
 		AuthProvider:  token.AuthProvider,
 		ProviderInfo:  token.ProviderInfo,
 		Description:   jsonInput.Description,
 		ClusterName:   jsonInput.ClusterID,
+		Scopes:        scopes,
 	}
+
+	tokenTTL, err := ValidateMaxTTL(time.Duration(int64(jsonInput.TTLMillis))*time.Millisecond, &derivedToken)
+	if err != nil {
+		return v3.Token{}, 500, fmt.Errorf("error validating max-ttl %v", err)
+	}
+	derivedToken.TTLMillis = tokenTTL.Milliseconds()
+
 	derivedToken, err = m.createToken(&derivedToken)
 
 	return derivedToken, 0, err
@@ -240,6 +253,24 @@ func (m *Manager) deleteTokenByName(tokenName string) (int, error) {
 	return 0, nil
 }
 
+// DeleteUserTokens deletes all login, derived and cluster tokens of the user, so that a user who was deprovisioned
+// in the identity provider loses access right away instead of when the tokens expire.
+func (m *Manager) DeleteUserTokens(userID string) error {
+	set := labels.Set(map[string]string{UserIDLabel: userID})
+	tokenList, err := m.tokensClient.List(metav1.ListOptions{LabelSelector: set.AsSelector().String()})
+	if err != nil {
+		return fmt.Errorf("error getting tokens for user: %v selector: %v  err: %v", userID, set.AsSelector().String(), err)
+	}
+
+	for _, t := range tokenList.Items {
+		if _, err := m.deleteTokenByName(t.Name); err != nil {
+			return fmt.Errorf("failed to delete token %v of user %v: %v", t.Name, userID, err)
+		}
+	}
+	logrus.Infof("Deleted %d tokens of user %v", len(tokenList.Items), userID)
+	return nil
+}
+
 //getToken will get the token by ID
 func (m *Manager) getTokenByID(tokenAuthValue string, tokenID string) (v3.Token, int, error) {
 	logrus.Debug("GET Token Invoked")
@@ -633,7 +664,7 @@ func (m *Manager) UserAttributeChanged(attribs *v32.UserAttribute, provider stri
 
 func (m *Manager) NewLoginToken(userID string, userPrincipal v32.Principal, groupPrincipals []v32.Principal, providerToken string, ttl int64, description string) (v3.Token, error) {
 	provider := userPrincipal.Provider
-	if (provider == "github" || provider == "azuread" || provider == "googleoauth") && providerToken != "" {
+	if (provider == "github" || provider == "azuread" || provider == "googleoauth" || provider == "oidc") && providerToken != "" {
 		err := m.CreateSecret(userID, provider, providerToken)
 		if err != nil {
 			return v3.Token{}, fmt.Errorf("unable to create secret: %s", err)
@@ -672,6 +703,13 @@ func (m *Manager) UpdateToken(token *v3.Token) (*v3.Token, error) {
 	return m.updateToken(token)
 }
 
+// ProvisionedGroupsKey is the key of the group principals of a user attribute that keeps the memberships of the
+// groups of the provider provisioned through SCIM. Logins and refreshes only replace the groups under the name of
+// the provider, so they leave these memberships alone.
+func ProvisionedGroupsKey(provider string) string {
+	return "scim:" + provider
+}
+
 func (m *Manager) GetGroupsForTokenAuthProvider(token *v3.Token) []v3.Principal {
 	var groups []v3.Principal
 
@@ -700,9 +738,26 @@ func (m *Manager) GetGroupsForTokenAuthProvider(token *v3.Token) []v3.Principal
 		}
 	}
 
+	if attribs != nil {
+		for _, principal := range attribs.GroupPrincipals[ProvisionedGroupsKey(token.AuthProvider)].Items {
+			if !containsPrincipal(groups, principal.Name) {
+				groups = append(groups, principal)
+			}
+		}
+	}
+
 	return groups
 }
 
+func containsPrincipal(principals []v3.Principal, name string) bool {
+	for _, p := range principals {
+		if p.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 func (m *Manager) IsMemberOf(token v3.Token, group v3.Principal) bool {
 	attribs, err := m.userAttributeLister.Get("", token.UserID)
 	if err != nil && !apierrors.IsNotFound(err) {
@@ -795,10 +850,20 @@ func ParseTokenTTL(ttl string) (time.Duration, error) {
 	return dur, nil
 }
 
-func ValidateMaxTTL(ttl time.Duration) (time.Duration, error) {
-	maxTTL, err := ParseTokenTTL(settings.AuthTokenMaxTTLMinutes.Get())
+// ValidateMaxTTL limits the ttl to the max ttl of the scope of the token. Tokens restricted to resources use
+// auth-token-max-ttl-minutes-scoped, tokens of a cluster use auth-token-max-ttl-minutes-cluster and the others, or
+// the ones for which these are not set, use auth-token-max-ttl-minutes.
+func ValidateMaxTTL(ttl time.Duration, token *v3.Token) (time.Duration, error) {
+	setting := settings.AuthTokenMaxTTLMinutes
+	if len(token.Scopes) > 0 && settings.AuthTokenMaxTTLMinutesScoped.Get() != "" {
+		setting = settings.AuthTokenMaxTTLMinutesScoped
+	} else if token.ClusterName != "" && settings.AuthTokenMaxTTLMinutesCluster.Get() != "" {
+		setting = settings.AuthTokenMaxTTLMinutesCluster
+	}
+
+	maxTTL, err := ParseTokenTTL(setting.Get())
 	if err != nil {
-		return 0, fmt.Errorf("error getting auth-token-max-ttl %v", err)
+		return 0, fmt.Errorf("error getting %s %v", setting.Name, err)
 	}
 	if maxTTL == 0 {
 		return ttl, nil
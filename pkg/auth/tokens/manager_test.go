@@ -7,7 +7,9 @@
 	"time"
 
 	"github.com/rancher/norman/types"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3/fakes"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
@@ -137,3 +139,36 @@ func (d *DummyIndexer) GetIndexers() cache.Indexers {
 func (d *DummyIndexer) AddIndexers(newIndexers cache.Indexers) error {
 	return nil
 }
+
+func TestGetGroupsForTokenAuthProvider(t *testing.T) {
+	assert := assert.New(t)
+
+	attribs := &v3.UserAttribute{
+		GroupPrincipals: map[string]v32.Principals{
+			"github": {Items: []v3.Principal{
+				{ObjectMeta: v1.ObjectMeta{Name: "github_team://1"}},
+			}},
+			ProvisionedGroupsKey("github"): {Items: []v3.Principal{
+				{ObjectMeta: v1.ObjectMeta{Name: "github_team://1"}},
+				{ObjectMeta: v1.ObjectMeta{Name: "github_team://2"}},
+			}},
+			ProvisionedGroupsKey("openldap"): {Items: []v3.Principal{
+				{ObjectMeta: v1.ObjectMeta{Name: "openldap_group://3"}},
+			}},
+		},
+	}
+	tokenManager := Manager{
+		userAttributeLister: &fakes.UserAttributeListerMock{
+			GetFunc: func(namespace string, name string) (*v3.UserAttribute, error) {
+				return attribs, nil
+			},
+		},
+	}
+
+	// the memberships provisioned through SCIM are merged with the groups of the login
+	var names []string
+	for _, p := range tokenManager.GetGroupsForTokenAuthProvider(&v3.Token{UserID: "u-abc", AuthProvider: "github"}) {
+		names = append(names, p.Name)
+	}
+	assert.Equal([]string{"github_team://1", "github_team://2"}, names)
+}
@@ -54,6 +54,24 @@ func (p *purger) purge() {
 		logrus.Infof("Purged %v expired tokens", count)
 	}
 
+	count = 0
+	for _, token := range allTokens {
+		if token.Enabled != nil && !*token.Enabled || IsExpired(*token) || !IsIdle(*token) {
+			continue
+		}
+		token = token.DeepCopy()
+		disabled := false
+		token.Enabled = &disabled
+		if _, err = p.tokens.Update(token); err != nil && !clientbase.IsNotFound(err) {
+			logrus.Errorf("Error: while disabling idle token %v: %v", token.ObjectMeta.Name, err)
+			continue
+		}
+		count++
+	}
+	if count > 0 {
+		logrus.Infof("Disabled %v idle tokens", count)
+	}
+
 	// saml tokens store encrypted token for login request from rancher cli
 	samlTokens, err := p.samlTokensLister.List(namespace.GlobalNamespace, labels.Everything())
 	if err != nil {
@@ -0,0 +1,205 @@
+package tokens
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	clientv3 "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+)
+
+const scopeWildcard = "*"
+
+var (
+	scopeVerbs = map[string]bool{
+		"get":         true,
+		"create":      true,
+		"update":      true,
+		"patch":       true,
+		"delete":      true,
+		scopeWildcard: true,
+	}
+	methodVerbs = map[string]string{
+		http.MethodGet:     "get",
+		http.MethodHead:    "get",
+		http.MethodOptions: "get",
+		http.MethodPost:    "create",
+		http.MethodPut:     "update",
+		http.MethodPatch:   "patch",
+		http.MethodDelete:  "delete",
+	}
+	// the API versions are not part of the resources of a scope
+	apiVersions = map[string]bool{
+		"v1": true,
+		"v3": true,
+	}
+	// resources of a project or a cluster can be addressed through the singular form as well
+	singularTypes = map[string]string{
+		"project": "projects",
+		"cluster": "clusters",
+	}
+)
+
+// ValidateScopes checks that every scope has resources and known verbs.
+func ValidateScopes(scopes []v32.TokenScope) error {
+	for _, scope := range scopes {
+		if len(scope.Resources) == 0 {
+			return fmt.Errorf("token scope must have resources")
+		}
+		for _, resource := range scope.Resources {
+			if len(resourceSegments(resource)) == 0 {
+				return fmt.Errorf("invalid token scope resource [%s]", resource)
+			}
+		}
+		if len(scope.Verbs) == 0 {
+			return fmt.Errorf("token scope must have verbs")
+		}
+		for _, verb := range scope.Verbs {
+			if !scopeVerbs[verb] {
+				return fmt.Errorf("invalid token scope verb [%s]", verb)
+			}
+		}
+	}
+	return nil
+}
+
+// ScopesAllow returns whether the scopes of a token allow the request. Tokens without scopes allow every request.
+// Scoped tokens are never allowed to create tokens with the full rights of their user, nor to use paths with ".."
+// segments, which could resolve to a resource outside of their scopes.
+func ScopesAllow(scopes []v32.TokenScope, req *http.Request) bool {
+	if len(scopes) == 0 {
+		return true
+	}
+	verb, ok := methodVerbs[req.Method]
+	if !ok {
+		return false
+	}
+	if mintsToken(req) {
+		return false
+	}
+	for _, segment := range strings.Split(req.URL.Path, "/") {
+		if segment == ".." {
+			return false
+		}
+	}
+	segments := resourceSegments(path.Clean("/" + req.URL.Path))
+	for _, scope := range scopes {
+		if !containsVerb(scope.Verbs, verb) {
+			continue
+		}
+		for _, resource := range scope.Resources {
+			if resourceMatches(resourceSegments(resource), segments) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ScopesCover returns whether every request allowed by the requested scopes is allowed by the scopes of the parent
+// token, so that a token can not be used to create a token with more authority than its own.
+func ScopesCover(parent, requested []v32.TokenScope) bool {
+	if len(parent) == 0 {
+		return true
+	}
+	if len(requested) == 0 {
+		return false
+	}
+	for _, scope := range requested {
+		for _, verb := range scope.Verbs {
+			for _, resource := range scope.Resources {
+				if !scopeCovers(parent, verb, resourceSegments(resource)) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// EffectiveScopes returns the scopes a token is limited to, a token without scopes can do everything its user can.
+func EffectiveScopes(token v3.Token) []v32.TokenScope {
+	if len(token.Scopes) > 0 {
+		return token.Scopes
+	}
+	return []v32.TokenScope{{
+		Resources: []string{scopeWildcard},
+		Verbs:     []string{scopeWildcard},
+	}}
+}
+
+// mintsToken returns whether the request creates a token for the user, such as the token of a generated kubeconfig
+// or of a kubectl shell. Those tokens have no scopes.
+func mintsToken(req *http.Request) bool {
+	query := req.URL.Query()
+	return strings.EqualFold(query.Get("action"), "generateKubeconfig") || query.Get("shell") == "true" ||
+		query.Get("link") == "shell"
+}
+
+func toTokenScopes(scopes []clientv3.TokenScope) []v32.TokenScope {
+	var result []v32.TokenScope
+	for _, scope := range scopes {
+		result = append(result, v32.TokenScope{
+			Resources: scope.Resources,
+			Verbs:     scope.Verbs,
+		})
+	}
+	return result
+}
+
+func scopeCovers(parent []v32.TokenScope, verb string, resource []string) bool {
+	for _, scope := range parent {
+		if !containsVerb(scope.Verbs, verb) {
+			continue
+		}
+		for _, parentResource := range scope.Resources {
+			if resourceMatches(resourceSegments(parentResource), resource) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func containsVerb(verbs []string, verb string) bool {
+	for _, v := range verbs {
+		if v == scopeWildcard || v == verb {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceMatches returns whether the path is the resource or below it. A wildcard segment of the resource matches
+// any single segment of the path.
+func resourceMatches(resource, path []string) bool {
+	if len(resource) == 0 || len(path) < len(resource) {
+		return false
+	}
+	for i, segment := range resource {
+		if segment != scopeWildcard && segment != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// resourceSegments splits a resource or a request path, leaving out the API version.
+func resourceSegments(resource string) []string {
+	var segments []string
+	for _, segment := range strings.Split(resource, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	if len(segments) > 0 && apiVersions[segments[0]] {
+		segments = segments[1:]
+	}
+	if len(segments) > 0 && singularTypes[segments[0]] != "" {
+		segments[0] = singularTypes[segments[0]]
+	}
+	return segments
+}
@@ -0,0 +1,108 @@
+package tokens
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScopesAllow(t *testing.T) {
+	assert := assert.New(t)
+
+	readApps := []v32.TokenScope{{
+		Resources: []string{"projects/*/apps"},
+		Verbs:     []string{"get"},
+	}}
+	allow := func(scopes []v32.TokenScope, method, path string) bool {
+		return ScopesAllow(scopes, httptest.NewRequest(method, path, nil))
+	}
+
+	assert.True(allow(nil, http.MethodDelete, "/v3/clusters/c-1"))
+	assert.True(allow(readApps, http.MethodGet, "/v3/projects/c-1:p-1/apps"))
+	assert.True(allow(readApps, http.MethodGet, "/v3/project/c-1:p-1/apps/p-1:app"))
+	assert.False(allow(readApps, http.MethodPost, "/v3/projects/c-1:p-1/apps"))
+	assert.False(allow(readApps, http.MethodGet, "/v3/projects/c-1:p-1"))
+	assert.False(allow(readApps, http.MethodGet, "/v3/projects/c-1:p-1/secrets"))
+	assert.False(allow(readApps, http.MethodGet, "/v3/clusters/c-1/apps"))
+	assert.False(allow(readApps, http.MethodGet, "/v3/projects/c-1:p-1/apps/../secrets"))
+	assert.False(allow(readApps, http.MethodGet, "/v3/projects/c-1:p-1/apps/%2e%2e/secrets"))
+	assert.True(allow(readApps, http.MethodGet, "/v3/projects/c-1:p-1/apps//p-1:app/"))
+
+	clusterActions := []v32.TokenScope{{
+		Resources: []string{"clusters/c-1"},
+		Verbs:     []string{"*"},
+	}}
+	assert.True(allow(clusterActions, http.MethodPost, "/v3/clusters/c-1?action=backupEtcd"))
+	assert.False(allow(clusterActions, http.MethodPost, "/v3/clusters/c-1?action=generateKubeconfig"))
+	assert.False(allow(clusterActions, http.MethodGet, "/v3/clusters/c-1?shell=true"))
+	assert.True(allow(nil, http.MethodPost, "/v3/clusters/c-1?action=generateKubeconfig"))
+
+	clusterAdmin := []v32.TokenScope{{
+		Resources: []string{"k8s/clusters/c-1"},
+		Verbs:     []string{"*"},
+	}}
+	assert.True(allow(clusterAdmin, http.MethodDelete, "/k8s/clusters/c-1/api/v1/namespaces/default/pods/web"))
+	assert.False(allow(clusterAdmin, http.MethodGet, "/k8s/clusters/c-2/api/v1/namespaces"))
+}
+
+func TestScopesCover(t *testing.T) {
+	assert := assert.New(t)
+
+	parent := []v32.TokenScope{{
+		Resources: []string{"projects/*/apps", "v3/clusters/c-1"},
+		Verbs:     []string{"get", "update"},
+	}}
+	assert.True(ScopesCover(nil, parent))
+	assert.True(ScopesCover(parent, []v32.TokenScope{{Resources: []string{"projects/c-1:p-1/apps"}, Verbs: []string{"get"}}}))
+	assert.True(ScopesCover(parent, []v32.TokenScope{{Resources: []string{"clusters/c-1/nodes"}, Verbs: []string{"update"}}}))
+	assert.False(ScopesCover(parent, nil))
+	assert.False(ScopesCover(parent, []v32.TokenScope{{Resources: []string{"projects/*/apps"}, Verbs: []string{"*"}}}))
+	assert.False(ScopesCover(parent, []v32.TokenScope{{Resources: []string{"projects"}, Verbs: []string{"get"}}}))
+	assert.False(ScopesCover(parent, []v32.TokenScope{{Resources: []string{"clusters/*"}, Verbs: []string{"get"}}}))
+}
+
+func TestValidateScopes(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(ValidateScopes(nil))
+	assert.Nil(ValidateScopes([]v32.TokenScope{{Resources: []string{"projects/*/apps"}, Verbs: []string{"get", "*"}}}))
+	assert.NotNil(ValidateScopes([]v32.TokenScope{{Resources: []string{"projects"}}}))
+	assert.NotNil(ValidateScopes([]v32.TokenScope{{Resources: []string{"/"}, Verbs: []string{"get"}}}))
+	assert.NotNil(ValidateScopes([]v32.TokenScope{{Resources: []string{"projects"}, Verbs: []string{"list"}}}))
+}
+
+func TestValidateMaxTTLByScope(t *testing.T) {
+	assert := assert.New(t)
+	defer settings.AuthTokenMaxTTLMinutes.Set(settings.AuthTokenMaxTTLMinutes.Default)
+	defer settings.AuthTokenMaxTTLMinutesScoped.Set(settings.AuthTokenMaxTTLMinutesScoped.Default)
+	defer settings.AuthTokenMaxTTLMinutesCluster.Set(settings.AuthTokenMaxTTLMinutesCluster.Default)
+
+	settings.AuthTokenMaxTTLMinutes.Set("600")
+	settings.AuthTokenMaxTTLMinutesScoped.Set("60")
+
+	unscoped := &v3.Token{}
+	cluster := &v3.Token{ClusterName: "c-1"}
+	scoped := &v3.Token{Scopes: []v32.TokenScope{{Resources: []string{"projects"}, Verbs: []string{"get"}}}}
+
+	ttl, err := ValidateMaxTTL(0, unscoped)
+	assert.Nil(err)
+	assert.Equal(600*time.Minute, ttl)
+	// the cluster setting falls back to auth-token-max-ttl-minutes
+	ttl, err = ValidateMaxTTL(0, cluster)
+	assert.Nil(err)
+	assert.Equal(600*time.Minute, ttl)
+	ttl, err = ValidateMaxTTL(120*time.Minute, scoped)
+	assert.Nil(err)
+	assert.Equal(60*time.Minute, ttl)
+
+	settings.AuthTokenMaxTTLMinutesCluster.Set("0")
+	ttl, err = ValidateMaxTTL(0, cluster)
+	assert.Nil(err)
+	assert.Equal(time.Duration(0), ttl)
+}
@@ -11,10 +11,18 @@
 	"github.com/rancher/norman/types"
 	"github.com/rancher/norman/types/convert"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
 	"github.com/rancher/rancher/pkg/user"
 	"github.com/sirupsen/logrus"
 )
 
+// idleTokenKinds are the kinds of login, API and kubeconfig tokens, API tokens have no kind
+var idleTokenKinds = map[string]bool{
+	"":                     true,
+	"session":              true,
+	KubeconfigResponseType: true,
+}
+
 func getAuthProviderName(principalID string) string {
 	parts := strings.Split(principalID, "://")
 	externalType := parts[0]
@@ -57,6 +65,21 @@ func IsExpired(token v3.Token) bool {
 	return durationElapsed.Seconds() >= ttlDuration.Seconds()
 }
 
+// IsIdle returns whether the token was not used for auth-token-max-idle-days. Only the tokens people use can become
+// idle, the tokens of the other kinds belong to rancher components.
+func IsIdle(token v3.Token) bool {
+	days := settings.AuthTokenMaxIdleDays.GetInt()
+	if days <= 0 || !idleTokenKinds[token.Labels[TokenKindLabel]] {
+		return false
+	}
+
+	lastUsed := token.ObjectMeta.CreationTimestamp.Time
+	if usedAt, err := time.Parse(time.RFC3339, token.LastUsedAt); err == nil && usedAt.After(lastUsed) {
+		lastUsed = usedAt
+	}
+	return time.Since(lastUsed) >= time.Duration(days)*24*time.Hour
+}
+
 func GetTokenAuthFromRequest(req *http.Request) string {
 	var tokenAuthValue string
 	authHeader := req.Header.Get(AuthHeaderName)
@@ -89,6 +112,7 @@ func GetTokenAuthFromRequest(req *http.Request) string {
 }
 
 func ConvertTokenResource(schema *types.Schema, token v3.Token) (map[string]interface{}, error) {
+	token.EffectiveScopes = EffectiveScopes(token)
 	tokenData, err := convert.EncodeToMap(token)
 	if err != nil {
 		return nil, err
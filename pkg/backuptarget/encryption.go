@@ -0,0 +1,201 @@
+package backuptarget
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	"github.com/rancher/rancher/pkg/ref"
+)
+
+// An encrypted snapshot starts with the magic and a random nonce prefix, followed by chunks of at most chunkSize bytes
+// sealed with AES-256-GCM. The nonce of a chunk is the prefix, the index of the chunk and a flag marking the last chunk,
+// so that chunks can neither be reordered nor the snapshot be truncated without failing the decryption.
+const (
+	magic           = "RCHENC01"
+	noncePrefixSize = 7
+	chunkSize       = 64 * 1024
+	tagSize         = 16
+	lastChunk       = 1
+)
+
+// EncryptionKey returns the key of the encryption config, the SHA-256 digest of the value of the key in the secret.
+func EncryptionKey(secretLister v1.SecretLister, config *v32.BackupEncryptionConfig) ([]byte, error) {
+	ns, name := ref.Parse(config.SecretName)
+	if ns == "" || name == "" {
+		return nil, fmt.Errorf("invalid encryption secret name [%s], expected namespace:name", config.SecretName)
+	}
+	secret, err := secretLister.Get(ns, name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get encryption secret [%s]", config.SecretName)
+	}
+	key := config.Key
+	if key == "" {
+		key = "key"
+	}
+	value := secret.Data[key]
+	if len(value) == 0 {
+		return nil, fmt.Errorf("encryption secret [%s] has no value for key [%s]", config.SecretName, key)
+	}
+	digest := sha256.Sum256(value)
+	return digest[:], nil
+}
+
+type encryptWriter struct {
+	w      io.Writer
+	aead   cipher.AEAD
+	prefix []byte
+	index  uint32
+	buf    []byte
+}
+
+// NewEncryptWriter returns a writer encrypting everything written to it into w. Close must be called to write the last
+// chunk, it does not close w.
+func NewEncryptWriter(w io.Writer, key []byte) (io.WriteCloser, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	prefix := make([]byte, noncePrefixSize)
+	if _, err := io.ReadFull(rand.Reader, prefix); err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(append([]byte(magic), prefix...)); err != nil {
+		return nil, err
+	}
+	return &encryptWriter{
+		w:      w,
+		aead:   aead,
+		prefix: prefix,
+	}, nil
+}
+
+func (e *encryptWriter) Write(p []byte) (int, error) {
+	e.buf = append(e.buf, p...)
+	// a full chunk is only written once more data follows, the last chunk is written by Close
+	for len(e.buf) > chunkSize {
+		if err := e.seal(e.buf[:chunkSize], false); err != nil {
+			return 0, err
+		}
+		e.buf = e.buf[chunkSize:]
+	}
+	return len(p), nil
+}
+
+func (e *encryptWriter) Close() error {
+	err := e.seal(e.buf, true)
+	e.buf = nil
+	return err
+}
+
+func (e *encryptWriter) seal(chunk []byte, last bool) error {
+	_, err := e.w.Write(e.aead.Seal(nil, chunkNonce(e.prefix, e.index, last), chunk, nil))
+	e.index++
+	return err
+}
+
+type decryptReader struct {
+	r      *bufio.Reader
+	aead   cipher.AEAD
+	prefix []byte
+	index  uint32
+	buf    []byte
+	done   bool
+}
+
+// NewDecryptReader returns a reader decrypting what was written by an encrypt writer from r. Reading fails if the
+// snapshot was modified or truncated.
+func NewDecryptReader(r io.Reader, key []byte) (io.Reader, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	br := bufio.NewReaderSize(r, chunkSize+tagSize)
+	header := make([]byte, len(magic)+noncePrefixSize)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return nil, errors.Wrap(err, "failed to read header of encrypted snapshot")
+	}
+	if !bytes.Equal(header[:len(magic)], []byte(magic)) {
+		return nil, fmt.Errorf("snapshot is not encrypted")
+	}
+	return &decryptReader{
+		r:      br,
+		aead:   aead,
+		prefix: header[len(magic):],
+	}, nil
+}
+
+func (d *decryptReader) Read(p []byte) (int, error) {
+	for len(d.buf) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+		if err := d.open(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, d.buf)
+	d.buf = d.buf[n:]
+	return n, nil
+}
+
+func (d *decryptReader) open() error {
+	chunk := make([]byte, chunkSize+tagSize)
+	n, err := io.ReadFull(d.r, chunk)
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	} else if err != nil && err != io.ErrUnexpectedEOF {
+		return err
+	}
+	last := err == io.ErrUnexpectedEOF
+	if !last {
+		if _, err := d.r.Peek(1); err == io.EOF {
+			last = true
+		}
+	}
+	plaintext, err := d.aead.Open(nil, chunkNonce(d.prefix, d.index, last), chunk[:n], nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to decrypt snapshot")
+	}
+	d.index++
+	d.buf = plaintext
+	d.done = last
+	return nil
+}
+
+// DecryptedSize returns the size of the snapshot encrypted into size bytes.
+func DecryptedSize(size int64) (int64, error) {
+	size -= int64(len(magic) + noncePrefixSize)
+	if size < tagSize {
+		return 0, fmt.Errorf("encrypted snapshot is truncated")
+	}
+	chunks := (size + chunkSize + tagSize - 1) / (chunkSize + tagSize)
+	return size - chunks*tagSize, nil
+}
+
+func chunkNonce(prefix []byte, index uint32, last bool) []byte {
+	nonce := make([]byte, noncePrefixSize+5)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[noncePrefixSize:], index)
+	if last {
+		nonce[len(nonce)-1] = lastChunk
+	}
+	return nonce
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
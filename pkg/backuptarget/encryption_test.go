@@ -0,0 +1,100 @@
+package backuptarget
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io/ioutil"
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/generated/norman/core/v1/fakes"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	assert := assert.New(t)
+	key := bytes.Repeat([]byte{7}, 32)
+
+	for _, size := range []int{0, 1, chunkSize - 1, chunkSize, chunkSize + 1, 3*chunkSize + 100} {
+		plaintext := make([]byte, size)
+		rand.Read(plaintext)
+
+		var encrypted bytes.Buffer
+		w, err := NewEncryptWriter(&encrypted, key)
+		assert.Nil(err)
+		_, err = w.Write(plaintext)
+		assert.Nil(err)
+		assert.Nil(w.Close())
+
+		decryptedSize, err := DecryptedSize(int64(encrypted.Len()))
+		assert.Nil(err)
+		assert.Equal(int64(size), decryptedSize)
+
+		r, err := NewDecryptReader(bytes.NewReader(encrypted.Bytes()), key)
+		assert.Nil(err)
+		decrypted, err := ioutil.ReadAll(r)
+		assert.Nil(err)
+		assert.True(bytes.Equal(plaintext, decrypted), "size %d", size)
+	}
+}
+
+func TestDecryptTampered(t *testing.T) {
+	assert := assert.New(t)
+	key := bytes.Repeat([]byte{7}, 32)
+
+	var encrypted bytes.Buffer
+	w, err := NewEncryptWriter(&encrypted, key)
+	assert.Nil(err)
+	_, err = w.Write(make([]byte, 2*chunkSize+10))
+	assert.Nil(err)
+	assert.Nil(w.Close())
+	data := encrypted.Bytes()
+
+	decrypt := func(data, key []byte) error {
+		r, err := NewDecryptReader(bytes.NewReader(data), key)
+		if err != nil {
+			return err
+		}
+		_, err = ioutil.ReadAll(r)
+		return err
+	}
+
+	assert.Nil(decrypt(data, key))
+	assert.NotNil(decrypt(data, bytes.Repeat([]byte{8}, 32)))
+	// a truncated snapshot is detected even when it ends at a chunk boundary
+	assert.NotNil(decrypt(data[:len(magic)+noncePrefixSize+chunkSize+tagSize], key))
+	assert.NotNil(decrypt(data[:len(data)-1], key))
+
+	modified := append([]byte{}, data...)
+	modified[len(magic)+noncePrefixSize+10] ^= 1
+	assert.NotNil(decrypt(modified, key))
+
+	// plain snapshots are refused
+	assert.NotNil(decrypt([]byte("PK\x03\x04 not encrypted"), key))
+}
+
+func TestEncryptionKey(t *testing.T) {
+	assert := assert.New(t)
+
+	secrets := &fakes.SecretListerMock{
+		GetFunc: func(namespace string, name string) (*corev1.Secret, error) {
+			if namespace != "cattle-global-data" || name != "etcd-key" {
+				return nil, apierrors.NewNotFound(corev1.Resource("secrets"), name)
+			}
+			return &corev1.Secret{Data: map[string][]byte{"key": []byte("0123456789abcdef0123456789abcdef")}}, nil
+		},
+	}
+
+	key, err := EncryptionKey(secrets, &v32.BackupEncryptionConfig{SecretName: "cattle-global-data:etcd-key"})
+	assert.Nil(err)
+	assert.Len(key, 32)
+
+	_, err = EncryptionKey(secrets, &v32.BackupEncryptionConfig{SecretName: "cattle-global-data:etcd-key", Key: "other"})
+	assert.NotNil(err)
+	_, err = EncryptionKey(secrets, &v32.BackupEncryptionConfig{SecretName: "cattle-global-data:missing"})
+	assert.NotNil(err)
+	_, err = EncryptionKey(secrets, &v32.BackupEncryptionConfig{SecretName: "etcd-key"})
+	assert.NotNil(err)
+}
@@ -0,0 +1,53 @@
+package backuptarget
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+)
+
+// localTarget stores snapshots in a directory of the Rancher server, usually an NFS mount.
+type localTarget struct {
+	path string
+}
+
+func newLocalTarget(config *v32.LocalBackupConfig) Target {
+	return &localTarget{
+		path: config.Path,
+	}
+}
+
+func (l *localTarget) Upload(ctx context.Context, name string, r io.Reader) error {
+	if err := os.MkdirAll(l.path, 0700); err != nil {
+		return err
+	}
+	// the snapshot is written to a temporary file first, so that a failed upload does not leave a partial snapshot
+	tmp, err := ioutil.TempFile(l.path, "."+name+"-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(l.path, name))
+}
+
+func (l *localTarget) Download(ctx context.Context, name string) (io.ReadCloser, error) {
+	return os.Open(filepath.Join(l.path, name))
+}
+
+func (l *localTarget) Remove(ctx context.Context, name string) error {
+	if err := os.Remove(filepath.Join(l.path, name)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
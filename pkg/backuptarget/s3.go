@@ -0,0 +1,115 @@
+package backuptarget
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	minio "github.com/minio/minio-go"
+	"github.com/minio/minio-go/pkg/credentials"
+	"github.com/rancher/rancher/pkg/types/config/dialer"
+	rketypes "github.com/rancher/rke/types"
+)
+
+const s3Endpoint = "s3.amazonaws.com"
+
+type s3Target struct {
+	client *minio.Client
+	config *rketypes.S3BackupConfig
+}
+
+func newS3Target(config *rketypes.S3BackupConfig) (Target, error) {
+	client, err := NewS3Client(config, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &s3Target{
+		client: client,
+		config: config,
+	}, nil
+}
+
+func (s *s3Target) Upload(ctx context.Context, name string, r io.Reader) error {
+	_, err := s.client.PutObjectWithContext(ctx, s.config.BucketName, objectPath(s.config.Folder, name), r, -1,
+		minio.PutObjectOptions{ContentType: "application/octet-stream"})
+	return err
+}
+
+func (s *s3Target) Download(ctx context.Context, name string) (io.ReadCloser, error) {
+	return s.client.GetObjectWithContext(ctx, s.config.BucketName, objectPath(s.config.Folder, name), minio.GetObjectOptions{})
+}
+
+func (s *s3Target) Remove(ctx context.Context, name string) error {
+	return s.client.RemoveObject(s.config.BucketName, objectPath(s.config.Folder, name))
+}
+
+// NewS3Client returns a client of the S3 or S3-compatible endpoint of the config, IAM roles are used when the config has
+// no credentials.
+func NewS3Client(sbc *rketypes.S3BackupConfig, timeout int, dialer dialer.Dialer) (*minio.Client, error) {
+	if sbc == nil {
+		return nil, fmt.Errorf("Can't find S3 backup target configuration")
+	}
+	var s3Client = &minio.Client{}
+	var creds *credentials.Credentials
+	var tr http.RoundTripper = &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+	endpoint := sbc.Endpoint
+	// no access credentials, we assume IAM roles
+	if sbc.AccessKey == "" ||
+		sbc.SecretKey == "" {
+		creds = credentials.NewIAM("")
+		if sbc.Endpoint == "" {
+			endpoint = s3Endpoint
+		}
+	} else {
+		accessKey := sbc.AccessKey
+		secretKey := sbc.SecretKey
+		creds = credentials.NewStatic(accessKey, secretKey, "", credentials.SignatureDefault)
+	}
+
+	bucketLookup := getBucketLookupType(endpoint)
+	s3Client, err := minio.NewWithOptions(endpoint, &minio.Options{
+		Creds:        creds,
+		Region:       sbc.Region,
+		Secure:       true,
+		BucketLookup: bucketLookup,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if sbc.CustomCA != "" {
+		tr = getCustomCATransport(tr, sbc.CustomCA)
+	}
+	s3Client.SetCustomTransport(tr)
+	return s3Client, nil
+}
+
+func getBucketLookupType(endpoint string) minio.BucketLookupType {
+	if endpoint == "" {
+		return minio.BucketLookupAuto
+	}
+	if strings.Contains(endpoint, "aliyun") {
+		return minio.BucketLookupDNS
+	}
+	return minio.BucketLookupAuto
+}
+
+func getCustomCATransport(tr http.RoundTripper, ca string) http.RoundTripper {
+	certPool := x509.NewCertPool()
+	certPool.AppendCertsFromPEM([]byte(ca))
+	tr.(*http.Transport).TLSClientConfig = &tls.Config{
+		RootCAs: certPool,
+	}
+	return tr
+}
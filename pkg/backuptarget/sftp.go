@@ -0,0 +1,167 @@
+package backuptarget
+
+import (
+	"context"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/pkg/sftp"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	sftpDefaultPort = 22
+	sftpDialTimeout = 30 * time.Second
+)
+
+type sftpTarget struct {
+	config *v32.SFTPBackupConfig
+}
+
+func newSFTPTarget(config *v32.SFTPBackupConfig) Target {
+	return &sftpTarget{
+		config: config,
+	}
+}
+
+func (s *sftpTarget) Upload(ctx context.Context, name string, r io.Reader) error {
+	c, err := dialSFTP(s.config)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	if s.config.Folder != "" {
+		if err := c.MkdirAll(s.config.Folder); err != nil {
+			return errors.Wrapf(err, "sftp: failed to create directory [%s]", s.config.Folder)
+		}
+	}
+	path := objectPath(s.config.Folder, name)
+	f, err := c.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+	if err != nil {
+		return errors.Wrapf(err, "sftp: failed to open [%s]", path)
+	}
+	if _, err := io.Copy(f, &contextReader{ctx: ctx, r: r}); err != nil {
+		f.Close()
+		return errors.Wrapf(err, "sftp: failed to write [%s]", path)
+	}
+	return f.Close()
+}
+
+func (s *sftpTarget) Download(ctx context.Context, name string) (io.ReadCloser, error) {
+	c, err := dialSFTP(s.config)
+	if err != nil {
+		return nil, err
+	}
+	path := objectPath(s.config.Folder, name)
+	f, err := c.Open(path)
+	if err != nil {
+		c.Close()
+		return nil, errors.Wrapf(err, "sftp: failed to open [%s]", path)
+	}
+	return &sftpFileReader{
+		client: c,
+		file:   f,
+	}, nil
+}
+
+func (s *sftpTarget) Remove(ctx context.Context, name string) error {
+	c, err := dialSFTP(s.config)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	path := objectPath(s.config.Folder, name)
+	if err := c.Remove(path); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "sftp: failed to remove [%s]", path)
+	}
+	return nil
+}
+
+// sftpClient closes the SSH connection along with the SFTP client running over it.
+type sftpClient struct {
+	*sftp.Client
+	conn *ssh.Client
+}
+
+func (c *sftpClient) Close() error {
+	c.Client.Close()
+	return c.conn.Close()
+}
+
+func dialSFTP(config *v32.SFTPBackupConfig) (*sftpClient, error) {
+	hostKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(config.HostKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "sftp: invalid host key")
+	}
+	var auth []ssh.AuthMethod
+	if config.PrivateKey != "" {
+		signer, err := ssh.ParsePrivateKey([]byte(config.PrivateKey))
+		if err != nil {
+			return nil, errors.Wrap(err, "sftp: invalid private key")
+		}
+		auth = append(auth, ssh.PublicKeys(signer))
+	}
+	if config.Password != "" {
+		auth = append(auth, ssh.Password(config.Password))
+	}
+	port := config.Port
+	if port == 0 {
+		port = sftpDefaultPort
+	}
+
+	conn, err := ssh.Dial("tcp", net.JoinHostPort(config.Host, strconv.Itoa(port)), &ssh.ClientConfig{
+		User:            config.Username,
+		Auth:            auth,
+		HostKeyCallback: ssh.FixedHostKey(hostKey),
+		Timeout:         sftpDialTimeout,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "sftp: failed to connect to [%s]", config.Host)
+	}
+	client, err := sftp.NewClient(conn)
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "sftp: subsystem is not available")
+	}
+	return &sftpClient{
+		Client: client,
+		conn:   conn,
+	}, nil
+}
+
+type sftpFileReader struct {
+	client *sftpClient
+	file   *sftp.File
+}
+
+func (f *sftpFileReader) Read(p []byte) (int, error) {
+	return f.file.Read(p)
+}
+
+func (f *sftpFileReader) Close() error {
+	closeErr := f.file.Close()
+	if err := f.client.Close(); err != nil && closeErr == nil {
+		closeErr = err
+	}
+	return closeErr
+}
+
+// contextReader stops reading once the context is done.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (r *contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.r.Read(p)
+}
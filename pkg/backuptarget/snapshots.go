@@ -0,0 +1,320 @@
+package backuptarget
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/pkg/errors"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	"github.com/rancher/rancher/pkg/kontainer-engine/cluster"
+	rkecluster "github.com/rancher/rke/cluster"
+	"github.com/rancher/rke/docker"
+	"github.com/rancher/rke/hosts"
+	"github.com/rancher/rke/services"
+	rketypes "github.com/rancher/rke/types"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	transferContainerName = "rancher-etcd-snapshot-transfer"
+	snapshotMountPath     = "/backup"
+)
+
+// Snapshots moves etcd snapshots between the etcd nodes of RKE clusters and their backup targets. RKE only takes
+// local snapshots for clusters with a backup target, which are then copied from an etcd node through the docker tunnel
+// of the node, and copied back to every etcd node before a restore.
+type Snapshots struct {
+	store        cluster.PersistentStore
+	dockerDialer hosts.DialerFactory
+	secretLister v1.SecretLister
+}
+
+func NewSnapshots(store cluster.PersistentStore, dockerDialer hosts.DialerFactory, secretLister v1.SecretLister) *Snapshots {
+	return &Snapshots{
+		store:        store,
+		dockerDialer: dockerDialer,
+		secretLister: secretLister,
+	}
+}
+
+// Upload copies the local snapshot from an etcd node of the cluster to the target, encrypting it if the target has an
+// encryption config. It returns the checksum and size of the snapshot as it was taken.
+func (s *Snapshots) Upload(ctx context.Context, clusterName string, target *v32.EtcdBackupTarget, filename string) (*v32.EtcdSnapshotInfo, error) {
+	t, err := New(target)
+	if err != nil {
+		return nil, err
+	}
+	key, err := s.key(target)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := s.readFromEtcdHosts(ctx, clusterName, filename)
+	if err != nil {
+		return nil, err
+	}
+	defer snapshot.Close()
+
+	checksum := newChecksumWriter()
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(copySnapshot(pw, io.TeeReader(snapshot, checksum), key))
+	}()
+	if err := t.Upload(ctx, ObjectName(filename, target), pr); err != nil {
+		pr.CloseWithError(err)
+		return nil, errors.Wrapf(err, "failed to upload snapshot [%s]", filename)
+	}
+	return checksum.info(), nil
+}
+
+// Download copies the snapshot from the target to every etcd node of the cluster, decrypting it if the target has an
+// encryption config, so that RKE restores it as a local snapshot.
+func (s *Snapshots) Download(ctx context.Context, clusterName string, target *v32.EtcdBackupTarget, filename string) error {
+	t, err := New(target)
+	if err != nil {
+		return err
+	}
+	key, err := s.key(target)
+	if err != nil {
+		return err
+	}
+	etcdHosts, rkeConfig, err := s.etcdHosts(ctx, clusterName)
+	if err != nil {
+		return err
+	}
+
+	// the snapshot is kept as it is stored on the target, it is only decrypted while it is copied to the nodes
+	tmp, err := ioutil.TempFile("", "etcd-snapshot-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+	object, err := t.Download(ctx, ObjectName(filename, target))
+	if err != nil {
+		return errors.Wrapf(err, "failed to download snapshot [%s]", filename)
+	}
+	_, err = io.Copy(tmp, object)
+	object.Close()
+	if err != nil {
+		return errors.Wrapf(err, "failed to download snapshot [%s]", filename)
+	}
+	info, err := tmp.Stat()
+	if err != nil {
+		return err
+	}
+	size := info.Size()
+	if key != nil {
+		if size, err = DecryptedSize(size); err != nil {
+			return err
+		}
+	}
+
+	for _, host := range etcdHosts {
+		if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		var snapshot io.Reader = tmp
+		if key != nil {
+			if snapshot, err = NewDecryptReader(tmp, key); err != nil {
+				return err
+			}
+		}
+		if err := writeSnapshot(ctx, host, rkeConfig, path.Base(filename), snapshot, size); err != nil {
+			return errors.Wrapf(err, "failed to copy snapshot [%s] to host [%s]", filename, host.Address)
+		}
+	}
+	return nil
+}
+
+// Remove removes the snapshot from the target.
+func (s *Snapshots) Remove(ctx context.Context, target *v32.EtcdBackupTarget, filename string) error {
+	t, err := New(target)
+	if err != nil {
+		return err
+	}
+	return t.Remove(ctx, ObjectName(filename, target))
+}
+
+// readFromEtcdHosts reads the local snapshot from the first etcd node of the cluster that has it.
+func (s *Snapshots) readFromEtcdHosts(ctx context.Context, clusterName, filename string) (io.ReadCloser, error) {
+	etcdHosts, rkeConfig, err := s.etcdHosts(ctx, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	for _, host := range etcdHosts {
+		var snapshot io.ReadCloser
+		if snapshot, err = readSnapshot(ctx, host, rkeConfig, path.Base(filename)); err == nil {
+			return snapshot, nil
+		}
+		logrus.Warnf("[etcd-backup] failed to read snapshot [%s] from host [%s]: %v", filename, host.Address, err)
+	}
+	return nil, fmt.Errorf("failed to read snapshot [%s] from the etcd nodes of cluster [%s]: %v", filename, clusterName, err)
+}
+
+func (s *Snapshots) key(target *v32.EtcdBackupTarget) ([]byte, error) {
+	if target.Encryption == nil {
+		return nil, nil
+	}
+	return EncryptionKey(s.secretLister, target.Encryption)
+}
+
+// etcdHosts returns the etcd hosts of the cluster with a docker client, as found in the RKE state of the cluster.
+func (s *Snapshots) etcdHosts(ctx context.Context, clusterName string) ([]*hosts.Host, *rketypes.RancherKubernetesEngineConfig, error) {
+	c, err := s.store.Get(clusterName)
+	if err != nil {
+		return nil, nil, err
+	}
+	state, ok := c.Metadata["fullState"]
+	if !ok {
+		return nil, nil, fmt.Errorf("cluster [%s] has no RKE state", clusterName)
+	}
+	var fullState rkecluster.FullState
+	if err := json.Unmarshal([]byte(state), &fullState); err != nil {
+		return nil, nil, err
+	}
+	rkeConfig := fullState.CurrentState.RancherKubernetesEngineConfig
+	if rkeConfig == nil {
+		return nil, nil, fmt.Errorf("cluster [%s] has no RKE state", clusterName)
+	}
+
+	etcdHosts := hosts.NodesToHosts(rkeConfig.Nodes, services.ETCDRole)
+	if len(etcdHosts) == 0 {
+		return nil, nil, fmt.Errorf("cluster [%s] has no etcd nodes", clusterName)
+	}
+	for _, host := range etcdHosts {
+		if err := host.TunnelUp(ctx, s.dockerDialer, rkeConfig.PrefixPath, rkeConfig.Version); err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to connect to host [%s]", host.Address)
+		}
+	}
+	return etcdHosts, rkeConfig, nil
+}
+
+func copySnapshot(w io.Writer, snapshot io.Reader, key []byte) error {
+	if key == nil {
+		_, err := io.Copy(w, snapshot)
+		return err
+	}
+	ew, err := NewEncryptWriter(w, key)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(ew, snapshot); err != nil {
+		return err
+	}
+	return ew.Close()
+}
+
+// readSnapshot reads the local snapshot from the host, through a container with the snapshot directory mounted that is
+// never started.
+func readSnapshot(ctx context.Context, host *hosts.Host, rkeConfig *rketypes.RancherKubernetesEngineConfig, name string) (io.ReadCloser, error) {
+	if err := createTransferContainer(ctx, host, rkeConfig); err != nil {
+		return nil, err
+	}
+	var (
+		archive io.ReadCloser
+		err     error
+	)
+	// RKE compresses snapshots when it takes them, but older snapshots have no extension
+	for _, candidate := range []string{name, strings.TrimSuffix(name, path.Ext(name))} {
+		archive, _, err = host.DClient.CopyFromContainer(ctx, transferContainerName, path.Join(snapshotMountPath, candidate))
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		removeTransferContainer(ctx, host)
+		return nil, err
+	}
+	tr := tar.NewReader(archive)
+	if _, err := tr.Next(); err != nil {
+		archive.Close()
+		removeTransferContainer(ctx, host)
+		return nil, err
+	}
+	return &snapshotReader{
+		Reader: tr,
+		close: func() error {
+			defer removeTransferContainer(ctx, host)
+			return archive.Close()
+		},
+	}, nil
+}
+
+func writeSnapshot(ctx context.Context, host *hosts.Host, rkeConfig *rketypes.RancherKubernetesEngineConfig, name string, snapshot io.Reader, size int64) error {
+	if err := createTransferContainer(ctx, host, rkeConfig); err != nil {
+		return err
+	}
+	defer removeTransferContainer(ctx, host)
+
+	pr, pw := io.Pipe()
+	go func() {
+		tw := tar.NewWriter(pw)
+		err := tw.WriteHeader(&tar.Header{
+			Name: name,
+			Mode: 0600,
+			Size: size,
+		})
+		if err == nil {
+			_, err = io.Copy(tw, snapshot)
+		}
+		if err == nil {
+			err = tw.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+	err := host.DClient.CopyToContainer(ctx, transferContainerName, snapshotMountPath, pr, types.CopyToContainerOptions{})
+	pr.CloseWithError(err)
+	return err
+}
+
+func createTransferContainer(ctx context.Context, host *hosts.Host, rkeConfig *rketypes.RancherKubernetesEngineConfig) error {
+	image := rkeConfig.SystemImages.Alpine
+	if err := docker.UseLocalOrPull(ctx, host.DClient, host.Address, image, services.ETCDRole, privateRegistries(rkeConfig)); err != nil {
+		return err
+	}
+	if err := docker.DoRemoveContainer(ctx, host.DClient, transferContainerName, host.Address); err != nil {
+		return err
+	}
+	imageCfg := &container.Config{
+		Image: image,
+		Cmd:   []string{"true"},
+	}
+	hostCfg := &container.HostConfig{
+		Binds: []string{fmt.Sprintf("%s:%s:z", services.EtcdSnapshotPath, snapshotMountPath)},
+	}
+	_, err := docker.CreateContainer(ctx, host.DClient, host.Address, transferContainerName, imageCfg, hostCfg)
+	return err
+}
+
+func removeTransferContainer(ctx context.Context, host *hosts.Host) {
+	if err := docker.DoRemoveContainer(ctx, host.DClient, transferContainerName, host.Address); err != nil {
+		logrus.Warnf("[etcd-backup] failed to remove container [%s] on host [%s]: %v", transferContainerName, host.Address, err)
+	}
+}
+
+func privateRegistries(rkeConfig *rketypes.RancherKubernetesEngineConfig) map[string]rketypes.PrivateRegistry {
+	registries := map[string]rketypes.PrivateRegistry{}
+	for _, registry := range rkeConfig.PrivateRegistries {
+		registries[registry.URL] = registry
+	}
+	return registries
+}
+
+type snapshotReader struct {
+	io.Reader
+	close func() error
+}
+
+func (s *snapshotReader) Close() error {
+	return s.close()
+}
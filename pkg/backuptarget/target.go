@@ -0,0 +1,90 @@
+package backuptarget
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+)
+
+const encryptedExtension = "enc"
+
+// Target stores etcd snapshots outside of the cluster.
+type Target interface {
+	Upload(ctx context.Context, name string, r io.Reader) error
+	Download(ctx context.Context, name string) (io.ReadCloser, error)
+	Remove(ctx context.Context, name string) error
+}
+
+// New returns the target the config points to.
+func New(target *v32.EtcdBackupTarget) (Target, error) {
+	if err := Validate(target); err != nil {
+		return nil, err
+	}
+	switch {
+	case target.S3Config != nil:
+		return newS3Target(target.S3Config)
+	case target.SFTPConfig != nil:
+		return newSFTPTarget(target.SFTPConfig), nil
+	default:
+		return newLocalTarget(target.LocalConfig), nil
+	}
+}
+
+// Validate checks that exactly one target is configured.
+func Validate(target *v32.EtcdBackupTarget) error {
+	if target == nil {
+		return fmt.Errorf("no etcd backup target")
+	}
+	var count int
+	if target.S3Config != nil {
+		count++
+		if target.S3Config.BucketName == "" {
+			return fmt.Errorf("s3 backup target requires a bucket name")
+		}
+	}
+	if target.SFTPConfig != nil {
+		count++
+		config := target.SFTPConfig
+		if config.Host == "" || config.Username == "" {
+			return fmt.Errorf("sftp backup target requires a host and a username")
+		}
+		if config.Password == "" && config.PrivateKey == "" {
+			return fmt.Errorf("sftp backup target requires a password or a private key")
+		}
+		if config.HostKey == "" {
+			return fmt.Errorf("sftp backup target requires the host key of the server")
+		}
+	}
+	if target.LocalConfig != nil {
+		count++
+		if !path.IsAbs(target.LocalConfig.Path) {
+			return fmt.Errorf("local backup target requires an absolute path")
+		}
+	}
+	if count != 1 {
+		return fmt.Errorf("etcd backup target must have exactly one of s3Config, sftpConfig and localConfig")
+	}
+	if target.Encryption != nil && target.Encryption.SecretName == "" {
+		return fmt.Errorf("etcd backup encryption requires a secret name")
+	}
+	return nil
+}
+
+// ObjectName returns the name the snapshot file is stored under on the target.
+func ObjectName(filename string, target *v32.EtcdBackupTarget) string {
+	name := path.Base(filename)
+	if target.Encryption != nil {
+		name = fmt.Sprintf("%s.%s", name, encryptedExtension)
+	}
+	return name
+}
+
+func objectPath(folder, name string) string {
+	if folder == "" {
+		return name
+	}
+	return path.Join(folder, name)
+}
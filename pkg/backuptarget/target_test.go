@@ -0,0 +1,68 @@
+package backuptarget
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	rketypes "github.com/rancher/rke/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	local := &v32.LocalBackupConfig{Path: "/backups"}
+	sftp := &v32.SFTPBackupConfig{Host: "backup.example.com", Username: "rancher", Password: "secret", HostKey: "ssh-ed25519 AAAA"}
+
+	assert.Nil(Validate(&v32.EtcdBackupTarget{LocalConfig: local}))
+	assert.Nil(Validate(&v32.EtcdBackupTarget{SFTPConfig: sftp}))
+	assert.Nil(Validate(&v32.EtcdBackupTarget{S3Config: &rketypes.S3BackupConfig{BucketName: "backups"}}))
+	assert.NotNil(Validate(nil))
+	assert.NotNil(Validate(&v32.EtcdBackupTarget{}))
+	assert.NotNil(Validate(&v32.EtcdBackupTarget{LocalConfig: local, SFTPConfig: sftp}))
+	assert.NotNil(Validate(&v32.EtcdBackupTarget{LocalConfig: &v32.LocalBackupConfig{Path: "backups"}}))
+	assert.NotNil(Validate(&v32.EtcdBackupTarget{S3Config: &rketypes.S3BackupConfig{}}))
+	assert.NotNil(Validate(&v32.EtcdBackupTarget{SFTPConfig: &v32.SFTPBackupConfig{Host: "backup.example.com", Username: "rancher", Password: "secret"}}))
+	assert.NotNil(Validate(&v32.EtcdBackupTarget{LocalConfig: local, Encryption: &v32.BackupEncryptionConfig{}}))
+}
+
+func TestObjectName(t *testing.T) {
+	assert := assert.New(t)
+
+	target := &v32.EtcdBackupTarget{LocalConfig: &v32.LocalBackupConfig{Path: "/backups"}}
+	assert.Equal("c-1-rl-abcde_2020-10-01T00:00:00Z.zip", ObjectName("c-1-rl-abcde_2020-10-01T00:00:00Z.zip", target))
+	target.Encryption = &v32.BackupEncryptionConfig{SecretName: "cattle-global-data:etcd-key"}
+	assert.Equal("c-1-rl-abcde_2020-10-01T00:00:00Z.zip.enc", ObjectName("c-1-rl-abcde_2020-10-01T00:00:00Z.zip", target))
+}
+
+func TestLocalTarget(t *testing.T) {
+	assert := assert.New(t)
+	dir, err := ioutil.TempDir("", "backuptarget")
+	assert.Nil(err)
+	defer os.RemoveAll(dir)
+
+	target, err := New(&v32.EtcdBackupTarget{LocalConfig: &v32.LocalBackupConfig{Path: dir + "/c-1"}})
+	assert.Nil(err)
+	ctx := context.Background()
+
+	assert.Nil(target.Upload(ctx, "snapshot.zip", bytes.NewReader([]byte("snapshot"))))
+	r, err := target.Download(ctx, "snapshot.zip")
+	assert.Nil(err)
+	data, err := ioutil.ReadAll(r)
+	r.Close()
+	assert.Nil(err)
+	assert.Equal("snapshot", string(data))
+
+	files, err := ioutil.ReadDir(dir + "/c-1")
+	assert.Nil(err)
+	assert.Len(files, 1)
+
+	assert.Nil(target.Remove(ctx, "snapshot.zip"))
+	assert.Nil(target.Remove(ctx, "snapshot.zip"))
+	_, err = target.Download(ctx, "snapshot.zip")
+	assert.NotNil(err)
+}
@@ -0,0 +1,245 @@
+package backuptarget
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	// etcd stores every revision of every key in this bucket
+	etcdKeyBucket = []byte("key")
+)
+
+const (
+	// length of a revision in the key bucket, tombstones have an extra marker byte
+	etcdRevisionLength = 17
+	// tag of the key field of the KeyValue protobuf message etcd stores revisions as
+	etcdKeyValueKeyTag = 0x0a
+)
+
+// Checksum returns the hex encoded SHA-256 and the size of the snapshot.
+func Checksum(r io.Reader) (*v32.EtcdSnapshotInfo, error) {
+	w := newChecksumWriter()
+	if _, err := io.Copy(w, r); err != nil {
+		return nil, err
+	}
+	return w.info(), nil
+}
+
+// Inspect returns the checksum and size of the local snapshot of the cluster, as found on an etcd node.
+func (s *Snapshots) Inspect(ctx context.Context, clusterName, filename string) (*v32.EtcdSnapshotInfo, error) {
+	snapshot, err := s.readFromEtcdHosts(ctx, clusterName, filename)
+	if err != nil {
+		return nil, err
+	}
+	defer snapshot.Close()
+	return Checksum(snapshot)
+}
+
+// Open returns the snapshot of the backup as it was taken, from the target of the backup, from S3 if RKE uploaded it,
+// or from an etcd node of the cluster.
+func (s *Snapshots) Open(ctx context.Context, clusterName string, backup *v32.EtcdBackup) (io.ReadCloser, error) {
+	target := backup.Target
+	if target == nil && backup.Spec.BackupConfig.S3BackupConfig != nil {
+		target = &v32.EtcdBackupTarget{S3Config: backup.Spec.BackupConfig.S3BackupConfig}
+	}
+	if target == nil {
+		return s.readFromEtcdHosts(ctx, clusterName, backup.Spec.Filename)
+	}
+
+	t, err := New(target)
+	if err != nil {
+		return nil, err
+	}
+	key, err := s.key(target)
+	if err != nil {
+		return nil, err
+	}
+	object, err := t.Download(ctx, ObjectName(backup.Spec.Filename, target))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to download snapshot [%s]", backup.Spec.Filename)
+	}
+	if key == nil {
+		return object, nil
+	}
+	r, err := NewDecryptReader(object, key)
+	if err != nil {
+		object.Close()
+		return nil, err
+	}
+	return &snapshotReader{
+		Reader: r,
+		close:  object.Close,
+	}, nil
+}
+
+// Verify checks the snapshot of the backup against the checksum recorded when the backup completed, and opens the etcd
+// database of the snapshot to count its keys. Backups taken before checksums were recorded only have their keys
+// counted.
+func (s *Snapshots) Verify(ctx context.Context, clusterName string, backup *v32.EtcdBackup) (*v32.EtcdSnapshotInfo, error) {
+	snapshot, err := s.Open(ctx, clusterName, backup)
+	if err != nil {
+		return nil, err
+	}
+	defer snapshot.Close()
+
+	tmp, err := ioutil.TempFile("", "etcd-snapshot-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+	w := newChecksumWriter()
+	if _, err := io.Copy(io.MultiWriter(tmp, w), snapshot); err != nil {
+		return nil, errors.Wrapf(err, "failed to read snapshot [%s]", backup.Spec.Filename)
+	}
+	info := w.info()
+
+	if recorded := backup.SnapshotInfo; recorded != nil && recorded.Checksum != "" {
+		if recorded.Checksum != info.Checksum || recorded.Size != info.Size {
+			return info, fmt.Errorf("snapshot [%s] does not match its checksum: expected %s (%d bytes), got %s (%d bytes)",
+				backup.Spec.Filename, recorded.Checksum, recorded.Size, info.Checksum, info.Size)
+		}
+	}
+	if info.KeyCount, err = CountKeys(tmp.Name()); err != nil {
+		return info, errors.Wrapf(err, "snapshot [%s] is not a valid etcd snapshot", backup.Spec.Filename)
+	}
+	return info, nil
+}
+
+// CountKeys returns the number of keys in the etcd database of the snapshot file, which is either the database itself
+// or a zip archive of it as RKE compresses snapshots.
+func CountKeys(filename string) (int64, error) {
+	archive, err := zip.OpenReader(filename)
+	if err == zip.ErrFormat {
+		return countKeys(filename)
+	} else if err != nil {
+		return 0, err
+	}
+	defer archive.Close()
+
+	db, err := extractDatabase(archive)
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(db)
+	return countKeys(db)
+}
+
+// extractDatabase extracts the etcd database from the archive to a temporary file. The database is the largest file of
+// the archive, which may also hold the RKE state of the cluster.
+func extractDatabase(archive *zip.ReadCloser) (string, error) {
+	var db *zip.File
+	for _, f := range archive.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		if db == nil || f.UncompressedSize64 > db.UncompressedSize64 {
+			db = f
+		}
+	}
+	if db == nil {
+		return "", fmt.Errorf("snapshot archive is empty")
+	}
+
+	r, err := db.Open()
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+	tmp, err := ioutil.TempFile("", "etcd-db-")
+	if err != nil {
+		return "", err
+	}
+	defer tmp.Close()
+	if _, err := io.Copy(tmp, r); err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	return tmp.Name(), nil
+}
+
+// countKeys counts the keys of the etcd database that are not deleted at its latest revision.
+func countKeys(filename string) (int64, error) {
+	db, err := bolt.Open(filename, 0600, &bolt.Options{ReadOnly: true, Timeout: time.Second})
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+
+	var count int64
+	err = db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(etcdKeyBucket)
+		if bucket == nil {
+			return fmt.Errorf("database has no %s bucket", etcdKeyBucket)
+		}
+		// revisions are sorted, so the last revision of a key tells whether the key exists
+		live := map[string]bool{}
+		err := bucket.ForEach(func(rev, value []byte) error {
+			key, err := revisionKey(value)
+			if err != nil {
+				return err
+			}
+			live[key] = len(rev) == etcdRevisionLength
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, ok := range live {
+			if ok {
+				count++
+			}
+		}
+		return nil
+	})
+	return count, err
+}
+
+// revisionKey returns the key of a revision, the first field of the KeyValue message etcd stores revisions as.
+func revisionKey(value []byte) (string, error) {
+	if len(value) == 0 || value[0] != etcdKeyValueKeyTag {
+		return "", fmt.Errorf("invalid revision in database")
+	}
+	length, n := binary.Uvarint(value[1:])
+	if n <= 0 || uint64(len(value)-1-n) < length {
+		return "", fmt.Errorf("invalid revision in database")
+	}
+	return string(value[1+n : 1+n+int(length)]), nil
+}
+
+type checksumWriter struct {
+	hash hash.Hash
+	size int64
+}
+
+func newChecksumWriter() *checksumWriter {
+	return &checksumWriter{
+		hash: sha256.New(),
+	}
+}
+
+func (c *checksumWriter) Write(p []byte) (int, error) {
+	c.size += int64(len(p))
+	return c.hash.Write(p)
+}
+
+func (c *checksumWriter) info() *v32.EtcdSnapshotInfo {
+	return &v32.EtcdSnapshotInfo{
+		Checksum: hex.EncodeToString(c.hash.Sum(nil)),
+		Size:     c.size,
+	}
+}
@@ -0,0 +1,160 @@
+package backuptarget
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	rketypes "github.com/rancher/rke/types"
+	"github.com/stretchr/testify/assert"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestCountKeys(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "etcd-snapshot-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	db := writeEtcdDatabase(t, dir)
+	count, err := CountKeys(db)
+	assert.Nil(err)
+	assert.Equal(int64(2), count)
+
+	archive := writeSnapshotArchive(t, dir, db)
+	count, err = CountKeys(archive)
+	assert.Nil(err)
+	assert.Equal(int64(2), count)
+
+	notADatabase := filepath.Join(dir, "snapshot")
+	if err := ioutil.WriteFile(notADatabase, []byte("not an etcd database"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	_, err = CountKeys(notADatabase)
+	assert.NotNil(err)
+}
+
+func TestVerify(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "etcd-snapshot-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	filename := "c-1-rl-abcde_2020-10-01T00:00:00Z.zip"
+	archive := writeSnapshotArchive(t, dir, writeEtcdDatabase(t, dir))
+	if err := os.Rename(archive, filepath.Join(dir, filename)); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(filepath.Join(dir, filename))
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorded, err := Checksum(f)
+	f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	backup := &v32.EtcdBackup{
+		Spec:         rketypes.EtcdBackupSpec{Filename: filename},
+		Target:       &v32.EtcdBackupTarget{LocalConfig: &v32.LocalBackupConfig{Path: dir}},
+		SnapshotInfo: recorded,
+	}
+	s := &Snapshots{}
+	info, err := s.Verify(context.Background(), "c-1", backup)
+	assert.Nil(err)
+	assert.Equal(recorded.Checksum, info.Checksum)
+	assert.Equal(recorded.Size, info.Size)
+	assert.Equal(int64(2), info.KeyCount)
+
+	backup.SnapshotInfo = &v32.EtcdSnapshotInfo{Checksum: "0000", Size: recorded.Size}
+	_, err = s.Verify(context.Background(), "c-1", backup)
+	assert.NotNil(err)
+}
+
+// writeEtcdDatabase writes a database with the revisions of three keys, one of which is deleted.
+func writeEtcdDatabase(t *testing.T, dir string) string {
+	filename := filepath.Join(dir, "db")
+	db, err := bolt.Open(filename, 0600, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	revisions := []struct {
+		key       string
+		tombstone bool
+	}{
+		{key: "/registry/namespaces/default"},
+		{key: "/registry/namespaces/test"},
+		{key: "/registry/namespaces/default"},
+		{key: "/registry/namespaces/test", tombstone: true},
+		{key: "/registry/namespaces/kube-system"},
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucket(etcdKeyBucket)
+		if err != nil {
+			return err
+		}
+		for i, r := range revisions {
+			rev := make([]byte, etcdRevisionLength, etcdRevisionLength+1)
+			binary.BigEndian.PutUint64(rev, uint64(i+1))
+			rev[8] = '_'
+			if r.tombstone {
+				rev = append(rev, 't')
+			}
+			value := []byte{etcdKeyValueKeyTag, byte(len(r.key))}
+			value = append(value, r.key...)
+			if err := bucket.Put(rev, value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return filename
+}
+
+// writeSnapshotArchive compresses the database along with an RKE state file, as RKE does.
+func writeSnapshotArchive(t *testing.T, dir, db string) string {
+	data, err := ioutil.ReadFile(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	filename := filepath.Join(dir, "snapshot.zip")
+	f, err := os.Create(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	zw := zip.NewWriter(f)
+	for name, content := range map[string][]byte{
+		"backup/snapshot":          data,
+		"backup/snapshot.rkestate": []byte("{}"),
+	} {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write(content); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return filename
+}
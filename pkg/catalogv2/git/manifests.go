@@ -0,0 +1,78 @@
+package git
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Manifests returns the content of the yaml and json files under the given paths of the repository, keyed by their
+// path relative to the root of the repository. The whole repository is read when no path is given.
+func Manifests(namespace, name, gitURL string, paths []string) (map[string][]byte, error) {
+	dir := gitDir(namespace, name, gitURL)
+	if err := ensureNoSymlinks(dir); err != nil {
+		return nil, err
+	}
+	return manifests(dir, paths)
+}
+
+func manifests(dir string, paths []string) (map[string][]byte, error) {
+	if len(paths) == 0 {
+		paths = []string{""}
+	}
+
+	baseAbs, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string][]byte{}
+	for _, p := range paths {
+		root, err := filepath.Abs(filepath.Join(baseAbs, p))
+		if err != nil {
+			return nil, err
+		}
+		if root != baseAbs && !strings.HasPrefix(root, baseAbs+string(filepath.Separator)) {
+			return nil, fmt.Errorf("path %s is outside of the repository", p)
+		}
+
+		err = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				if info.Name() == ".git" {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			switch filepath.Ext(path) {
+			case ".yaml", ".yml", ".json":
+			default:
+				return nil
+			}
+
+			rel, err := filepath.Rel(baseAbs, path)
+			if err != nil {
+				return err
+			}
+			rel = filepath.ToSlash(rel)
+			if _, ok := result[rel]; ok {
+				return nil
+			}
+			content, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			result[rel] = content
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,12 @@
+package client
+
+const (
+	AlertEscalationType              = "alertEscalation"
+	AlertEscalationFieldAfterMinutes = "afterMinutes"
+	AlertEscalationFieldRecipients   = "recipients"
+)
+
+type AlertEscalation struct {
+	AfterMinutes int64       `json:"afterMinutes,omitempty" yaml:"afterMinutes,omitempty"`
+	Recipients   []Recipient `json:"recipients,omitempty" yaml:"recipients,omitempty"`
+}
@@ -0,0 +1,18 @@
+package client
+
+const (
+	AlertRouteType            = "alertRoute"
+	AlertRouteFieldContinue   = "continue"
+	AlertRouteFieldMatch      = "match"
+	AlertRouteFieldMatchRegex = "matchRegex"
+	AlertRouteFieldRecipients = "recipients"
+	AlertRouteFieldSeverity   = "severity"
+)
+
+type AlertRoute struct {
+	Continue   bool              `json:"continue,omitempty" yaml:"continue,omitempty"`
+	Match      map[string]string `json:"match,omitempty" yaml:"match,omitempty"`
+	MatchRegex map[string]string `json:"matchRegex,omitempty" yaml:"matchRegex,omitempty"`
+	Recipients []Recipient       `json:"recipients,omitempty" yaml:"recipients,omitempty"`
+	Severity   string            `json:"severity,omitempty" yaml:"severity,omitempty"`
+}
@@ -0,0 +1,18 @@
+package client
+
+const (
+	AlertSilenceType          = "alertSilence"
+	AlertSilenceFieldComment  = "comment"
+	AlertSilenceFieldEndsAt   = "endsAt"
+	AlertSilenceFieldMatch    = "match"
+	AlertSilenceFieldName     = "name"
+	AlertSilenceFieldStartsAt = "startsAt"
+)
+
+type AlertSilence struct {
+	Comment  string            `json:"comment,omitempty" yaml:"comment,omitempty"`
+	EndsAt   string            `json:"endsAt,omitempty" yaml:"endsAt,omitempty"`
+	Match    map[string]string `json:"match,omitempty" yaml:"match,omitempty"`
+	Name     string            `json:"name,omitempty" yaml:"name,omitempty"`
+	StartsAt string            `json:"startsAt,omitempty" yaml:"startsAt,omitempty"`
+}
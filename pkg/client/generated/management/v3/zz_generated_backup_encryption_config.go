@@ -0,0 +1,12 @@
+package client
+
+const (
+	BackupEncryptionConfigType            = "backupEncryptionConfig"
+	BackupEncryptionConfigFieldKey        = "key"
+	BackupEncryptionConfigFieldSecretName = "secretName"
+)
+
+type BackupEncryptionConfig struct {
+	Key        string `json:"key,omitempty" yaml:"key,omitempty"`
+	SecretName string `json:"secretName,omitempty" yaml:"secretName,omitempty"`
+}
@@ -72,6 +72,8 @@ type Client struct {
 	CisConfig                               CisConfigOperations
 	CisBenchmarkVersion                     CisBenchmarkVersionOperations
 	FleetWorkspace                          FleetWorkspaceOperations
+	GitSync                                 GitSyncOperations
+	ResourceQuotaRequest                    ResourceQuotaRequestOperations
 }
 
 func NewClient(opts *clientbase.ClientOpts) (*Client, error) {
@@ -149,6 +151,8 @@ func NewClient(opts *clientbase.ClientOpts) (*Client, error) {
 	client.CisConfig = newCisConfigClient(client)
 	client.CisBenchmarkVersion = newCisBenchmarkVersionClient(client)
 	client.FleetWorkspace = newFleetWorkspaceClient(client)
+	client.GitSync = newGitSyncClient(client)
+	client.ResourceQuotaRequest = newResourceQuotaRequestClient(client)
 
 	return client, nil
 }
@@ -30,6 +30,7 @@
 	ClusterFieldCreatorID                            = "creatorId"
 	ClusterFieldCurrentCisRunName                    = "currentCisRunName"
 	ClusterFieldDefaultClusterRoleForProjectMembers  = "defaultClusterRoleForProjectMembers"
+	ClusterFieldDefaultNodeDrainPolicy               = "defaultNodeDrainPolicy"
 	ClusterFieldDefaultPodSecurityPolicyTemplateID   = "defaultPodSecurityPolicyTemplateId"
 	ClusterFieldDescription                          = "description"
 	ClusterFieldDesiredAgentImage                    = "desiredAgentImage"
@@ -41,8 +42,12 @@
 	ClusterFieldEnableClusterAlerting                = "enableClusterAlerting"
 	ClusterFieldEnableClusterMonitoring              = "enableClusterMonitoring"
 	ClusterFieldEnableNetworkPolicy                  = "enableNetworkPolicy"
+	ClusterFieldEtcdBackupRetention                  = "etcdBackupRetention"
+	ClusterFieldEtcdBackupTarget                     = "etcdBackupTarget"
 	ClusterFieldFailedSpec                           = "failedSpec"
 	ClusterFieldFleetWorkspaceName                   = "fleetWorkspaceName"
+	ClusterFieldHealthProbeStatuses                  = "healthProbeStatuses"
+	ClusterFieldHealthProbes                         = "healthProbes"
 	ClusterFieldImportedConfig                       = "importedConfig"
 	ClusterFieldInternal                             = "internal"
 	ClusterFieldIstioEnabled                         = "istioEnabled"
@@ -53,12 +58,14 @@
 	ClusterFieldMonitoringStatus                     = "monitoringStatus"
 	ClusterFieldName                                 = "name"
 	ClusterFieldNodeCount                            = "nodeCount"
+	ClusterFieldNodeDrainPolicies                    = "nodeDrainPolicies"
 	ClusterFieldNodeVersion                          = "nodeVersion"
 	ClusterFieldOwnerReferences                      = "ownerReferences"
 	ClusterFieldProvider                             = "provider"
 	ClusterFieldRancherKubernetesEngineConfig        = "rancherKubernetesEngineConfig"
 	ClusterFieldRemoved                              = "removed"
 	ClusterFieldRequested                            = "requested"
+	ClusterFieldResourceQuota                        = "resourceQuota"
 	ClusterFieldRke2Config                           = "rke2Config"
 	ClusterFieldScheduledClusterScan                 = "scheduledClusterScan"
 	ClusterFieldScheduledClusterScanStatus           = "scheduledClusterScanStatus"
@@ -96,6 +103,7 @@ type Cluster struct {
 	CreatorID                            string                         `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
 	CurrentCisRunName                    string                         `json:"currentCisRunName,omitempty" yaml:"currentCisRunName,omitempty"`
 	DefaultClusterRoleForProjectMembers  string                         `json:"defaultClusterRoleForProjectMembers,omitempty" yaml:"defaultClusterRoleForProjectMembers,omitempty"`
+	DefaultNodeDrainPolicy               string                         `json:"defaultNodeDrainPolicy,omitempty" yaml:"defaultNodeDrainPolicy,omitempty"`
 	DefaultPodSecurityPolicyTemplateID   string                         `json:"defaultPodSecurityPolicyTemplateId,omitempty" yaml:"defaultPodSecurityPolicyTemplateId,omitempty"`
 	Description                          string                         `json:"description,omitempty" yaml:"description,omitempty"`
 	DesiredAgentImage                    string                         `json:"desiredAgentImage,omitempty" yaml:"desiredAgentImage,omitempty"`
@@ -107,8 +115,12 @@ type Cluster struct {
 	EnableClusterAlerting                bool                           `json:"enableClusterAlerting,omitempty" yaml:"enableClusterAlerting,omitempty"`
 	EnableClusterMonitoring              bool                           `json:"enableClusterMonitoring,omitempty" yaml:"enableClusterMonitoring,omitempty"`
 	EnableNetworkPolicy                  *bool                          `json:"enableNetworkPolicy,omitempty" yaml:"enableNetworkPolicy,omitempty"`
+	EtcdBackupRetention                  *EtcdBackupRetention           `json:"etcdBackupRetention,omitempty" yaml:"etcdBackupRetention,omitempty"`
+	EtcdBackupTarget                     *EtcdBackupTarget              `json:"etcdBackupTarget,omitempty" yaml:"etcdBackupTarget,omitempty"`
 	FailedSpec                           *ClusterSpec                   `json:"failedSpec,omitempty" yaml:"failedSpec,omitempty"`
 	FleetWorkspaceName                   string                         `json:"fleetWorkspaceName,omitempty" yaml:"fleetWorkspaceName,omitempty"`
+	HealthProbeStatuses                  []ClusterHealthProbeStatus     `json:"healthProbeStatuses,omitempty" yaml:"healthProbeStatuses,omitempty"`
+	HealthProbes                         []ClusterHealthProbe           `json:"healthProbes,omitempty" yaml:"healthProbes,omitempty"`
 	ImportedConfig                       *ImportedConfig                `json:"importedConfig,omitempty" yaml:"importedConfig,omitempty"`
 	Internal                             bool                           `json:"internal,omitempty" yaml:"internal,omitempty"`
 	IstioEnabled                         bool                           `json:"istioEnabled,omitempty" yaml:"istioEnabled,omitempty"`
@@ -119,12 +131,14 @@ type Cluster struct {
 	MonitoringStatus                     *MonitoringStatus              `json:"monitoringStatus,omitempty" yaml:"monitoringStatus,omitempty"`
 	Name                                 string                         `json:"name,omitempty" yaml:"name,omitempty"`
 	NodeCount                            int64                          `json:"nodeCount,omitempty" yaml:"nodeCount,omitempty"`
+	NodeDrainPolicies                    []NodeDrainPolicy              `json:"nodeDrainPolicies,omitempty" yaml:"nodeDrainPolicies,omitempty"`
 	NodeVersion                          int64                          `json:"nodeVersion,omitempty" yaml:"nodeVersion,omitempty"`
 	OwnerReferences                      []OwnerReference               `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
 	Provider                             string                         `json:"provider,omitempty" yaml:"provider,omitempty"`
 	RancherKubernetesEngineConfig        *RancherKubernetesEngineConfig `json:"rancherKubernetesEngineConfig,omitempty" yaml:"rancherKubernetesEngineConfig,omitempty"`
 	Removed                              string                         `json:"removed,omitempty" yaml:"removed,omitempty"`
 	Requested                            map[string]string              `json:"requested,omitempty" yaml:"requested,omitempty"`
+	ResourceQuota                        *ClusterResourceQuota          `json:"resourceQuota,omitempty" yaml:"resourceQuota,omitempty"`
 	Rke2Config                           *Rke2Config                    `json:"rke2Config,omitempty" yaml:"rke2Config,omitempty"`
 	ScheduledClusterScan                 *ScheduledClusterScan          `json:"scheduledClusterScan,omitempty" yaml:"scheduledClusterScan,omitempty"`
 	ScheduledClusterScanStatus           *ScheduledClusterScanStatus    `json:"scheduledClusterScanStatus,omitempty" yaml:"scheduledClusterScanStatus,omitempty"`
@@ -167,8 +181,14 @@ type ClusterOperations interface {
 
 	ActionGenerateKubeconfig(resource *Cluster) (*GenerateKubeConfigOutput, error)
 
+	ActionImportBundle(resource *Cluster, input *ImportBundleInput) (*ImportBundleOutput, error)
+
 	ActionImportYaml(resource *Cluster, input *ImportClusterYamlInput) (*ImportYamlOutput, error)
 
+	ActionQueryUtilization(resource *Cluster, input *ClusterUtilizationInput) (*ClusterUtilizationOutput, error)
+
+	ActionRestoreDryRun(resource *Cluster, input *RestoreDryRunInput) (*RestoreDryRunOutput, error)
+
 	ActionRestoreFromEtcdBackup(resource *Cluster, input *RestoreFromEtcdBackupInput) error
 
 	ActionRotateCertificates(resource *Cluster, input *RotateCertificateInput) (*RotateCertificateOutput, error)
@@ -281,12 +301,30 @@ func (c *ClusterClient) ActionGenerateKubeconfig(resource *Cluster) (*GenerateKu
 	return resp, err
 }
 
+func (c *ClusterClient) ActionImportBundle(resource *Cluster, input *ImportBundleInput) (*ImportBundleOutput, error) {
+	resp := &ImportBundleOutput{}
+	err := c.apiClient.Ops.DoAction(ClusterType, "importBundle", &resource.Resource, input, resp)
+	return resp, err
+}
+
 func (c *ClusterClient) ActionImportYaml(resource *Cluster, input *ImportClusterYamlInput) (*ImportYamlOutput, error) {
 	resp := &ImportYamlOutput{}
 	err := c.apiClient.Ops.DoAction(ClusterType, "importYaml", &resource.Resource, input, resp)
 	return resp, err
 }
 
+func (c *ClusterClient) ActionQueryUtilization(resource *Cluster, input *ClusterUtilizationInput) (*ClusterUtilizationOutput, error) {
+	resp := &ClusterUtilizationOutput{}
+	err := c.apiClient.Ops.DoAction(ClusterType, "queryUtilization", &resource.Resource, input, resp)
+	return resp, err
+}
+
+func (c *ClusterClient) ActionRestoreDryRun(resource *Cluster, input *RestoreDryRunInput) (*RestoreDryRunOutput, error) {
+	resp := &RestoreDryRunOutput{}
+	err := c.apiClient.Ops.DoAction(ClusterType, "restoreDryRun", &resource.Resource, input, resp)
+	return resp, err
+}
+
 func (c *ClusterClient) ActionRestoreFromEtcdBackup(resource *Cluster, input *RestoreFromEtcdBackupInput) error {
 	err := c.apiClient.Ops.DoAction(ClusterType, "restoreFromEtcdBackup", &resource.Resource, input, nil)
 	return err
@@ -12,6 +12,7 @@
 	ClusterAlertGroupFieldCreated               = "created"
 	ClusterAlertGroupFieldCreatorID             = "creatorId"
 	ClusterAlertGroupFieldDescription           = "description"
+	ClusterAlertGroupFieldEscalation            = "escalation"
 	ClusterAlertGroupFieldGroupIntervalSeconds  = "groupIntervalSeconds"
 	ClusterAlertGroupFieldGroupWaitSeconds      = "groupWaitSeconds"
 	ClusterAlertGroupFieldLabels                = "labels"
@@ -21,6 +22,8 @@
 	ClusterAlertGroupFieldRecipients            = "recipients"
 	ClusterAlertGroupFieldRemoved               = "removed"
 	ClusterAlertGroupFieldRepeatIntervalSeconds = "repeatIntervalSeconds"
+	ClusterAlertGroupFieldRoutes                = "routes"
+	ClusterAlertGroupFieldSilences              = "silences"
 	ClusterAlertGroupFieldState                 = "state"
 	ClusterAlertGroupFieldTransitioning         = "transitioning"
 	ClusterAlertGroupFieldTransitioningMessage  = "transitioningMessage"
@@ -35,6 +38,7 @@ type ClusterAlertGroup struct {
 	Created               string            `json:"created,omitempty" yaml:"created,omitempty"`
 	CreatorID             string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
 	Description           string            `json:"description,omitempty" yaml:"description,omitempty"`
+	Escalation            *AlertEscalation  `json:"escalation,omitempty" yaml:"escalation,omitempty"`
 	GroupIntervalSeconds  int64             `json:"groupIntervalSeconds,omitempty" yaml:"groupIntervalSeconds,omitempty"`
 	GroupWaitSeconds      int64             `json:"groupWaitSeconds,omitempty" yaml:"groupWaitSeconds,omitempty"`
 	Labels                map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
@@ -44,6 +48,8 @@ type ClusterAlertGroup struct {
 	Recipients            []Recipient       `json:"recipients,omitempty" yaml:"recipients,omitempty"`
 	Removed               string            `json:"removed,omitempty" yaml:"removed,omitempty"`
 	RepeatIntervalSeconds int64             `json:"repeatIntervalSeconds,omitempty" yaml:"repeatIntervalSeconds,omitempty"`
+	Routes                []AlertRoute      `json:"routes,omitempty" yaml:"routes,omitempty"`
+	Silences              []AlertSilence    `json:"silences,omitempty" yaml:"silences,omitempty"`
 	State                 string            `json:"state,omitempty" yaml:"state,omitempty"`
 	Transitioning         string            `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
 	TransitioningMessage  string            `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
@@ -5,6 +5,7 @@
 	ClusterAlertRuleSpecFieldClusterID             = "clusterId"
 	ClusterAlertRuleSpecFieldClusterScanRule       = "clusterScanRule"
 	ClusterAlertRuleSpecFieldDisplayName           = "displayName"
+	ClusterAlertRuleSpecFieldEtcdBackupRule        = "etcdBackupRule"
 	ClusterAlertRuleSpecFieldEventRule             = "eventRule"
 	ClusterAlertRuleSpecFieldGroupID               = "groupId"
 	ClusterAlertRuleSpecFieldGroupIntervalSeconds  = "groupIntervalSeconds"
@@ -21,6 +22,7 @@ type ClusterAlertRuleSpec struct {
 	ClusterID             string             `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
 	ClusterScanRule       *ClusterScanRule   `json:"clusterScanRule,omitempty" yaml:"clusterScanRule,omitempty"`
 	DisplayName           string             `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	EtcdBackupRule        *EtcdBackupRule    `json:"etcdBackupRule,omitempty" yaml:"etcdBackupRule,omitempty"`
 	EventRule             *EventRule         `json:"eventRule,omitempty" yaml:"eventRule,omitempty"`
 	GroupID               string             `json:"groupId,omitempty" yaml:"groupId,omitempty"`
 	GroupIntervalSeconds  int64              `json:"groupIntervalSeconds,omitempty" yaml:"groupIntervalSeconds,omitempty"`
@@ -5,18 +5,24 @@
 	ClusterGroupSpecFieldClusterID             = "clusterId"
 	ClusterGroupSpecFieldDescription           = "description"
 	ClusterGroupSpecFieldDisplayName           = "displayName"
+	ClusterGroupSpecFieldEscalation            = "escalation"
 	ClusterGroupSpecFieldGroupIntervalSeconds  = "groupIntervalSeconds"
 	ClusterGroupSpecFieldGroupWaitSeconds      = "groupWaitSeconds"
 	ClusterGroupSpecFieldRecipients            = "recipients"
 	ClusterGroupSpecFieldRepeatIntervalSeconds = "repeatIntervalSeconds"
+	ClusterGroupSpecFieldRoutes                = "routes"
+	ClusterGroupSpecFieldSilences              = "silences"
 )
 
 type ClusterGroupSpec struct {
-	ClusterID             string      `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
-	Description           string      `json:"description,omitempty" yaml:"description,omitempty"`
-	DisplayName           string      `json:"displayName,omitempty" yaml:"displayName,omitempty"`
-	GroupIntervalSeconds  int64       `json:"groupIntervalSeconds,omitempty" yaml:"groupIntervalSeconds,omitempty"`
-	GroupWaitSeconds      int64       `json:"groupWaitSeconds,omitempty" yaml:"groupWaitSeconds,omitempty"`
-	Recipients            []Recipient `json:"recipients,omitempty" yaml:"recipients,omitempty"`
-	RepeatIntervalSeconds int64       `json:"repeatIntervalSeconds,omitempty" yaml:"repeatIntervalSeconds,omitempty"`
+	ClusterID             string           `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	Description           string           `json:"description,omitempty" yaml:"description,omitempty"`
+	DisplayName           string           `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	Escalation            *AlertEscalation `json:"escalation,omitempty" yaml:"escalation,omitempty"`
+	GroupIntervalSeconds  int64            `json:"groupIntervalSeconds,omitempty" yaml:"groupIntervalSeconds,omitempty"`
+	GroupWaitSeconds      int64            `json:"groupWaitSeconds,omitempty" yaml:"groupWaitSeconds,omitempty"`
+	Recipients            []Recipient      `json:"recipients,omitempty" yaml:"recipients,omitempty"`
+	RepeatIntervalSeconds int64            `json:"repeatIntervalSeconds,omitempty" yaml:"repeatIntervalSeconds,omitempty"`
+	Routes                []AlertRoute     `json:"routes,omitempty" yaml:"routes,omitempty"`
+	Silences              []AlertSilence   `json:"silences,omitempty" yaml:"silences,omitempty"`
 }
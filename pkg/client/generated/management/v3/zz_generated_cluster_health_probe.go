@@ -0,0 +1,22 @@
+package client
+
+const (
+	ClusterHealthProbeType                  = "clusterHealthProbe"
+	ClusterHealthProbeFieldDNS              = "dns"
+	ClusterHealthProbeFieldFailureThreshold = "failureThreshold"
+	ClusterHealthProbeFieldHTTP             = "http"
+	ClusterHealthProbeFieldIntervalSeconds  = "intervalSeconds"
+	ClusterHealthProbeFieldName             = "name"
+	ClusterHealthProbeFieldPodToPod         = "podToPod"
+	ClusterHealthProbeFieldTimeoutSeconds   = "timeoutSeconds"
+)
+
+type ClusterHealthProbe struct {
+	DNS              *DNSHealthProbe      `json:"dns,omitempty" yaml:"dns,omitempty"`
+	FailureThreshold int64                `json:"failureThreshold,omitempty" yaml:"failureThreshold,omitempty"`
+	HTTP             *HTTPHealthProbe     `json:"http,omitempty" yaml:"http,omitempty"`
+	IntervalSeconds  int64                `json:"intervalSeconds,omitempty" yaml:"intervalSeconds,omitempty"`
+	Name             string               `json:"name,omitempty" yaml:"name,omitempty"`
+	PodToPod         *PodToPodHealthProbe `json:"podToPod,omitempty" yaml:"podToPod,omitempty"`
+	TimeoutSeconds   int64                `json:"timeoutSeconds,omitempty" yaml:"timeoutSeconds,omitempty"`
+}
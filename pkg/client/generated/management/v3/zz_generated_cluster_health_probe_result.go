@@ -0,0 +1,16 @@
+package client
+
+const (
+	ClusterHealthProbeResultType           = "clusterHealthProbeResult"
+	ClusterHealthProbeResultFieldLatencyMs = "latencyMs"
+	ClusterHealthProbeResultFieldMessage   = "message"
+	ClusterHealthProbeResultFieldSuccess   = "success"
+	ClusterHealthProbeResultFieldTime      = "time"
+)
+
+type ClusterHealthProbeResult struct {
+	LatencyMs int64  `json:"latencyMs,omitempty" yaml:"latencyMs,omitempty"`
+	Message   string `json:"message,omitempty" yaml:"message,omitempty"`
+	Success   bool   `json:"success,omitempty" yaml:"success,omitempty"`
+	Time      string `json:"time,omitempty" yaml:"time,omitempty"`
+}
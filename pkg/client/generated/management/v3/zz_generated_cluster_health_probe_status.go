@@ -0,0 +1,18 @@
+package client
+
+const (
+	ClusterHealthProbeStatusType                     = "clusterHealthProbeStatus"
+	ClusterHealthProbeStatusFieldConsecutiveFailures = "consecutiveFailures"
+	ClusterHealthProbeStatusFieldHealthy             = "healthy"
+	ClusterHealthProbeStatusFieldHistory             = "history"
+	ClusterHealthProbeStatusFieldLastProbeTime       = "lastProbeTime"
+	ClusterHealthProbeStatusFieldName                = "name"
+)
+
+type ClusterHealthProbeStatus struct {
+	ConsecutiveFailures int64                      `json:"consecutiveFailures,omitempty" yaml:"consecutiveFailures,omitempty"`
+	Healthy             bool                       `json:"healthy,omitempty" yaml:"healthy,omitempty"`
+	History             []ClusterHealthProbeResult `json:"history,omitempty" yaml:"history,omitempty"`
+	LastProbeTime       string                     `json:"lastProbeTime,omitempty" yaml:"lastProbeTime,omitempty"`
+	Name                string                     `json:"name,omitempty" yaml:"name,omitempty"`
+}
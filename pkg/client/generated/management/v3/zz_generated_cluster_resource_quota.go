@@ -0,0 +1,14 @@
+package client
+
+const (
+	ClusterResourceQuotaType                 = "clusterResourceQuota"
+	ClusterResourceQuotaFieldLimit           = "limit"
+	ClusterResourceQuotaFieldOvercommitRatio = "overcommitRatio"
+	ClusterResourceQuotaFieldUsedLimit       = "usedLimit"
+)
+
+type ClusterResourceQuota struct {
+	Limit           *ResourceQuotaLimit `json:"limit,omitempty" yaml:"limit,omitempty"`
+	OvercommitRatio map[string]string   `json:"overcommitRatio,omitempty" yaml:"overcommitRatio,omitempty"`
+	UsedLimit       *ResourceQuotaLimit `json:"usedLimit,omitempty" yaml:"usedLimit,omitempty"`
+}
@@ -10,6 +10,7 @@
 	ClusterSpecFieldClusterTemplateQuestions            = "questions"
 	ClusterSpecFieldClusterTemplateRevisionID           = "clusterTemplateRevisionId"
 	ClusterSpecFieldDefaultClusterRoleForProjectMembers = "defaultClusterRoleForProjectMembers"
+	ClusterSpecFieldDefaultNodeDrainPolicy              = "defaultNodeDrainPolicy"
 	ClusterSpecFieldDefaultPodSecurityPolicyTemplateID  = "defaultPodSecurityPolicyTemplateId"
 	ClusterSpecFieldDescription                         = "description"
 	ClusterSpecFieldDesiredAgentImage                   = "desiredAgentImage"
@@ -20,14 +21,19 @@
 	ClusterSpecFieldEnableClusterAlerting               = "enableClusterAlerting"
 	ClusterSpecFieldEnableClusterMonitoring             = "enableClusterMonitoring"
 	ClusterSpecFieldEnableNetworkPolicy                 = "enableNetworkPolicy"
+	ClusterSpecFieldEtcdBackupRetention                 = "etcdBackupRetention"
+	ClusterSpecFieldEtcdBackupTarget                    = "etcdBackupTarget"
 	ClusterSpecFieldFleetWorkspaceName                  = "fleetWorkspaceName"
 	ClusterSpecFieldGenericEngineConfig                 = "genericEngineConfig"
 	ClusterSpecFieldGoogleKubernetesEngineConfig        = "googleKubernetesEngineConfig"
+	ClusterSpecFieldHealthProbes                        = "healthProbes"
 	ClusterSpecFieldImportedConfig                      = "importedConfig"
 	ClusterSpecFieldInternal                            = "internal"
 	ClusterSpecFieldK3sConfig                           = "k3sConfig"
 	ClusterSpecFieldLocalClusterAuthEndpoint            = "localClusterAuthEndpoint"
+	ClusterSpecFieldNodeDrainPolicies                   = "nodeDrainPolicies"
 	ClusterSpecFieldRancherKubernetesEngineConfig       = "rancherKubernetesEngineConfig"
+	ClusterSpecFieldResourceQuota                       = "resourceQuota"
 	ClusterSpecFieldRke2Config                          = "rke2Config"
 	ClusterSpecFieldScheduledClusterScan                = "scheduledClusterScan"
 	ClusterSpecFieldWindowsPreferedCluster              = "windowsPreferedCluster"
@@ -42,6 +48,7 @@ type ClusterSpec struct {
 	ClusterTemplateQuestions            []Question                     `json:"questions,omitempty" yaml:"questions,omitempty"`
 	ClusterTemplateRevisionID           string                         `json:"clusterTemplateRevisionId,omitempty" yaml:"clusterTemplateRevisionId,omitempty"`
 	DefaultClusterRoleForProjectMembers string                         `json:"defaultClusterRoleForProjectMembers,omitempty" yaml:"defaultClusterRoleForProjectMembers,omitempty"`
+	DefaultNodeDrainPolicy              string                         `json:"defaultNodeDrainPolicy,omitempty" yaml:"defaultNodeDrainPolicy,omitempty"`
 	DefaultPodSecurityPolicyTemplateID  string                         `json:"defaultPodSecurityPolicyTemplateId,omitempty" yaml:"defaultPodSecurityPolicyTemplateId,omitempty"`
 	Description                         string                         `json:"description,omitempty" yaml:"description,omitempty"`
 	DesiredAgentImage                   string                         `json:"desiredAgentImage,omitempty" yaml:"desiredAgentImage,omitempty"`
@@ -52,14 +59,19 @@ type ClusterSpec struct {
 	EnableClusterAlerting               bool                           `json:"enableClusterAlerting,omitempty" yaml:"enableClusterAlerting,omitempty"`
 	EnableClusterMonitoring             bool                           `json:"enableClusterMonitoring,omitempty" yaml:"enableClusterMonitoring,omitempty"`
 	EnableNetworkPolicy                 *bool                          `json:"enableNetworkPolicy,omitempty" yaml:"enableNetworkPolicy,omitempty"`
+	EtcdBackupRetention                 *EtcdBackupRetention           `json:"etcdBackupRetention,omitempty" yaml:"etcdBackupRetention,omitempty"`
+	EtcdBackupTarget                    *EtcdBackupTarget              `json:"etcdBackupTarget,omitempty" yaml:"etcdBackupTarget,omitempty"`
 	FleetWorkspaceName                  string                         `json:"fleetWorkspaceName,omitempty" yaml:"fleetWorkspaceName,omitempty"`
 	GenericEngineConfig                 map[string]interface{}         `json:"genericEngineConfig,omitempty" yaml:"genericEngineConfig,omitempty"`
 	GoogleKubernetesEngineConfig        map[string]interface{}         `json:"googleKubernetesEngineConfig,omitempty" yaml:"googleKubernetesEngineConfig,omitempty"`
+	HealthProbes                        []ClusterHealthProbe           `json:"healthProbes,omitempty" yaml:"healthProbes,omitempty"`
 	ImportedConfig                      *ImportedConfig                `json:"importedConfig,omitempty" yaml:"importedConfig,omitempty"`
 	Internal                            bool                           `json:"internal,omitempty" yaml:"internal,omitempty"`
 	K3sConfig                           *K3sConfig                     `json:"k3sConfig,omitempty" yaml:"k3sConfig,omitempty"`
 	LocalClusterAuthEndpoint            *LocalClusterAuthEndpoint      `json:"localClusterAuthEndpoint,omitempty" yaml:"localClusterAuthEndpoint,omitempty"`
+	NodeDrainPolicies                   []NodeDrainPolicy              `json:"nodeDrainPolicies,omitempty" yaml:"nodeDrainPolicies,omitempty"`
 	RancherKubernetesEngineConfig       *RancherKubernetesEngineConfig `json:"rancherKubernetesEngineConfig,omitempty" yaml:"rancherKubernetesEngineConfig,omitempty"`
+	ResourceQuota                       *ClusterResourceQuota          `json:"resourceQuota,omitempty" yaml:"resourceQuota,omitempty"`
 	Rke2Config                          *Rke2Config                    `json:"rke2Config,omitempty" yaml:"rke2Config,omitempty"`
 	ScheduledClusterScan                *ScheduledClusterScan          `json:"scheduledClusterScan,omitempty" yaml:"scheduledClusterScan,omitempty"`
 	WindowsPreferedCluster              bool                           `json:"windowsPreferedCluster,omitempty" yaml:"windowsPreferedCluster,omitempty"`
@@ -11,6 +11,8 @@
 	ClusterSpecBaseFieldEnableClusterAlerting               = "enableClusterAlerting"
 	ClusterSpecBaseFieldEnableClusterMonitoring             = "enableClusterMonitoring"
 	ClusterSpecBaseFieldEnableNetworkPolicy                 = "enableNetworkPolicy"
+	ClusterSpecBaseFieldEtcdBackupRetention                 = "etcdBackupRetention"
+	ClusterSpecBaseFieldEtcdBackupTarget                    = "etcdBackupTarget"
 	ClusterSpecBaseFieldLocalClusterAuthEndpoint            = "localClusterAuthEndpoint"
 	ClusterSpecBaseFieldRancherKubernetesEngineConfig       = "rancherKubernetesEngineConfig"
 	ClusterSpecBaseFieldScheduledClusterScan                = "scheduledClusterScan"
@@ -27,6 +29,8 @@ type ClusterSpecBase struct {
 	EnableClusterAlerting               bool                           `json:"enableClusterAlerting,omitempty" yaml:"enableClusterAlerting,omitempty"`
 	EnableClusterMonitoring             bool                           `json:"enableClusterMonitoring,omitempty" yaml:"enableClusterMonitoring,omitempty"`
 	EnableNetworkPolicy                 *bool                          `json:"enableNetworkPolicy,omitempty" yaml:"enableNetworkPolicy,omitempty"`
+	EtcdBackupRetention                 *EtcdBackupRetention           `json:"etcdBackupRetention,omitempty" yaml:"etcdBackupRetention,omitempty"`
+	EtcdBackupTarget                    *EtcdBackupTarget              `json:"etcdBackupTarget,omitempty" yaml:"etcdBackupTarget,omitempty"`
 	LocalClusterAuthEndpoint            *LocalClusterAuthEndpoint      `json:"localClusterAuthEndpoint,omitempty" yaml:"localClusterAuthEndpoint,omitempty"`
 	RancherKubernetesEngineConfig       *RancherKubernetesEngineConfig `json:"rancherKubernetesEngineConfig,omitempty" yaml:"rancherKubernetesEngineConfig,omitempty"`
 	ScheduledClusterScan                *ScheduledClusterScan          `json:"scheduledClusterScan,omitempty" yaml:"scheduledClusterScan,omitempty"`
@@ -20,6 +20,7 @@
 	ClusterStatusFieldDriver                               = "driver"
 	ClusterStatusFieldEKSStatus                            = "eksStatus"
 	ClusterStatusFieldFailedSpec                           = "failedSpec"
+	ClusterStatusFieldHealthProbeStatuses                  = "healthProbeStatuses"
 	ClusterStatusFieldIstioEnabled                         = "istioEnabled"
 	ClusterStatusFieldLimits                               = "limits"
 	ClusterStatusFieldMonitoringStatus                     = "monitoringStatus"
@@ -50,6 +51,7 @@ type ClusterStatus struct {
 	Driver                               string                      `json:"driver,omitempty" yaml:"driver,omitempty"`
 	EKSStatus                            *EKSStatus                  `json:"eksStatus,omitempty" yaml:"eksStatus,omitempty"`
 	FailedSpec                           *ClusterSpec                `json:"failedSpec,omitempty" yaml:"failedSpec,omitempty"`
+	HealthProbeStatuses                  []ClusterHealthProbeStatus  `json:"healthProbeStatuses,omitempty" yaml:"healthProbeStatuses,omitempty"`
 	IstioEnabled                         bool                        `json:"istioEnabled,omitempty" yaml:"istioEnabled,omitempty"`
 	Limits                               map[string]string           `json:"limits,omitempty" yaml:"limits,omitempty"`
 	MonitoringStatus                     *MonitoringStatus           `json:"monitoringStatus,omitempty" yaml:"monitoringStatus,omitempty"`
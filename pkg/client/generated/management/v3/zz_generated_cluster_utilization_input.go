@@ -0,0 +1,18 @@
+package client
+
+const (
+	ClusterUtilizationInputType            = "clusterUtilizationInput"
+	ClusterUtilizationInputFieldFrom       = "from"
+	ClusterUtilizationInputFieldNodePoolID = "nodePoolId"
+	ClusterUtilizationInputFieldProjectID  = "projectId"
+	ClusterUtilizationInputFieldResolution = "resolution"
+	ClusterUtilizationInputFieldTo         = "to"
+)
+
+type ClusterUtilizationInput struct {
+	From       string `json:"from,omitempty" yaml:"from,omitempty"`
+	NodePoolID string `json:"nodePoolId,omitempty" yaml:"nodePoolId,omitempty"`
+	ProjectID  string `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	Resolution string `json:"resolution,omitempty" yaml:"resolution,omitempty"`
+	To         string `json:"to,omitempty" yaml:"to,omitempty"`
+}
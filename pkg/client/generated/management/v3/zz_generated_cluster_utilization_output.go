@@ -0,0 +1,12 @@
+package client
+
+const (
+	ClusterUtilizationOutputType            = "clusterUtilizationOutput"
+	ClusterUtilizationOutputFieldPoints     = "points"
+	ClusterUtilizationOutputFieldResolution = "resolution"
+)
+
+type ClusterUtilizationOutput struct {
+	Points     []UtilizationPoint `json:"points,omitempty" yaml:"points,omitempty"`
+	Resolution string             `json:"resolution,omitempty" yaml:"resolution,omitempty"`
+}
@@ -0,0 +1,12 @@
+package client
+
+const (
+	DNSHealthProbeType          = "dnsHealthProbe"
+	DNSHealthProbeFieldHostname = "hostname"
+	DNSHealthProbeFieldServer   = "server"
+)
+
+type DNSHealthProbe struct {
+	Hostname string `json:"hostname,omitempty" yaml:"hostname,omitempty"`
+	Server   string `json:"server,omitempty" yaml:"server,omitempty"`
+}
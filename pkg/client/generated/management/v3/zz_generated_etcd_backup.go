@@ -17,9 +17,12 @@
 	EtcdBackupFieldName                 = "name"
 	EtcdBackupFieldNamespaceId          = "namespaceId"
 	EtcdBackupFieldOwnerReferences      = "ownerReferences"
+	EtcdBackupFieldPinned               = "pinned"
 	EtcdBackupFieldRemoved              = "removed"
+	EtcdBackupFieldSnapshotInfo         = "snapshotInfo"
 	EtcdBackupFieldState                = "state"
 	EtcdBackupFieldStatus               = "status"
+	EtcdBackupFieldTarget               = "target"
 	EtcdBackupFieldTransitioning        = "transitioning"
 	EtcdBackupFieldTransitioningMessage = "transitioningMessage"
 	EtcdBackupFieldUUID                 = "uuid"
@@ -38,9 +41,12 @@ type EtcdBackup struct {
 	Name                 string            `json:"name,omitempty" yaml:"name,omitempty"`
 	NamespaceId          string            `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
 	OwnerReferences      []OwnerReference  `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	Pinned               bool              `json:"pinned,omitempty" yaml:"pinned,omitempty"`
 	Removed              string            `json:"removed,omitempty" yaml:"removed,omitempty"`
+	SnapshotInfo         *EtcdSnapshotInfo `json:"snapshotInfo,omitempty" yaml:"snapshotInfo,omitempty"`
 	State                string            `json:"state,omitempty" yaml:"state,omitempty"`
 	Status               *EtcdBackupStatus `json:"status,omitempty" yaml:"status,omitempty"`
+	Target               *EtcdBackupTarget `json:"target,omitempty" yaml:"target,omitempty"`
 	Transitioning        string            `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
 	TransitioningMessage string            `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
 	UUID                 string            `json:"uuid,omitempty" yaml:"uuid,omitempty"`
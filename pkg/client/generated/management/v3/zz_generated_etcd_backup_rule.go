@@ -0,0 +1,10 @@
+package client
+
+const (
+	EtcdBackupRuleType               = "etcdBackupRule"
+	EtcdBackupRuleFieldIncludeManual = "includeManual"
+)
+
+type EtcdBackupRule struct {
+	IncludeManual bool `json:"includeManual,omitempty" yaml:"includeManual,omitempty"`
+}
@@ -0,0 +1,16 @@
+package client
+
+const (
+	EtcdBackupTargetType             = "etcdBackupTarget"
+	EtcdBackupTargetFieldEncryption  = "encryption"
+	EtcdBackupTargetFieldLocalConfig = "localConfig"
+	EtcdBackupTargetFieldS3Config    = "s3Config"
+	EtcdBackupTargetFieldSFTPConfig  = "sftpConfig"
+)
+
+type EtcdBackupTarget struct {
+	Encryption  *BackupEncryptionConfig `json:"encryption,omitempty" yaml:"encryption,omitempty"`
+	LocalConfig *LocalBackupConfig      `json:"localConfig,omitempty" yaml:"localConfig,omitempty"`
+	S3Config    *S3BackupConfig         `json:"s3Config,omitempty" yaml:"s3Config,omitempty"`
+	SFTPConfig  *SFTPBackupConfig       `json:"sftpConfig,omitempty" yaml:"sftpConfig,omitempty"`
+}
@@ -0,0 +1,16 @@
+package client
+
+const (
+	EtcdSnapshotInfoType              = "etcdSnapshotInfo"
+	EtcdSnapshotInfoFieldChecksum     = "checksum"
+	EtcdSnapshotInfoFieldKeyCount     = "keyCount"
+	EtcdSnapshotInfoFieldLastVerified = "lastVerified"
+	EtcdSnapshotInfoFieldSize         = "size"
+)
+
+type EtcdSnapshotInfo struct {
+	Checksum     string `json:"checksum,omitempty" yaml:"checksum,omitempty"`
+	KeyCount     int64  `json:"keyCount,omitempty" yaml:"keyCount,omitempty"`
+	LastVerified string `json:"lastVerified,omitempty" yaml:"lastVerified,omitempty"`
+	Size         int64  `json:"size,omitempty" yaml:"size,omitempty"`
+}
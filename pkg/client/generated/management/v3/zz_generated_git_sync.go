@@ -0,0 +1,142 @@
+package client
+
+import (
+	"github.com/rancher/norman/types"
+)
+
+const (
+	GitSyncType                       = "gitSync"
+	GitSyncFieldAnnotations           = "annotations"
+	GitSyncFieldBranch                = "branch"
+	GitSyncFieldClientSecretName      = "clientSecretName"
+	GitSyncFieldCreated               = "created"
+	GitSyncFieldCreatorID             = "creatorId"
+	GitSyncFieldDryRun                = "dryRun"
+	GitSyncFieldInsecureSkipTLSVerify = "insecureSkipTLSVerify"
+	GitSyncFieldIntervalSeconds       = "intervalSeconds"
+	GitSyncFieldLabels                = "labels"
+	GitSyncFieldName                  = "name"
+	GitSyncFieldOwnerReferences       = "ownerReferences"
+	GitSyncFieldPaths                 = "paths"
+	GitSyncFieldPrune                 = "prune"
+	GitSyncFieldRemoved               = "removed"
+	GitSyncFieldRepo                  = "repo"
+	GitSyncFieldState                 = "state"
+	GitSyncFieldStatus                = "status"
+	GitSyncFieldTransitioning         = "transitioning"
+	GitSyncFieldTransitioningMessage  = "transitioningMessage"
+	GitSyncFieldUUID                  = "uuid"
+)
+
+type GitSync struct {
+	types.Resource
+	Annotations           map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	Branch                string            `json:"branch,omitempty" yaml:"branch,omitempty"`
+	ClientSecretName      string            `json:"clientSecretName,omitempty" yaml:"clientSecretName,omitempty"`
+	Created               string            `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID             string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	DryRun                bool              `json:"dryRun,omitempty" yaml:"dryRun,omitempty"`
+	InsecureSkipTLSVerify bool              `json:"insecureSkipTLSVerify,omitempty" yaml:"insecureSkipTLSVerify,omitempty"`
+	IntervalSeconds       int64             `json:"intervalSeconds,omitempty" yaml:"intervalSeconds,omitempty"`
+	Labels                map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Name                  string            `json:"name,omitempty" yaml:"name,omitempty"`
+	OwnerReferences       []OwnerReference  `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	Paths                 []string          `json:"paths,omitempty" yaml:"paths,omitempty"`
+	Prune                 bool              `json:"prune,omitempty" yaml:"prune,omitempty"`
+	Removed               string            `json:"removed,omitempty" yaml:"removed,omitempty"`
+	Repo                  string            `json:"repo,omitempty" yaml:"repo,omitempty"`
+	State                 string            `json:"state,omitempty" yaml:"state,omitempty"`
+	Status                *GitSyncStatus    `json:"status,omitempty" yaml:"status,omitempty"`
+	Transitioning         string            `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
+	TransitioningMessage  string            `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
+	UUID                  string            `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+}
+
+type GitSyncCollection struct {
+	types.Collection
+	Data   []GitSync `json:"data,omitempty"`
+	client *GitSyncClient
+}
+
+type GitSyncClient struct {
+	apiClient *Client
+}
+
+type GitSyncOperations interface {
+	List(opts *types.ListOpts) (*GitSyncCollection, error)
+	ListAll(opts *types.ListOpts) (*GitSyncCollection, error)
+	Create(opts *GitSync) (*GitSync, error)
+	Update(existing *GitSync, updates interface{}) (*GitSync, error)
+	Replace(existing *GitSync) (*GitSync, error)
+	ByID(id string) (*GitSync, error)
+	Delete(container *GitSync) error
+}
+
+func newGitSyncClient(apiClient *Client) *GitSyncClient {
+	return &GitSyncClient{
+		apiClient: apiClient,
+	}
+}
+
+func (c *GitSyncClient) Create(container *GitSync) (*GitSync, error) {
+	resp := &GitSync{}
+	err := c.apiClient.Ops.DoCreate(GitSyncType, container, resp)
+	return resp, err
+}
+
+func (c *GitSyncClient) Update(existing *GitSync, updates interface{}) (*GitSync, error) {
+	resp := &GitSync{}
+	err := c.apiClient.Ops.DoUpdate(GitSyncType, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *GitSyncClient) Replace(obj *GitSync) (*GitSync, error) {
+	resp := &GitSync{}
+	err := c.apiClient.Ops.DoReplace(GitSyncType, &obj.Resource, obj, resp)
+	return resp, err
+}
+
+func (c *GitSyncClient) List(opts *types.ListOpts) (*GitSyncCollection, error) {
+	resp := &GitSyncCollection{}
+	err := c.apiClient.Ops.DoList(GitSyncType, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (c *GitSyncClient) ListAll(opts *types.ListOpts) (*GitSyncCollection, error) {
+	resp := &GitSyncCollection{}
+	resp, err := c.List(opts)
+	if err != nil {
+		return resp, err
+	}
+	data := resp.Data
+	for next, err := resp.Next(); next != nil && err == nil; next, err = next.Next() {
+		data = append(data, next.Data...)
+		resp = next
+		resp.Data = data
+	}
+	if err != nil {
+		return resp, err
+	}
+	return resp, err
+}
+
+func (cc *GitSyncCollection) Next() (*GitSyncCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &GitSyncCollection{}
+		err := cc.client.apiClient.Ops.DoNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *GitSyncClient) ByID(id string) (*GitSync, error) {
+	resp := &GitSync{}
+	err := c.apiClient.Ops.DoByID(GitSyncType, id, resp)
+	return resp, err
+}
+
+func (c *GitSyncClient) Delete(container *GitSync) error {
+	return c.apiClient.Ops.DoResourceDelete(GitSyncType, &container.Resource)
+}
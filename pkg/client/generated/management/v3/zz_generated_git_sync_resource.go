@@ -0,0 +1,28 @@
+package client
+
+const (
+	GitSyncResourceType            = "gitSyncResource"
+	GitSyncResourceFieldAPIVersion = "apiVersion"
+	GitSyncResourceFieldCreated    = "created"
+	GitSyncResourceFieldDrifted    = "drifted"
+	GitSyncResourceFieldHash       = "hash"
+	GitSyncResourceFieldKind       = "kind"
+	GitSyncResourceFieldMessage    = "message"
+	GitSyncResourceFieldName       = "name"
+	GitSyncResourceFieldNamespace  = "namespace"
+	GitSyncResourceFieldPath       = "path"
+	GitSyncResourceFieldState      = "state"
+)
+
+type GitSyncResource struct {
+	APIVersion string `json:"apiVersion,omitempty" yaml:"apiVersion,omitempty"`
+	Created    bool   `json:"created,omitempty" yaml:"created,omitempty"`
+	Drifted    bool   `json:"drifted,omitempty" yaml:"drifted,omitempty"`
+	Hash       string `json:"hash,omitempty" yaml:"hash,omitempty"`
+	Kind       string `json:"kind,omitempty" yaml:"kind,omitempty"`
+	Message    string `json:"message,omitempty" yaml:"message,omitempty"`
+	Name       string `json:"name,omitempty" yaml:"name,omitempty"`
+	Namespace  string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	Path       string `json:"path,omitempty" yaml:"path,omitempty"`
+	State      string `json:"state,omitempty" yaml:"state,omitempty"`
+}
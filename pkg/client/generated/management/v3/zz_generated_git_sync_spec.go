@@ -0,0 +1,24 @@
+package client
+
+const (
+	GitSyncSpecType                       = "gitSyncSpec"
+	GitSyncSpecFieldBranch                = "branch"
+	GitSyncSpecFieldClientSecretName      = "clientSecretName"
+	GitSyncSpecFieldDryRun                = "dryRun"
+	GitSyncSpecFieldInsecureSkipTLSVerify = "insecureSkipTLSVerify"
+	GitSyncSpecFieldIntervalSeconds       = "intervalSeconds"
+	GitSyncSpecFieldPaths                 = "paths"
+	GitSyncSpecFieldPrune                 = "prune"
+	GitSyncSpecFieldRepo                  = "repo"
+)
+
+type GitSyncSpec struct {
+	Branch                string   `json:"branch,omitempty" yaml:"branch,omitempty"`
+	ClientSecretName      string   `json:"clientSecretName,omitempty" yaml:"clientSecretName,omitempty"`
+	DryRun                bool     `json:"dryRun,omitempty" yaml:"dryRun,omitempty"`
+	InsecureSkipTLSVerify bool     `json:"insecureSkipTLSVerify,omitempty" yaml:"insecureSkipTLSVerify,omitempty"`
+	IntervalSeconds       int64    `json:"intervalSeconds,omitempty" yaml:"intervalSeconds,omitempty"`
+	Paths                 []string `json:"paths,omitempty" yaml:"paths,omitempty"`
+	Prune                 bool     `json:"prune,omitempty" yaml:"prune,omitempty"`
+	Repo                  string   `json:"repo,omitempty" yaml:"repo,omitempty"`
+}
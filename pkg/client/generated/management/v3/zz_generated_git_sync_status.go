@@ -0,0 +1,18 @@
+package client
+
+const (
+	GitSyncStatusType              = "gitSyncStatus"
+	GitSyncStatusFieldCommit       = "commit"
+	GitSyncStatusFieldConditions   = "conditions"
+	GitSyncStatusFieldLastSyncTime = "lastSyncTime"
+	GitSyncStatusFieldResources    = "resources"
+	GitSyncStatusFieldSpecHash     = "specHash"
+)
+
+type GitSyncStatus struct {
+	Commit       string             `json:"commit,omitempty" yaml:"commit,omitempty"`
+	Conditions   []GitSyncCondition `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	LastSyncTime string             `json:"lastSyncTime,omitempty" yaml:"lastSyncTime,omitempty"`
+	Resources    []GitSyncResource  `json:"resources,omitempty" yaml:"resources,omitempty"`
+	SpecHash     string             `json:"specHash,omitempty" yaml:"specHash,omitempty"`
+}
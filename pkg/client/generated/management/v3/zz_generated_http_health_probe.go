@@ -0,0 +1,12 @@
+package client
+
+const (
+	HTTPHealthProbeType                    = "httpHealthProbe"
+	HTTPHealthProbeFieldInsecureSkipVerify = "insecureSkipVerify"
+	HTTPHealthProbeFieldURL                = "url"
+)
+
+type HTTPHealthProbe struct {
+	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty" yaml:"insecureSkipVerify,omitempty"`
+	URL                string `json:"url,omitempty" yaml:"url,omitempty"`
+}
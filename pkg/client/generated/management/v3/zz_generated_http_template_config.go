@@ -0,0 +1,24 @@
+package client
+
+const (
+	HTTPTemplateConfigType                 = "httpTemplateConfig"
+	HTTPTemplateConfigFieldBodyTemplate    = "bodyTemplate"
+	HTTPTemplateConfigFieldContentType     = "contentType"
+	HTTPTemplateConfigFieldHeaders         = "headers"
+	HTTPTemplateConfigFieldMethod          = "method"
+	HTTPTemplateConfigFieldProxyURL        = "proxyUrl"
+	HTTPTemplateConfigFieldSecret          = "secret"
+	HTTPTemplateConfigFieldSignatureHeader = "signatureHeader"
+	HTTPTemplateConfigFieldURL             = "url"
+)
+
+type HTTPTemplateConfig struct {
+	BodyTemplate    string            `json:"bodyTemplate,omitempty" yaml:"bodyTemplate,omitempty"`
+	ContentType     string            `json:"contentType,omitempty" yaml:"contentType,omitempty"`
+	Headers         map[string]string `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Method          string            `json:"method,omitempty" yaml:"method,omitempty"`
+	ProxyURL        string            `json:"proxyUrl,omitempty" yaml:"proxyUrl,omitempty"`
+	Secret          string            `json:"secret,omitempty" yaml:"secret,omitempty"`
+	SignatureHeader string            `json:"signatureHeader,omitempty" yaml:"signatureHeader,omitempty"`
+	URL             string            `json:"url,omitempty" yaml:"url,omitempty"`
+}
@@ -0,0 +1,12 @@
+package client
+
+const (
+	ImportBundleInputType         = "importBundleInput"
+	ImportBundleInputFieldSecrets = "secrets"
+	ImportBundleInputFieldYAML    = "yaml"
+)
+
+type ImportBundleInput struct {
+	Secrets map[string]string `json:"secrets,omitempty" yaml:"secrets,omitempty"`
+	YAML    string            `json:"yaml,omitempty" yaml:"yaml,omitempty"`
+}
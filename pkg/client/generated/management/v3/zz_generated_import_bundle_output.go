@@ -0,0 +1,14 @@
+package client
+
+const (
+	ImportBundleOutputType         = "importBundleOutput"
+	ImportBundleOutputFieldCreated = "created"
+	ImportBundleOutputFieldErrors  = "errors"
+	ImportBundleOutputFieldSkipped = "skipped"
+)
+
+type ImportBundleOutput struct {
+	Created []string `json:"created,omitempty" yaml:"created,omitempty"`
+	Errors  []string `json:"errors,omitempty" yaml:"errors,omitempty"`
+	Skipped []string `json:"skipped,omitempty" yaml:"skipped,omitempty"`
+}
@@ -0,0 +1,10 @@
+package client
+
+const (
+	LocalBackupConfigType      = "localBackupConfig"
+	LocalBackupConfigFieldPath = "path"
+)
+
+type LocalBackupConfig struct {
+	Path string `json:"path,omitempty" yaml:"path,omitempty"`
+}
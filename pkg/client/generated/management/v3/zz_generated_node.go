@@ -28,6 +28,7 @@
 	NodeFieldLimits               = "limits"
 	NodeFieldName                 = "name"
 	NodeFieldNamespaceId          = "namespaceId"
+	NodeFieldNodeDrainPolicyName  = "nodeDrainPolicyName"
 	NodeFieldNodeName             = "nodeName"
 	NodeFieldNodePlan             = "nodePlan"
 	NodeFieldNodePoolID           = "nodePoolId"
@@ -77,6 +78,7 @@ type Node struct {
 	Limits               map[string]string         `json:"limits,omitempty" yaml:"limits,omitempty"`
 	Name                 string                    `json:"name,omitempty" yaml:"name,omitempty"`
 	NamespaceId          string                    `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
+	NodeDrainPolicyName  string                    `json:"nodeDrainPolicyName,omitempty" yaml:"nodeDrainPolicyName,omitempty"`
 	NodeName             string                    `json:"nodeName,omitempty" yaml:"nodeName,omitempty"`
 	NodePlan             *NodePlan                 `json:"nodePlan,omitempty" yaml:"nodePlan,omitempty"`
 	NodePoolID           string                    `json:"nodePoolId,omitempty" yaml:"nodePoolId,omitempty"`
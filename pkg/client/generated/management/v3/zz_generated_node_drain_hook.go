@@ -0,0 +1,18 @@
+package client
+
+const (
+	NodeDrainHookType                = "nodeDrainHook"
+	NodeDrainHookFieldCACerts        = "caCerts"
+	NodeDrainHookFieldIgnoreFailure  = "ignoreFailure"
+	NodeDrainHookFieldName           = "name"
+	NodeDrainHookFieldTimeoutSeconds = "timeoutSeconds"
+	NodeDrainHookFieldURL            = "url"
+)
+
+type NodeDrainHook struct {
+	CACerts        string `json:"caCerts,omitempty" yaml:"caCerts,omitempty"`
+	IgnoreFailure  bool   `json:"ignoreFailure,omitempty" yaml:"ignoreFailure,omitempty"`
+	Name           string `json:"name,omitempty" yaml:"name,omitempty"`
+	TimeoutSeconds int64  `json:"timeoutSeconds,omitempty" yaml:"timeoutSeconds,omitempty"`
+	URL            string `json:"url,omitempty" yaml:"url,omitempty"`
+}
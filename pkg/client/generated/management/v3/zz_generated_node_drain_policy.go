@@ -0,0 +1,22 @@
+package client
+
+const (
+	NodeDrainPolicyType                            = "nodeDrainPolicy"
+	NodeDrainPolicyFieldIgnorePodDisruptionBudgets = "ignorePodDisruptionBudgets"
+	NodeDrainPolicyFieldMaxWaitSeconds             = "maxWaitSeconds"
+	NodeDrainPolicyFieldName                       = "name"
+	NodeDrainPolicyFieldOrderByPriority            = "orderByPriority"
+	NodeDrainPolicyFieldPostDrainHooks             = "postDrainHooks"
+	NodeDrainPolicyFieldPreDrainHooks              = "preDrainHooks"
+	NodeDrainPolicyFieldSkipNamespaces             = "skipNamespaces"
+)
+
+type NodeDrainPolicy struct {
+	IgnorePodDisruptionBudgets bool            `json:"ignorePodDisruptionBudgets,omitempty" yaml:"ignorePodDisruptionBudgets,omitempty"`
+	MaxWaitSeconds             int64           `json:"maxWaitSeconds,omitempty" yaml:"maxWaitSeconds,omitempty"`
+	Name                       string          `json:"name,omitempty" yaml:"name,omitempty"`
+	OrderByPriority            bool            `json:"orderByPriority,omitempty" yaml:"orderByPriority,omitempty"`
+	PostDrainHooks             []NodeDrainHook `json:"postDrainHooks,omitempty" yaml:"postDrainHooks,omitempty"`
+	PreDrainHooks              []NodeDrainHook `json:"preDrainHooks,omitempty" yaml:"preDrainHooks,omitempty"`
+	SkipNamespaces             []string        `json:"skipNamespaces,omitempty" yaml:"skipNamespaces,omitempty"`
+}
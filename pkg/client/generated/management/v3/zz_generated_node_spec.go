@@ -12,6 +12,7 @@
 	NodeSpecFieldImported                 = "imported"
 	NodeSpecFieldMetadataUpdate           = "metadataUpdate"
 	NodeSpecFieldNodeDrainInput           = "nodeDrainInput"
+	NodeSpecFieldNodeDrainPolicyName      = "nodeDrainPolicyName"
 	NodeSpecFieldNodePoolID               = "nodePoolId"
 	NodeSpecFieldNodeTemplateID           = "nodeTemplateId"
 	NodeSpecFieldPodCidr                  = "podCidr"
@@ -35,6 +36,7 @@ type NodeSpec struct {
 	Imported                 bool            `json:"imported,omitempty" yaml:"imported,omitempty"`
 	MetadataUpdate           *MetadataUpdate `json:"metadataUpdate,omitempty" yaml:"metadataUpdate,omitempty"`
 	NodeDrainInput           *NodeDrainInput `json:"nodeDrainInput,omitempty" yaml:"nodeDrainInput,omitempty"`
+	NodeDrainPolicyName      string          `json:"nodeDrainPolicyName,omitempty" yaml:"nodeDrainPolicyName,omitempty"`
 	NodePoolID               string          `json:"nodePoolId,omitempty" yaml:"nodePoolId,omitempty"`
 	NodeTemplateID           string          `json:"nodeTemplateId,omitempty" yaml:"nodeTemplateId,omitempty"`
 	PodCidr                  string          `json:"podCidr,omitempty" yaml:"podCidr,omitempty"`
@@ -12,10 +12,13 @@
 	NotifierFieldCreatorID            = "creatorId"
 	NotifierFieldDescription          = "description"
 	NotifierFieldDingtalkConfig       = "dingtalkConfig"
+	NotifierFieldHTTPTemplateConfig   = "httpTemplateConfig"
 	NotifierFieldLabels               = "labels"
 	NotifierFieldMSTeamsConfig        = "msteamsConfig"
+	NotifierFieldMattermostConfig     = "mattermostConfig"
 	NotifierFieldName                 = "name"
 	NotifierFieldNamespaceId          = "namespaceId"
+	NotifierFieldOpsgenieConfig       = "opsgenieConfig"
 	NotifierFieldOwnerReferences      = "ownerReferences"
 	NotifierFieldPagerdutyConfig      = "pagerdutyConfig"
 	NotifierFieldRemoved              = "removed"
@@ -24,6 +27,7 @@
 	NotifierFieldSlackConfig          = "slackConfig"
 	NotifierFieldState                = "state"
 	NotifierFieldStatus               = "status"
+	NotifierFieldTelegramConfig       = "telegramConfig"
 	NotifierFieldTransitioning        = "transitioning"
 	NotifierFieldTransitioningMessage = "transitioningMessage"
 	NotifierFieldUUID                 = "uuid"
@@ -33,29 +37,33 @@
 
 type Notifier struct {
 	types.Resource
-	Annotations          map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
-	ClusterID            string            `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
-	Created              string            `json:"created,omitempty" yaml:"created,omitempty"`
-	CreatorID            string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
-	Description          string            `json:"description,omitempty" yaml:"description,omitempty"`
-	DingtalkConfig       *DingtalkConfig   `json:"dingtalkConfig,omitempty" yaml:"dingtalkConfig,omitempty"`
-	Labels               map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
-	MSTeamsConfig        *MSTeamsConfig    `json:"msteamsConfig,omitempty" yaml:"msteamsConfig,omitempty"`
-	Name                 string            `json:"name,omitempty" yaml:"name,omitempty"`
-	NamespaceId          string            `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
-	OwnerReferences      []OwnerReference  `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
-	PagerdutyConfig      *PagerdutyConfig  `json:"pagerdutyConfig,omitempty" yaml:"pagerdutyConfig,omitempty"`
-	Removed              string            `json:"removed,omitempty" yaml:"removed,omitempty"`
-	SMTPConfig           *SMTPConfig       `json:"smtpConfig,omitempty" yaml:"smtpConfig,omitempty"`
-	SendResolved         bool              `json:"sendResolved,omitempty" yaml:"sendResolved,omitempty"`
-	SlackConfig          *SlackConfig      `json:"slackConfig,omitempty" yaml:"slackConfig,omitempty"`
-	State                string            `json:"state,omitempty" yaml:"state,omitempty"`
-	Status               *NotifierStatus   `json:"status,omitempty" yaml:"status,omitempty"`
-	Transitioning        string            `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
-	TransitioningMessage string            `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
-	UUID                 string            `json:"uuid,omitempty" yaml:"uuid,omitempty"`
-	WebhookConfig        *WebhookConfig    `json:"webhookConfig,omitempty" yaml:"webhookConfig,omitempty"`
-	WechatConfig         *WechatConfig     `json:"wechatConfig,omitempty" yaml:"wechatConfig,omitempty"`
+	Annotations          map[string]string   `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	ClusterID            string              `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	Created              string              `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID            string              `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	Description          string              `json:"description,omitempty" yaml:"description,omitempty"`
+	DingtalkConfig       *DingtalkConfig     `json:"dingtalkConfig,omitempty" yaml:"dingtalkConfig,omitempty"`
+	HTTPTemplateConfig   *HTTPTemplateConfig `json:"httpTemplateConfig,omitempty" yaml:"httpTemplateConfig,omitempty"`
+	Labels               map[string]string   `json:"labels,omitempty" yaml:"labels,omitempty"`
+	MSTeamsConfig        *MSTeamsConfig      `json:"msteamsConfig,omitempty" yaml:"msteamsConfig,omitempty"`
+	MattermostConfig     *MattermostConfig   `json:"mattermostConfig,omitempty" yaml:"mattermostConfig,omitempty"`
+	Name                 string              `json:"name,omitempty" yaml:"name,omitempty"`
+	NamespaceId          string              `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
+	OpsgenieConfig       *OpsgenieConfig     `json:"opsgenieConfig,omitempty" yaml:"opsgenieConfig,omitempty"`
+	OwnerReferences      []OwnerReference    `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	PagerdutyConfig      *PagerdutyConfig    `json:"pagerdutyConfig,omitempty" yaml:"pagerdutyConfig,omitempty"`
+	Removed              string              `json:"removed,omitempty" yaml:"removed,omitempty"`
+	SMTPConfig           *SMTPConfig         `json:"smtpConfig,omitempty" yaml:"smtpConfig,omitempty"`
+	SendResolved         bool                `json:"sendResolved,omitempty" yaml:"sendResolved,omitempty"`
+	SlackConfig          *SlackConfig        `json:"slackConfig,omitempty" yaml:"slackConfig,omitempty"`
+	State                string              `json:"state,omitempty" yaml:"state,omitempty"`
+	Status               *NotifierStatus     `json:"status,omitempty" yaml:"status,omitempty"`
+	TelegramConfig       *TelegramConfig     `json:"telegramConfig,omitempty" yaml:"telegramConfig,omitempty"`
+	Transitioning        string              `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
+	TransitioningMessage string              `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
+	UUID                 string              `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+	WebhookConfig        *WebhookConfig      `json:"webhookConfig,omitempty" yaml:"webhookConfig,omitempty"`
+	WechatConfig         *WechatConfig       `json:"wechatConfig,omitempty" yaml:"wechatConfig,omitempty"`
 }
 
 type NotifierCollection struct {
@@ -0,0 +1,16 @@
+package client
+
+const (
+	OIDCConfigApplyInputType              = "oidcConfigApplyInput"
+	OIDCConfigApplyInputFieldCode         = "code"
+	OIDCConfigApplyInputFieldCodeVerifier = "codeVerifier"
+	OIDCConfigApplyInputFieldEnabled      = "enabled"
+	OIDCConfigApplyInputFieldOIDCConfig   = "oidcConfig"
+)
+
+type OIDCConfigApplyInput struct {
+	Code         string      `json:"code,omitempty" yaml:"code,omitempty"`
+	CodeVerifier string      `json:"codeVerifier,omitempty" yaml:"codeVerifier,omitempty"`
+	Enabled      bool        `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	OIDCConfig   *OIDCConfig `json:"oidcConfig,omitempty" yaml:"oidcConfig,omitempty"`
+}
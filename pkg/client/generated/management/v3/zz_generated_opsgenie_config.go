@@ -0,0 +1,16 @@
+package client
+
+const (
+	OpsgenieConfigType                  = "opsgenieConfig"
+	OpsgenieConfigFieldAPIKey           = "apiKey"
+	OpsgenieConfigFieldAPIURL           = "apiUrl"
+	OpsgenieConfigFieldDefaultRecipient = "defaultRecipient"
+	OpsgenieConfigFieldProxyURL         = "proxyUrl"
+)
+
+type OpsgenieConfig struct {
+	APIKey           string `json:"apiKey,omitempty" yaml:"apiKey,omitempty"`
+	APIURL           string `json:"apiUrl,omitempty" yaml:"apiUrl,omitempty"`
+	DefaultRecipient string `json:"defaultRecipient,omitempty" yaml:"defaultRecipient,omitempty"`
+	ProxyURL         string `json:"proxyUrl,omitempty" yaml:"proxyUrl,omitempty"`
+}
@@ -0,0 +1,10 @@
+package client
+
+const (
+	PodToPodHealthProbeType      = "podToPodHealthProbe"
+	PodToPodHealthProbeFieldPort = "port"
+)
+
+type PodToPodHealthProbe struct {
+	Port int64 `json:"port,omitempty" yaml:"port,omitempty"`
+}
@@ -4,19 +4,25 @@
 	ProjectGroupSpecType                       = "projectGroupSpec"
 	ProjectGroupSpecFieldDescription           = "description"
 	ProjectGroupSpecFieldDisplayName           = "displayName"
+	ProjectGroupSpecFieldEscalation            = "escalation"
 	ProjectGroupSpecFieldGroupIntervalSeconds  = "groupIntervalSeconds"
 	ProjectGroupSpecFieldGroupWaitSeconds      = "groupWaitSeconds"
 	ProjectGroupSpecFieldProjectID             = "projectId"
 	ProjectGroupSpecFieldRecipients            = "recipients"
 	ProjectGroupSpecFieldRepeatIntervalSeconds = "repeatIntervalSeconds"
+	ProjectGroupSpecFieldRoutes                = "routes"
+	ProjectGroupSpecFieldSilences              = "silences"
 )
 
 type ProjectGroupSpec struct {
-	Description           string      `json:"description,omitempty" yaml:"description,omitempty"`
-	DisplayName           string      `json:"displayName,omitempty" yaml:"displayName,omitempty"`
-	GroupIntervalSeconds  int64       `json:"groupIntervalSeconds,omitempty" yaml:"groupIntervalSeconds,omitempty"`
-	GroupWaitSeconds      int64       `json:"groupWaitSeconds,omitempty" yaml:"groupWaitSeconds,omitempty"`
-	ProjectID             string      `json:"projectId,omitempty" yaml:"projectId,omitempty"`
-	Recipients            []Recipient `json:"recipients,omitempty" yaml:"recipients,omitempty"`
-	RepeatIntervalSeconds int64       `json:"repeatIntervalSeconds,omitempty" yaml:"repeatIntervalSeconds,omitempty"`
+	Description           string           `json:"description,omitempty" yaml:"description,omitempty"`
+	DisplayName           string           `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	Escalation            *AlertEscalation `json:"escalation,omitempty" yaml:"escalation,omitempty"`
+	GroupIntervalSeconds  int64            `json:"groupIntervalSeconds,omitempty" yaml:"groupIntervalSeconds,omitempty"`
+	GroupWaitSeconds      int64            `json:"groupWaitSeconds,omitempty" yaml:"groupWaitSeconds,omitempty"`
+	ProjectID             string           `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	Recipients            []Recipient      `json:"recipients,omitempty" yaml:"recipients,omitempty"`
+	RepeatIntervalSeconds int64            `json:"repeatIntervalSeconds,omitempty" yaml:"repeatIntervalSeconds,omitempty"`
+	Routes                []AlertRoute     `json:"routes,omitempty" yaml:"routes,omitempty"`
+	Silences              []AlertSilence   `json:"silences,omitempty" yaml:"silences,omitempty"`
 }
@@ -1,12 +1,14 @@
 package client
 
 const (
-	ProjectResourceQuotaType           = "projectResourceQuota"
-	ProjectResourceQuotaFieldLimit     = "limit"
-	ProjectResourceQuotaFieldUsedLimit = "usedLimit"
+	ProjectResourceQuotaType                 = "projectResourceQuota"
+	ProjectResourceQuotaFieldLimit           = "limit"
+	ProjectResourceQuotaFieldOvercommitRatio = "overcommitRatio"
+	ProjectResourceQuotaFieldUsedLimit       = "usedLimit"
 )
 
 type ProjectResourceQuota struct {
-	Limit     *ResourceQuotaLimit `json:"limit,omitempty" yaml:"limit,omitempty"`
-	UsedLimit *ResourceQuotaLimit `json:"usedLimit,omitempty" yaml:"usedLimit,omitempty"`
+	Limit           *ResourceQuotaLimit `json:"limit,omitempty" yaml:"limit,omitempty"`
+	OvercommitRatio map[string]string   `json:"overcommitRatio,omitempty" yaml:"overcommitRatio,omitempty"`
+	UsedLimit       *ResourceQuotaLimit `json:"usedLimit,omitempty" yaml:"usedLimit,omitempty"`
 }
@@ -0,0 +1,160 @@
+package client
+
+import (
+	"github.com/rancher/norman/types"
+)
+
+const (
+	ResourceQuotaRequestType                      = "resourceQuotaRequest"
+	ResourceQuotaRequestFieldAnnotations          = "annotations"
+	ResourceQuotaRequestFieldCreated              = "created"
+	ResourceQuotaRequestFieldCreatorID            = "creatorId"
+	ResourceQuotaRequestFieldLabels               = "labels"
+	ResourceQuotaRequestFieldLimit                = "limit"
+	ResourceQuotaRequestFieldMessage              = "message"
+	ResourceQuotaRequestFieldName                 = "name"
+	ResourceQuotaRequestFieldNamespaceId          = "namespaceId"
+	ResourceQuotaRequestFieldNamespaceName        = "namespaceName"
+	ResourceQuotaRequestFieldOwnerReferences      = "ownerReferences"
+	ResourceQuotaRequestFieldPhase                = "phase"
+	ResourceQuotaRequestFieldProjectID            = "projectId"
+	ResourceQuotaRequestFieldReason               = "reason"
+	ResourceQuotaRequestFieldRemoved              = "removed"
+	ResourceQuotaRequestFieldReviewMessage        = "reviewMessage"
+	ResourceQuotaRequestFieldReviewedBy           = "reviewedBy"
+	ResourceQuotaRequestFieldSourceNamespaceName  = "sourceNamespaceName"
+	ResourceQuotaRequestFieldState                = "state"
+	ResourceQuotaRequestFieldTransitioning        = "transitioning"
+	ResourceQuotaRequestFieldTransitioningMessage = "transitioningMessage"
+	ResourceQuotaRequestFieldUUID                 = "uuid"
+)
+
+type ResourceQuotaRequest struct {
+	types.Resource
+	Annotations          map[string]string   `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	Created              string              `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID            string              `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	Labels               map[string]string   `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Limit                *ResourceQuotaLimit `json:"limit,omitempty" yaml:"limit,omitempty"`
+	Message              string              `json:"message,omitempty" yaml:"message,omitempty"`
+	Name                 string              `json:"name,omitempty" yaml:"name,omitempty"`
+	NamespaceId          string              `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
+	NamespaceName        string              `json:"namespaceName,omitempty" yaml:"namespaceName,omitempty"`
+	OwnerReferences      []OwnerReference    `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	Phase                string              `json:"phase,omitempty" yaml:"phase,omitempty"`
+	ProjectID            string              `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	Reason               string              `json:"reason,omitempty" yaml:"reason,omitempty"`
+	Removed              string              `json:"removed,omitempty" yaml:"removed,omitempty"`
+	ReviewMessage        string              `json:"reviewMessage,omitempty" yaml:"reviewMessage,omitempty"`
+	ReviewedBy           string              `json:"reviewedBy,omitempty" yaml:"reviewedBy,omitempty"`
+	SourceNamespaceName  string              `json:"sourceNamespaceName,omitempty" yaml:"sourceNamespaceName,omitempty"`
+	State                string              `json:"state,omitempty" yaml:"state,omitempty"`
+	Transitioning        string              `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
+	TransitioningMessage string              `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
+	UUID                 string              `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+}
+
+type ResourceQuotaRequestCollection struct {
+	types.Collection
+	Data   []ResourceQuotaRequest `json:"data,omitempty"`
+	client *ResourceQuotaRequestClient
+}
+
+type ResourceQuotaRequestClient struct {
+	apiClient *Client
+}
+
+type ResourceQuotaRequestOperations interface {
+	List(opts *types.ListOpts) (*ResourceQuotaRequestCollection, error)
+	ListAll(opts *types.ListOpts) (*ResourceQuotaRequestCollection, error)
+	Create(opts *ResourceQuotaRequest) (*ResourceQuotaRequest, error)
+	Update(existing *ResourceQuotaRequest, updates interface{}) (*ResourceQuotaRequest, error)
+	Replace(existing *ResourceQuotaRequest) (*ResourceQuotaRequest, error)
+	ByID(id string) (*ResourceQuotaRequest, error)
+	Delete(container *ResourceQuotaRequest) error
+
+	ActionApprove(resource *ResourceQuotaRequest, input *ResourceQuotaRequestReviewInput) (*ResourceQuotaRequest, error)
+
+	ActionDeny(resource *ResourceQuotaRequest, input *ResourceQuotaRequestReviewInput) (*ResourceQuotaRequest, error)
+}
+
+func newResourceQuotaRequestClient(apiClient *Client) *ResourceQuotaRequestClient {
+	return &ResourceQuotaRequestClient{
+		apiClient: apiClient,
+	}
+}
+
+func (c *ResourceQuotaRequestClient) Create(container *ResourceQuotaRequest) (*ResourceQuotaRequest, error) {
+	resp := &ResourceQuotaRequest{}
+	err := c.apiClient.Ops.DoCreate(ResourceQuotaRequestType, container, resp)
+	return resp, err
+}
+
+func (c *ResourceQuotaRequestClient) Update(existing *ResourceQuotaRequest, updates interface{}) (*ResourceQuotaRequest, error) {
+	resp := &ResourceQuotaRequest{}
+	err := c.apiClient.Ops.DoUpdate(ResourceQuotaRequestType, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *ResourceQuotaRequestClient) Replace(obj *ResourceQuotaRequest) (*ResourceQuotaRequest, error) {
+	resp := &ResourceQuotaRequest{}
+	err := c.apiClient.Ops.DoReplace(ResourceQuotaRequestType, &obj.Resource, obj, resp)
+	return resp, err
+}
+
+func (c *ResourceQuotaRequestClient) List(opts *types.ListOpts) (*ResourceQuotaRequestCollection, error) {
+	resp := &ResourceQuotaRequestCollection{}
+	err := c.apiClient.Ops.DoList(ResourceQuotaRequestType, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (c *ResourceQuotaRequestClient) ListAll(opts *types.ListOpts) (*ResourceQuotaRequestCollection, error) {
+	resp := &ResourceQuotaRequestCollection{}
+	resp, err := c.List(opts)
+	if err != nil {
+		return resp, err
+	}
+	data := resp.Data
+	for next, err := resp.Next(); next != nil && err == nil; next, err = next.Next() {
+		data = append(data, next.Data...)
+		resp = next
+		resp.Data = data
+	}
+	if err != nil {
+		return resp, err
+	}
+	return resp, err
+}
+
+func (cc *ResourceQuotaRequestCollection) Next() (*ResourceQuotaRequestCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &ResourceQuotaRequestCollection{}
+		err := cc.client.apiClient.Ops.DoNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *ResourceQuotaRequestClient) ByID(id string) (*ResourceQuotaRequest, error) {
+	resp := &ResourceQuotaRequest{}
+	err := c.apiClient.Ops.DoByID(ResourceQuotaRequestType, id, resp)
+	return resp, err
+}
+
+func (c *ResourceQuotaRequestClient) Delete(container *ResourceQuotaRequest) error {
+	return c.apiClient.Ops.DoResourceDelete(ResourceQuotaRequestType, &container.Resource)
+}
+
+func (c *ResourceQuotaRequestClient) ActionApprove(resource *ResourceQuotaRequest, input *ResourceQuotaRequestReviewInput) (*ResourceQuotaRequest, error) {
+	resp := &ResourceQuotaRequest{}
+	err := c.apiClient.Ops.DoAction(ResourceQuotaRequestType, "approve", &resource.Resource, input, resp)
+	return resp, err
+}
+
+func (c *ResourceQuotaRequestClient) ActionDeny(resource *ResourceQuotaRequest, input *ResourceQuotaRequestReviewInput) (*ResourceQuotaRequest, error) {
+	resp := &ResourceQuotaRequest{}
+	err := c.apiClient.Ops.DoAction(ResourceQuotaRequestType, "deny", &resource.Resource, input, resp)
+	return resp, err
+}
@@ -0,0 +1,10 @@
+package client
+
+const (
+	ResourceQuotaRequestReviewInputType         = "resourceQuotaRequestReviewInput"
+	ResourceQuotaRequestReviewInputFieldMessage = "message"
+)
+
+type ResourceQuotaRequestReviewInput struct {
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+}
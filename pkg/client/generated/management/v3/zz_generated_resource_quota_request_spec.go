@@ -0,0 +1,18 @@
+package client
+
+const (
+	ResourceQuotaRequestSpecType                     = "resourceQuotaRequestSpec"
+	ResourceQuotaRequestSpecFieldLimit               = "limit"
+	ResourceQuotaRequestSpecFieldNamespaceName       = "namespaceName"
+	ResourceQuotaRequestSpecFieldProjectID           = "projectId"
+	ResourceQuotaRequestSpecFieldReason              = "reason"
+	ResourceQuotaRequestSpecFieldSourceNamespaceName = "sourceNamespaceName"
+)
+
+type ResourceQuotaRequestSpec struct {
+	Limit               *ResourceQuotaLimit `json:"limit,omitempty" yaml:"limit,omitempty"`
+	NamespaceName       string              `json:"namespaceName,omitempty" yaml:"namespaceName,omitempty"`
+	ProjectID           string              `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	Reason              string              `json:"reason,omitempty" yaml:"reason,omitempty"`
+	SourceNamespaceName string              `json:"sourceNamespaceName,omitempty" yaml:"sourceNamespaceName,omitempty"`
+}
@@ -0,0 +1,16 @@
+package client
+
+const (
+	ResourceQuotaRequestStatusType               = "resourceQuotaRequestStatus"
+	ResourceQuotaRequestStatusFieldMessage       = "message"
+	ResourceQuotaRequestStatusFieldPhase         = "phase"
+	ResourceQuotaRequestStatusFieldReviewMessage = "reviewMessage"
+	ResourceQuotaRequestStatusFieldReviewedBy    = "reviewedBy"
+)
+
+type ResourceQuotaRequestStatus struct {
+	Message       string `json:"message,omitempty" yaml:"message,omitempty"`
+	Phase         string `json:"phase,omitempty" yaml:"phase,omitempty"`
+	ReviewMessage string `json:"reviewMessage,omitempty" yaml:"reviewMessage,omitempty"`
+	ReviewedBy    string `json:"reviewedBy,omitempty" yaml:"reviewedBy,omitempty"`
+}
@@ -0,0 +1,10 @@
+package client
+
+const (
+	RestoreDryRunInputType              = "restoreDryRunInput"
+	RestoreDryRunInputFieldEtcdBackupID = "etcdBackupId"
+)
+
+type RestoreDryRunInput struct {
+	EtcdBackupID string `json:"etcdBackupId,omitempty" yaml:"etcdBackupId,omitempty"`
+}
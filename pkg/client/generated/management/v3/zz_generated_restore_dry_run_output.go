@@ -0,0 +1,18 @@
+package client
+
+const (
+	RestoreDryRunOutputType          = "restoreDryRunOutput"
+	RestoreDryRunOutputFieldChecksum = "checksum"
+	RestoreDryRunOutputFieldKeyCount = "keyCount"
+	RestoreDryRunOutputFieldMessage  = "message"
+	RestoreDryRunOutputFieldSize     = "size"
+	RestoreDryRunOutputFieldVerified = "verified"
+)
+
+type RestoreDryRunOutput struct {
+	Checksum string `json:"checksum,omitempty" yaml:"checksum,omitempty"`
+	KeyCount int64  `json:"keyCount,omitempty" yaml:"keyCount,omitempty"`
+	Message  string `json:"message,omitempty" yaml:"message,omitempty"`
+	Size     int64  `json:"size,omitempty" yaml:"size,omitempty"`
+	Verified bool   `json:"verified,omitempty" yaml:"verified,omitempty"`
+}
@@ -0,0 +1,22 @@
+package client
+
+const (
+	SFTPBackupConfigType            = "sftpBackupConfig"
+	SFTPBackupConfigFieldFolder     = "folder"
+	SFTPBackupConfigFieldHost       = "host"
+	SFTPBackupConfigFieldHostKey    = "hostKey"
+	SFTPBackupConfigFieldPassword   = "password"
+	SFTPBackupConfigFieldPort       = "port"
+	SFTPBackupConfigFieldPrivateKey = "privateKey"
+	SFTPBackupConfigFieldUsername   = "username"
+)
+
+type SFTPBackupConfig struct {
+	Folder     string `json:"folder,omitempty" yaml:"folder,omitempty"`
+	Host       string `json:"host,omitempty" yaml:"host,omitempty"`
+	HostKey    string `json:"hostKey,omitempty" yaml:"hostKey,omitempty"`
+	Password   string `json:"password,omitempty" yaml:"password,omitempty"`
+	Port       int64  `json:"port,omitempty" yaml:"port,omitempty"`
+	PrivateKey string `json:"privateKey,omitempty" yaml:"privateKey,omitempty"`
+	Username   string `json:"username,omitempty" yaml:"username,omitempty"`
+}
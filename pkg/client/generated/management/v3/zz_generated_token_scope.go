@@ -0,0 +1,12 @@
+package client
+
+const (
+	TokenScopeType           = "tokenScope"
+	TokenScopeFieldResources = "resources"
+	TokenScopeFieldVerbs     = "verbs"
+)
+
+type TokenScope struct {
+	Resources []string `json:"resources,omitempty" yaml:"resources,omitempty"`
+	Verbs     []string `json:"verbs,omitempty" yaml:"verbs,omitempty"`
+}
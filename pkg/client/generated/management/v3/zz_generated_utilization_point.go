@@ -0,0 +1,14 @@
+package client
+
+const (
+	UtilizationPointType           = "utilizationPoint"
+	UtilizationPointFieldAverage   = "average"
+	UtilizationPointFieldMax       = "max"
+	UtilizationPointFieldTimestamp = "timestamp"
+)
+
+type UtilizationPoint struct {
+	Average   map[string]float64 `json:"average,omitempty" yaml:"average,omitempty"`
+	Max       map[string]float64 `json:"max,omitempty" yaml:"max,omitempty"`
+	Timestamp string             `json:"timestamp,omitempty" yaml:"timestamp,omitempty"`
+}
@@ -0,0 +1,18 @@
+package client
+
+const (
+	OIDCLoginType              = "oidcLogin"
+	OIDCLoginFieldCode         = "code"
+	OIDCLoginFieldCodeVerifier = "codeVerifier"
+	OIDCLoginFieldDescription  = "description"
+	OIDCLoginFieldResponseType = "responseType"
+	OIDCLoginFieldTTLMillis    = "ttl"
+)
+
+type OIDCLogin struct {
+	Code         string `json:"code,omitempty" yaml:"code,omitempty"`
+	CodeVerifier string `json:"codeVerifier,omitempty" yaml:"codeVerifier,omitempty"`
+	Description  string `json:"description,omitempty" yaml:"description,omitempty"`
+	ResponseType string `json:"responseType,omitempty" yaml:"responseType,omitempty"`
+	TTLMillis    int64  `json:"ttl,omitempty" yaml:"ttl,omitempty"`
+}
@@ -9,6 +9,7 @@
 	TokenFieldCreatorID       = "creatorId"
 	TokenFieldCurrent         = "current"
 	TokenFieldDescription     = "description"
+	TokenFieldEffectiveScopes = "effectiveScopes"
 	TokenFieldEnabled         = "enabled"
 	TokenFieldExpired         = "expired"
 	TokenFieldExpiresAt       = "expiresAt"
@@ -16,10 +17,13 @@
 	TokenFieldIsDerived       = "isDerived"
 	TokenFieldLabels          = "labels"
 	TokenFieldLastUpdateTime  = "lastUpdateTime"
+	TokenFieldLastUsedAt      = "lastUsedAt"
+	TokenFieldLastUsedFrom    = "lastUsedFrom"
 	TokenFieldName            = "name"
 	TokenFieldOwnerReferences = "ownerReferences"
 	TokenFieldProviderInfo    = "providerInfo"
 	TokenFieldRemoved         = "removed"
+	TokenFieldScopes          = "scopes"
 	TokenFieldTTLMillis       = "ttl"
 	TokenFieldToken           = "token"
 	TokenFieldUUID            = "uuid"
@@ -35,6 +39,7 @@ type Token struct {
 	CreatorID       string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
 	Current         bool              `json:"current,omitempty" yaml:"current,omitempty"`
 	Description     string            `json:"description,omitempty" yaml:"description,omitempty"`
+	EffectiveScopes []TokenScope      `json:"effectiveScopes,omitempty" yaml:"effectiveScopes,omitempty"`
 	Enabled         *bool             `json:"enabled,omitempty" yaml:"enabled,omitempty"`
 	Expired         bool              `json:"expired,omitempty" yaml:"expired,omitempty"`
 	ExpiresAt       string            `json:"expiresAt,omitempty" yaml:"expiresAt,omitempty"`
@@ -42,10 +47,13 @@ type Token struct {
 	IsDerived       bool              `json:"isDerived,omitempty" yaml:"isDerived,omitempty"`
 	Labels          map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
 	LastUpdateTime  string            `json:"lastUpdateTime,omitempty" yaml:"lastUpdateTime,omitempty"`
+	LastUsedAt      string            `json:"lastUsedAt,omitempty" yaml:"lastUsedAt,omitempty"`
+	LastUsedFrom    string            `json:"lastUsedFrom,omitempty" yaml:"lastUsedFrom,omitempty"`
 	Name            string            `json:"name,omitempty" yaml:"name,omitempty"`
 	OwnerReferences []OwnerReference  `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
 	ProviderInfo    map[string]string `json:"providerInfo,omitempty" yaml:"providerInfo,omitempty"`
 	Removed         string            `json:"removed,omitempty" yaml:"removed,omitempty"`
+	Scopes          []TokenScope      `json:"scopes,omitempty" yaml:"scopes,omitempty"`
 	TTLMillis       int64             `json:"ttl,omitempty" yaml:"ttl,omitempty"`
 	Token           string            `json:"token,omitempty" yaml:"token,omitempty"`
 	UUID            string            `json:"uuid,omitempty" yaml:"uuid,omitempty"`
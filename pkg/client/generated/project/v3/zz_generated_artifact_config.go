@@ -0,0 +1,12 @@
+package client
+
+const (
+	ArtifactConfigType       = "artifactConfig"
+	ArtifactConfigFieldName  = "name"
+	ArtifactConfigFieldPaths = "paths"
+)
+
+type ArtifactConfig struct {
+	Name  string   `json:"name,omitempty" yaml:"name,omitempty"`
+	Paths []string `json:"paths,omitempty" yaml:"paths,omitempty"`
+}
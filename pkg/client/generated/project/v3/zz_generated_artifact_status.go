@@ -0,0 +1,16 @@
+package client
+
+const (
+	ArtifactStatusType         = "artifactStatus"
+	ArtifactStatusFieldCreated = "created"
+	ArtifactStatusFieldName    = "name"
+	ArtifactStatusFieldStage   = "stage"
+	ArtifactStatusFieldStep    = "step"
+)
+
+type ArtifactStatus struct {
+	Created string `json:"created,omitempty" yaml:"created,omitempty"`
+	Name    string `json:"name,omitempty" yaml:"name,omitempty"`
+	Stage   int64  `json:"stage,omitempty" yaml:"stage,omitempty"`
+	Step    int64  `json:"step,omitempty" yaml:"step,omitempty"`
+}
@@ -0,0 +1,14 @@
+package client
+
+const (
+	GitApplyInputType              = "gitApplyInput"
+	GitApplyInputFieldAllowedHosts = "allowedHosts"
+	GitApplyInputFieldKnownHosts   = "knownHosts"
+	GitApplyInputFieldPollInterval = "pollInterval"
+)
+
+type GitApplyInput struct {
+	AllowedHosts []string `json:"allowedHosts,omitempty" yaml:"allowedHosts,omitempty"`
+	KnownHosts   string   `json:"knownHosts,omitempty" yaml:"knownHosts,omitempty"`
+	PollInterval int64    `json:"pollInterval,omitempty" yaml:"pollInterval,omitempty"`
+}
@@ -0,0 +1,14 @@
+package client
+
+const (
+	GitLoginInputType               = "gitLoginInput"
+	GitLoginInputFieldPassword      = "password"
+	GitLoginInputFieldSSHPrivateKey = "sshPrivateKey"
+	GitLoginInputFieldUsername      = "username"
+)
+
+type GitLoginInput struct {
+	Password      string `json:"password,omitempty" yaml:"password,omitempty"`
+	SSHPrivateKey string `json:"sshPrivateKey,omitempty" yaml:"sshPrivateKey,omitempty"`
+	Username      string `json:"username,omitempty" yaml:"username,omitempty"`
+}
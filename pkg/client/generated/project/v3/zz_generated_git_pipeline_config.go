@@ -0,0 +1,38 @@
+package client
+
+const (
+	GitPipelineConfigType                 = "gitPipelineConfig"
+	GitPipelineConfigFieldAllowedHosts    = "allowedHosts"
+	GitPipelineConfigFieldAnnotations     = "annotations"
+	GitPipelineConfigFieldCreated         = "created"
+	GitPipelineConfigFieldCreatorID       = "creatorId"
+	GitPipelineConfigFieldEnabled         = "enabled"
+	GitPipelineConfigFieldKnownHosts      = "knownHosts"
+	GitPipelineConfigFieldLabels          = "labels"
+	GitPipelineConfigFieldName            = "name"
+	GitPipelineConfigFieldNamespaceId     = "namespaceId"
+	GitPipelineConfigFieldOwnerReferences = "ownerReferences"
+	GitPipelineConfigFieldPollInterval    = "pollInterval"
+	GitPipelineConfigFieldProjectID       = "projectId"
+	GitPipelineConfigFieldRemoved         = "removed"
+	GitPipelineConfigFieldType            = "type"
+	GitPipelineConfigFieldUUID            = "uuid"
+)
+
+type GitPipelineConfig struct {
+	AllowedHosts    []string          `json:"allowedHosts,omitempty" yaml:"allowedHosts,omitempty"`
+	Annotations     map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	Created         string            `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID       string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	Enabled         bool              `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	KnownHosts      string            `json:"knownHosts,omitempty" yaml:"knownHosts,omitempty"`
+	Labels          map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Name            string            `json:"name,omitempty" yaml:"name,omitempty"`
+	NamespaceId     string            `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
+	OwnerReferences []OwnerReference  `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	PollInterval    int64             `json:"pollInterval,omitempty" yaml:"pollInterval,omitempty"`
+	ProjectID       string            `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	Removed         string            `json:"removed,omitempty" yaml:"removed,omitempty"`
+	Type            string            `json:"type,omitempty" yaml:"type,omitempty"`
+	UUID            string            `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+}
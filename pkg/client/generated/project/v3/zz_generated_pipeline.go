@@ -11,6 +11,7 @@
 	PipelineFieldCreatorID              = "creatorId"
 	PipelineFieldLabels                 = "labels"
 	PipelineFieldLastExecutionID        = "lastExecutionId"
+	PipelineFieldLastPolled             = "lastPolled"
 	PipelineFieldLastRunState           = "lastRunState"
 	PipelineFieldLastStarted            = "lastStarted"
 	PipelineFieldName                   = "name"
@@ -19,6 +20,7 @@
 	PipelineFieldNextStart              = "nextStart"
 	PipelineFieldOwnerReferences        = "ownerReferences"
 	PipelineFieldPipelineState          = "pipelineState"
+	PipelineFieldPolledRefs             = "polledRefs"
 	PipelineFieldProjectID              = "projectId"
 	PipelineFieldRemoved                = "removed"
 	PipelineFieldRepositoryURL          = "repositoryUrl"
@@ -42,6 +44,7 @@ type Pipeline struct {
 	CreatorID              string                `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
 	Labels                 map[string]string     `json:"labels,omitempty" yaml:"labels,omitempty"`
 	LastExecutionID        string                `json:"lastExecutionId,omitempty" yaml:"lastExecutionId,omitempty"`
+	LastPolled             string                `json:"lastPolled,omitempty" yaml:"lastPolled,omitempty"`
 	LastRunState           string                `json:"lastRunState,omitempty" yaml:"lastRunState,omitempty"`
 	LastStarted            string                `json:"lastStarted,omitempty" yaml:"lastStarted,omitempty"`
 	Name                   string                `json:"name,omitempty" yaml:"name,omitempty"`
@@ -50,6 +53,7 @@ type Pipeline struct {
 	NextStart              string                `json:"nextStart,omitempty" yaml:"nextStart,omitempty"`
 	OwnerReferences        []OwnerReference      `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
 	PipelineState          string                `json:"pipelineState,omitempty" yaml:"pipelineState,omitempty"`
+	PolledRefs             map[string]string     `json:"polledRefs,omitempty" yaml:"polledRefs,omitempty"`
 	ProjectID              string                `json:"projectId,omitempty" yaml:"projectId,omitempty"`
 	Removed                string                `json:"removed,omitempty" yaml:"removed,omitempty"`
 	RepositoryURL          string                `json:"repositoryUrl,omitempty" yaml:"repositoryUrl,omitempty"`
@@ -7,6 +7,8 @@
 const (
 	PipelineExecutionType                      = "pipelineExecution"
 	PipelineExecutionFieldAnnotations          = "annotations"
+	PipelineExecutionFieldApprovedBy           = "approvedBy"
+	PipelineExecutionFieldArtifacts            = "artifacts"
 	PipelineExecutionFieldAuthor               = "author"
 	PipelineExecutionFieldAvatarURL            = "avatarUrl"
 	PipelineExecutionFieldBranch               = "branch"
@@ -18,6 +20,7 @@
 	PipelineExecutionFieldEnded                = "ended"
 	PipelineExecutionFieldEvent                = "event"
 	PipelineExecutionFieldExecutionState       = "executionState"
+	PipelineExecutionFieldFromFork             = "fromFork"
 	PipelineExecutionFieldHTMLLink             = "htmlLink"
 	PipelineExecutionFieldLabels               = "labels"
 	PipelineExecutionFieldMessage              = "message"
@@ -29,6 +32,7 @@
 	PipelineExecutionFieldProjectID            = "projectId"
 	PipelineExecutionFieldRef                  = "ref"
 	PipelineExecutionFieldRemoved              = "removed"
+	PipelineExecutionFieldReportedState        = "reportedState"
 	PipelineExecutionFieldRepositoryURL        = "repositoryUrl"
 	PipelineExecutionFieldRun                  = "run"
 	PipelineExecutionFieldStages               = "stages"
@@ -45,6 +49,8 @@
 type PipelineExecution struct {
 	types.Resource
 	Annotations          map[string]string   `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	ApprovedBy           string              `json:"approvedBy,omitempty" yaml:"approvedBy,omitempty"`
+	Artifacts            []ArtifactStatus    `json:"artifacts,omitempty" yaml:"artifacts,omitempty"`
 	Author               string              `json:"author,omitempty" yaml:"author,omitempty"`
 	AvatarURL            string              `json:"avatarUrl,omitempty" yaml:"avatarUrl,omitempty"`
 	Branch               string              `json:"branch,omitempty" yaml:"branch,omitempty"`
@@ -56,6 +62,7 @@ type PipelineExecution struct {
 	Ended                string              `json:"ended,omitempty" yaml:"ended,omitempty"`
 	Event                string              `json:"event,omitempty" yaml:"event,omitempty"`
 	ExecutionState       string              `json:"executionState,omitempty" yaml:"executionState,omitempty"`
+	FromFork             bool                `json:"fromFork,omitempty" yaml:"fromFork,omitempty"`
 	HTMLLink             string              `json:"htmlLink,omitempty" yaml:"htmlLink,omitempty"`
 	Labels               map[string]string   `json:"labels,omitempty" yaml:"labels,omitempty"`
 	Message              string              `json:"message,omitempty" yaml:"message,omitempty"`
@@ -67,6 +74,7 @@ type PipelineExecution struct {
 	ProjectID            string              `json:"projectId,omitempty" yaml:"projectId,omitempty"`
 	Ref                  string              `json:"ref,omitempty" yaml:"ref,omitempty"`
 	Removed              string              `json:"removed,omitempty" yaml:"removed,omitempty"`
+	ReportedState        string              `json:"reportedState,omitempty" yaml:"reportedState,omitempty"`
 	RepositoryURL        string              `json:"repositoryUrl,omitempty" yaml:"repositoryUrl,omitempty"`
 	Run                  int64               `json:"run,omitempty" yaml:"run,omitempty"`
 	Stages               []StageStatus       `json:"stages,omitempty" yaml:"stages,omitempty"`
@@ -99,6 +107,8 @@ type PipelineExecutionOperations interface {
 	ByID(id string) (*PipelineExecution, error)
 	Delete(container *PipelineExecution) error
 
+	ActionApprove(resource *PipelineExecution) error
+
 	ActionRerun(resource *PipelineExecution) error
 
 	ActionStop(resource *PipelineExecution) error
@@ -173,6 +183,11 @@ func (c *PipelineExecutionClient) Delete(container *PipelineExecution) error {
 	return c.apiClient.Ops.DoResourceDelete(PipelineExecutionType, &container.Resource)
 }
 
+func (c *PipelineExecutionClient) ActionApprove(resource *PipelineExecution) error {
+	err := c.apiClient.Ops.DoAction(PipelineExecutionType, "approve", &resource.Resource, nil, nil)
+	return err
+}
+
 func (c *PipelineExecutionClient) ActionRerun(resource *PipelineExecution) error {
 	err := c.apiClient.Ops.DoAction(PipelineExecutionType, "rerun", &resource.Resource, nil, nil)
 	return err
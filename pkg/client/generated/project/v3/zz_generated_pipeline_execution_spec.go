@@ -8,6 +8,7 @@
 	PipelineExecutionSpecFieldCommit         = "commit"
 	PipelineExecutionSpecFieldEmail          = "email"
 	PipelineExecutionSpecFieldEvent          = "event"
+	PipelineExecutionSpecFieldFromFork       = "fromFork"
 	PipelineExecutionSpecFieldHTMLLink       = "htmlLink"
 	PipelineExecutionSpecFieldMessage        = "message"
 	PipelineExecutionSpecFieldPipelineConfig = "pipelineConfig"
@@ -28,6 +29,7 @@ type PipelineExecutionSpec struct {
 	Commit         string          `json:"commit,omitempty" yaml:"commit,omitempty"`
 	Email          string          `json:"email,omitempty" yaml:"email,omitempty"`
 	Event          string          `json:"event,omitempty" yaml:"event,omitempty"`
+	FromFork       bool            `json:"fromFork,omitempty" yaml:"fromFork,omitempty"`
 	HTMLLink       string          `json:"htmlLink,omitempty" yaml:"htmlLink,omitempty"`
 	Message        string          `json:"message,omitempty" yaml:"message,omitempty"`
 	PipelineConfig *PipelineConfig `json:"pipelineConfig,omitempty" yaml:"pipelineConfig,omitempty"`
@@ -2,17 +2,23 @@
 
 const (
 	PipelineExecutionStatusType                = "pipelineExecutionStatus"
+	PipelineExecutionStatusFieldApprovedBy     = "approvedBy"
+	PipelineExecutionStatusFieldArtifacts      = "artifacts"
 	PipelineExecutionStatusFieldConditions     = "conditions"
 	PipelineExecutionStatusFieldEnded          = "ended"
 	PipelineExecutionStatusFieldExecutionState = "executionState"
+	PipelineExecutionStatusFieldReportedState  = "reportedState"
 	PipelineExecutionStatusFieldStages         = "stages"
 	PipelineExecutionStatusFieldStarted        = "started"
 )
 
 type PipelineExecutionStatus struct {
+	ApprovedBy     string              `json:"approvedBy,omitempty" yaml:"approvedBy,omitempty"`
+	Artifacts      []ArtifactStatus    `json:"artifacts,omitempty" yaml:"artifacts,omitempty"`
 	Conditions     []PipelineCondition `json:"conditions,omitempty" yaml:"conditions,omitempty"`
 	Ended          string              `json:"ended,omitempty" yaml:"ended,omitempty"`
 	ExecutionState string              `json:"executionState,omitempty" yaml:"executionState,omitempty"`
+	ReportedState  string              `json:"reportedState,omitempty" yaml:"reportedState,omitempty"`
 	Stages         []StageStatus       `json:"stages,omitempty" yaml:"stages,omitempty"`
 	Started        string              `json:"started,omitempty" yaml:"started,omitempty"`
 }
@@ -3,11 +3,13 @@
 const (
 	PipelineStatusType                      = "pipelineStatus"
 	PipelineStatusFieldLastExecutionID      = "lastExecutionId"
+	PipelineStatusFieldLastPolled           = "lastPolled"
 	PipelineStatusFieldLastRunState         = "lastRunState"
 	PipelineStatusFieldLastStarted          = "lastStarted"
 	PipelineStatusFieldNextRun              = "nextRun"
 	PipelineStatusFieldNextStart            = "nextStart"
 	PipelineStatusFieldPipelineState        = "pipelineState"
+	PipelineStatusFieldPolledRefs           = "polledRefs"
 	PipelineStatusFieldSourceCodeCredential = "sourceCodeCredential"
 	PipelineStatusFieldToken                = "token"
 	PipelineStatusFieldWebHookID            = "webhookId"
@@ -15,11 +17,13 @@
 
 type PipelineStatus struct {
 	LastExecutionID      string                `json:"lastExecutionId,omitempty" yaml:"lastExecutionId,omitempty"`
+	LastPolled           string                `json:"lastPolled,omitempty" yaml:"lastPolled,omitempty"`
 	LastRunState         string                `json:"lastRunState,omitempty" yaml:"lastRunState,omitempty"`
 	LastStarted          string                `json:"lastStarted,omitempty" yaml:"lastStarted,omitempty"`
 	NextRun              int64                 `json:"nextRun,omitempty" yaml:"nextRun,omitempty"`
 	NextStart            string                `json:"nextStart,omitempty" yaml:"nextStart,omitempty"`
 	PipelineState        string                `json:"pipelineState,omitempty" yaml:"pipelineState,omitempty"`
+	PolledRefs           map[string]string     `json:"polledRefs,omitempty" yaml:"polledRefs,omitempty"`
 	SourceCodeCredential *SourceCodeCredential `json:"sourceCodeCredential,omitempty" yaml:"sourceCodeCredential,omitempty"`
 	Token                string                `json:"token,omitempty" yaml:"token,omitempty"`
 	WebHookID            string                `json:"webhookId,omitempty" yaml:"webhookId,omitempty"`
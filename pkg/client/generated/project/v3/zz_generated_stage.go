@@ -1,14 +1,20 @@
 package client
 
 const (
-	StageType       = "stage"
-	StageFieldName  = "name"
-	StageFieldSteps = "steps"
-	StageFieldWhen  = "when"
+	StageType           = "stage"
+	StageFieldArtifacts = "artifacts"
+	StageFieldCache     = "cache"
+	StageFieldMatrix    = "matrix"
+	StageFieldName      = "name"
+	StageFieldSteps     = "steps"
+	StageFieldWhen      = "when"
 )
 
 type Stage struct {
-	Name  string       `json:"name,omitempty" yaml:"name,omitempty"`
-	Steps []Step       `json:"steps,omitempty" yaml:"steps,omitempty"`
-	When  *Constraints `json:"when,omitempty" yaml:"when,omitempty"`
+	Artifacts []ArtifactConfig    `json:"artifacts,omitempty" yaml:"artifacts,omitempty"`
+	Cache     *CacheConfig        `json:"cache,omitempty" yaml:"cache,omitempty"`
+	Matrix    map[string][]string `json:"matrix,omitempty" yaml:"matrix,omitempty"`
+	Name      string              `json:"name,omitempty" yaml:"name,omitempty"`
+	Steps     []Step              `json:"steps,omitempty" yaml:"steps,omitempty"`
+	When      *Constraints        `json:"when,omitempty" yaml:"when,omitempty"`
 }
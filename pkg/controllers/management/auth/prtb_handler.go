@@ -35,6 +35,7 @@
 	"projectcatalogs":             "management.cattle.io",
 	"projectmonitorgraphs":        "management.cattle.io",
 	"projectroletemplatebindings": "management.cattle.io",
+	"resourcequotarequests":       "management.cattle.io",
 	"secrets":                     "",
 }
 var prtbClusterManagmentPlaneResources = map[string]string{
@@ -17,6 +17,7 @@
 	"github.com/rancher/norman/types/convert"
 	client "github.com/rancher/rancher/pkg/client/generated/project/v3"
 	"github.com/rancher/rancher/pkg/controllers/management/clusterprovisioner"
+	"github.com/rancher/rancher/pkg/encryptedstore"
 	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/kontainer-engine/service"
@@ -46,6 +47,7 @@ type controller struct {
 	kontainerDriverLister v3.KontainerDriverLister
 	namespaces            v1.NamespaceInterface
 	coreV1                v1.Interface
+	secretsKeys           encryptedstore.KeyProviders
 	capabilitiesSchema    *normantypes.Schema
 }
 
@@ -56,6 +58,7 @@ func Register(ctx context.Context, management *config.ManagementContext) {
 		kontainerDriverLister: management.Management.KontainerDrivers("").Controller().Lister(),
 		namespaces:            management.Core.Namespaces(""),
 		coreV1:                management.Core,
+		secretsKeys:           management.SecretsEncryptionKeys,
 		capabilitiesSchema:    management.Schemas.Schema(&managementschema.Version, client.CapabilitiesType).InternalSchema,
 	}
 
@@ -102,7 +105,7 @@ func (c *controller) capsSync(key string, cluster *v3.Cluster) (runtime.Object,
 		}
 
 		driver := service.NewEngineService(
-			clusterprovisioner.NewPersistentStore(c.namespaces, c.coreV1),
+			clusterprovisioner.NewPersistentStore(c.namespaces, c.coreV1, c.secretsKeys),
 		)
 		k8sCapabilities, err := driver.GetK8sCapabilities(context.Background(), kontainerDriver.Name, kontainerDriver,
 			cluster.Spec)
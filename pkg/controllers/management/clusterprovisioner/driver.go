@@ -130,6 +130,14 @@ func (p *Provisioner) driverRestore(cluster *v3.Cluster, spec v32.ClusterSpec, s
 
 }
 
+func (p *Provisioner) driverDownloadSnapshot(cluster *v3.Cluster, backup *v3.EtcdBackup) error {
+	ctx, logger := clusterprovisioninglogger.NewLogger(p.Clusters, cluster, v32.ClusterConditionUpdated)
+	defer logger.Close()
+
+	logrus.Infof("Downloading etcd snapshot [%s] of cluster [%s] from its backup target", backup.Name, cluster.Name)
+	return p.snapshots.Download(ctx, cluster.Name, backup.Target, backup.Spec.Filename)
+}
+
 func (p *Provisioner) generateServiceAccount(cluster *v3.Cluster, spec v32.ClusterSpec) (string, error) {
 	ctx, logger := clusterprovisioninglogger.NewLogger(p.Clusters, cluster, v32.ClusterConditionUpdated)
 	defer logger.Close()
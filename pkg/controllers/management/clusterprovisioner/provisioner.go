@@ -16,6 +16,7 @@
 	"github.com/rancher/norman/types/slice"
 	"github.com/rancher/norman/types/values"
 	apimgmtv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/backuptarget"
 	util "github.com/rancher/rancher/pkg/cluster"
 	kd "github.com/rancher/rancher/pkg/controllers/management/kontainerdrivermetadata"
 	v1 "github.com/rancher/rancher/pkg/generated/norman/apps/v1"
@@ -56,11 +57,13 @@ type Provisioner struct {
 	Backups               v3.EtcdBackupLister
 	RKESystemImages       v3.RkeK8sSystemImageInterface
 	RKESystemImagesLister v3.RkeK8sSystemImageLister
+	snapshots             *backuptarget.Snapshots
 }
 
 func Register(ctx context.Context, management *config.ManagementContext) {
+	store := NewPersistentStore(management.Core.Namespaces(""), management.Core, management.SecretsEncryptionKeys)
 	p := &Provisioner{
-		engineService:         service.NewEngineService(NewPersistentStore(management.Core.Namespaces(""), management.Core)),
+		engineService:         service.NewEngineService(store),
 		Clusters:              management.Management.Clusters(""),
 		ClusterController:     management.Management.Clusters("").Controller(),
 		NodeLister:            management.Management.Nodes("").Controller().Lister(),
@@ -87,6 +90,8 @@ func Register(ctx context.Context, management *config.ManagementContext) {
 		Ctx:     ctx,
 	}
 
+	p.snapshots = backuptarget.NewSnapshots(store, docker.Build, management.Core.Secrets("").Controller().Lister())
+
 	driver := service.Drivers[service.RancherKubernetesEngineDriverName]
 	rkeDriver := driver.(*rke.Driver)
 	rkeDriver.DockerDialer = docker.Build
@@ -951,6 +956,17 @@ func (p *Provisioner) restoreClusterBackup(cluster *v3.Cluster, spec apimgmtv3.C
 	if backup.Spec.ClusterID != cluster.Name {
 		return "", "", "", fmt.Errorf("snapshot [%s] is not a backup of cluster [%s]", backup.Name, cluster.Name)
 	}
+	if backup.Target != nil {
+		// snapshots uploaded by Rancher are restored as local snapshots once they are copied back to the etcd nodes
+		if err := p.driverDownloadSnapshot(cluster, backup); err != nil {
+			return "", "", "", err
+		}
+		spec = *spec.DeepCopy()
+		spec.RancherKubernetesEngineConfig.Services.Etcd.BackupConfig = spec.RancherKubernetesEngineConfig.Services.Etcd.BackupConfig.DeepCopy()
+		if spec.RancherKubernetesEngineConfig.Services.Etcd.BackupConfig != nil {
+			spec.RancherKubernetesEngineConfig.Services.Etcd.BackupConfig.S3BackupConfig = nil
+		}
+	}
 
 	api, token, cert, err = p.driverRestore(cluster, spec, GetBackupFilename(backup))
 	if err != nil {
@@ -13,8 +13,13 @@
 	dataKey = "cluster"
 )
 
-func NewPersistentStore(namespaces v1.NamespaceInterface, secretsGetter v1.SecretsGetter) cluster.PersistentStore {
-	store, err := encryptedstore.NewGenericEncrypedStore("c-", "", namespaces, secretsGetter)
+// NewStore returns the encrypted store that cluster states of kontainer engine drivers are kept in.
+func NewStore(namespaces v1.NamespaceInterface, secretsGetter v1.SecretsGetter, keys encryptedstore.KeyProviders) (*encryptedstore.GenericEncryptedStore, error) {
+	return encryptedstore.NewGenericEncrypedStore("c-", "", namespaces, secretsGetter, keys)
+}
+
+func NewPersistentStore(namespaces v1.NamespaceInterface, secretsGetter v1.SecretsGetter, keys encryptedstore.KeyProviders) cluster.PersistentStore {
+	store, err := NewStore(namespaces, secretsGetter, keys)
 	if err != nil {
 		logrus.Fatal(err)
 	}
@@ -0,0 +1,242 @@
+package clusterstats
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/clustermanager"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/ref"
+	"github.com/rancher/rancher/pkg/utilization"
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	sampleInterval      = 5 * time.Minute
+	projectIDAnnotation = "field.cattle.io/projectId"
+)
+
+// historyRecorder samples the capacity and the utilization of the clusters, their node pools and their projects into
+// the utilization store, the store keeps the history Cluster.Status does not.
+type historyRecorder struct {
+	nodesLister    v3.NodeLister
+	nodePoolLister v3.NodePoolLister
+	projectLister  v3.ProjectLister
+	clusters       v3.ClusterInterface
+	clusterManager *clustermanager.Manager
+	store          *utilization.Store
+
+	mu           sync.Mutex
+	lastRecorded map[string]time.Time
+}
+
+func (h *historyRecorder) sync(key string, cluster *v3.Cluster) (runtime.Object, error) {
+	if cluster == nil || cluster.DeletionTimestamp != nil {
+		h.mu.Lock()
+		delete(h.lastRecorded, key)
+		h.mu.Unlock()
+		h.store.Forget(key)
+		return nil, nil
+	}
+	if wait := h.nextSample(cluster.Name); wait > 0 {
+		h.clusters.Controller().EnqueueAfter("", cluster.Name, wait)
+		return nil, nil
+	}
+	defer h.clusters.Controller().EnqueueAfter("", cluster.Name, sampleInterval)
+	if !v32.ClusterConditionReady.IsTrue(cluster) {
+		return nil, nil
+	}
+
+	// samples are taken at the start of their interval, so that a sample recorded again after an error is ignored by
+	// the objects it was already recorded for
+	now := time.Now()
+	if err := h.record(cluster, now.Truncate(sampleInterval)); err != nil {
+		return nil, err
+	}
+
+	h.mu.Lock()
+	h.lastRecorded[cluster.Name] = now
+	h.mu.Unlock()
+	return nil, nil
+}
+
+// nextSample returns how long to wait before the next sample of the cluster, handlers run on every change of the
+// cluster but it is only sampled once per interval.
+func (h *historyRecorder) nextSample(clusterName string) time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	last, ok := h.lastRecorded[clusterName]
+	if !ok {
+		return 0
+	}
+	return sampleInterval - time.Since(last)
+}
+
+func (h *historyRecorder) record(cluster *v3.Cluster, now time.Time) error {
+	values := map[string]float64{}
+	addResources(values, "capacity", cluster.Status.Capacity)
+	addResources(values, "allocatable", cluster.Status.Allocatable)
+	addResources(values, "requested", cluster.Status.Requested)
+	addResources(values, "limits", cluster.Status.Limits)
+	if err := h.store.Record(cluster.Name, utilization.KindCluster, cluster.Name, nil, now, values); err != nil {
+		return err
+	}
+
+	if err := h.recordNodePools(cluster, now); err != nil {
+		return err
+	}
+
+	if err := h.recordProjects(cluster, now); err != nil {
+		// the cluster and its node pools are recorded from the management cluster, only projects need the cluster
+		logrus.Warnf("[clusterstats] failed to record the utilization of the projects of cluster [%s]: %v", cluster.Name, err)
+	}
+	return nil
+}
+
+func (h *historyRecorder) recordNodePools(cluster *v3.Cluster, now time.Time) error {
+	nodes, err := h.nodesLister.List(cluster.Name, labels.Everything())
+	if err != nil {
+		return err
+	}
+	pools := map[string]map[string]float64{}
+	for _, node := range nodes {
+		if node.Spec.NodePoolName == "" {
+			continue
+		}
+		_, poolName := ref.Parse(node.Spec.NodePoolName)
+		values, ok := pools[poolName]
+		if !ok {
+			values = map[string]float64{}
+			pools[poolName] = values
+		}
+		addResources(values, "capacity", node.Status.InternalNodeStatus.Capacity)
+		addResources(values, "allocatable", node.Status.InternalNodeStatus.Allocatable)
+		addResources(values, "requested", node.Status.Requested)
+		addResources(values, "limits", node.Status.Limits)
+	}
+
+	for poolName, values := range pools {
+		pool, err := h.nodePoolLister.Get(cluster.Name, poolName)
+		if apierrors.IsNotFound(err) {
+			// the nodes of a deleted pool are removed after it
+			continue
+		} else if err != nil {
+			return err
+		}
+		owner := &metav1.OwnerReference{
+			APIVersion: v3.NodePoolGroupVersionKind.GroupVersion().String(),
+			Kind:       v3.NodePoolGroupVersionKind.Kind,
+			Name:       pool.Name,
+			UID:        pool.UID,
+		}
+		if err := h.store.Record(cluster.Name, utilization.KindNodePool, poolName, owner, now, values); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *historyRecorder) recordProjects(cluster *v3.Cluster, now time.Time) error {
+	projects, err := h.projectLister.List(cluster.Name, labels.Everything())
+	if err != nil || len(projects) == 0 {
+		return err
+	}
+
+	userContext, err := h.clusterManager.UserContext(cluster.Name)
+	if err != nil {
+		return err
+	}
+	// the lists are served from the cache of the api server
+	namespaces, err := userContext.Core.Namespaces("").List(metav1.ListOptions{ResourceVersion: "0"})
+	if err != nil {
+		return err
+	}
+	pods, err := userContext.Core.Pods("").List(metav1.ListOptions{ResourceVersion: "0"})
+	if err != nil {
+		return err
+	}
+
+	projectByNamespace := map[string]string{}
+	for _, ns := range namespaces.Items {
+		if projectID := ns.Annotations[projectIDAnnotation]; projectID != "" {
+			_, projectByNamespace[ns.Name] = ref.Parse(projectID)
+		}
+	}
+	byProject := map[string]map[string]float64{}
+	for _, project := range projects {
+		byProject[project.Name] = map[string]float64{}
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+			continue
+		}
+		values, ok := byProject[projectByNamespace[pod.Namespace]]
+		if !ok {
+			continue
+		}
+		requests, limits := podRequestsAndLimits(pod)
+		addResources(values, "requested", requests)
+		addResources(values, "limits", limits)
+		values["pods.requested"]++
+	}
+
+	for _, project := range projects {
+		owner := &metav1.OwnerReference{
+			APIVersion: v3.ProjectGroupVersionKind.GroupVersion().String(),
+			Kind:       v3.ProjectGroupVersionKind.Kind,
+			Name:       project.Name,
+			UID:        project.UID,
+		}
+		if err := h.store.Record(cluster.Name, utilization.KindProject, project.Name, owner, now, byProject[project.Name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addResources adds the cpu, memory and pods of the list to the metrics of the kind, cpu is in cores and memory in
+// bytes.
+func addResources(values map[string]float64, kind string, list v1.ResourceList) {
+	values[fmt.Sprintf("cpu.%s", kind)] += float64(list.Cpu().MilliValue()) / 1000
+	values[fmt.Sprintf("memory.%s", kind)] += float64(list.Memory().Value())
+	values[fmt.Sprintf("pods.%s", kind)] += float64(list.Pods().Value())
+}
+
+// podRequestsAndLimits returns the resources of the pod the scheduler accounts for, an init container counts for its
+// largest request.
+func podRequestsAndLimits(pod *v1.Pod) (v1.ResourceList, v1.ResourceList) {
+	requests, limits := v1.ResourceList{}, v1.ResourceList{}
+	for _, container := range pod.Spec.Containers {
+		addList(requests, container.Resources.Requests)
+		addList(limits, container.Resources.Limits)
+	}
+	for _, container := range pod.Spec.InitContainers {
+		maxList(requests, container.Resources.Requests)
+		maxList(limits, container.Resources.Limits)
+	}
+	return requests, limits
+}
+
+func addList(dst, src v1.ResourceList) {
+	for name, quantity := range src {
+		value := dst[name]
+		value.Add(quantity)
+		dst[name] = value
+	}
+}
+
+func maxList(dst, src v1.ResourceList) {
+	for name, quantity := range src {
+		if value, ok := dst[name]; !ok || quantity.Cmp(value) > 0 {
+			dst[name] = quantity.DeepCopy()
+		}
+	}
+}
@@ -13,6 +13,7 @@
 	"github.com/rancher/rancher/pkg/clustermanager"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/rancher/rancher/pkg/utilization"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/labels"
@@ -52,6 +53,17 @@ func Register(ctx context.Context, management *config.ManagementContext, cluster
 
 	clustersClient.AddHandler(ctx, "cluster-stats", s.sync)
 	machinesClient.AddHandler(ctx, "cluster-stats", s.machineChanged)
+
+	h := &historyRecorder{
+		nodesLister:    machinesClient.Controller().Lister(),
+		nodePoolLister: management.Management.NodePools("").Controller().Lister(),
+		projectLister:  management.Management.Projects("").Controller().Lister(),
+		clusters:       clustersClient,
+		clusterManager: clusterManager,
+		store:          utilization.NewStore(management.Core.ConfigMaps("")),
+		lastRecorded:   map[string]time.Time{},
+	}
+	clustersClient.AddHandler(ctx, "cluster-stats-history", h.sync)
 }
 
 func (s *StatsAggregator) sync(key string, cluster *v3.Cluster) (runtime.Object, error) {
@@ -13,6 +13,7 @@
 	"github.com/rancher/rancher/pkg/controllers/management/clusterprovisioner"
 	"github.com/rancher/rancher/pkg/controllers/management/drivers"
 	"github.com/rancher/rancher/pkg/controllers/management/drivers/nodedriver"
+	"github.com/rancher/rancher/pkg/encryptedstore"
 	corev1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
 	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
@@ -38,6 +39,7 @@ func Register(ctx context.Context, management *config.ManagementContext) {
 		dynamicSchemasLister: management.Management.DynamicSchemas("").Controller().Lister(),
 		namespaces:           management.Core.Namespaces(""),
 		coreV1:               management.Core,
+		secretsKeys:          management.SecretsEncryptionKeys,
 	}
 
 	management.Management.KontainerDrivers("").AddLifecycle(ctx, "mgmt-kontainer-driver-lifecycle", lifecycle)
@@ -48,6 +50,7 @@ type Lifecycle struct {
 	dynamicSchemasLister v3.DynamicSchemaLister
 	namespaces           v1.NamespaceInterface
 	coreV1               corev1.Interface
+	secretsKeys          encryptedstore.KeyProviders
 }
 
 func (l *Lifecycle) Create(obj *v3.KontainerDriver) (runtime.Object, error) {
@@ -177,7 +180,7 @@ func (l *Lifecycle) updateDynamicSchema(dynamicSchema *v3.DynamicSchema, obj *v3
 
 func (l *Lifecycle) getResourceFields(obj *v3.KontainerDriver) (map[string]v32.Field, error) {
 	driver := service.NewEngineService(
-		clusterprovisioner.NewPersistentStore(l.namespaces, l.coreV1),
+		clusterprovisioner.NewPersistentStore(l.namespaces, l.coreV1, l.secretsKeys),
 	)
 	flags, err := driver.GetDriverCreateOptions(context.Background(), obj.Name, obj, v32.ClusterSpec{
 		GenericEngineConfig: &v32.MapStringInterface{
@@ -4,27 +4,25 @@
 	"bytes"
 	"compress/gzip"
 	"context"
-	"crypto/tls"
-	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
-	"net/http"
 	"strings"
 	"time"
 
 	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/backuptarget"
 
 	rketypes "github.com/rancher/rke/types"
 
 	minio "github.com/minio/minio-go"
-	"github.com/minio/minio-go/pkg/credentials"
 	"github.com/rancher/rancher/pkg/controllers/management/clusterprovisioner"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/kontainer-engine/drivers/rke"
 	"github.com/rancher/rancher/pkg/kontainer-engine/service"
 	"github.com/rancher/rancher/pkg/rkedialerfactory"
+	"github.com/rancher/rancher/pkg/settings"
 	"github.com/rancher/rancher/pkg/types/config"
 	"github.com/rancher/rancher/pkg/types/config/dialer"
 	"github.com/rancher/wrangler/pkg/ticker"
@@ -38,8 +36,8 @@
 
 const (
 	clusterBackupCheckInterval = 5 * time.Minute
+	backupVerifyCheckInterval  = 15 * time.Minute
 	compressedExtension        = "zip"
-	s3Endpoint                 = "s3.amazonaws.com"
 )
 
 type Controller struct {
@@ -50,16 +48,18 @@ type Controller struct {
 	backupLister          v3.EtcdBackupLister
 	backupDriver          *service.EngineService
 	KontainerDriverLister v3.KontainerDriverLister
+	snapshots             *backuptarget.Snapshots
 }
 
 func Register(ctx context.Context, management *config.ManagementContext) {
+	store := clusterprovisioner.NewPersistentStore(management.Core.Namespaces(""), management.Core, management.SecretsEncryptionKeys)
 	c := &Controller{
 		ctx:                   ctx,
 		clusterClient:         management.Management.Clusters(""),
 		clusterLister:         management.Management.Clusters("").Controller().Lister(),
 		backupClient:          management.Management.EtcdBackups(""),
 		backupLister:          management.Management.EtcdBackups("").Controller().Lister(),
-		backupDriver:          service.NewEngineService(clusterprovisioner.NewPersistentStore(management.Core.Namespaces(""), management.Core)),
+		backupDriver:          service.NewEngineService(store),
 		KontainerDriverLister: management.Management.KontainerDrivers("").Controller().Lister(),
 	}
 
@@ -72,6 +72,7 @@ func Register(ctx context.Context, management *config.ManagementContext) {
 		Docker:  true,
 		Ctx:     ctx,
 	}
+	c.snapshots = backuptarget.NewSnapshots(store, docker.Build, management.Core.Secrets("").Controller().Lister())
 	driver := service.Drivers[service.RancherKubernetesEngineDriverName]
 	rkeDriver := driver.(*rke.Driver)
 	rkeDriver.DockerDialer = docker.Build
@@ -80,6 +81,7 @@ func Register(ctx context.Context, management *config.ManagementContext) {
 
 	c.backupClient.AddLifecycle(ctx, "etcdbackup-controller", c)
 	go c.clusterBackupSync(ctx, clusterBackupCheckInterval)
+	go c.backupVerifySync(ctx, backupVerifyCheckInterval)
 }
 
 func (c *Controller) Create(b *v3.EtcdBackup) (runtime.Object, error) {
@@ -97,8 +99,10 @@ func (c *Controller) Create(b *v3.EtcdBackup) (runtime.Object, error) {
 	}
 
 	if !rketypes.BackupConditionCreated.IsTrue(b) {
-		b.Spec.Filename = generateBackupFilename(b.Name, cluster.Spec.RancherKubernetesEngineConfig.Services.Etcd.BackupConfig)
-		b.Spec.BackupConfig = *cluster.Spec.RancherKubernetesEngineConfig.Services.Etcd.BackupConfig
+		backupConfig := rkeBackupConfig(cluster)
+		b.Spec.Filename = generateBackupFilename(b.Name, backupConfig)
+		b.Spec.BackupConfig = *backupConfig
+		b.Target = cluster.Spec.EtcdBackupTarget.DeepCopy()
 		rketypes.BackupConditionCreated.True(b)
 		// we set ConditionCompleted to Unknown to avoid incorrect "active" state
 		rketypes.BackupConditionCompleted.Unknown(b)
@@ -148,6 +152,59 @@ func (c *Controller) clusterBackupSync(ctx context.Context, interval time.Durati
 	return nil
 }
 
+func (c *Controller) backupVerifySync(ctx context.Context, interval time.Duration) {
+	for range ticker.Context(ctx, interval) {
+		verifyInterval := time.Duration(settings.EtcdBackupVerifyIntervalHours.GetInt()) * time.Hour
+		if verifyInterval <= 0 {
+			continue
+		}
+		backups, err := c.backupLister.List("", labels.NewSelector())
+		if err != nil {
+			logrus.Errorf("[etcd-backup] backupVerifySync failed: %v", err)
+			continue
+		}
+		for _, backup := range backups {
+			if !isVerificationDue(backup, verifyInterval) {
+				continue
+			}
+			logrus.Debugf("[etcd-backup] Verifying backup: %s", backup.Name)
+			if _, err := c.verifyBackup(backup); err != nil && !apierrors.IsConflict(err) {
+				logrus.Errorf("[etcd-backup] backupVerifySync failed: %v", err)
+			}
+		}
+	}
+}
+
+// verifyBackup verifies the snapshot of the backup and records the result in the Verified condition of the backup. A
+// failed verification resets the Alerted condition, so that the alert watcher of the cluster sends an alert.
+func (c *Controller) verifyBackup(b *v3.EtcdBackup) (*v3.EtcdBackup, error) {
+	info, verifyErr := c.snapshots.Verify(c.ctx, b.Spec.ClusterID, b)
+	b = b.DeepCopy()
+	if b.SnapshotInfo == nil {
+		b.SnapshotInfo = &v32.EtcdSnapshotInfo{}
+	}
+	// failed verifications are recorded too, so that they are not retried and alerted on before the next interval
+	b.SnapshotInfo.LastVerified = time.Now().UTC().Format(time.RFC3339)
+	if verifyErr != nil {
+		logrus.Warnf("[etcd-backup] verification of backup [%s] failed: %v", b.Name, verifyErr)
+		v32.BackupConditionVerified.False(b)
+		v32.BackupConditionVerified.ReasonAndMessageFromError(b, verifyErr)
+		v32.BackupConditionAlerted.Unknown(b)
+		return c.backupClient.Update(b)
+	}
+
+	if b.SnapshotInfo.Checksum == "" {
+		// backups taken before checksums were recorded are checked against this checksum from now on
+		b.SnapshotInfo.Checksum = info.Checksum
+		b.SnapshotInfo.Size = info.Size
+	}
+	b.SnapshotInfo.KeyCount = info.KeyCount
+	v32.BackupConditionVerified.True(b)
+	v32.BackupConditionVerified.Reason(b, "")
+	v32.BackupConditionVerified.Message(b, "")
+	return c.backupClient.Update(b)
+}
+
 func (c *Controller) doClusterBackupSync(cluster *v3.Cluster) error {
 	if cluster == nil || cluster.DeletionTimestamp != nil {
 		return nil
@@ -220,15 +277,38 @@ func (c *Controller) etcdSaveWithBackoff(b *v3.EtcdBackup) (runtime.Object, erro
 		if err != nil {
 			return b, err
 		}
+		spec := cluster.Spec
+		if b.Target != nil {
+			// RKE only takes a local snapshot, which is uploaded to the target below
+			spec = *cluster.Spec.DeepCopy()
+			spec.RancherKubernetesEngineConfig.Services.Etcd.BackupConfig = rkeBackupConfig(cluster)
+		}
 		var inErr error
 		err = wait.ExponentialBackoff(backoff, func() (bool, error) {
-			if inErr = c.backupDriver.ETCDSave(c.ctx, cluster.Name, kontainerDriver, cluster.Spec, snapshotName); inErr != nil {
+			if inErr = c.backupDriver.ETCDSave(c.ctx, cluster.Name, kontainerDriver, spec, snapshotName); inErr != nil {
 				logrus.Warnf("%v", inErr)
 				return false, nil
 			}
 			return true, nil
 		})
+		if inErr != nil {
+			return b, inErr
+		}
+		if b.Target == nil {
+			// the checksum is only used to verify the snapshot later on, failing to record it does not fail the backup
+			if b.SnapshotInfo, inErr = c.snapshots.Inspect(c.ctx, cluster.Name, b.Spec.Filename); inErr != nil {
+				logrus.Warnf("[etcd-backup] failed to record the checksum of backup [%s]: %v", b.Name, inErr)
+			}
+			return b, nil
+		}
 
+		err = wait.ExponentialBackoff(backoff, func() (bool, error) {
+			if b.SnapshotInfo, inErr = c.snapshots.Upload(c.ctx, cluster.Name, b.Target, b.Spec.Filename); inErr != nil {
+				logrus.Warnf("%v", inErr)
+				return false, nil
+			}
+			return true, nil
+		})
 		return b, inErr
 	})
 	if err != nil {
@@ -251,28 +331,55 @@ func (c *Controller) etcdRemoveSnapshotWithBackoff(b *v3.EtcdBackup) error {
 		return err
 	}
 	snapshotName := clusterprovisioner.GetBackupFilename(b)
-	return wait.ExponentialBackoff(backoff, func() (bool, error) {
+	err = wait.ExponentialBackoff(backoff, func() (bool, error) {
 		if inErr := c.backupDriver.ETCDRemoveSnapshot(c.ctx, cluster.Name, kontainerDriver, cluster.Spec, snapshotName); inErr != nil {
 			logrus.Warnf("%v", inErr)
 			return false, nil
 		}
 		return true, nil
 	})
+	if b.Target == nil {
+		return err
+	}
+	// the snapshot is removed from the target even when the local snapshots could not be removed
+	targetErr := wait.ExponentialBackoff(backoff, func() (bool, error) {
+		if inErr := c.snapshots.Remove(c.ctx, b.Target, b.Spec.Filename); inErr != nil {
+			logrus.Warnf("%v", inErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+	return targetErr
 }
 
 func (c *Controller) rotateExpiredBackups(cluster *v3.Cluster, clusterBackups []*v3.EtcdBackup) error {
-	retention := cluster.Spec.RancherKubernetesEngineConfig.Services.Etcd.BackupConfig.Retention
-	intervalHours := cluster.Spec.RancherKubernetesEngineConfig.Services.Etcd.BackupConfig.IntervalHours
-	expiredBackups := getExpiredBackups(retention, intervalHours, clusterBackups)
-	for _, backup := range expiredBackups {
-		if backup.Spec.Manual {
-			continue
+	keep := getRetentionPolicy(cluster)
+	expiredBackups := getExpiredBackups(keep, clusterBackups)
+	if retention := cluster.Spec.EtcdBackupRetention; retention != nil && retention.Manual > 0 {
+		manualBackups, err := c.getManualBackupsList(cluster)
+		if err != nil {
+			return err
 		}
+		expiredBackups = append(expiredBackups, getExpiredManualBackups(retention.Manual, manualBackups)...)
+	}
+	if len(expiredBackups) == 0 {
+		return nil
+	}
+	for _, backup := range expiredBackups {
 		if err := c.backupClient.DeleteNamespaced(backup.Namespace, backup.Name, &metav1.DeleteOptions{}); err != nil {
 			return err
 		}
 	}
-	return nil
+
+	// snapshots only outlive their backup when removing the backup fails, so the bucket is only checked after a rotation
+	backups, err := c.backupLister.List(cluster.Name, labels.NewSelector())
+	if err != nil {
+		return err
+	}
+	return c.rotateExpiredS3Snapshots(cluster, keep, backups)
 }
 
 func NewBackupObject(cluster *v3.Cluster, manual bool) (*v3.EtcdBackup, error) {
@@ -391,49 +498,9 @@ func generateBackupFilename(snapshotName string, backupConfig *rketypes.BackupCo
 
 }
 
+// GetS3Client returns a client of the S3 backup target of the config.
 func GetS3Client(sbc *rketypes.S3BackupConfig, timeout int, dialer dialer.Dialer) (*minio.Client, error) {
-	if sbc == nil {
-		return nil, fmt.Errorf("Can't find S3 backup target configuration")
-	}
-	var s3Client = &minio.Client{}
-	var creds *credentials.Credentials
-	var tr http.RoundTripper = &http.Transport{
-		Proxy:                 http.ProxyFromEnvironment,
-		DialContext:           dialer,
-		MaxIdleConns:          100,
-		IdleConnTimeout:       90 * time.Second,
-		TLSHandshakeTimeout:   10 * time.Second,
-		ExpectContinueTimeout: 1 * time.Second,
-	}
-	endpoint := sbc.Endpoint
-	// no access credentials, we assume IAM roles
-	if sbc.AccessKey == "" ||
-		sbc.SecretKey == "" {
-		creds = credentials.NewIAM("")
-		if sbc.Endpoint == "" {
-			endpoint = s3Endpoint
-		}
-	} else {
-		accessKey := sbc.AccessKey
-		secretKey := sbc.SecretKey
-		creds = credentials.NewStatic(accessKey, secretKey, "", credentials.SignatureDefault)
-	}
-
-	bucketLookup := getBucketLookupType(endpoint)
-	s3Client, err := minio.NewWithOptions(endpoint, &minio.Options{
-		Creds:        creds,
-		Region:       sbc.Region,
-		Secure:       true,
-		BucketLookup: bucketLookup,
-	})
-	if err != nil {
-		return nil, err
-	}
-	if sbc.CustomCA != "" {
-		tr = getCustomCATransport(tr, sbc.CustomCA)
-	}
-	s3Client.SetCustomTransport(tr)
-	return s3Client, nil
+	return backuptarget.NewS3Client(sbc, timeout, dialer)
 }
 
 func (c *Controller) getRecuringBackupsList(cluster *v3.Cluster) ([]*v3.EtcdBackup, error) {
@@ -450,14 +517,18 @@ func (c *Controller) getRecuringBackupsList(cluster *v3.Cluster) ([]*v3.EtcdBack
 	return retList, nil
 }
 
-func getBucketLookupType(endpoint string) minio.BucketLookupType {
-	if endpoint == "" {
-		return minio.BucketLookupAuto
+func (c *Controller) getManualBackupsList(cluster *v3.Cluster) ([]*v3.EtcdBackup, error) {
+	retList := []*v3.EtcdBackup{}
+	backups, err := c.backupLister.List(cluster.Name, labels.NewSelector())
+	if err != nil {
+		return nil, err
 	}
-	if strings.Contains(endpoint, "aliyun") {
-		return minio.BucketLookupDNS
+	for _, backup := range backups {
+		if backup.Spec.Manual {
+			retList = append(retList, backup)
+		}
 	}
-	return minio.BucketLookupAuto
+	return retList, nil
 }
 
 func getBackupCompletedTime(o runtime.Object) time.Time {
@@ -465,15 +536,17 @@ func getBackupCompletedTime(o runtime.Object) time.Time {
 	return t
 }
 
-func getExpiredBackups(retention, intervalHours int, backups []*v3.EtcdBackup) []*v3.EtcdBackup {
-	expiredList := []*v3.EtcdBackup{}
-	toKeepDuration := time.Duration(retention*intervalHours) * time.Hour
-	for _, backup := range backups {
-		if time.Since(getBackupCompletedTime(backup)) > toKeepDuration {
-			expiredList = append(expiredList, backup)
-		}
+// isVerificationDue tells whether the interval elapsed since the last verification of a completed backup. The time is
+// taken from the snapshot info, as the Verified condition keeps its update time while its status does not change.
+func isVerificationDue(backup *v3.EtcdBackup, interval time.Duration) bool {
+	if backup.DeletionTimestamp != nil || !rketypes.BackupConditionCompleted.IsTrue(backup) {
+		return false
+	}
+	if backup.SnapshotInfo == nil {
+		return true
 	}
-	return expiredList
+	lastVerified, err := time.Parse(time.RFC3339, backup.SnapshotInfo.LastVerified)
+	return err != nil || time.Since(lastVerified) > interval
 }
 
 func shouldBackup(cluster *v3.Cluster) bool {
@@ -499,6 +572,18 @@ func shouldBackup(cluster *v3.Cluster) bool {
 	return true
 }
 
+// rkeBackupConfig returns the backup config RKE takes snapshots with. Snapshots of clusters with a backup target are
+// uploaded by Rancher, RKE only takes them locally.
+func rkeBackupConfig(cluster *v3.Cluster) *rketypes.BackupConfig {
+	backupConfig := cluster.Spec.RancherKubernetesEngineConfig.Services.Etcd.BackupConfig
+	if cluster.Spec.EtcdBackupTarget == nil || backupConfig == nil {
+		return backupConfig
+	}
+	backupConfig = backupConfig.DeepCopy()
+	backupConfig.S3BackupConfig = nil
+	return backupConfig
+}
+
 func getBackoff() wait.Backoff {
 	return wait.Backoff{
 		Duration: 1000 * time.Millisecond,
@@ -516,12 +601,3 @@ func isBackupSet(rkeConfig *rketypes.RancherKubernetesEngineConfig) bool {
 func isRecurringBackupEnabled(rkeConfig *rketypes.RancherKubernetesEngineConfig) bool {
 	return isBackupSet(rkeConfig) && rkeConfig.Services.Etcd.BackupConfig.Enabled != nil && *rkeConfig.Services.Etcd.BackupConfig.Enabled
 }
-
-func getCustomCATransport(tr http.RoundTripper, ca string) http.RoundTripper {
-	certPool := x509.NewCertPool()
-	certPool.AppendCertsFromPEM([]byte(ca))
-	tr.(*http.Transport).TLSClientConfig = &tls.Config{
-		RootCAs: certPool,
-	}
-	return tr
-}
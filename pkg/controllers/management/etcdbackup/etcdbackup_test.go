@@ -0,0 +1,35 @@
+package etcdbackup
+
+import (
+	"testing"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	rketypes "github.com/rancher/rke/types"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestIsVerificationDue(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Now()
+	interval := 24 * time.Hour
+
+	backup := newTestBackup("backup", now.Add(-72*time.Hour), v1.ConditionTrue)
+	assert.True(isVerificationDue(backup, interval))
+
+	// the Verified condition was set True again by every verification since the first one, two days ago
+	backup.Status.Conditions = append(backup.Status.Conditions, rketypes.EtcdBackupCondition{
+		Type:           string(v32.BackupConditionVerified),
+		Status:         v1.ConditionTrue,
+		LastUpdateTime: now.Add(-48 * time.Hour).UTC().Format(time.RFC3339),
+	})
+	backup.SnapshotInfo = &v32.EtcdSnapshotInfo{LastVerified: now.Add(-time.Hour).UTC().Format(time.RFC3339)}
+	assert.False(isVerificationDue(backup, interval))
+
+	backup.SnapshotInfo.LastVerified = now.Add(-25 * time.Hour).UTC().Format(time.RFC3339)
+	assert.True(isVerificationDue(backup, interval))
+
+	failed := newTestBackup("failed", now.Add(-72*time.Hour), v1.ConditionFalse)
+	assert.False(isVerificationDue(failed, interval))
+}
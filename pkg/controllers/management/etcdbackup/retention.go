@@ -0,0 +1,206 @@
+package etcdbackup
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/backuptarget"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	rketypes "github.com/rancher/rke/types"
+	"github.com/sirupsen/logrus"
+)
+
+// retentionPolicy returns the indexes of the snapshot times to keep, the times are sorted newest first.
+type retentionPolicy func(times []time.Time) map[int]bool
+
+// getRetentionPolicy returns the grandfather-father-son policy of the cluster if it has one, and otherwise keeps the
+// snapshots of the last retention*intervalHours hours of the backup config.
+func getRetentionPolicy(cluster *v3.Cluster) retentionPolicy {
+	if retention := cluster.Spec.EtcdBackupRetention; retention != nil {
+		return func(times []time.Time) map[int]bool {
+			return gfsRetained(retention, times)
+		}
+	}
+	backupConfig := cluster.Spec.RancherKubernetesEngineConfig.Services.Etcd.BackupConfig
+	toKeepDuration := time.Duration(backupConfig.Retention*backupConfig.IntervalHours) * time.Hour
+	return func(times []time.Time) map[int]bool {
+		kept := map[int]bool{}
+		for i, t := range times {
+			if time.Since(t) <= toKeepDuration {
+				kept[i] = true
+			}
+		}
+		return kept
+	}
+}
+
+// gfsRetained keeps the newest snapshot of each of the last hours, days, weeks and months the retention asks for,
+// periods without snapshots do not count.
+func gfsRetained(retention *v32.EtcdBackupRetention, times []time.Time) map[int]bool {
+	periods := []struct {
+		count int
+		key   func(time.Time) string
+	}{
+		{retention.Hourly, func(t time.Time) string { return t.Format("2006-01-02T15") }},
+		{retention.Daily, func(t time.Time) string { return t.Format("2006-01-02") }},
+		{retention.Weekly, func(t time.Time) string {
+			year, week := t.ISOWeek()
+			return fmt.Sprintf("%d-%d", year, week)
+		}},
+		{retention.Monthly, func(t time.Time) string { return t.Format("2006-01") }},
+	}
+
+	kept := map[int]bool{}
+	for _, period := range periods {
+		seen := map[string]bool{}
+		for i, t := range times {
+			if len(seen) >= period.count {
+				break
+			}
+			key := period.key(t.UTC())
+			if !seen[key] {
+				seen[key] = true
+				kept[i] = true
+			}
+		}
+	}
+	return kept
+}
+
+// getExpiredBackups returns the recurring backups the policy does not keep. Only completed backups count toward the
+// policy, failed backups expire once they are older than the oldest backup the policy keeps, backups in progress and
+// pinned backups never expire.
+func getExpiredBackups(keep retentionPolicy, backups []*v3.EtcdBackup) []*v3.EtcdBackup {
+	var completed, failed []*v3.EtcdBackup
+	for _, backup := range backups {
+		switch {
+		case backup.Pinned:
+		case rketypes.BackupConditionCompleted.IsTrue(backup):
+			completed = append(completed, backup)
+		case rketypes.BackupConditionCompleted.IsFalse(backup):
+			failed = append(failed, backup)
+		}
+	}
+	sortNewestFirst(completed)
+
+	times := make([]time.Time, len(completed))
+	for i, backup := range completed {
+		times[i] = getBackupCompletedTime(backup)
+	}
+	kept := keep(times)
+
+	expiredList := []*v3.EtcdBackup{}
+	var oldestKept time.Time
+	for i, backup := range completed {
+		if kept[i] {
+			oldestKept = times[i]
+		} else {
+			expiredList = append(expiredList, backup)
+		}
+	}
+	if oldestKept.IsZero() {
+		return expiredList
+	}
+	for _, backup := range failed {
+		if getBackupCompletedTime(backup).Before(oldestKept) {
+			expiredList = append(expiredList, backup)
+		}
+	}
+	return expiredList
+}
+
+// getExpiredManualBackups returns the manual backups beyond the newest keep ones that are not pinned.
+func getExpiredManualBackups(keep int, backups []*v3.EtcdBackup) []*v3.EtcdBackup {
+	var unpinned []*v3.EtcdBackup
+	for _, backup := range backups {
+		if !backup.Pinned && !rketypes.BackupConditionCompleted.IsUnknown(backup) {
+			unpinned = append(unpinned, backup)
+		}
+	}
+	if keep <= 0 || len(unpinned) <= keep {
+		return nil
+	}
+	sortNewestFirst(unpinned)
+	return unpinned[keep:]
+}
+
+// rotateExpiredS3Snapshots applies the policy to the recurring snapshots in the S3 bucket of the cluster. Snapshots of
+// existing backups are removed along with their backup, this removes the snapshots that outlived their backup, e.g.
+// because the bucket was unreachable when the backup was removed.
+func (c *Controller) rotateExpiredS3Snapshots(cluster *v3.Cluster, keep retentionPolicy, backups []*v3.EtcdBackup) error {
+	s3Config := cluster.Spec.RancherKubernetesEngineConfig.Services.Etcd.BackupConfig.S3BackupConfig
+	if target := cluster.Spec.EtcdBackupTarget; target != nil {
+		s3Config = target.S3Config
+	}
+	if s3Config == nil {
+		return nil
+	}
+	client, err := GetS3Client(s3Config, 0, nil)
+	if err != nil {
+		return err
+	}
+
+	referenced := map[string]bool{}
+	for _, backup := range backups {
+		referenced[path.Base(backup.Spec.Filename)] = true
+		if backup.Target != nil {
+			referenced[backuptarget.ObjectName(backup.Spec.Filename, backup.Target)] = true
+		}
+	}
+
+	prefix := fmt.Sprintf("%s-r", cluster.Name)
+	if s3Config.Folder != "" {
+		prefix = path.Join(s3Config.Folder, prefix)
+	}
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	var names []string
+	var times []time.Time
+	for object := range client.ListObjectsV2(s3Config.BucketName, prefix, false, doneCh) {
+		if object.Err != nil {
+			return object.Err
+		}
+		names = append(names, object.Key)
+		times = append(times, object.LastModified)
+	}
+	sort.Sort(&byNewest{names: names, times: times})
+
+	kept := keep(times)
+	for i, name := range names {
+		if kept[i] || referenced[path.Base(name)] {
+			continue
+		}
+		logrus.Infof("[etcd-backup] Removing expired snapshot [%s] of cluster [%s] from S3", name, cluster.Name)
+		if err := client.RemoveObject(s3Config.BucketName, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sortNewestFirst(backups []*v3.EtcdBackup) {
+	sort.SliceStable(backups, func(i, j int) bool {
+		return getBackupCompletedTime(backups[i]).After(getBackupCompletedTime(backups[j]))
+	})
+}
+
+type byNewest struct {
+	names []string
+	times []time.Time
+}
+
+func (b *byNewest) Len() int {
+	return len(b.names)
+}
+
+func (b *byNewest) Less(i, j int) bool {
+	return b.times[i].After(b.times[j])
+}
+
+func (b *byNewest) Swap(i, j int) {
+	b.names[i], b.names[j] = b.names[j], b.names[i]
+	b.times[i], b.times[j] = b.times[j], b.times[i]
+}
@@ -0,0 +1,103 @@
+package etcdbackup
+
+import (
+	"testing"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	rketypes "github.com/rancher/rke/types"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGFSRetained(t *testing.T) {
+	assert := assert.New(t)
+
+	// one snapshot every 6 hours for 60 days, newest first
+	now := time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC)
+	var times []time.Time
+	for i := 0; i < 60*4; i++ {
+		times = append(times, now.Add(-time.Duration(i)*6*time.Hour))
+	}
+
+	kept := gfsRetained(&v32.EtcdBackupRetention{Hourly: 2}, times)
+	assert.Equal(map[int]bool{0: true, 1: true}, kept)
+
+	// the newest snapshot of the last 3 days
+	kept = gfsRetained(&v32.EtcdBackupRetention{Daily: 3}, times)
+	assert.Equal(map[int]bool{0: true, 1: true, 5: true}, kept)
+
+	// the newest snapshot of October and September, and of the weeks of 2020-09-28 and 2020-09-21
+	kept = gfsRetained(&v32.EtcdBackupRetention{Weekly: 2, Monthly: 2}, times)
+	assert.Equal(map[int]bool{0: true, 1: true, 13: true}, kept)
+
+	kept = gfsRetained(&v32.EtcdBackupRetention{Hourly: 1, Daily: 1, Weekly: 1, Monthly: 1}, times)
+	assert.Equal(map[int]bool{0: true}, kept)
+}
+
+func TestGetExpiredBackups(t *testing.T) {
+	assert := assert.New(t)
+
+	now := time.Now()
+	newest := newTestBackup("newest", now.Add(-time.Hour), v1.ConditionTrue)
+	older := newTestBackup("older", now.Add(-2*time.Hour), v1.ConditionTrue)
+	pinned := newTestBackup("pinned", now.Add(-3*time.Hour), v1.ConditionTrue)
+	pinned.Pinned = true
+	failed := newTestBackup("failed", now.Add(-4*time.Hour), v1.ConditionFalse)
+	running := newTestBackup("running", now.Add(-5*time.Hour), v1.ConditionUnknown)
+	backups := []*v3.EtcdBackup{older, failed, newest, running, pinned}
+
+	keepNewest := func(times []time.Time) map[int]bool {
+		return map[int]bool{0: true}
+	}
+	assert.Equal([]*v3.EtcdBackup{older, failed}, getExpiredBackups(keepNewest, backups))
+
+	keepAll := func(times []time.Time) map[int]bool {
+		kept := map[int]bool{}
+		for i := range times {
+			kept[i] = true
+		}
+		return kept
+	}
+	assert.Equal([]*v3.EtcdBackup{failed}, getExpiredBackups(keepAll, backups))
+
+	keepNone := func(times []time.Time) map[int]bool {
+		return map[int]bool{}
+	}
+	assert.Equal([]*v3.EtcdBackup{newest, older}, getExpiredBackups(keepNone, backups))
+}
+
+func TestGetExpiredManualBackups(t *testing.T) {
+	assert := assert.New(t)
+
+	now := time.Now()
+	newest := newTestBackup("newest", now.Add(-time.Hour), v1.ConditionTrue)
+	older := newTestBackup("older", now.Add(-2*time.Hour), v1.ConditionTrue)
+	oldest := newTestBackup("oldest", now.Add(-3*time.Hour), v1.ConditionTrue)
+	pinned := newTestBackup("pinned", now.Add(-4*time.Hour), v1.ConditionTrue)
+	pinned.Pinned = true
+	backups := []*v3.EtcdBackup{oldest, pinned, newest, older}
+
+	assert.Equal([]*v3.EtcdBackup{older, oldest}, getExpiredManualBackups(1, backups))
+	assert.Empty(getExpiredManualBackups(3, backups))
+	assert.Empty(getExpiredManualBackups(0, backups))
+}
+
+func newTestBackup(name string, completed time.Time, status v1.ConditionStatus) *v3.EtcdBackup {
+	return &v3.EtcdBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Status: rketypes.EtcdBackupStatus{
+			Conditions: []rketypes.EtcdBackupCondition{
+				{
+					Type:           string(rketypes.BackupConditionCompleted),
+					Status:         status,
+					LastUpdateTime: completed.UTC().Format(time.RFC3339),
+				},
+			},
+		},
+	}
+}
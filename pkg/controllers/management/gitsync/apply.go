@@ -0,0 +1,420 @@
+package gitsync
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/wrangler/pkg/yaml"
+	"github.com/sirupsen/logrus"
+	authv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/client-go/dynamic"
+)
+
+const (
+	stateCreated  = "created"
+	stateUpdated  = "updated"
+	stateInSync   = "in-sync"
+	statePending  = "pending"
+	stateOrphaned = "orphaned"
+	stateError    = "error"
+)
+
+// allowedKinds are the kinds a GitSync manages by API group, the objects of the repository of other kinds are refused.
+// Every object is applied with the permissions of the creator of the GitSync, see checkAccess.
+var allowedKinds = map[string]map[string]bool{
+	"management.cattle.io": {
+		"Cluster":                    true,
+		"Project":                    true,
+		"GlobalRole":                 true,
+		"Catalog":                    true,
+		"RoleTemplate":               true,
+		"ClusterRoleTemplateBinding": true,
+		"ProjectRoleTemplateBinding": true,
+		"Notifier":                   true,
+		"ClusterAlertGroup":          true,
+		"ClusterAlertRule":           true,
+		"ProjectAlertGroup":          true,
+		"ProjectAlertRule":           true,
+	},
+	"project.cattle.io": {
+		"App":             true,
+		"Pipeline":        true,
+		"PipelineSetting": true,
+	},
+}
+
+type manifest struct {
+	key  string
+	path string
+	hash string
+	obj  *unstructured.Unstructured
+}
+
+// parseManifests decodes the objects of the files of the repository, sorted by path. An object that cannot be decoded,
+// is not of an allowed kind or is defined twice fails the whole repository.
+func parseManifests(files map[string][]byte) ([]manifest, error) {
+	var paths []string
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var result []manifest
+	seen := map[string]string{}
+	for _, path := range paths {
+		objs, err := yaml.ToObjects(bytes.NewReader(files[path]))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", path, err)
+		}
+		for _, obj := range objs {
+			u, ok := obj.(*unstructured.Unstructured)
+			if !ok {
+				return nil, fmt.Errorf("failed to decode %s: unexpected object %T", path, obj)
+			}
+			gvk := u.GroupVersionKind()
+			if !allowedKinds[gvk.Group][gvk.Kind] {
+				return nil, fmt.Errorf("%s: %s %s is not of a kind managed by GitSyncs", path, gvk.Kind, u.GetName())
+			}
+			if u.GetName() == "" {
+				return nil, fmt.Errorf("%s: %s without a name, generated names are not supported", path, gvk.Kind)
+			}
+
+			key := objectKey(gvk.Group, gvk.Kind, u.GetNamespace(), u.GetName())
+			if other, ok := seen[key]; ok {
+				return nil, fmt.Errorf("%s %s is defined in both %s and %s", gvk.Kind, u.GetName(), other, path)
+			}
+			seen[key] = path
+
+			hash, err := hashOf(u.Object)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, manifest{
+				key:  key,
+				path: path,
+				hash: hash,
+				obj:  u,
+			})
+		}
+	}
+	return result, nil
+}
+
+// reconcile applies the manifests and prunes the objects the GitSync created whose manifest was removed, it returns the
+// state of every object.
+func (c *controller) reconcile(obj *v3.GitSync, manifests []manifest) []v32.GitSyncResource {
+	previous := map[string]v32.GitSyncResource{}
+	for _, resource := range obj.Status.Resources {
+		previous[resourceKey(resource)] = resource
+	}
+
+	var result []v32.GitSyncResource
+	current := map[string]bool{}
+	for _, m := range manifests {
+		current[m.key] = true
+		result = append(result, c.apply(obj, m, previous[m.key]))
+	}
+
+	for key, resource := range previous {
+		if current[key] || !resource.Created {
+			continue
+		}
+		if resource, keep := c.prune(obj, resource); keep {
+			result = append(result, resource)
+		}
+	}
+
+	sortResources(result)
+	return result
+}
+
+func (c *controller) apply(obj *v3.GitSync, m manifest, previous v32.GitSyncResource) v32.GitSyncResource {
+	resource := v32.GitSyncResource{
+		APIVersion: m.obj.GetAPIVersion(),
+		Kind:       m.obj.GetKind(),
+		Namespace:  m.obj.GetNamespace(),
+		Name:       m.obj.GetName(),
+		Path:       m.path,
+		// the hash of the manifest last applied, kept as is until this one is applied
+		Hash:    previous.Hash,
+		Created: previous.Created,
+	}
+
+	gvk := m.obj.GroupVersionKind()
+	client := c.resourceClient(gvk, resource.Namespace)
+	existing, err := client.Get(context.TODO(), resource.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		if err := c.checkAccess(obj, "create", gvk, resource.Namespace, ""); err != nil {
+			return withError(resource, err)
+		}
+		if obj.Spec.DryRun {
+			resource.State = statePending
+			resource.Message = "would be created"
+			return resource
+		}
+
+		desired := m.obj.DeepCopy()
+		labels := desired.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[gitSyncLabel] = obj.Name
+		desired.SetLabels(labels)
+		if _, err := client.Create(context.TODO(), desired, metav1.CreateOptions{}); err != nil {
+			return withError(resource, err)
+		}
+		logrus.Infof("[gitsync] Created %s [%s] of GitSync [%s]", resource.Kind, resourceName(resource), obj.Name)
+		resource.Hash = m.hash
+		resource.Created = true
+		resource.State = stateCreated
+		return resource
+	} else if err != nil {
+		return withError(resource, err)
+	}
+
+	desired := desiredContent(m.obj.Object)
+	if isSubset(desired, existing.Object) {
+		resource.Hash = m.hash
+		resource.State = stateInSync
+		return resource
+	}
+
+	// the object no longer matches a manifest that did not change since it was applied
+	resource.Drifted = previous.Hash == m.hash
+	if err := c.checkAccess(obj, "update", gvk, resource.Namespace, resource.Name); err != nil {
+		return withError(resource, err)
+	}
+	if obj.Spec.DryRun {
+		resource.State = statePending
+		resource.Message = "would be updated"
+		return resource
+	}
+
+	updated := existing.DeepCopy()
+	mergeInto(updated.Object, desired)
+	if _, err := client.Update(context.TODO(), updated, metav1.UpdateOptions{}); err != nil {
+		return withError(resource, err)
+	}
+	logrus.Infof("[gitsync] Updated %s [%s] of GitSync [%s]", resource.Kind, resourceName(resource), obj.Name)
+	resource.Hash = m.hash
+	resource.State = stateUpdated
+	return resource
+}
+
+// prune deletes an object the GitSync created once its manifest is removed from the repository, it returns whether the
+// object is still to be reported.
+func (c *controller) prune(obj *v3.GitSync, resource v32.GitSyncResource) (v32.GitSyncResource, bool) {
+	resource.Drifted = false
+	resource.Message = ""
+	if !obj.Spec.Prune {
+		resource.State = stateOrphaned
+		resource.Message = "removed from the repository"
+		return resource, true
+	}
+	if obj.Spec.DryRun {
+		resource.State = statePending
+		resource.Message = "would be deleted"
+		return resource, true
+	}
+
+	gv, err := schema.ParseGroupVersion(resource.APIVersion)
+	if err != nil {
+		return withError(resource, err), true
+	}
+	client := c.resourceClient(gv.WithKind(resource.Kind), resource.Namespace)
+	existing, err := client.Get(context.TODO(), resource.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return resource, false
+	} else if err != nil {
+		return withError(resource, err), true
+	}
+	if existing.GetLabels()[gitSyncLabel] != obj.Name {
+		// the object was recreated or taken over outside of the GitSync, it is not ours to remove anymore
+		return resource, false
+	}
+	if err := c.checkAccess(obj, "delete", gv.WithKind(resource.Kind), resource.Namespace, resource.Name); err != nil {
+		return withError(resource, err), true
+	}
+
+	if err := client.Delete(context.TODO(), resource.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return withError(resource, err), true
+	}
+	logrus.Infof("[gitsync] Pruned %s [%s] of GitSync [%s]", resource.Kind, resourceName(resource), obj.Name)
+	return resource, false
+}
+
+// checkAccess fails unless the creator of the GitSync may apply the verb to the object, so that a GitSync never grants
+// more than its creator already has.
+func (c *controller) checkAccess(obj *v3.GitSync, verb string, gvk schema.GroupVersionKind, namespace, name string) error {
+	creatorID := obj.Annotations[creatorIDAnn]
+	if creatorID == "" {
+		return fmt.Errorf("GitSync %s has no creator to check the permissions of", obj.Name)
+	}
+	groups, err := c.creatorGroups(creatorID)
+	if err != nil {
+		return err
+	}
+	gvr, _ := meta.UnsafeGuessKindToResource(gvk)
+	review := &authv1.SubjectAccessReview{
+		Spec: authv1.SubjectAccessReviewSpec{
+			User:   creatorID,
+			Groups: groups,
+			ResourceAttributes: &authv1.ResourceAttributes{
+				Verb:      verb,
+				Group:     gvr.Group,
+				Resource:  gvr.Resource,
+				Namespace: namespace,
+				Name:      name,
+			},
+		},
+	}
+	result, err := c.subjectAccessReviews.Create(context.TODO(), review, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+	if !result.Status.Allowed {
+		return fmt.Errorf("creator %s of the GitSync is not allowed to %s %s", creatorID, verb, gvr.Resource)
+	}
+	return nil
+}
+
+// creatorGroups returns the groups of the creator the way API requests of the creator are authenticated with, so that
+// permissions granted through group bindings are honored.
+func (c *controller) creatorGroups(creatorID string) ([]string, error) {
+	var groups []string
+	attribs, err := c.userAttributeLister.Get("", creatorID)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	if attribs != nil {
+		for _, principals := range attribs.GroupPrincipals {
+			for _, principal := range principals.Items {
+				groups = append(groups, strings.TrimPrefix(principal.Name, "local://"))
+			}
+		}
+	}
+	sort.Strings(groups)
+	return append(groups, user.AllAuthenticated, "system:cattle:authenticated"), nil
+}
+
+func (c *controller) resourceClient(gvk schema.GroupVersionKind, namespace string) dynamic.ResourceInterface {
+	gvr, _ := meta.UnsafeGuessKindToResource(gvk)
+	if namespace == "" {
+		return c.dynamicClient.Resource(gvr)
+	}
+	return c.dynamicClient.Resource(gvr).Namespace(namespace)
+}
+
+// desiredContent returns the fields of a manifest to compare with and to apply on the object, the metadata of a
+// manifest only sets labels and annotations.
+func desiredContent(obj map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+	for k, v := range obj {
+		switch k {
+		case "apiVersion", "kind", "status":
+		case "metadata":
+			metadata, _ := v.(map[string]interface{})
+			desired := map[string]interface{}{}
+			for _, field := range []string{"labels", "annotations"} {
+				if value, ok := metadata[field]; ok {
+					desired[field] = runtime.DeepCopyJSONValue(value)
+				}
+			}
+			if len(desired) > 0 {
+				result[k] = desired
+			}
+		default:
+			result[k] = runtime.DeepCopyJSONValue(v)
+		}
+	}
+	return result
+}
+
+// isSubset tells whether every field of desired is set to the same value in actual, lists must match as a whole.
+func isSubset(desired, actual interface{}) bool {
+	switch desired := desired.(type) {
+	case map[string]interface{}:
+		actual, ok := actual.(map[string]interface{})
+		if !ok {
+			return len(desired) == 0 && actual == nil
+		}
+		for k, v := range desired {
+			if !isSubset(v, actual[k]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		actual, ok := actual.([]interface{})
+		if !ok || len(desired) != len(actual) {
+			return len(desired) == 0 && actual == nil
+		}
+		for i := range desired {
+			if !isSubset(desired[i], actual[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		if isNumber(desired) && isNumber(actual) {
+			return fmt.Sprint(desired) == fmt.Sprint(actual)
+		}
+		return reflect.DeepEqual(desired, actual)
+	}
+}
+
+// mergeInto sets the fields of src on dst, maps are merged and any other value is replaced.
+func mergeInto(dst, src map[string]interface{}) {
+	for k, v := range src {
+		if srcMap, ok := v.(map[string]interface{}); ok {
+			if dstMap, ok := dst[k].(map[string]interface{}); ok {
+				mergeInto(dstMap, srcMap)
+				continue
+			}
+		}
+		dst[k] = runtime.DeepCopyJSONValue(v)
+	}
+}
+
+func isNumber(v interface{}) bool {
+	switch v.(type) {
+	case int64, float64:
+		return true
+	}
+	return false
+}
+
+func withError(resource v32.GitSyncResource, err error) v32.GitSyncResource {
+	resource.State = stateError
+	resource.Message = err.Error()
+	return resource
+}
+
+func objectKey(group, kind, namespace, name string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", group, kind, namespace, name)
+}
+
+func resourceKey(resource v32.GitSyncResource) string {
+	gv, _ := schema.ParseGroupVersion(resource.APIVersion)
+	return objectKey(gv.Group, resource.Kind, resource.Namespace, resource.Name)
+}
+
+func resourceName(resource v32.GitSyncResource) string {
+	if resource.Namespace == "" {
+		return resource.Name
+	}
+	return resource.Namespace + "/" + resource.Name
+}
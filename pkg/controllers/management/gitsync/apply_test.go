@@ -0,0 +1,268 @@
+package gitsync
+
+import (
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3/fakes"
+	"github.com/stretchr/testify/assert"
+	authv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestParseManifests(t *testing.T) {
+	assert := assert.New(t)
+
+	manifests, err := parseManifests(map[string][]byte{
+		"roles/admins.yaml": []byte(`apiVersion: management.cattle.io/v3
+kind: ProjectRoleTemplateBinding
+metadata:
+  name: admins
+  namespace: p-abcde
+projectName: c-abcde:p-abcde
+roleTemplateName: project-owner
+groupPrincipalName: github_team://1234
+---
+apiVersion: management.cattle.io/v3
+kind: RoleTemplate
+metadata:
+  name: auditor
+context: project
+rules:
+- apiGroups: ["*"]
+  resources: ["*"]
+  verbs: ["get", "list"]
+`),
+		"projects/default.json": []byte(`{"apiVersion": "management.cattle.io/v3", "kind": "Project", "metadata": {"name": "p-abcde", "namespace": "c-abcde"}, "spec": {"displayName": "Default", "clusterName": "c-abcde"}}`),
+	})
+	assert.Nil(err)
+	if assert.Len(manifests, 3) {
+		assert.Equal("projects/default.json", manifests[0].path)
+		assert.Equal("management.cattle.io/Project/c-abcde/p-abcde", manifests[0].key)
+		assert.Equal("roles/admins.yaml", manifests[1].path)
+		assert.Equal("ProjectRoleTemplateBinding", manifests[1].obj.GetKind())
+		assert.Equal("auditor", manifests[2].obj.GetName())
+		assert.NotEmpty(manifests[2].hash)
+	}
+
+	_, err = parseManifests(map[string][]byte{
+		"deployment.yaml": []byte("apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: web\n"),
+	})
+	assert.EqualError(err, "deployment.yaml: Deployment web is not of a kind managed by GitSyncs")
+
+	_, err = parseManifests(map[string][]byte{
+		"admins.yaml": []byte("apiVersion: management.cattle.io/v3\nkind: GlobalRoleBinding\nmetadata:\n  name: admins\nglobalRoleName: admin\n"),
+	})
+	assert.EqualError(err, "admins.yaml: GlobalRoleBinding admins is not of a kind managed by GitSyncs")
+
+	duplicate := []byte("apiVersion: management.cattle.io/v3\nkind: RoleTemplate\nmetadata:\n  name: auditor\n")
+	_, err = parseManifests(map[string][]byte{
+		"a.yaml": duplicate,
+		"b.yaml": duplicate,
+	})
+	assert.EqualError(err, "RoleTemplate auditor is defined in both a.yaml and b.yaml")
+}
+
+func TestApplyChecksAccess(t *testing.T) {
+	assert := assert.New(t)
+
+	var reviews []authv1.ResourceAttributes
+	clientset := k8sfake.NewSimpleClientset()
+	clientset.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authv1.SubjectAccessReview)
+		reviews = append(reviews, *review.Spec.ResourceAttributes)
+		// role templates are granted to the user, and the other kinds through a group of the user
+		review.Status.Allowed = review.Spec.User == "u-owner" && review.Spec.ResourceAttributes.Resource == "roletemplates" ||
+			review.Spec.ResourceAttributes.Resource == "globalroles" && hasGroup(review.Spec.Groups, "github_team://1234")
+		return true, review, nil
+	})
+	c := &controller{
+		dynamicClient:        dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()),
+		subjectAccessReviews: clientset.AuthorizationV1().SubjectAccessReviews(),
+		userAttributeLister: &fakes.UserAttributeListerMock{
+			GetFunc: func(namespace, name string) (*v3.UserAttribute, error) {
+				if name != "u-owner" {
+					return nil, apierrors.NewNotFound(schema.GroupResource{}, name)
+				}
+				return &v3.UserAttribute{GroupPrincipals: map[string]v32.Principals{
+					"github": {Items: []v32.Principal{{ObjectMeta: metav1.ObjectMeta{Name: "github_team://1234"}}}},
+				}}, nil
+			},
+		},
+	}
+
+	manifests, err := parseManifests(map[string][]byte{
+		"roles.yaml": []byte(`apiVersion: management.cattle.io/v3
+kind: RoleTemplate
+metadata:
+  name: auditor
+context: project
+---
+apiVersion: management.cattle.io/v3
+kind: ProjectRoleTemplateBinding
+metadata:
+  name: admins
+  namespace: p-abcde
+roleTemplateName: project-owner
+---
+apiVersion: management.cattle.io/v3
+kind: GlobalRole
+metadata:
+  name: catalog-manager
+rules:
+- apiGroups: ["management.cattle.io"]
+  resources: ["catalogs"]
+  verbs: ["*"]
+`),
+	})
+	if !assert.Nil(err) {
+		return
+	}
+
+	obj := &v3.GitSync{}
+	obj.Name = "roles"
+	obj.Annotations = map[string]string{creatorIDAnn: "u-owner"}
+	resources := c.reconcile(obj, manifests)
+	if assert.Len(resources, 3) {
+		assert.Equal("GlobalRole", resources[0].Kind)
+		assert.Equal(stateCreated, resources[0].State)
+		assert.Equal("ProjectRoleTemplateBinding", resources[1].Kind)
+		assert.Equal(stateError, resources[1].State)
+		assert.Equal("creator u-owner of the GitSync is not allowed to create projectroletemplatebindings", resources[1].Message)
+		assert.Equal("RoleTemplate", resources[2].Kind)
+		assert.Equal(stateCreated, resources[2].State)
+	}
+	assert.Equal([]authv1.ResourceAttributes{
+		{Verb: "create", Group: "management.cattle.io", Resource: "roletemplates"},
+		{Verb: "create", Group: "management.cattle.io", Resource: "projectroletemplatebindings", Namespace: "p-abcde"},
+		{Verb: "create", Group: "management.cattle.io", Resource: "globalroles"},
+	}, reviews)
+
+	obj.Annotations = nil
+	resources = c.reconcile(obj, manifests[1:2])
+	if assert.Len(resources, 1) {
+		assert.Equal(stateError, resources[0].State)
+		assert.Equal("GitSync roles has no creator to check the permissions of", resources[0].Message)
+	}
+}
+
+func TestDesiredContent(t *testing.T) {
+	assert := assert.New(t)
+
+	desired := desiredContent(map[string]interface{}{
+		"apiVersion": "management.cattle.io/v3",
+		"kind":       "GlobalRole",
+		"metadata": map[string]interface{}{
+			"name":            "auditor",
+			"resourceVersion": "1234",
+			"labels": map[string]interface{}{
+				"team": "security",
+			},
+		},
+		"displayName": "Auditor",
+		"status": map[string]interface{}{
+			"conditions": []interface{}{},
+		},
+	})
+	assert.Equal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]interface{}{
+				"team": "security",
+			},
+		},
+		"displayName": "Auditor",
+	}, desired)
+}
+
+func TestIsSubset(t *testing.T) {
+	assert := assert.New(t)
+
+	actual := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name": "auditor",
+			"labels": map[string]interface{}{
+				"team":                         "security",
+				"management.cattle.io/gitsync": "roles",
+			},
+		},
+		"displayName":    "Auditor",
+		"newUserDefault": false,
+		"replicas":       int64(2),
+		"rules": []interface{}{
+			map[string]interface{}{"verbs": []interface{}{"get", "list"}},
+		},
+	}
+
+	assert.True(isSubset(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]interface{}{"team": "security"},
+		},
+		"displayName": "Auditor",
+		"replicas":    float64(2),
+	}, actual))
+	assert.False(isSubset(map[string]interface{}{"displayName": "Auditors"}, actual))
+	assert.False(isSubset(map[string]interface{}{"newUserDefault": true}, actual))
+	assert.False(isSubset(map[string]interface{}{
+		"rules": []interface{}{
+			map[string]interface{}{"verbs": []interface{}{"get"}},
+		},
+	}, actual))
+	assert.True(isSubset(map[string]interface{}{"annotations": map[string]interface{}{}}, actual))
+}
+
+func TestMergeInto(t *testing.T) {
+	assert := assert.New(t)
+
+	dst := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":            "auditor",
+			"resourceVersion": "1234",
+			"labels": map[string]interface{}{
+				"management.cattle.io/gitsync": "roles",
+			},
+		},
+		"displayName": "Auditors",
+		"rules": []interface{}{
+			map[string]interface{}{"verbs": []interface{}{"get"}},
+		},
+	}
+	mergeInto(dst, map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]interface{}{"team": "security"},
+		},
+		"displayName": "Auditor",
+		"rules": []interface{}{
+			map[string]interface{}{"verbs": []interface{}{"get", "list"}},
+		},
+	})
+	assert.Equal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":            "auditor",
+			"resourceVersion": "1234",
+			"labels": map[string]interface{}{
+				"management.cattle.io/gitsync": "roles",
+				"team":                         "security",
+			},
+		},
+		"displayName": "Auditor",
+		"rules": []interface{}{
+			map[string]interface{}{"verbs": []interface{}{"get", "list"}},
+		},
+	}, dst)
+}
+
+func hasGroup(groups []string, group string) bool {
+	for _, g := range groups {
+		if g == group {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,189 @@
+package gitsync
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/catalogv2/git"
+	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/namespace"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	authv1client "k8s.io/client-go/kubernetes/typed/authorization/v1"
+)
+
+const (
+	// gitSyncLabel is set on the objects a GitSync created to the name of the GitSync
+	gitSyncLabel = "management.cattle.io/gitsync"
+	// creatorIDAnn is the user who created the GitSync, whose permissions the objects are applied with
+	creatorIDAnn = "field.cattle.io/creatorId"
+	// gitNamespace is the namespace the repositories are checked out under in the git state directory
+	gitNamespace = "gitsync"
+
+	defaultInterval = 300
+	minInterval     = 15
+)
+
+type controller struct {
+	gitSyncs             v3.GitSyncInterface
+	secretLister         v1.SecretLister
+	userAttributeLister  v3.UserAttributeLister
+	dynamicClient        dynamic.Interface
+	subjectAccessReviews authv1client.SubjectAccessReviewInterface
+}
+
+func Register(ctx context.Context, management *config.ManagementContext) {
+	c := &controller{
+		gitSyncs:             management.Management.GitSyncs(""),
+		secretLister:         management.Core.Secrets("").Controller().Lister(),
+		userAttributeLister:  management.Management.UserAttributes("").Controller().Lister(),
+		dynamicClient:        management.DynamicClient,
+		subjectAccessReviews: management.K8sClient.AuthorizationV1().SubjectAccessReviews(),
+	}
+	c.gitSyncs.AddHandler(ctx, "gitsync-controller", c.sync)
+}
+
+func (c *controller) sync(key string, obj *v3.GitSync) (runtime.Object, error) {
+	if obj == nil || obj.DeletionTimestamp != nil {
+		return obj, nil
+	}
+
+	interval := syncInterval(obj)
+	specHash, err := hashOf(obj.Spec)
+	if err != nil {
+		return obj, err
+	}
+	if !shouldSync(obj, specHash, interval) {
+		c.gitSyncs.Controller().EnqueueAfter("", obj.Name, interval)
+		return obj, nil
+	}
+	defer c.gitSyncs.Controller().EnqueueAfter("", obj.Name, interval)
+
+	newObj := obj.DeepCopy()
+	newObj.Status.SpecHash = specHash
+	newObj.Status.LastSyncTime = time.Now().UTC().Format(time.RFC3339)
+
+	commit, files, err := c.download(newObj)
+	if err != nil {
+		logrus.Errorf("[gitsync] Failed to download repository [%s] of GitSync [%s]: %v", obj.Spec.Repo, obj.Name, err)
+		v32.GitSyncConditionDownloaded.False(newObj)
+		v32.GitSyncConditionDownloaded.ReasonAndMessageFromError(newObj, err)
+		return c.gitSyncs.Update(newObj)
+	}
+	v32.GitSyncConditionDownloaded.True(newObj)
+	v32.GitSyncConditionDownloaded.Message(newObj, "")
+	v32.GitSyncConditionDownloaded.Reason(newObj, "")
+
+	manifests, err := parseManifests(files)
+	if err != nil {
+		// nothing is applied nor pruned until the repository is valid again
+		v32.GitSyncConditionSynced.False(newObj)
+		v32.GitSyncConditionSynced.ReasonAndMessageFromError(newObj, err)
+		return c.gitSyncs.Update(newObj)
+	}
+
+	newObj.Status.Resources = c.reconcile(newObj, manifests)
+	newObj.Status.Commit = commit
+	setSyncConditions(newObj)
+	return c.gitSyncs.Update(newObj)
+}
+
+func (c *controller) download(obj *v3.GitSync) (string, map[string][]byte, error) {
+	var secret *corev1.Secret
+	if obj.Spec.ClientSecretName != "" {
+		var err error
+		secret, err = c.secretLister.Get(namespace.GlobalNamespace, obj.Spec.ClientSecretName)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	commit, err := git.Update(secret, gitNamespace, obj.Name, obj.Spec.Repo, obj.Spec.Branch, obj.Spec.InsecureSkipTLSVerify)
+	if err != nil {
+		return "", nil, err
+	}
+	files, err := git.Manifests(gitNamespace, obj.Name, obj.Spec.Repo, obj.Spec.Paths)
+	return commit, files, err
+}
+
+// setSyncConditions sets the Synced and Drifted conditions from the state of the resources of the last sync.
+func setSyncConditions(obj *v3.GitSync) {
+	var failed, drifted []string
+	for _, resource := range obj.Status.Resources {
+		if resource.State == stateError {
+			failed = append(failed, fmt.Sprintf("%s %s: %s", resource.Kind, resourceName(resource), resource.Message))
+		}
+		if resource.Drifted {
+			drifted = append(drifted, fmt.Sprintf("%s %s", resource.Kind, resourceName(resource)))
+		}
+	}
+
+	v32.GitSyncConditionSynced.Reason(obj, "")
+	if len(failed) > 0 {
+		v32.GitSyncConditionSynced.False(obj)
+		v32.GitSyncConditionSynced.Message(obj, strings.Join(failed, "; "))
+	} else {
+		v32.GitSyncConditionSynced.True(obj)
+		v32.GitSyncConditionSynced.Message(obj, "")
+	}
+
+	if len(drifted) > 0 {
+		v32.GitSyncConditionDrifted.True(obj)
+		v32.GitSyncConditionDrifted.Message(obj, "changed outside of the repository: "+strings.Join(drifted, ", "))
+	} else {
+		v32.GitSyncConditionDrifted.False(obj)
+		v32.GitSyncConditionDrifted.Message(obj, "")
+	}
+}
+
+// shouldSync tells whether the GitSync is due, either because its spec changed or because the interval elapsed since
+// the last sync.
+func shouldSync(obj *v3.GitSync, specHash string, interval time.Duration) bool {
+	if obj.Status.SpecHash != specHash {
+		return true
+	}
+	last, err := time.Parse(time.RFC3339, obj.Status.LastSyncTime)
+	if err != nil {
+		return true
+	}
+	return time.Since(last) >= interval
+}
+
+func syncInterval(obj *v3.GitSync) time.Duration {
+	seconds := obj.Spec.IntervalSeconds
+	if seconds == 0 {
+		seconds = defaultInterval
+	} else if seconds < minInterval {
+		seconds = minInterval
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+func hashOf(obj interface{}) (string, error) {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func sortResources(resources []v32.GitSyncResource) {
+	sort.Slice(resources, func(i, j int) bool {
+		if resources[i].Path != resources[j].Path {
+			return resources[i].Path < resources[j].Path
+		}
+		return resourceKey(resources[i]) < resourceKey(resources[j])
+	})
+}
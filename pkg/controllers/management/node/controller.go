@@ -66,7 +66,7 @@
 }
 
 func Register(ctx context.Context, management *config.ManagementContext, clusterManager *clustermanager.Manager) {
-	secretStore, err := nodeconfig.NewStore(management.Core.Namespaces(""), management.Core)
+	secretStore, err := nodeconfig.NewStore(management.Core.Namespaces(""), management.Core, management.SecretsEncryptionKeys)
 	if err != nil {
 		logrus.Fatal(err)
 	}
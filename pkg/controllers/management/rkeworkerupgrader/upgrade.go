@@ -49,7 +49,7 @@ func Register(ctx context.Context, mgmt *config.ManagementContext, scaledContext
 		clusterLister:        mgmt.Management.Clusters("").Controller().Lister(),
 		nodes:                mgmt.Management.Nodes(""),
 		nodeLister:           mgmt.Management.Nodes("").Controller().Lister(),
-		lookup:               nodeserver.NewLookup(scaledContext.Core.Namespaces(""), scaledContext.Core),
+		lookup:               nodeserver.NewLookup(scaledContext.Core.Namespaces(""), scaledContext.Core, scaledContext.SecretsEncryptionKeys),
 		systemAccountManager: systemaccount.NewManagerFromScale(scaledContext),
 		serviceOptionsLister: mgmt.Management.RkeK8sServiceOptions("").Controller().Lister(),
 		serviceOptions:       mgmt.Management.RkeK8sServiceOptions(""),
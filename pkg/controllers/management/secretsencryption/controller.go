@@ -0,0 +1,63 @@
+package secretsencryption
+
+import (
+	"context"
+
+	"github.com/rancher/rancher/pkg/controllers/management/clusterprovisioner"
+	"github.com/rancher/rancher/pkg/encryptedstore"
+	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	"github.com/rancher/rancher/pkg/nodeconfig"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Controller encrypts the secrets of every encrypted store that are still in plain text, or that were encrypted
+// with a key other than the current one, as they are seen by the informer.
+type Controller struct {
+	secrets v1.SecretInterface
+	stores  []*encryptedstore.GenericEncryptedStore
+}
+
+func Register(ctx context.Context, management *config.ManagementContext) {
+	nodeStore, err := nodeconfig.NewStore(management.Core.Namespaces(""), management.Core, management.SecretsEncryptionKeys)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+	clusterStore, err := clusterprovisioner.NewStore(management.Core.Namespaces(""), management.Core, management.SecretsEncryptionKeys)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+	c := Controller{
+		secrets: management.Core.Secrets(""),
+		stores:  []*encryptedstore.GenericEncryptedStore{nodeStore, clusterStore},
+	}
+	management.Core.Secrets("").AddHandler(ctx, "management-secrets-reencryption", c.sync)
+}
+
+func (c *Controller) sync(key string, secret *corev1.Secret) (runtime.Object, error) {
+	if secret == nil || secret.DeletionTimestamp != nil {
+		return secret, nil
+	}
+	store := c.storeOf(secret)
+	if store == nil || !store.NeedsReencryption(secret) {
+		return secret, nil
+	}
+
+	logrus.Debugf("[secrets-reencryption] encrypting secret %s/%s with the current key", secret.Namespace, secret.Name)
+	reencrypted, err := store.Reencrypt(secret)
+	if err != nil {
+		return secret, err
+	}
+	return c.secrets.Update(reencrypted)
+}
+
+func (c *Controller) storeOf(secret *corev1.Secret) *encryptedstore.GenericEncryptedStore {
+	for _, store := range c.stores {
+		if store.Owns(secret) {
+			return store
+		}
+	}
+	return nil
+}
@@ -11,7 +11,6 @@
 
 type appHandler struct {
 	cattleAppClient           projectv3.AppInterface
-	cattleSecretClient        corev1.SecretInterface
 	cattleProjectClient       mgmtv3.ProjectInterface
 	cattleClusterGraphClient  mgmtv3.ClusterMonitorGraphInterface
 	cattleProjectGraphClient  mgmtv3.ProjectMonitorGraphInterface
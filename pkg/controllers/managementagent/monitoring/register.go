@@ -3,6 +3,7 @@
 import (
 	"context"
 
+	"github.com/rancher/rancher/pkg/controllers/management/clusterprovisioner"
 	"github.com/rancher/rancher/pkg/monitoring"
 	"github.com/rancher/rancher/pkg/systemaccount"
 	"github.com/rancher/rancher/pkg/types/config"
@@ -32,7 +33,6 @@ func Register(ctx context.Context, agentContext *config.UserContext) {
 	ah := &appHandler{
 		cattleAppClient:           cattleContext.Project.Apps(metav1.NamespaceAll),
 		cattleProjectClient:       cattleProjectsClient,
-		cattleSecretClient:        cattleContext.Core.Secrets(metav1.NamespaceAll),
 		cattleClusterGraphClient:  mgmtContext.ClusterMonitorGraphs(metav1.NamespaceAll),
 		cattleProjectGraphClient:  mgmtContext.ProjectMonitorGraphs(metav1.NamespaceAll),
 		cattleMonitorMetricClient: mgmtContext.MonitorMetrics(metav1.NamespaceAll),
@@ -70,6 +70,7 @@ func Register(ctx context.Context, agentContext *config.UserContext) {
 		cattleClustersClient: cattleClustersClient,
 		cattleCatalogManager: cattleContext.CatalogManager,
 		agentEndpointsLister: agentClusterMonitoringEndpointLister,
+		clusterStore:         clusterprovisioner.NewPersistentStore(cattleContext.Core.Namespaces(""), cattleContext.Core, cattleContext.SecretsEncryptionKeys),
 		app:                  ah,
 	}
 	cattleClustersClient.AddHandler(ctx, "cluster-monitoring-handler", ch.sync)
@@ -3,11 +3,15 @@
 import (
 	"fmt"
 
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/ref"
 	"github.com/sirupsen/logrus"
 )
 
+// EscalatedLabel marks the copy of an alert that is sent to the escalation recipients of its group.
+const EscalatedLabel = "escalated"
+
 func GetRuleID(groupID string, ruleName string) string {
 	return fmt.Sprintf("%s_%s", groupID, ruleName)
 }
@@ -16,6 +20,18 @@ func GetGroupID(namespace, name string) string {
 	return fmt.Sprintf("%s:%s", namespace, name)
 }
 
+// GetGroupRecipients returns the recipients of a group together with the recipients of its routes and its escalation.
+func GetGroupRecipients(recipients []v32.Recipient, field v32.CommonGroupField) []v32.Recipient {
+	result := append([]v32.Recipient{}, recipients...)
+	for _, route := range field.Routes {
+		result = append(result, route.Recipients...)
+	}
+	if field.Escalation != nil {
+		result = append(result, field.Escalation.Recipients...)
+	}
+	return result
+}
+
 func GetAlertManagerSecretName(appName string) string {
 	return fmt.Sprintf("alertmanager-%s", appName)
 }
@@ -4,6 +4,7 @@
 	"bytes"
 	"context"
 	"fmt"
+	"net/http"
 	"net/url"
 	"sort"
 	"strconv"
@@ -42,6 +43,8 @@
 	webhookReceiverURL  = "http://webhook-receiver.cattle-prometheus.svc:9094/"
 	DingTalk            = "DINGTALK"
 	MicrosoftTeams      = "MICROSOFT_TEAMS"
+	Telegram            = "TELEGRAM"
+	HTTPTemplate        = "HTTP_TEMPLATE"
 )
 
 type WebhookReceiverConfig struct {
@@ -54,10 +57,17 @@ type Provider struct {
 	WebHookURL string `json:"webhook_url,omitempty" yaml:"webhook_url,omitempty"`
 	Secret     string `json:"secret,omitempty" yaml:"secret,omitempty"`
 	ProxyURL   string `json:"proxy_url,omitempty" yaml:"proxy_url,omitempty"`
+	// The request of an HTTP template provider, the body is rendered from the template over the alerts.
+	Method          string            `json:"method,omitempty" yaml:"method,omitempty"`
+	Headers         map[string]string `json:"headers,omitempty" yaml:"headers,omitempty"`
+	ContentType     string            `json:"content_type,omitempty" yaml:"content_type,omitempty"`
+	Template        string            `json:"template,omitempty" yaml:"template,omitempty"`
+	SignatureHeader string            `json:"signature_header,omitempty" yaml:"signature_header,omitempty"`
 }
 
 type Receiver struct {
-	Provider string `yaml:"provider"`
+	Provider string   `yaml:"provider"`
+	To       []string `yaml:"to,omitempty"`
 }
 
 func NewConfigSyncer(ctx context.Context, cluster *config.UserContext, alertManager *manager.AlertManager, operatorCRDManager *manager.PromOperatorCRDManager) *ConfigSyncer {
@@ -149,7 +159,7 @@ func (d *ConfigSyncer) sync() error {
 
 	cAlertGroupsMap := map[string]*v3.ClusterAlertGroup{}
 	for _, v := range clusterAlertGroup {
-		if len(v.Spec.Recipients) > 0 {
+		if len(common.GetGroupRecipients(v.Spec.Recipients, v.Spec.CommonGroupField)) > 0 {
 			groupID := common.GetGroupID(v.Namespace, v.Name)
 			cAlertGroupsMap[groupID] = v
 		}
@@ -162,7 +172,7 @@ func (d *ConfigSyncer) sync() error {
 
 	pAlertGroupsMap := map[string]*v3.ProjectAlertGroup{}
 	for _, v := range projectAlertGroup {
-		if len(v.Spec.Recipients) > 0 && controller.ObjectInCluster(d.clusterName, v) {
+		if len(common.GetGroupRecipients(v.Spec.Recipients, v.Spec.CommonGroupField)) > 0 && controller.ObjectInCluster(d.clusterName, v) {
 			groupID := common.GetGroupID(v.Namespace, v.Name)
 			pAlertGroupsMap[groupID] = v
 		}
@@ -361,9 +371,11 @@ func (d *ConfigSyncer) addProjectAlert2Config(config *alertconfig.Config, projec
 			receiver := &alertconfig.Receiver{Name: groupID}
 
 			exist := d.addRecipients(notifiers, receiver, group.Spec.Recipients)
+			groupReceivers, groupRoutes := d.newGroupRoutes(groupID, group.Spec.CommonGroupField, notifiers)
 
-			if exist {
+			if exist || len(groupRoutes) > 0 {
 				config.Receivers = append(config.Receivers, receiver)
+				config.Receivers = append(config.Receivers, groupReceivers...)
 				r1 := d.newRoute(map[string]string{"group_id": groupID}, false, group.Spec.TimingField, []model.LabelName{"group_id"})
 
 				for _, alert := range rules {
@@ -379,6 +391,7 @@ func (d *ConfigSyncer) addProjectAlert2Config(config *alertconfig.Config, projec
 					}
 
 				}
+				prependGroupRoutes(r1, groupRoutes)
 				d.appendRoute(config.Route, r1)
 			}
 		}
@@ -398,9 +411,11 @@ func (d *ConfigSyncer) addClusterAlert2Config(config *alertconfig.Config, alerts
 		}
 
 		exist := d.addRecipients(notifiers, receiver, group.Spec.Recipients)
+		groupReceivers, groupRoutes := d.newGroupRoutes(groupID, group.Spec.CommonGroupField, notifiers)
 
-		if exist {
+		if exist || len(groupRoutes) > 0 {
 			config.Receivers = append(config.Receivers, receiver)
+			config.Receivers = append(config.Receivers, groupReceivers...)
 			r1 := d.newRoute(map[string]string{"group_id": groupID}, false, group.Spec.TimingField, []model.LabelName{"group_id"})
 			for _, alert := range groupRules {
 				if alert.Status.AlertState == "inactive" {
@@ -426,6 +441,7 @@ func (d *ConfigSyncer) addClusterAlert2Config(config *alertconfig.Config, alerts
 
 			}
 
+			prependGroupRoutes(r1, groupRoutes)
 			d.appendRoute(config.Route, r1)
 		}
 	}
@@ -569,6 +585,80 @@ func (d *ConfigSyncer) addRecipients(notifiers []*v3.Notifier, receiver *alertco
 				receiver.WebhookConfigs = append(receiver.WebhookConfigs, msTeams)
 				receiverExist = true
 
+			} else if notifier.Spec.TelegramConfig != nil {
+				webhookURL := webhookReceiverURL + telegramReceiverName(r)
+				telegram := &alertconfig.WebhookConfig{
+					NotifierConfig: commonNotifierConfig,
+					URL:            webhookURL,
+				}
+
+				receiver.WebhookConfigs = append(receiver.WebhookConfigs, telegram)
+				receiverExist = true
+
+			} else if notifier.Spec.HTTPTemplateConfig != nil {
+				webhookURL := webhookReceiverURL + r.NotifierName
+				httpTemplate := &alertconfig.WebhookConfig{
+					NotifierConfig: commonNotifierConfig,
+					URL:            webhookURL,
+				}
+
+				receiver.WebhookConfigs = append(receiver.WebhookConfigs, httpTemplate)
+				receiverExist = true
+
+			} else if notifier.Spec.OpsgenieConfig != nil {
+				opsgenie := &alertconfig.OpsGenieConfig{
+					NotifierConfig: commonNotifierConfig,
+					APIKey:         alertconfig.Secret(notifier.Spec.OpsgenieConfig.APIKey),
+					APIHost:        notifier.Spec.OpsgenieConfig.APIURL,
+					Message:        `{{ template "rancher.title" . }}`,
+					Description:    `{{ template "slack.text" . }}`,
+					Source:         "rancher",
+					Teams:          notifier.Spec.OpsgenieConfig.DefaultRecipient,
+				}
+				if r.Recipient != "" {
+					opsgenie.Teams = r.Recipient
+				}
+
+				if notifierutil.IsHTTPClientConfigSet(notifier.Spec.OpsgenieConfig.HTTPClientConfig) {
+					url, err := toAlertManagerURL(notifier.Spec.OpsgenieConfig.HTTPClientConfig.ProxyURL)
+					if err != nil {
+						logrus.Errorf("Failed to parse opsgenie proxy url %s, %v", notifier.Spec.OpsgenieConfig.HTTPClientConfig.ProxyURL, err)
+						continue
+					}
+					opsgenie.HTTPConfig = &alertconfig.HTTPClientConfig{
+						ProxyURL: *url,
+					}
+				}
+				receiver.OpsGenieConfigs = append(receiver.OpsGenieConfigs, opsgenie)
+				receiverExist = true
+
+			} else if notifier.Spec.MattermostConfig != nil {
+				// Mattermost incoming webhooks accept the payload of Slack
+				mattermost := &alertconfig.SlackConfig{
+					NotifierConfig: commonNotifierConfig,
+					APIURL:         alertconfig.Secret(notifier.Spec.MattermostConfig.URL),
+					Channel:        notifier.Spec.MattermostConfig.DefaultRecipient,
+					Text:           `{{ template "slack.text" . }}`,
+					Title:          `{{ template "rancher.title" . }}`,
+					Color:          `{{ if eq (index .Alerts 0).Labels.severity "critical" }}danger{{ else if eq (index .Alerts 0).Labels.severity "warning" }}warning{{ else }}good{{ end }}`,
+				}
+				if r.Recipient != "" {
+					mattermost.Channel = r.Recipient
+				}
+
+				if notifierutil.IsHTTPClientConfigSet(notifier.Spec.MattermostConfig.HTTPClientConfig) {
+					url, err := toAlertManagerURL(notifier.Spec.MattermostConfig.HTTPClientConfig.ProxyURL)
+					if err != nil {
+						logrus.Errorf("Failed to parse mattermost proxy url %s, %v", notifier.Spec.MattermostConfig.HTTPClientConfig.ProxyURL, err)
+						continue
+					}
+					mattermost.HTTPConfig = &alertconfig.HTTPClientConfig{
+						ProxyURL: *url,
+					}
+				}
+				receiver.SlackConfigs = append(receiver.SlackConfigs, mattermost)
+				receiverExist = true
+
 			} else if notifier.Spec.WebhookConfig != nil {
 				webhook := &alertconfig.WebhookConfig{
 					NotifierConfig: commonNotifierConfig,
@@ -703,6 +793,15 @@ func getProjectAlertGroupBy(spec v32.ProjectAlertRuleSpec) []model.LabelName {
 	return nil
 }
 
+// telegramReceiverName returns the name of the webhook receiver of a Telegram recipient. A recipient that overrides the
+// chat of the notifier gets a receiver of its own, so that alert groups sending to different chats do not collide.
+func telegramReceiverName(r v32.Recipient) string {
+	if r.Recipient == "" {
+		return r.NotifierName
+	}
+	return r.NotifierName + "-" + url.PathEscape(r.Recipient)
+}
+
 func toAlertManagerURL(urlStr string) (*alertconfig.URL, error) {
 	url, err := url.Parse(urlStr)
 	if err != nil {
@@ -714,11 +813,11 @@ func toAlertManagerURL(urlStr string) (*alertconfig.URL, error) {
 func (d *ConfigSyncer) syncWebhookConfig(notifiers []*v3.Notifier, cAlertGroupsMap map[string]*v3.ClusterAlertGroup, pAlertGroupsMap map[string]*v3.ProjectAlertGroup) error {
 	var recipients []v32.Recipient
 	for _, group := range cAlertGroupsMap {
-		recipients = append(recipients, group.Spec.Recipients...)
+		recipients = append(recipients, common.GetGroupRecipients(group.Spec.Recipients, group.Spec.CommonGroupField)...)
 	}
 
 	for _, group := range pAlertGroupsMap {
-		recipients = append(recipients, group.Spec.Recipients...)
+		recipients = append(recipients, common.GetGroupRecipients(group.Spec.Recipients, group.Spec.CommonGroupField)...)
 	}
 
 	webhookSecreteName, altermanagerAppNamespace := monitorutil.SecretWebhook()
@@ -768,6 +867,57 @@ func (d *ConfigSyncer) syncWebhookConfig(notifiers []*v3.Notifier, cAlertGroupsM
 				}
 				providers[r.NotifierName] = provider
 				receivers[r.NotifierName] = receiver
+			} else if notifier.Spec.TelegramConfig != nil {
+				provider := &Provider{
+					Type:       Telegram,
+					WebHookURL: notifier.Spec.TelegramConfig.APIURL,
+					Secret:     notifier.Spec.TelegramConfig.BotToken,
+				}
+				if provider.WebHookURL == "" {
+					provider.WebHookURL = notifierutil.DefaultTelegramURL
+				}
+				if notifierutil.IsHTTPClientConfigSet(notifier.Spec.TelegramConfig.HTTPClientConfig) {
+					provider.ProxyURL = notifier.Spec.TelegramConfig.HTTPClientConfig.ProxyURL
+				}
+				chatID := notifier.Spec.TelegramConfig.DefaultRecipient
+				if r.Recipient != "" {
+					chatID = r.Recipient
+				}
+				receiver := &Receiver{
+					Provider: r.NotifierName,
+					To:       []string{chatID},
+				}
+				providers[r.NotifierName] = provider
+				receivers[telegramReceiverName(r)] = receiver
+			} else if notifier.Spec.HTTPTemplateConfig != nil {
+				c := notifier.Spec.HTTPTemplateConfig
+				provider := &Provider{
+					Type:            HTTPTemplate,
+					WebHookURL:      c.URL,
+					Secret:          c.Secret,
+					Method:          c.Method,
+					Headers:         c.Headers,
+					ContentType:     c.ContentType,
+					Template:        c.BodyTemplate,
+					SignatureHeader: c.SignatureHeader,
+				}
+				if provider.Method == "" {
+					provider.Method = http.MethodPost
+				}
+				if provider.ContentType == "" {
+					provider.ContentType = "application/json"
+				}
+				if provider.Secret != "" && provider.SignatureHeader == "" {
+					provider.SignatureHeader = notifierutil.DefaultSignatureHeader
+				}
+				if notifierutil.IsHTTPClientConfigSet(c.HTTPClientConfig) {
+					provider.ProxyURL = c.HTTPClientConfig.ProxyURL
+				}
+				receiver := &Receiver{
+					Provider: r.NotifierName,
+				}
+				providers[r.NotifierName] = provider
+				receivers[r.NotifierName] = receiver
 			}
 		}
 	}
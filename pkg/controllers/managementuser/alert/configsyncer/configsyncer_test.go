@@ -8,8 +8,13 @@
 
 	"github.com/prometheus/common/model"
 	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	alertconfig "github.com/rancher/rancher/pkg/controllers/managementuser/alert/config"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/manager"
+	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	"github.com/rancher/rancher/pkg/generated/norman/core/v1/fakes"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -448,3 +453,180 @@ func TestAddProjectAlert2Config(t *testing.T) {
 
 	projectMetricGroupBy = getProjectAlertGroupBy(projectMetricAlert.Spec)
 )
+
+func TestAddRecipients(t *testing.T) {
+	newNotifier := func(name string, spec v32.NotifierSpec) *v3.Notifier {
+		spec.ClusterName = clusterName
+		return &v3.Notifier{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       spec,
+		}
+	}
+	notifiers := []*v3.Notifier{
+		newNotifier("opsgenie", v32.NotifierSpec{OpsgenieConfig: &v32.OpsgenieConfig{APIKey: "key", DefaultRecipient: "ops"}}),
+		newNotifier("mattermost", v32.NotifierSpec{MattermostConfig: &v32.MattermostConfig{URL: "https://mattermost.example.com/hooks/1"}}),
+		newNotifier("telegram", v32.NotifierSpec{TelegramConfig: &v32.TelegramConfig{BotToken: "token", DefaultRecipient: "-100"}}),
+		newNotifier("template", v32.NotifierSpec{HTTPTemplateConfig: &v32.HTTPTemplateConfig{URL: "https://hooks.example.com/alerts"}}),
+	}
+	recipients := []v32.Recipient{
+		{NotifierName: clusterName + ":opsgenie"},
+		{NotifierName: clusterName + ":mattermost", Recipient: "town-square"},
+		{NotifierName: clusterName + ":telegram", Recipient: "-200"},
+		{NotifierName: clusterName + ":telegram"},
+		{NotifierName: clusterName + ":telegram", Recipient: "@channel"},
+		{NotifierName: clusterName + ":template"},
+	}
+
+	configSyncer := ConfigSyncer{clusterName: clusterName}
+	receiver := &alertconfig.Receiver{Name: groupID}
+	if !configSyncer.addRecipients(notifiers, receiver, recipients) {
+		t.Fatal("expected receivers to be added")
+	}
+
+	if len(receiver.OpsGenieConfigs) != 1 || receiver.OpsGenieConfigs[0].Teams != "ops" {
+		t.Errorf("expected an opsgenie config for team ops, actual %v", receiver.OpsGenieConfigs)
+	}
+	if len(receiver.SlackConfigs) != 1 || receiver.SlackConfigs[0].Channel != "town-square" {
+		t.Errorf("expected a slack config for the mattermost channel town-square, actual %v", receiver.SlackConfigs)
+	}
+	var urls []string
+	for _, webhook := range receiver.WebhookConfigs {
+		urls = append(urls, webhook.URL)
+	}
+	expectedURLs := []string{
+		webhookReceiverURL + clusterName + ":telegram--200",
+		webhookReceiverURL + clusterName + ":telegram",
+		webhookReceiverURL + clusterName + ":telegram-@channel",
+		webhookReceiverURL + clusterName + ":template",
+	}
+	if !reflect.DeepEqual(urls, expectedURLs) {
+		t.Errorf("expected webhook receiver urls %v, actual %v", expectedURLs, urls)
+	}
+}
+
+func TestSyncWebhookConfig(t *testing.T) {
+	notifiers := []*v3.Notifier{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "telegram", Namespace: clusterName},
+			Spec: v32.NotifierSpec{
+				ClusterName:    clusterName,
+				TelegramConfig: &v32.TelegramConfig{BotToken: "token", DefaultRecipient: "-100"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "template", Namespace: clusterName},
+			Spec: v32.NotifierSpec{
+				ClusterName: clusterName,
+				HTTPTemplateConfig: &v32.HTTPTemplateConfig{
+					URL:          "https://hooks.example.com/alerts",
+					Method:       "PUT",
+					Headers:      map[string]string{"Authorization": "Bearer token"},
+					BodyTemplate: `{"text": "{{ .Title }}"}`,
+					Secret:       "hmac",
+				},
+			},
+		},
+	}
+	group := &v3.ClusterAlertGroup{
+		Spec: v32.ClusterGroupSpec{
+			Recipients: []v32.Recipient{
+				{NotifierName: clusterName + ":telegram", Recipient: "-200"},
+				{NotifierName: clusterName + ":telegram"},
+				{NotifierName: clusterName + ":template"},
+			},
+		},
+	}
+
+	secret := &corev1.Secret{Data: map[string][]byte{}}
+	secrets := &fakes.SecretInterfaceMock{
+		GetFunc: func(name string, opts metav1.GetOptions) (*corev1.Secret, error) {
+			return secret, nil
+		},
+		UpdateFunc: func(in *corev1.Secret) (*corev1.Secret, error) {
+			secret = in
+			return in, nil
+		},
+	}
+	configSyncer := ConfigSyncer{
+		clusterName: clusterName,
+		secretsGetter: &fakes.SecretsGetterMock{
+			SecretsFunc: func(namespace string) v1.SecretInterface {
+				return secrets
+			},
+		},
+	}
+	if err := configSyncer.syncWebhookConfig(notifiers, map[string]*v3.ClusterAlertGroup{"group": group}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	config := WebhookReceiverConfig{}
+	if err := yaml.Unmarshal(secret.Data["config.yaml"], &config); err != nil {
+		t.Fatal(err)
+	}
+	expectedProviders := map[string]*Provider{
+		clusterName + ":telegram": {
+			Type:       Telegram,
+			WebHookURL: "https://api.telegram.org",
+			Secret:     "token",
+		},
+		clusterName + ":template": {
+			Type:            HTTPTemplate,
+			WebHookURL:      "https://hooks.example.com/alerts",
+			Secret:          "hmac",
+			Method:          "PUT",
+			Headers:         map[string]string{"Authorization": "Bearer token"},
+			ContentType:     "application/json",
+			Template:        `{"text": "{{ .Title }}"}`,
+			SignatureHeader: "X-Rancher-Signature",
+		},
+	}
+	if !reflect.DeepEqual(config.Providers, expectedProviders) {
+		t.Errorf("expected providers %v, actual %v", expectedProviders, config.Providers)
+	}
+	expectedReceivers := map[string]*Receiver{
+		clusterName + ":telegram--200": {Provider: clusterName + ":telegram", To: []string{"-200"}},
+		clusterName + ":telegram":      {Provider: clusterName + ":telegram", To: []string{"-100"}},
+		clusterName + ":template":      {Provider: clusterName + ":template"},
+	}
+	if !reflect.DeepEqual(config.Receivers, expectedReceivers) {
+		t.Errorf("expected receivers %v, actual %v", expectedReceivers, config.Receivers)
+	}
+}
+
+func TestGroupRoutes(t *testing.T) {
+	commonGroupField := v32.CommonGroupField{
+		Routes: []v32.AlertRoute{
+			{Severity: "critical", Recipients: recipients},
+			{MatchRegex: map[string]string{"namespace": "kube-.*"}, Recipients: recipients, Continue: true},
+			{Severity: "info", Recipients: []v32.Recipient{{NotifierName: clusterName + ":missing"}}},
+		},
+		Escalation:  &v32.AlertEscalation{AfterMinutes: 30, Recipients: recipients},
+		TimingField: defaultTimingField,
+	}
+
+	configSyncer := ConfigSyncer{clusterName: clusterName}
+	receivers, routes := configSyncer.newGroupRoutes(groupID, commonGroupField, notifiers)
+
+	if len(receivers) != 3 || len(routes) != 3 {
+		t.Fatalf("expected the escalation and two routes, actual %d receivers and %d routes", len(receivers), len(routes))
+	}
+	if routes[0].Receiver != groupID+"-escalation" || routes[0].Match["escalated"] != "true" {
+		t.Errorf("expected the escalation route first, actual %v", routes[0])
+	}
+	if routes[1].Receiver != groupID+"-route-0" || routes[1].Match["severity"] != "critical" || routes[1].Continue {
+		t.Errorf("unexpected severity route %v", routes[1])
+	}
+	if routes[2].MatchRE["namespace"].String() != "kube-.*" || !routes[2].Continue {
+		t.Errorf("unexpected regex route %v", routes[2])
+	}
+
+	ruleRoute := &alertconfig.Route{Match: map[string]string{"rule_id": "rule"}}
+	groupRoute := &alertconfig.Route{Receiver: groupID, Routes: []*alertconfig.Route{ruleRoute}}
+	prependGroupRoutes(groupRoute, routes)
+	if len(groupRoute.Routes) != 4 || groupRoute.Routes[3] != ruleRoute {
+		t.Errorf("expected the group routes in front of the rule routes, actual %v", groupRoute.Routes)
+	}
+	if len(routes[1].Routes) != 1 || routes[1].Routes[0] != ruleRoute {
+		t.Errorf("expected the rule routes below the group routes, actual %v", routes[1].Routes)
+	}
+}
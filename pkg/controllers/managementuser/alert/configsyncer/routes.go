@@ -0,0 +1,81 @@
+package configsyncer
+
+import (
+	"regexp"
+	"strconv"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/common"
+	alertconfig "github.com/rancher/rancher/pkg/controllers/managementuser/alert/config"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/sirupsen/logrus"
+)
+
+// newGroupRoutes returns a receiver and a route for the escalation and for every route of a group. Routes whose
+// recipients can not be found are left out.
+func (d *ConfigSyncer) newGroupRoutes(groupID string, field v32.CommonGroupField, notifiers []*v3.Notifier) ([]*alertconfig.Receiver, []*alertconfig.Route) {
+	var (
+		receivers []*alertconfig.Receiver
+		routes    []*alertconfig.Route
+	)
+
+	if field.Escalation != nil {
+		receiver := &alertconfig.Receiver{Name: groupID + "-escalation"}
+		if d.addRecipients(notifiers, receiver, field.Escalation.Recipients) {
+			receivers = append(receivers, receiver)
+			routes = append(routes, &alertconfig.Route{
+				Receiver: receiver.Name,
+				Match:    map[string]string{common.EscalatedLabel: "true"},
+			})
+		}
+	}
+
+	for i, r := range field.Routes {
+		match := map[string]string{}
+		for k, v := range r.Match {
+			match[k] = v
+		}
+		if r.Severity != "" {
+			match["severity"] = r.Severity
+		}
+		matchRE := map[string]alertconfig.Regexp{}
+		for k, v := range r.MatchRegex {
+			re, err := regexp.Compile(v)
+			if err != nil {
+				logrus.Errorf("Failed to parse the regex of route %d of alert group %s, %v", i, groupID, err)
+				continue
+			}
+			matchRE[k] = alertconfig.Regexp{Regexp: re}
+		}
+
+		receiver := &alertconfig.Receiver{Name: groupID + "-route-" + strconv.Itoa(i)}
+		if !d.addRecipients(notifiers, receiver, r.Recipients) {
+			continue
+		}
+		receivers = append(receivers, receiver)
+		route := &alertconfig.Route{
+			Receiver: receiver.Name,
+			Match:    match,
+			Continue: r.Continue,
+		}
+		if len(matchRE) > 0 {
+			route.MatchRE = matchRE
+		}
+		routes = append(routes, route)
+	}
+
+	return receivers, routes
+}
+
+// prependGroupRoutes puts the routes of a group in front of the routes of its rules. The routes of the rules are
+// repeated below every route of the group, so that the timing of the rules applies to routed alerts as well.
+func prependGroupRoutes(groupRoute *alertconfig.Route, routes []*alertconfig.Route) {
+	if len(routes) == 0 {
+		return
+	}
+	ruleRoutes := groupRoute.Routes
+	for _, route := range routes {
+		route.Routes = ruleRoutes
+	}
+	groupRoute.Routes = append(routes, ruleRoutes...)
+}
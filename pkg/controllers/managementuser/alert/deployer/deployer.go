@@ -41,6 +41,8 @@
 	webhookReceiverTypes = []string{
 		"dingtalk",
 		"msteams",
+		"telegram",
+		"httptemplate",
 	}
 )
 
@@ -198,9 +200,10 @@ func (d *Deployer) needDeploy() (bool, bool, error) {
 	}
 
 	for _, alert := range clusterAlerts {
-		if len(alert.Spec.Recipients) > 0 {
+		recipients := alertutil.GetGroupRecipients(alert.Spec.Recipients, alert.Spec.CommonGroupField)
+		if len(recipients) > 0 {
 			needDeploy = true
-			for _, r := range alert.Spec.Recipients {
+			for _, r := range recipients {
 				if slice.ContainsString(webhookReceiverTypes, r.NotifierType) {
 					needWebhookReceiver = true
 					return needDeploy, needWebhookReceiver, nil
@@ -216,9 +219,10 @@ func (d *Deployer) needDeploy() (bool, bool, error) {
 
 	for _, alert := range projectAlerts {
 		if controller.ObjectInCluster(d.clusterName, alert) {
-			if len(alert.Spec.Recipients) > 0 {
+			recipients := alertutil.GetGroupRecipients(alert.Spec.Recipients, alert.Spec.CommonGroupField)
+			if len(recipients) > 0 {
 				needDeploy = true
-				for _, r := range alert.Spec.Recipients {
+				for _, r := range recipients {
 					if slice.ContainsString(webhookReceiverTypes, r.NotifierType) {
 						needWebhookReceiver = true
 						return needDeploy, needWebhookReceiver, nil
@@ -35,6 +35,9 @@
 
 {{- else if eq .CommonLabels.alert_type "metric" -}}
 The metric {{ .CommonLabels.alert_name}} crossed the threshold 
+
+{{- else if eq .CommonLabels.alert_type "etcdBackup" -}}
+The verification of the etcd backup {{ .CommonLabels.component_name}} failed
 {{ end -}}
 {{ end -}}
 
@@ -361,3 +361,112 @@ func (m *AlertManager) SendAlert(labels map[string]string) error {
 
 	return nil
 }
+
+// GetSilenceList returns the silences of alertmanager, including the expired ones.
+func (m *AlertManager) GetSilenceList() ([]*Silence, error) {
+	url, err := m.GetAlertManagerEndpoint()
+	if err != nil {
+		return nil, err
+	}
+	res := struct {
+		Data   []*Silence `json:"data"`
+		Status string     `json:"status"`
+	}{}
+
+	req, err := http.NewRequest(http.MethodGet, url+"/api/v1/silences", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	requestBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(requestBytes, &res); err != nil {
+		return nil, err
+	}
+
+	if res.Status != "success" {
+		return nil, fmt.Errorf("Failed to get silence rules")
+	}
+
+	return res.Data, nil
+}
+
+// AddSilence mutes the alerts matching all the matchers between startsAt and endsAt.
+func (m *AlertManager) AddSilence(matchers map[string]string, startsAt, endsAt time.Time, createdBy, comment string) error {
+	url, err := m.GetAlertManagerEndpoint()
+	if err != nil {
+		return err
+	}
+
+	silence := model.Silence{
+		StartsAt:  startsAt,
+		EndsAt:    endsAt,
+		CreatedAt: time.Now(),
+		CreatedBy: createdBy,
+		Comment:   comment,
+	}
+	for k, v := range matchers {
+		silence.Matchers = append(silence.Matchers, &model.Matcher{
+			Name:  model.LabelName(k),
+			Value: v,
+		})
+	}
+
+	silenceData, err := json.Marshal(silence)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, url+"/api/v1/silences", bytes.NewBuffer(silenceData))
+	if err != nil {
+		return err
+	}
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("alertmanager response is %d, body: %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// DeleteSilence expires the silence with the given id.
+func (m *AlertManager) DeleteSilence(id string) error {
+	url, err := m.GetAlertManagerEndpoint()
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, url+"/api/v1/silence/"+id, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, err = ioutil.ReadAll(resp.Body)
+	return err
+}
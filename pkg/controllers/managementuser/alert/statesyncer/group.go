@@ -0,0 +1,172 @@
+package statesyncer
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rancher/norman/controller"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/common"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/manager"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// groupSilenceCreator is the creator of the silences made for the silences of alert groups, silences of other creators
+// are left alone.
+const groupSilenceCreator = "rancher-group-silence"
+
+type groupSilence struct {
+	createdBy string
+	matchers  map[string]string
+	startsAt  time.Time
+	endsAt    time.Time
+	comment   string
+}
+
+// syncGroups escalates the alerts that are not resolved in time and applies the silences of the alert groups.
+func (s *StateSyncer) syncGroups(apiAlerts []*manager.APIAlert) error {
+	escalations := map[string]*v32.AlertEscalation{}
+	var silences []groupSilence
+	now := time.Now()
+
+	clusterGroups, err := s.clusterAlertGroupLister.List(s.clusterName, labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, group := range clusterGroups {
+		groupID := common.GetGroupID(group.Namespace, group.Name)
+		if group.Spec.Escalation != nil {
+			escalations[groupID] = group.Spec.Escalation
+		}
+		silences = append(silences, groupSilences(groupID, group.Spec.Silences, now)...)
+	}
+
+	projectGroups, err := s.projectAlertGroupLister.List("", labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, group := range projectGroups {
+		if !controller.ObjectInCluster(s.clusterName, group) {
+			continue
+		}
+		groupID := common.GetGroupID(group.Namespace, group.Name)
+		if group.Spec.Escalation != nil {
+			escalations[groupID] = group.Spec.Escalation
+		}
+		silences = append(silences, groupSilences(groupID, group.Spec.Silences, now)...)
+	}
+
+	for _, alert := range escalatedAlerts(apiAlerts, escalations, now) {
+		if err := s.alertManager.SendAlert(alert); err != nil {
+			logrus.Errorf("Error occurred while escalating alert %s: %v", alert["rule_id"], err)
+		}
+	}
+
+	return s.syncSilences(silences)
+}
+
+func (s *StateSyncer) syncSilences(desired []groupSilence) error {
+	existing, err := s.alertManager.GetSilenceList()
+	if err != nil {
+		return err
+	}
+
+	found := map[string]bool{}
+	for _, silence := range existing {
+		if !strings.HasPrefix(silence.CreatedBy, groupSilenceCreator+":") || silence.Status.State == manager.SilenceStateExpired {
+			continue
+		}
+		if isDesiredSilence(silence.CreatedBy, desired) {
+			found[silence.CreatedBy] = true
+			continue
+		}
+		if err := s.alertManager.DeleteSilence(silence.ID); err != nil {
+			logrus.Errorf("Error occurred while removing silence %s: %v", silence.ID, err)
+		}
+	}
+
+	for _, silence := range desired {
+		if found[silence.createdBy] {
+			continue
+		}
+		if err := s.alertManager.AddSilence(silence.matchers, silence.startsAt, silence.endsAt, silence.createdBy, silence.comment); err != nil {
+			logrus.Errorf("Error occurred while adding silence %s: %v", silence.createdBy, err)
+		}
+	}
+
+	return nil
+}
+
+func isDesiredSilence(createdBy string, desired []groupSilence) bool {
+	for _, silence := range desired {
+		if silence.createdBy == createdBy {
+			return true
+		}
+	}
+	return false
+}
+
+// groupSilences returns the silences of a group that have not ended yet. Every silence is limited to the alerts of the
+// group, and is identified by a hash of its spec so that a changed silence replaces the old one.
+func groupSilences(groupID string, silences []v32.AlertSilence, now time.Time) []groupSilence {
+	var result []groupSilence
+	for _, silence := range silences {
+		startsAt, err := time.Parse(time.RFC3339, silence.StartsAt)
+		if err != nil {
+			logrus.Errorf("Invalid start of silence %s of alert group %s: %v", silence.Name, groupID, err)
+			continue
+		}
+		endsAt, err := time.Parse(time.RFC3339, silence.EndsAt)
+		if err != nil {
+			logrus.Errorf("Invalid end of silence %s of alert group %s: %v", silence.Name, groupID, err)
+			continue
+		}
+		if !endsAt.After(now) || !endsAt.After(startsAt) {
+			continue
+		}
+
+		matchers := map[string]string{}
+		for k, v := range silence.Match {
+			matchers[k] = v
+		}
+		matchers["group_id"] = groupID
+
+		data, _ := json.Marshal(silence)
+		hash := fmt.Sprintf("%x", sha256.Sum256(data))
+		result = append(result, groupSilence{
+			createdBy: fmt.Sprintf("%s:%s/%s:%s", groupSilenceCreator, groupID, silence.Name, hash[:12]),
+			matchers:  matchers,
+			startsAt:  startsAt,
+			endsAt:    endsAt,
+			comment:   silence.Comment,
+		})
+	}
+	return result
+}
+
+// escalatedAlerts returns the labels of the escalated copies of the alerts that have been firing for longer than the
+// escalation of their group allows. The copies are sent again on every sync while the alerts fire, and resolve on their
+// own once they are not sent anymore.
+func escalatedAlerts(apiAlerts []*manager.APIAlert, escalations map[string]*v32.AlertEscalation, now time.Time) []map[string]string {
+	var result []map[string]string
+	for _, a := range apiAlerts {
+		if a.Alert == nil || a.Status.State == manager.AlertStateSuppressed || a.Labels[common.EscalatedLabel] != "" {
+			continue
+		}
+		escalation, ok := escalations[string(a.Labels["group_id"])]
+		if !ok || now.Sub(a.StartsAt) < time.Duration(escalation.AfterMinutes)*time.Minute {
+			continue
+		}
+		labels := map[string]string{}
+		for k, v := range a.Labels {
+			labels[string(k)] = string(v)
+		}
+		labels[common.EscalatedLabel] = "true"
+		result = append(result, labels)
+	}
+	return result
+}
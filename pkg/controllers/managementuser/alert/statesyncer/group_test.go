@@ -0,0 +1,68 @@
+package statesyncer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/manager"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEscalatedAlerts(t *testing.T) {
+	assert := assert.New(t)
+
+	now := time.Now()
+	newAlert := func(groupID string, firingFor time.Duration, state manager.State, escalated bool) *manager.APIAlert {
+		labels := model.LabelSet{"group_id": model.LabelValue(groupID), "rule_id": "rule"}
+		if escalated {
+			labels["escalated"] = "true"
+		}
+		return &manager.APIAlert{
+			Alert:  &model.Alert{Labels: labels, StartsAt: now.Add(-firingFor)},
+			Status: manager.AlertStatus{State: state},
+		}
+	}
+	escalations := map[string]*v32.AlertEscalation{
+		"c-1:g-1": {AfterMinutes: 30},
+	}
+
+	alerts := escalatedAlerts([]*manager.APIAlert{
+		newAlert("c-1:g-1", time.Hour, manager.AlertStateActive, false),
+		newAlert("c-1:g-1", time.Minute, manager.AlertStateActive, false),
+		newAlert("c-1:g-1", time.Hour, manager.AlertStateSuppressed, false),
+		newAlert("c-1:g-1", time.Hour, manager.AlertStateActive, true),
+		newAlert("c-1:g-2", time.Hour, manager.AlertStateActive, false),
+	}, escalations, now)
+
+	assert.Equal([]map[string]string{{"group_id": "c-1:g-1", "rule_id": "rule", "escalated": "true"}}, alerts)
+}
+
+func TestGroupSilences(t *testing.T) {
+	assert := assert.New(t)
+
+	now := time.Now()
+	maintenance := v32.AlertSilence{
+		Name:     "maintenance",
+		Match:    map[string]string{"severity": "warning"},
+		StartsAt: now.Add(time.Hour).Format(time.RFC3339),
+		EndsAt:   now.Add(2 * time.Hour).Format(time.RFC3339),
+	}
+	ended := v32.AlertSilence{
+		Name:     "ended",
+		StartsAt: now.Add(-2 * time.Hour).Format(time.RFC3339),
+		EndsAt:   now.Add(-time.Hour).Format(time.RFC3339),
+	}
+
+	silences := groupSilences("c-1:g-1", []v32.AlertSilence{maintenance, ended}, now)
+	if assert.Len(silences, 1) {
+		assert.Equal(map[string]string{"severity": "warning", "group_id": "c-1:g-1"}, silences[0].matchers)
+		assert.Contains(silences[0].createdBy, groupSilenceCreator+":c-1:g-1/maintenance:")
+	}
+
+	// a changed silence gets a new identity, so that the old one is replaced
+	maintenance.EndsAt = now.Add(3 * time.Hour).Format(time.RFC3339)
+	changed := groupSilences("c-1:g-1", []v32.AlertSilence{maintenance}, now)
+	assert.NotEqual(silences[0].createdBy, changed[0].createdBy)
+}
@@ -17,10 +17,12 @@
 
 func StartStateSyncer(ctx context.Context, cluster *config.UserContext, manager *manager.AlertManager) {
 	s := &StateSyncer{
-		clusterAlertRules: cluster.Management.Management.ClusterAlertRules(cluster.ClusterName),
-		projectAlertRules: cluster.Management.Management.ProjectAlertRules(""),
-		alertManager:      manager,
-		clusterName:       cluster.ClusterName,
+		clusterAlertRules:       cluster.Management.Management.ClusterAlertRules(cluster.ClusterName),
+		projectAlertRules:       cluster.Management.Management.ProjectAlertRules(""),
+		clusterAlertGroupLister: cluster.Management.Management.ClusterAlertGroups(cluster.ClusterName).Controller().Lister(),
+		projectAlertGroupLister: cluster.Management.Management.ProjectAlertGroups("").Controller().Lister(),
+		alertManager:            manager,
+		clusterName:             cluster.ClusterName,
 	}
 	go s.watch(ctx, 10*time.Second)
 }
@@ -32,10 +34,12 @@ func (s *StateSyncer) watch(ctx context.Context, interval time.Duration) {
 }
 
 type StateSyncer struct {
-	clusterAlertRules v3.ClusterAlertRuleInterface
-	projectAlertRules v3.ProjectAlertRuleInterface
-	alertManager      *manager.AlertManager
-	clusterName       string
+	clusterAlertRules       v3.ClusterAlertRuleInterface
+	projectAlertRules       v3.ProjectAlertRuleInterface
+	clusterAlertGroupLister v3.ClusterAlertGroupLister
+	projectAlertGroupLister v3.ProjectAlertGroupLister
+	alertManager            *manager.AlertManager
+	clusterName             string
 }
 
 //synchronize the state between alert CRD and alertmanager.
@@ -101,6 +105,10 @@ func (s *StateSyncer) syncState() error {
 				}
 			}
 		}
+
+		if err := s.syncGroups(apiAlerts); err != nil {
+			logrus.Errorf("Error occurred while syncing the escalations and silences of alert groups, %v", err)
+		}
 	}
 
 	return err
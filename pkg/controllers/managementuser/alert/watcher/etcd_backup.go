@@ -0,0 +1,102 @@
+package watcher
+
+import (
+	"context"
+	"fmt"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/common"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/alert/manager"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type EtcdBackupWatcher struct {
+	clusterName            string
+	clusterLister          v3.ClusterLister
+	clusterAlertRuleLister v3.ClusterAlertRuleLister
+	alertManager           *manager.AlertManager
+}
+
+func StartEtcdBackupWatcher(ctx context.Context, cluster *config.UserContext, alertManager *manager.AlertManager) {
+	clusterLister := cluster.Management.Management.Clusters("").Controller().Lister()
+	etcdBackups := cluster.Management.Management.EtcdBackups(cluster.ClusterName)
+	clusterAlertRuleLister := cluster.Management.Management.ClusterAlertRules(cluster.ClusterName).Controller().Lister()
+
+	etcdBackupWatcher := &EtcdBackupWatcher{
+		clusterName:            cluster.ClusterName,
+		clusterLister:          clusterLister,
+		clusterAlertRuleLister: clusterAlertRuleLister,
+		alertManager:           alertManager,
+	}
+	etcdBackups.AddClusterScopedHandler(ctx, "etcd-backup-watcher", cluster.ClusterName, etcdBackupWatcher.Sync)
+}
+
+func (w *EtcdBackupWatcher) Sync(_ string, b *v3.EtcdBackup) (runtime.Object, error) {
+	if b == nil || b.DeletionTimestamp != nil {
+		return b, nil
+	}
+	// the etcd backup controller resets the Alerted condition to Unknown when a verification fails
+	if !(v32.BackupConditionAlerted.IsUnknown(b) && v32.BackupConditionVerified.IsFalse(b)) {
+		return b, nil
+	}
+	if !w.alertManager.IsDeploy {
+		v32.BackupConditionAlerted.False(b)
+		v32.BackupConditionAlerted.Message(b, MsgAlertManagerNotDeployed)
+		return b, nil
+	}
+	clusterAlertRules, err := w.clusterAlertRuleLister.List("", labels.NewSelector())
+	if err != nil {
+		return b, fmt.Errorf("EtcdBackupWatcher: Sync: error listing cluster alert rules: %v", err)
+	}
+
+	var matchingAlertRules []*v3.ClusterAlertRule
+	for _, alertRule := range clusterAlertRules {
+		if alertRule.Status.AlertState == "inactive" || alertRule.Spec.EtcdBackupRule == nil {
+			continue
+		}
+		if b.Spec.Manual && !alertRule.Spec.EtcdBackupRule.IncludeManual {
+			continue
+		}
+		matchingAlertRules = append(matchingAlertRules, alertRule)
+	}
+	if len(matchingAlertRules) == 0 {
+		v32.BackupConditionAlerted.False(b)
+		v32.BackupConditionAlerted.Message(b, MsgNoMatchingAlertRule)
+		return b, nil
+	}
+
+	for _, alertRule := range matchingAlertRules {
+		if e := w.sendAlert(b, alertRule); e != nil {
+			logrus.Errorf("EtcdBackupWatcher: Sync: error sending alert: %v", e)
+			err = multierror.Append(err, e)
+		}
+	}
+	if err != nil {
+		return b, err
+	}
+	v32.BackupConditionAlerted.True(b)
+	return b, nil
+}
+
+func (w *EtcdBackupWatcher) sendAlert(b *v3.EtcdBackup, alertRule *v3.ClusterAlertRule) error {
+	ruleID := common.GetRuleID(alertRule.Spec.GroupName, alertRule.Name)
+	clusterDisplayName := common.GetClusterDisplayName(w.clusterName, w.clusterLister)
+
+	data := map[string]string{}
+	data["rule_id"] = ruleID
+	data["group_id"] = alertRule.Spec.GroupName
+	data["alert_type"] = "etcdBackup"
+	data["alert_name"] = alertRule.Spec.DisplayName
+	data["severity"] = alertRule.Spec.Severity
+	data["cluster_name"] = clusterDisplayName
+	data["component_name"] = b.Name
+	data["logs"] = fmt.Sprintf("Verification of etcd backup failed: %s", v32.BackupConditionVerified.GetMessage(b))
+
+	return w.alertManager.SendAlert(data)
+}
@@ -39,7 +39,7 @@ func Register(ctx context.Context, userContext *config.UserContext) {
 		ClusterName:   userContext.ClusterName,
 		ClusterLister: userContext.Management.Management.Clusters("").Controller().Lister(),
 		ClusterClient: userContext.Management.Management.Clusters(""),
-		ClusterStore:  clusterprovisioner.NewPersistentStore(userContext.Management.Core.Namespaces(""), userContext.Management.Core),
+		ClusterStore:  clusterprovisioner.NewPersistentStore(userContext.Management.Core.Namespaces(""), userContext.Management.Core, userContext.Management.SecretsEncryptionKeys),
 		SecretLister:  userContext.Core.Secrets("").Controller().Lister(),
 	}
 
@@ -31,6 +31,10 @@ type tokenHandler struct {
 }
 
 func (h *tokenHandler) Create(token *managementv3.Token) (runtime.Object, error) {
+	// the authorized cluster endpoint can not enforce the scope of a token, so scoped tokens only work through rancher
+	if len(token.Scopes) > 0 {
+		return nil, nil
+	}
 
 	_, err := h.clusterAuthTokenLister.Get(h.namespace, token.Name)
 	if !errors.IsNotFound(err) {
@@ -40,6 +40,7 @@ type HealthSyncer struct {
 	componentStatuses corev1.ComponentStatusInterface
 	namespaces        corev1.NamespaceInterface
 	k8s               kubernetes.Interface
+	prober            *prober
 }
 
 func Register(ctx context.Context, workload *config.UserContext) {
@@ -51,6 +52,7 @@ func Register(ctx context.Context, workload *config.UserContext) {
 		componentStatuses: workload.Core.ComponentStatuses(""),
 		namespaces:        workload.Core.Namespaces(""),
 		k8s:               workload.K8sClient,
+		prober:            newProber(workload.ClusterName, workload.K8sClient, workload.Management.Dialer),
 	}
 
 	go h.syncHealth(ctx, syncInterval)
@@ -125,6 +127,9 @@ func (h *HealthSyncer) updateClusterHealth() error {
 		v32.ClusterConditionWaiting.Message(newObj, "")
 	}
 
+	h.prober.run(h.ctx, oldCluster)
+	h.prober.apply(newObj.(*v3.Cluster))
+
 	if !reflect.DeepEqual(oldCluster, newObj) {
 		if _, err := h.clusters.Update(newObj.(*v3.Cluster)); err != nil {
 			return errors.Wrapf(err, "[updateClusterHealth] Failed to update cluster [%s]", cluster.Name)
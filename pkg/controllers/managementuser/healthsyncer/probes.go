@@ -0,0 +1,407 @@
+package healthsyncer
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rancher/norman/condition"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/image"
+	"github.com/rancher/rancher/pkg/namespace"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/rancher/rancher/pkg/types/config/dialer"
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	probeDaemonSetName = "cattle-health-probe"
+	// probeHistorySize is the number of results kept per probe in the status of the cluster
+	probeHistorySize = 20
+)
+
+// prober runs the health probes of the cluster in the background and keeps their results until the health syncer
+// writes them to the cluster.
+type prober struct {
+	sync.Mutex
+	clusterName   string
+	k8s           kubernetes.Interface
+	dialerFactory dialer.Factory
+
+	statuses map[string]*v32.ClusterHealthProbeStatus
+	running  map[string]bool
+	lastRun  map[string]time.Time
+	// cleaned is set once the pod-to-pod DaemonSet is known to be removed
+	cleaned bool
+}
+
+func newProber(clusterName string, k8s kubernetes.Interface, dialerFactory dialer.Factory) *prober {
+	return &prober{
+		clusterName:   clusterName,
+		k8s:           k8s,
+		dialerFactory: dialerFactory,
+		running:       map[string]bool{},
+		lastRun:       map[string]time.Time{},
+	}
+}
+
+// run starts the probes of the cluster that are due.
+func (p *prober) run(ctx context.Context, cluster *v3.Cluster) {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.statuses == nil {
+		// the results of the probes survive restarts through the status of the cluster
+		p.statuses = map[string]*v32.ClusterHealthProbeStatus{}
+		for i := range cluster.Status.HealthProbeStatuses {
+			status := &cluster.Status.HealthProbeStatuses[i]
+			p.statuses[status.Name] = status.DeepCopy()
+		}
+	}
+
+	podToPod := false
+	now := time.Now()
+	for _, probe := range cluster.Spec.HealthProbes {
+		if probe.PodToPod != nil {
+			podToPod = true
+		}
+		interval := time.Duration(probe.IntervalSeconds) * time.Second
+		if p.running[probe.Name] || now.Sub(p.lastRun[probe.Name]) < interval {
+			continue
+		}
+		p.running[probe.Name] = true
+		p.lastRun[probe.Name] = now
+		go p.probe(ctx, cluster, probe)
+	}
+
+	if !podToPod && !p.cleaned {
+		err := p.k8s.AppsV1().DaemonSets(namespace.System).Delete(ctx, probeDaemonSetName, metav1.DeleteOptions{})
+		if err == nil || apierrors.IsNotFound(err) {
+			p.cleaned = true
+		} else {
+			logrus.Warnf("[healthsyncer] failed to remove health probe DaemonSet of cluster [%s]: %v", p.clusterName, err)
+		}
+	}
+}
+
+func (p *prober) probe(ctx context.Context, cluster *v3.Cluster, probe v32.ClusterHealthProbe) {
+	timeout := time.Duration(probe.TimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	var err error
+	switch {
+	case probe.DNS != nil:
+		err = p.probeDNS(ctx, probe.DNS)
+	case probe.HTTP != nil:
+		err = p.probeHTTP(ctx, probe.HTTP)
+	case probe.PodToPod != nil:
+		err = p.probePodToPod(ctx, cluster, probe.PodToPod)
+	default:
+		err = fmt.Errorf("probe has no check")
+	}
+	result := v32.ClusterHealthProbeResult{
+		Time:      start.UTC().Format(time.RFC3339),
+		Success:   err == nil,
+		LatencyMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		result.Message = err.Error()
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	delete(p.running, probe.Name)
+	status := p.statuses[probe.Name]
+	if status == nil {
+		status = &v32.ClusterHealthProbeStatus{Name: probe.Name}
+		p.statuses[probe.Name] = status
+	}
+	addProbeResult(status, result, probe.FailureThreshold)
+}
+
+func (p *prober) probeDNS(ctx context.Context, probe *v32.DNSHealthProbe) error {
+	server := probe.Server
+	if server == "" {
+		svc, err := p.k8s.CoreV1().Services("kube-system").Get(ctx, "kube-dns", metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to find the DNS service of the cluster: %v", err)
+		}
+		server = net.JoinHostPort(svc.Spec.ClusterIP, "53")
+	} else if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(server, "53")
+	}
+	dial, err := p.dialerFactory.ClusterDialer(p.clusterName)
+	if err != nil {
+		return err
+	}
+	// the query goes over TCP as the cluster dialer only streams
+	resolver := &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			return dial(ctx, "tcp", server)
+		},
+	}
+	hostname := probe.Hostname
+	if !strings.HasSuffix(hostname, ".") {
+		// the search domains of the local resolver do not apply to the cluster
+		hostname += "."
+	}
+	addrs, err := resolver.LookupHost(ctx, hostname)
+	if err != nil {
+		return err
+	}
+	if len(addrs) == 0 {
+		return fmt.Errorf("%s has no address", probe.Hostname)
+	}
+	return nil
+}
+
+func (p *prober) probeHTTP(ctx context.Context, probe *v32.HTTPHealthProbe) error {
+	dial, err := p.dialerFactory.ClusterDialer(p.clusterName)
+	if err != nil {
+		return err
+	}
+	client := &http.Client{
+		Transport: &http.Transport{
+			DialContext: dial,
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: probe.InsecureSkipVerify,
+			},
+		},
+		// a redirect is a success, it is not followed out of the cluster
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, probe.URL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 1024*1024))
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+// probePodToPod reaches the pod-to-pod DaemonSet pod of every node from the cluster agent, it tells the nodes whose pod
+// network can not be reached.
+func (p *prober) probePodToPod(ctx context.Context, cluster *v3.Cluster, probe *v32.PodToPodHealthProbe) error {
+	if err := p.ensureDaemonSet(ctx, cluster, probe.Port); err != nil {
+		return err
+	}
+	pods, err := p.k8s.CoreV1().Pods(namespace.System).List(ctx, metav1.ListOptions{
+		LabelSelector: "app=" + probeDaemonSetName,
+	})
+	if err != nil {
+		return err
+	}
+	dial, err := p.dialerFactory.ClusterDialer(p.clusterName)
+	if err != nil {
+		return err
+	}
+
+	var (
+		mu          sync.Mutex
+		wg          sync.WaitGroup
+		unreachable []string
+		reached     int
+	)
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != v1.PodRunning || pod.Status.PodIP == "" || pod.DeletionTimestamp != nil {
+			continue
+		}
+		wg.Add(1)
+		go func(pod v1.Pod) {
+			defer wg.Done()
+			err := pingPod(ctx, dial, net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(probe.Port)))
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				unreachable = append(unreachable, pod.Spec.NodeName)
+				return
+			}
+			reached++
+		}(pod)
+	}
+	wg.Wait()
+
+	if len(unreachable) > 0 {
+		sort.Strings(unreachable)
+		return fmt.Errorf("pods unreachable on nodes %s", strings.Join(unreachable, ", "))
+	}
+	if reached == 0 {
+		return fmt.Errorf("no health probe pod is running")
+	}
+	return nil
+}
+
+func pingPod(ctx context.Context, dial dialer.Dialer, address string) error {
+	conn, err := dial(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	// the pod answers ok on every connection
+	buf := make([]byte, 2)
+	_, err = io.ReadFull(conn, buf)
+	return err
+}
+
+func (p *prober) ensureDaemonSet(ctx context.Context, cluster *v3.Cluster, port int) error {
+	p.Lock()
+	p.cleaned = false
+	p.Unlock()
+
+	desired := probeDaemonSet(cluster, port)
+	existing, err := p.k8s.AppsV1().DaemonSets(namespace.System).Get(ctx, probeDaemonSetName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = p.k8s.AppsV1().DaemonSets(namespace.System).Create(ctx, desired, metav1.CreateOptions{})
+		return err
+	} else if err != nil {
+		return err
+	}
+	if existing.Spec.Template.Spec.Containers[0].Image == desired.Spec.Template.Spec.Containers[0].Image &&
+		strings.Join(existing.Spec.Template.Spec.Containers[0].Command, " ") == strings.Join(desired.Spec.Template.Spec.Containers[0].Command, " ") {
+		return nil
+	}
+	toUpdate := existing.DeepCopy()
+	toUpdate.Spec.Template = desired.Spec.Template
+	_, err = p.k8s.AppsV1().DaemonSets(namespace.System).Update(ctx, toUpdate, metav1.UpdateOptions{})
+	return err
+}
+
+func probeDaemonSet(cluster *v3.Cluster, port int) *appsv1.DaemonSet {
+	labels := map[string]string{"app": probeDaemonSetName}
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      probeDaemonSetName,
+			Namespace: namespace.System,
+			Labels:    labels,
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: v1.PodSpec{
+					Tolerations: []v1.Toleration{{Operator: v1.TolerationOpExists}},
+					NodeSelector: map[string]string{
+						"kubernetes.io/os": "linux",
+					},
+					Containers: []v1.Container{{
+						Name:  "probe",
+						Image: image.ResolveWithCluster(settings.ShellImage.Get(), cluster),
+						Command: []string{"sh", "-c",
+							fmt.Sprintf("while true; do echo ok | nc -l -p %d; done", port)},
+						Ports: []v1.ContainerPort{{ContainerPort: int32(port)}},
+					}},
+				},
+			},
+		},
+	}
+}
+
+// apply writes the results of the probes to the status of the cluster and rolls them up into its Healthy condition.
+func (p *prober) apply(cluster *v3.Cluster) {
+	p.Lock()
+	defer p.Unlock()
+
+	if len(cluster.Spec.HealthProbes) == 0 {
+		cluster.Status.HealthProbeStatuses = nil
+		removeClusterCondition(cluster, v32.ClusterConditionHealthy)
+		return
+	}
+
+	var statuses []v32.ClusterHealthProbeStatus
+	var failing []string
+	for _, probe := range cluster.Spec.HealthProbes {
+		status := p.statuses[probe.Name]
+		if status == nil {
+			continue
+		}
+		statuses = append(statuses, *status.DeepCopy())
+		if !status.Healthy {
+			failing = append(failing, fmt.Sprintf("%s: %s", probe.Name, lastMessage(status)))
+		}
+	}
+	cluster.Status.HealthProbeStatuses = statuses
+
+	if len(failing) > 0 {
+		v32.ClusterConditionHealthy.False(cluster)
+		v32.ClusterConditionHealthy.Reason(cluster, "HealthProbesFailed")
+		v32.ClusterConditionHealthy.Message(cluster, strings.Join(failing, "; "))
+		return
+	}
+	if len(statuses) < len(cluster.Spec.HealthProbes) {
+		// some probes did not run yet
+		if !v32.ClusterConditionHealthy.IsTrue(cluster) {
+			v32.ClusterConditionHealthy.Unknown(cluster)
+		}
+		return
+	}
+	v32.ClusterConditionHealthy.True(cluster)
+	v32.ClusterConditionHealthy.Reason(cluster, "")
+	v32.ClusterConditionHealthy.Message(cluster, "")
+}
+
+// addProbeResult adds the result to the history of the probe, the probe is unhealthy after threshold failures in a row.
+func addProbeResult(status *v32.ClusterHealthProbeStatus, result v32.ClusterHealthProbeResult, threshold int) {
+	if threshold <= 0 {
+		threshold = 1
+	}
+	status.LastProbeTime = result.Time
+	status.History = append(status.History, result)
+	if len(status.History) > probeHistorySize {
+		status.History = status.History[len(status.History)-probeHistorySize:]
+	}
+	if result.Success {
+		status.ConsecutiveFailures = 0
+	} else {
+		status.ConsecutiveFailures++
+	}
+	status.Healthy = status.ConsecutiveFailures < threshold
+}
+
+func lastMessage(status *v32.ClusterHealthProbeStatus) string {
+	if len(status.History) == 0 {
+		return ""
+	}
+	return status.History[len(status.History)-1].Message
+}
+
+func removeClusterCondition(cluster *v3.Cluster, cond condition.Cond) {
+	for i, c := range cluster.Status.Conditions {
+		if string(c.Type) == string(cond) {
+			cluster.Status.Conditions = append(cluster.Status.Conditions[:i:i], cluster.Status.Conditions[i+1:]...)
+			return
+		}
+	}
+}
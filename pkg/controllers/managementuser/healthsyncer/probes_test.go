@@ -0,0 +1,68 @@
+package healthsyncer
+
+import (
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddProbeResult(t *testing.T) {
+	assert := assert.New(t)
+
+	status := &v32.ClusterHealthProbeStatus{Name: "dns"}
+	addProbeResult(status, v32.ClusterHealthProbeResult{Time: "t0", Success: true}, 2)
+	assert.True(status.Healthy)
+
+	addProbeResult(status, v32.ClusterHealthProbeResult{Time: "t1", Message: "timeout"}, 2)
+	assert.True(status.Healthy)
+	assert.Equal(1, status.ConsecutiveFailures)
+
+	addProbeResult(status, v32.ClusterHealthProbeResult{Time: "t2", Message: "timeout"}, 2)
+	assert.False(status.Healthy)
+	assert.Equal("t2", status.LastProbeTime)
+
+	addProbeResult(status, v32.ClusterHealthProbeResult{Time: "t3", Success: true}, 2)
+	assert.True(status.Healthy)
+	assert.Equal(0, status.ConsecutiveFailures)
+
+	for i := 0; i < probeHistorySize; i++ {
+		addProbeResult(status, v32.ClusterHealthProbeResult{Time: "t", Success: true}, 2)
+	}
+	assert.Len(status.History, probeHistorySize)
+}
+
+func TestApplyProbes(t *testing.T) {
+	assert := assert.New(t)
+
+	cluster := &v3.Cluster{}
+	cluster.Spec.HealthProbes = []v32.ClusterHealthProbe{
+		{Name: "dns", DNS: &v32.DNSHealthProbe{Hostname: "kubernetes.default"}},
+		{Name: "ingress", HTTP: &v32.HTTPHealthProbe{URL: "http://ingress"}},
+	}
+	p := newProber("c-test", nil, nil)
+	p.statuses = map[string]*v32.ClusterHealthProbeStatus{
+		"dns": {Name: "dns", Healthy: true},
+	}
+
+	p.apply(cluster)
+	assert.True(v32.ClusterConditionHealthy.IsUnknown(cluster))
+	assert.Len(cluster.Status.HealthProbeStatuses, 1)
+
+	status := &v32.ClusterHealthProbeStatus{Name: "ingress"}
+	addProbeResult(status, v32.ClusterHealthProbeResult{Message: "unexpected status 503"}, 1)
+	p.statuses["ingress"] = status
+	p.apply(cluster)
+	assert.True(v32.ClusterConditionHealthy.IsFalse(cluster))
+	assert.Equal("ingress: unexpected status 503", v32.ClusterConditionHealthy.GetMessage(cluster))
+
+	addProbeResult(status, v32.ClusterHealthProbeResult{Success: true}, 1)
+	p.apply(cluster)
+	assert.True(v32.ClusterConditionHealthy.IsTrue(cluster))
+
+	cluster.Spec.HealthProbes = nil
+	p.apply(cluster)
+	assert.Nil(cluster.Status.HealthProbeStatuses)
+	assert.Len(cluster.Status.Conditions, 0)
+}
@@ -157,6 +157,19 @@ func (d *nodeDrain) drain(ctx context.Context, obj *v3.Node, nodeName string, ca
 			if err != nil {
 				return obj, err
 			}
+			policy, err := d.getDrainPolicy(nodeObj)
+			if err != nil {
+				return nodeObj, err
+			}
+			if policy != nil {
+				nodeObj, err = d.drainWithPolicy(ctx, nodeObj, nodeName, policy)
+				if err != nil && ctx.Err() == context.Canceled {
+					stopped = true
+					logrus.Infof(fmt.Sprintf("Stopped draining %s in %s", nodeName, obj.Namespace))
+					return nodeObj, nil
+				}
+				return nodeObj, err
+			}
 			logrus.Infof("Draining node %s in %s with flags %v", nodeName, obj.Namespace,
 				strings.Join(nodehelper.GetDrainFlags(nodeObj), " "))
 			_, msg, err := kubectl.Drain(ctx, kubeConfig, nodeName, nodehelper.GetDrainFlags(nodeObj))
@@ -172,7 +185,10 @@ func (d *nodeDrain) drain(ctx context.Context, obj *v3.Node, nodeName string, ca
 			return nodeObj, nil
 		})
 		kubeErr := err
-		if err != nil {
+		if _, ok := err.(*drainPolicyError); ok {
+			// the policy failed the drain, it is not retried
+			kubeErr = nil
+		} else if err != nil {
 			ignore, timeoutErr := ignoreErr(err.Error())
 			if ignore {
 				if timeoutErr {
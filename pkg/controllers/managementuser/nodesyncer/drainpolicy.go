@@ -0,0 +1,380 @@
+package nodesyncer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/kubectl/pkg/drain"
+)
+
+const (
+	podEvicting = "evicting"
+	podEvicted  = "evicted"
+	podDeleted  = "deleted"
+	podSkipped  = "skipped"
+	podPending  = "pending"
+
+	// progressInterval bounds how often the progress of a drain is written to the node
+	progressInterval = 5 * time.Second
+)
+
+// drainPolicyError fails the drain of a node with a policy, the drain is not retried.
+type drainPolicyError struct {
+	msg string
+}
+
+func (e *drainPolicyError) Error() string {
+	return e.msg
+}
+
+// drainHookEvent is the body posted to the hooks of a drain policy.
+type drainHookEvent struct {
+	Event       string   `json:"event"`
+	Policy      string   `json:"policy"`
+	ClusterName string   `json:"clusterName"`
+	NodeName    string   `json:"nodeName"`
+	Hostname    string   `json:"hostname"`
+	Pods        []string `json:"pods,omitempty"`
+}
+
+// drainProgress is the state of each pod of a drain, it is reported in the message of the Drained condition of the node
+// while the drain runs.
+type drainProgress struct {
+	sync.Mutex
+	d        *nodeDrain
+	node     *v3.Node
+	stage    string
+	pods     map[string]string
+	reported time.Time
+}
+
+// getDrainPolicy returns the drain policy of the node, the one it names or else the default one of its cluster. Nodes
+// without a policy are drained with kubectl.
+func (d *nodeDrain) getDrainPolicy(obj *v3.Node) (*v32.NodeDrainPolicy, error) {
+	cluster, err := d.clusterLister.Get("", d.clusterName)
+	if err != nil {
+		return nil, err
+	}
+	name := obj.Spec.NodeDrainPolicyName
+	if name == "" {
+		name = cluster.Spec.DefaultNodeDrainPolicy
+	}
+	if name == "" {
+		return nil, nil
+	}
+	for i := range cluster.Spec.NodeDrainPolicies {
+		if cluster.Spec.NodeDrainPolicies[i].Name == name {
+			return &cluster.Spec.NodeDrainPolicies[i], nil
+		}
+	}
+	return nil, &drainPolicyError{msg: fmt.Sprintf("Drain failed: drain policy %s not found in cluster %s", name, d.clusterName)}
+}
+
+// drainWithPolicy cordons the node, calls the pre-drain hooks, evicts the pods the policy does not skip and calls the
+// post-drain hooks.
+func (d *nodeDrain) drainWithPolicy(ctx context.Context, obj *v3.Node, nodeName string, policy *v32.NodeDrainPolicy) (*v3.Node, error) {
+	node, err := d.nodeLister.Get("", nodeName)
+	if err != nil {
+		return obj, err
+	}
+	helper := newDrainHelper(ctx, d, obj.Spec.NodeDrainInput, policy)
+	progress := &drainProgress{
+		d:    d,
+		node: obj,
+		pods: map[string]string{},
+	}
+	helper.OnPodDeletedOrEvicted = func(pod *corev1.Pod, usingEviction bool) {
+		state := podDeleted
+		if usingEviction {
+			state = podEvicted
+		}
+		progress.set(pod.Namespace+"/"+pod.Name, state)
+	}
+
+	logrus.Infof("Draining node %s in %s with policy %s", nodeName, obj.Namespace, policy.Name)
+	if err := drain.RunCordonOrUncordon(helper, node, true); err != nil {
+		return progress.latest(), err
+	}
+
+	list, errs := helper.GetPodsForDeletion(nodeName)
+	if len(errs) > 0 {
+		return progress.latest(), &drainPolicyError{msg: fmt.Sprintf("Drain failed: %v", utilerrors.NewAggregate(errs))}
+	}
+	pods := skipNamespaces(list.Pods(), policy.SkipNamespaces, progress)
+
+	event := drainHookEvent{
+		Policy:      policy.Name,
+		ClusterName: d.clusterName,
+		NodeName:    obj.Name,
+		Hostname:    nodeName,
+		Pods:        podKeys(pods),
+	}
+	event.Event = "preDrain"
+	if err := d.runHooks(ctx, policy.PreDrainHooks, event, progress); err != nil {
+		return progress.latest(), err
+	}
+
+	deadline := time.Now().Add(helper.Timeout)
+	for _, group := range evictionGroups(pods, policy.OrderByPriority) {
+		if helper.Timeout > 0 {
+			helper.Timeout = time.Until(deadline)
+			if helper.Timeout <= 0 {
+				return progress.latest(), &drainPolicyError{msg: fmt.Sprintf("Drain failed: pods were not evicted within %vs", policy.MaxWaitSeconds)}
+			}
+		}
+		for _, pod := range group {
+			progress.set(pod.Namespace+"/"+pod.Name, podEvicting)
+		}
+		progress.setStage(fmt.Sprintf("evicting pods of priority %d", podPriority(group[0])))
+		if err := helper.DeleteOrEvictPods(group); err != nil {
+			if ctx.Err() != nil {
+				return progress.latest(), ctx.Err()
+			}
+			return progress.latest(), &drainPolicyError{msg: fmt.Sprintf("Drain failed: %v", err)}
+		}
+	}
+
+	event.Event = "postDrain"
+	if err := d.runHooks(ctx, policy.PostDrainHooks, event, progress); err != nil {
+		return progress.latest(), err
+	}
+	progress.setStage("drained")
+	return progress.latest(), nil
+}
+
+func newDrainHelper(ctx context.Context, d *nodeDrain, input *v32.NodeDrainInput, policy *v32.NodeDrainPolicy) *drain.Helper {
+	helper := &drain.Helper{
+		Ctx:                 ctx,
+		Client:              d.k8sClient,
+		GracePeriodSeconds:  -1,
+		IgnoreAllDaemonSets: true,
+		Timeout:             time.Duration(policy.MaxWaitSeconds) * time.Second,
+		DisableEviction:     policy.IgnorePodDisruptionBudgets,
+		Out:                 ioutil.Discard,
+		ErrOut:              ioutil.Discard,
+	}
+	if input != nil {
+		helper.Force = input.Force
+		helper.DeleteLocalData = input.DeleteLocalData
+		helper.GracePeriodSeconds = input.GracePeriod
+		if input.IgnoreDaemonSets != nil {
+			helper.IgnoreAllDaemonSets = *input.IgnoreDaemonSets
+		}
+	}
+	return helper
+}
+
+func (d *nodeDrain) runHooks(ctx context.Context, hooks []v32.NodeDrainHook, event drainHookEvent, progress *drainProgress) error {
+	for _, hook := range hooks {
+		progress.setStage(fmt.Sprintf("calling %s hook %s", event.Event, hook.Name))
+		if err := d.callHook(ctx, hook, event); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if hook.IgnoreFailure {
+				logrus.Warnf("nodeDrain: ignoring failure of %s hook %s for node [%s] in cluster [%s]: %v", event.Event,
+					hook.Name, event.NodeName, d.clusterName, err)
+				continue
+			}
+			return &drainPolicyError{msg: fmt.Sprintf("Drain failed: %s hook %s: %v", event.Event, hook.Name, err)}
+		}
+	}
+	return nil
+}
+
+func (d *nodeDrain) callHook(ctx context.Context, hook v32.NodeDrainHook, event drainHookEvent) error {
+	client, err := d.hookClient(hook)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	timeout := time.Duration(hook.TimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// hookClient calls the hooks through the cluster so that they can be services of the cluster.
+func (d *nodeDrain) hookClient(hook v32.NodeDrainHook) (*http.Client, error) {
+	dialer, err := d.dialerFactory.ClusterDialer(d.clusterName)
+	if err != nil {
+		return nil, err
+	}
+	transport := &http.Transport{
+		DialContext: dialer,
+	}
+	if hook.CACerts != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(hook.CACerts)) {
+			return nil, fmt.Errorf("invalid CA certificates")
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	}
+	return &http.Client{Transport: transport}, nil
+}
+
+// skipNamespaces returns the pods that are not in the namespaces the policy skips.
+func skipNamespaces(pods []corev1.Pod, namespaces []string, progress *drainProgress) []corev1.Pod {
+	skip := map[string]bool{}
+	for _, ns := range namespaces {
+		skip[ns] = true
+	}
+	var result []corev1.Pod
+	for _, pod := range pods {
+		key := pod.Namespace + "/" + pod.Name
+		if skip[pod.Namespace] {
+			progress.pods[key] = podSkipped
+			continue
+		}
+		progress.pods[key] = podPending
+		result = append(result, pod)
+	}
+	return result
+}
+
+// evictionGroups splits the pods by priority, lowest first, when the policy orders the evictions.
+func evictionGroups(pods []corev1.Pod, byPriority bool) [][]corev1.Pod {
+	if len(pods) == 0 {
+		return nil
+	}
+	if !byPriority {
+		return [][]corev1.Pod{pods}
+	}
+	sorted := append([]corev1.Pod(nil), pods...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return podPriority(sorted[i]) < podPriority(sorted[j])
+	})
+	var groups [][]corev1.Pod
+	for i, pod := range sorted {
+		if i == 0 || podPriority(pod) != podPriority(sorted[i-1]) {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], pod)
+	}
+	return groups
+}
+
+func podPriority(pod corev1.Pod) int32 {
+	if pod.Spec.Priority == nil {
+		return 0
+	}
+	return *pod.Spec.Priority
+}
+
+func podKeys(pods []corev1.Pod) []string {
+	var keys []string
+	for _, pod := range pods {
+		keys = append(keys, pod.Namespace+"/"+pod.Name)
+	}
+	return keys
+}
+
+func (p *drainProgress) set(key, state string) {
+	p.Lock()
+	defer p.Unlock()
+	p.pods[key] = state
+	p.report(false)
+}
+
+func (p *drainProgress) setStage(stage string) {
+	p.Lock()
+	defer p.Unlock()
+	p.stage = stage
+	p.report(true)
+}
+
+func (p *drainProgress) latest() *v3.Node {
+	p.Lock()
+	defer p.Unlock()
+	return p.node
+}
+
+// report writes the progress in the message of the Drained condition, at most every progressInterval unless forced.
+func (p *drainProgress) report(force bool) {
+	if !force && time.Since(p.reported) < progressInterval {
+		return
+	}
+	msg := p.message()
+	setMessage := func(node *v3.Node, _ error, _ error) {
+		if v32.NodeConditionDrained.IsUnknown(node) {
+			v32.NodeConditionDrained.Message(node, msg)
+		}
+	}
+	nodeCopy := p.node.DeepCopy()
+	setMessage(nodeCopy, nil, nil)
+	updated, err := p.d.updateNode(nodeCopy, setMessage, nil, nil)
+	if err != nil {
+		logrus.Warnf("nodeDrain: error reporting drain progress of node %s: %v", p.node.Name, err)
+		return
+	}
+	p.node = updated
+	p.reported = time.Now()
+}
+
+// message lists the state of each pod of the drain after its current stage.
+func (p *drainProgress) message() string {
+	var keys []string
+	for key := range p.pods {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	done := 0
+	lines := []string{p.stage}
+	for _, key := range keys {
+		state := p.pods[key]
+		if state == podEvicted || state == podDeleted {
+			done++
+		}
+		lines = append(lines, fmt.Sprintf("%s: %s", key, state))
+	}
+	lines[0] = fmt.Sprintf("%s, %d of %d pods removed", p.stage, done, len(keys)-p.count(podSkipped))
+	return strings.Join(lines, "\n")
+}
+
+func (p *drainProgress) count(state string) int {
+	n := 0
+	for _, s := range p.pods {
+		if s == state {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,100 @@
+package nodesyncer
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/types/config/dialer"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type localDialerFactory struct {
+	dialer.Factory
+}
+
+func (f localDialerFactory) ClusterDialer(clusterName string) (dialer.Dialer, error) {
+	return (&net.Dialer{}).DialContext, nil
+}
+
+func testPod(namespace, name string, priority *int32) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec:       corev1.PodSpec{Priority: priority},
+	}
+}
+
+func TestEvictionGroups(t *testing.T) {
+	assert := assert.New(t)
+
+	low, high := int32(-10), int32(1000)
+	pods := []corev1.Pod{
+		testPod("default", "a", &high),
+		testPod("default", "b", nil),
+		testPod("default", "c", &low),
+		testPod("default", "d", &high),
+	}
+
+	assert.Nil(evictionGroups(nil, true))
+	assert.Len(evictionGroups(pods, false), 1)
+
+	groups := evictionGroups(pods, true)
+	assert.Len(groups, 3)
+	assert.Equal([]string{"default/c"}, podKeys(groups[0]))
+	assert.Equal([]string{"default/b"}, podKeys(groups[1]))
+	assert.Equal([]string{"default/a", "default/d"}, podKeys(groups[2]))
+}
+
+func TestDrainProgressMessage(t *testing.T) {
+	assert := assert.New(t)
+
+	progress := &drainProgress{pods: map[string]string{}}
+	pods := skipNamespaces([]corev1.Pod{
+		testPod("storage", "csi", nil),
+		testPod("default", "web-1", nil),
+		testPod("default", "web-0", nil),
+	}, []string{"storage"}, progress)
+	assert.Equal([]string{"default/web-1", "default/web-0"}, podKeys(pods))
+
+	progress.stage = "evicting pods of priority 0"
+	progress.pods["default/web-0"] = podEvicted
+	progress.pods["default/web-1"] = podEvicting
+	assert.Equal("evicting pods of priority 0, 1 of 2 pods removed\n"+
+		"default/web-0: evicted\n"+
+		"default/web-1: evicting\n"+
+		"storage/csi: skipped", progress.message())
+}
+
+func TestCallHook(t *testing.T) {
+	assert := assert.New(t)
+
+	var received drainHookEvent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if received.Event == "postDrain" {
+			http.Error(w, "volume still attached", http.StatusConflict)
+		}
+	}))
+	defer server.Close()
+
+	d := &nodeDrain{clusterName: "c-test", dialerFactory: localDialerFactory{}}
+	hook := v32.NodeDrainHook{Name: "storage", URL: server.URL}
+	event := drainHookEvent{Event: "preDrain", ClusterName: "c-test", NodeName: "m-test", Hostname: "node1"}
+
+	assert.Nil(d.callHook(context.Background(), hook, event))
+	assert.Equal(event, received)
+
+	event.Event = "postDrain"
+	err := d.callHook(context.Background(), hook, event)
+	assert.NotNil(err)
+	assert.Contains(err.Error(), "volume still attached")
+}
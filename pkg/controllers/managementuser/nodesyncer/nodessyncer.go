@@ -17,6 +17,7 @@
 	nodehelper "github.com/rancher/rancher/pkg/node"
 	"github.com/rancher/rancher/pkg/systemaccount"
 	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/rancher/rancher/pkg/types/config/dialer"
 	"github.com/rancher/rancher/pkg/user"
 	rketypes "github.com/rancher/rke/types"
 	"github.com/sirupsen/logrus"
@@ -25,6 +26,7 @@
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
 )
 
 const (
@@ -65,6 +67,8 @@ type nodeDrain struct {
 	clusterLister        v3.ClusterLister
 	machines             v3.NodeInterface
 	nodeLister           v1.NodeLister
+	k8sClient            kubernetes.Interface
+	dialerFactory        dialer.Factory
 	ctx                  context.Context
 	nodesToContext       map[string]context.CancelFunc
 }
@@ -102,6 +106,8 @@ func Register(ctx context.Context, cluster *config.UserContext, kubeConfigGetter
 		clusterLister:        cluster.Management.Management.Clusters("").Controller().Lister(),
 		machines:             cluster.Management.Management.Nodes(cluster.ClusterName),
 		nodeLister:           cluster.Core.Nodes("").Controller().Lister(),
+		k8sClient:            cluster.K8sClient,
+		dialerFactory:        cluster.Management.Dialer,
 		ctx:                  ctx,
 		nodesToContext:       map[string]context.CancelFunc{},
 	}
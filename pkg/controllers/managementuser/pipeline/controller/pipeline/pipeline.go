@@ -33,6 +33,16 @@ func Register(ctx context.Context, cluster *config.UserContext) {
 	}
 
 	pipelines.AddClusterScopedLifecycle(ctx, "pipeline-controller", cluster.ClusterName, pipelineLifecycle)
+
+	poller := &Poller{
+		clusterName:                cluster.ClusterName,
+		pipelineLister:             pipelines.Controller().Lister(),
+		pipelines:                  pipelines,
+		pipelineExecutions:         cluster.Management.Project.PipelineExecutions(""),
+		sourceCodeCredentialLister: sourceCodeCredentialLister,
+		sourceCodeCredentials:      sourceCodeCredentials,
+	}
+	go poller.sync(ctx, pollCheckInterval)
 }
 
 func (l *Lifecycle) Create(obj *v3.Pipeline) (runtime.Object, error) {
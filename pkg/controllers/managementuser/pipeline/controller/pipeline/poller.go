@@ -0,0 +1,282 @@
+package pipeline
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/project.cattle.io/v3"
+
+	"github.com/pkg/errors"
+	"github.com/rancher/norman/controller"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/pipeline/providers"
+	"github.com/rancher/rancher/pkg/pipeline/remote"
+	"github.com/rancher/rancher/pkg/pipeline/remote/model"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	"github.com/rancher/rancher/pkg/ref"
+	"github.com/rancher/wrangler/pkg/ticker"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/util/retry"
+)
+
+// The poller is responsible for running pipelines on repositories of the generic
+// git provider, which cannot send webhooks. The branches and tags of the repositories
+// are polled and compared with the ones seen last time.
+
+const (
+	pollCheckInterval   = 15 * time.Second
+	defaultPollInterval = 60 * time.Second
+
+	refsBranchPrefix = "refs/heads/"
+	refsTagPrefix    = "refs/tags/"
+
+	// maxRunClaims bounds how often a run number taken by another execution is skipped
+	maxRunClaims = 5
+)
+
+type Poller struct {
+	clusterName string
+
+	pipelineLister             v3.PipelineLister
+	pipelines                  v3.PipelineInterface
+	pipelineExecutions         v3.PipelineExecutionInterface
+	sourceCodeCredentialLister v3.SourceCodeCredentialLister
+	sourceCodeCredentials      v3.SourceCodeCredentialInterface
+
+	// lastPolled is only touched by the poll loop
+	lastPolled map[string]time.Time
+}
+
+func (p *Poller) sync(ctx context.Context, syncInterval time.Duration) {
+	for range ticker.Context(ctx, syncInterval) {
+		p.poll()
+	}
+}
+
+func (p *Poller) poll() {
+	pipelines, err := p.pipelineLister.List("", labels.Everything())
+	if err != nil {
+		logrus.Errorf("Error listing pipelines - %v", err)
+		return
+	}
+	lastPolled := map[string]time.Time{}
+	for _, pipeline := range pipelines {
+		if !controller.ObjectInCluster(p.clusterName, pipeline) || !isPolled(pipeline) {
+			continue
+		}
+		key := ref.Ref(pipeline)
+		lastPolled[key] = p.lastPolled[key]
+
+		ns, name := ref.Parse(pipeline.Spec.SourceCodeCredentialName)
+		credential, err := p.sourceCodeCredentialLister.Get(ns, name)
+		if err != nil || credential.Spec.SourceCodeType != model.GitType {
+			continue
+		}
+		_, projectID := ref.Parse(pipeline.Spec.ProjectName)
+		scpConfig, err := providers.GetSourceCodeProviderConfig(model.GitType, projectID)
+		if err != nil {
+			logrus.Errorf("Error getting git provider config of project %s - %v", projectID, err)
+			continue
+		}
+		gitConfig, ok := scpConfig.(*v32.GitPipelineConfig)
+		if !ok || !gitConfig.Enabled {
+			continue
+		}
+		interval := defaultPollInterval
+		if gitConfig.PollInterval > 0 {
+			interval = time.Duration(gitConfig.PollInterval) * time.Second
+		}
+		if time.Since(lastPolled[key]) < interval {
+			continue
+		}
+		lastPolled[key] = time.Now()
+		if err := p.pollPipeline(pipeline, credential, gitConfig); err != nil {
+			logrus.Errorf("Error polling repository of pipeline %s - %v", key, err)
+		}
+	}
+	p.lastPolled = lastPolled
+}
+
+func (p *Poller) pollPipeline(pipeline *v3.Pipeline, credential *v3.SourceCodeCredential, config *v32.GitPipelineConfig) error {
+	r, err := remote.New(config)
+	if err != nil {
+		return err
+	}
+	poller, ok := r.(model.Poller)
+	if !ok {
+		return errors.New("git remote does not support polling")
+	}
+	accessToken, err := utils.EnsureAccessToken(p.sourceCodeCredentials, r, credential)
+	if err != nil {
+		return err
+	}
+	heads, err := poller.Heads(pipeline.Spec.RepositoryURL, accessToken)
+	if err != nil {
+		return err
+	}
+
+	// the first poll only records the refs, there is nothing to compare them with yet
+	polled := map[string]string{}
+	for name, commit := range heads {
+		polled[name] = commit
+	}
+	if pipeline.Status.LastPolled != "" {
+		for _, info := range changedRefs(pipeline, heads) {
+			if info.Event == utils.WebhookEventPush {
+				if head, err := r.GetHeadInfo(pipeline.Spec.RepositoryURL, info.Branch, accessToken); err == nil && head.Commit == info.Commit {
+					info.Message = head.Message
+					info.Author = head.Author
+					info.Email = head.Email
+				}
+			}
+			if err := p.trigger(pipeline, info); err != nil {
+				logrus.Errorf("Error triggering pipeline %s for %s - %v", ref.Ref(pipeline), info.Ref, err)
+				// the ref is not recorded, so that it is triggered again by the next poll
+				if commit, ok := pipeline.Status.PolledRefs[info.Ref]; ok {
+					polled[info.Ref] = commit
+				} else {
+					delete(polled, info.Ref)
+				}
+			}
+		}
+	}
+
+	now := time.Now()
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := p.pipelines.GetNamespaced(pipeline.Namespace, pipeline.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		toUpdate := recordPoll(current, polled, now)
+		if toUpdate == nil {
+			return nil
+		}
+		_, err = p.pipelines.Update(toUpdate)
+		return err
+	})
+}
+
+// recordPoll returns the pipeline with the polled refs recorded, or nil when they are already
+// recorded. Empty repositories are recorded too, so that their first push is built.
+func recordPoll(pipeline *v3.Pipeline, heads map[string]string, now time.Time) *v3.Pipeline {
+	if pipeline.Status.LastPolled != "" && refsEqual(pipeline.Status.PolledRefs, heads) {
+		return nil
+	}
+	toUpdate := pipeline.DeepCopy()
+	toUpdate.Status.PolledRefs = heads
+	toUpdate.Status.LastPolled = now.UTC().Format(time.RFC3339)
+	return toUpdate
+}
+
+func (p *Poller) trigger(pipeline *v3.Pipeline, info *model.BuildInfo) error {
+	pipelineConfig, err := providers.GetPipelineConfigByBranch(p.sourceCodeCredentials, p.sourceCodeCredentialLister, pipeline, info.Ref)
+	if err != nil {
+		return err
+	}
+	if pipelineConfig == nil || !utils.Match(pipelineConfig.Branch, info.Branch) {
+		return nil
+	}
+	return p.createExecution(pipeline, pipelineConfig, info)
+}
+
+// createExecution creates the execution from the latest run number of the pipeline and claims it, so that
+// the executions of the other refs changed in the same poll are not created with the same name. The
+// execution controller counts the run too, so the run number may already be taken by an execution
+// that the pipeline does not count yet.
+func (p *Poller) createExecution(pipeline *v3.Pipeline, pipelineConfig *v32.PipelineConfig, info *model.BuildInfo) error {
+	for i := 0; i < maxRunClaims; i++ {
+		current, err := p.pipelines.GetNamespaced(pipeline.Namespace, pipeline.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		execution, err := utils.GenerateExecution(p.pipelineExecutions, current, pipelineConfig, info)
+		if apierrors.IsAlreadyExists(err) {
+			if err := p.claimRun(current, current.Status.NextRun, nil); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil || execution == nil {
+			return err
+		}
+		return p.claimRun(current, execution.Spec.Run, execution)
+	}
+	return errors.Errorf("no free run number for pipeline %s", ref.Ref(pipeline))
+}
+
+// claimRun counts the run of the pipeline unless the execution controller already did.
+func (p *Poller) claimRun(pipeline *v3.Pipeline, run int, execution *v3.PipelineExecution) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := p.pipelines.GetNamespaced(pipeline.Namespace, pipeline.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if current.Status.NextRun > run {
+			return nil
+		}
+		current.Status.NextRun = run + 1
+		if execution != nil {
+			current.Status.LastExecutionID = ref.Ref(execution)
+			current.Status.LastStarted = execution.Status.Started
+		}
+		_, err = p.pipelines.Update(current)
+		return err
+	})
+}
+
+func isPolled(pipeline *v3.Pipeline) bool {
+	return pipeline.Spec.SourceCodeCredentialName != "" &&
+		pipeline.Status.PipelineState != "inactive" &&
+		(pipeline.Spec.TriggerWebhookPush || pipeline.Spec.TriggerWebhookTag)
+}
+
+// changedRefs returns the build info of the branches that moved and of the tags that
+// were created or moved since the last poll, for the events the pipeline is triggered by
+func changedRefs(pipeline *v3.Pipeline, heads map[string]string) []*model.BuildInfo {
+	var refs []string
+	for name, commit := range heads {
+		if pipeline.Status.PolledRefs[name] != commit {
+			refs = append(refs, name)
+		}
+	}
+	sort.Strings(refs)
+
+	var result []*model.BuildInfo
+	for _, name := range refs {
+		info := &model.BuildInfo{
+			TriggerType: utils.TriggerTypeWebhook,
+			Commit:      heads[name],
+			Ref:         name,
+		}
+		switch {
+		case strings.HasPrefix(name, refsBranchPrefix) && pipeline.Spec.TriggerWebhookPush:
+			info.Event = utils.WebhookEventPush
+			info.Branch = strings.TrimPrefix(name, refsBranchPrefix)
+		case strings.HasPrefix(name, refsTagPrefix) && pipeline.Spec.TriggerWebhookTag:
+			info.Event = utils.WebhookEventTag
+			info.Branch = strings.TrimPrefix(name, refsTagPrefix)
+			info.Message = "tag " + info.Branch
+		default:
+			continue
+		}
+		result = append(result, info)
+	}
+	return result
+}
+
+func refsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if b[k] != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,149 @@
+package pipeline
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/project.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3/fakes"
+	"github.com/rancher/rancher/pkg/pipeline/remote/model"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestChangedRefs(t *testing.T) {
+	assert := assert.New(t)
+	pipeline := &v3.Pipeline{}
+	pipeline.Spec.TriggerWebhookPush = true
+	pipeline.Spec.TriggerWebhookTag = true
+	pipeline.Status.PolledRefs = map[string]string{
+		"refs/heads/main": "a",
+		"refs/heads/dev":  "b",
+		"refs/tags/v1":    "c",
+	}
+	heads := map[string]string{
+		"refs/heads/main": "a",
+		"refs/heads/dev":  "d",
+		"refs/tags/v1":    "c",
+		"refs/tags/v2":    "e",
+	}
+
+	infos := changedRefs(pipeline, heads)
+	assert.Len(infos, 2)
+	assert.Equal(utils.WebhookEventPush, infos[0].Event)
+	assert.Equal("dev", infos[0].Branch)
+	assert.Equal("d", infos[0].Commit)
+	assert.Equal(utils.WebhookEventTag, infos[1].Event)
+	assert.Equal("v2", infos[1].Branch)
+	assert.Equal("e", infos[1].Commit)
+
+	pipeline.Spec.TriggerWebhookTag = false
+	infos = changedRefs(pipeline, heads)
+	assert.Len(infos, 1)
+	assert.Equal("refs/heads/dev", infos[0].Ref)
+}
+
+func TestRecordPoll(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	pipeline := &v3.Pipeline{}
+	toUpdate := recordPoll(pipeline, map[string]string{}, now)
+	if assert.NotNil(toUpdate) {
+		assert.Equal("2020-01-01T00:00:00Z", toUpdate.Status.LastPolled)
+		assert.Empty(toUpdate.Status.PolledRefs)
+	}
+
+	// an empty repository is not recorded again once polled
+	pipeline.Status.LastPolled = "2020-01-01T00:00:00Z"
+	assert.Nil(recordPoll(pipeline, map[string]string{}, now.Add(time.Minute)))
+
+	heads := map[string]string{"refs/heads/main": "a"}
+	toUpdate = recordPoll(pipeline, heads, now.Add(time.Minute))
+	if assert.NotNil(toUpdate) {
+		assert.Equal("2020-01-01T00:01:00Z", toUpdate.Status.LastPolled)
+		assert.Equal(heads, toUpdate.Status.PolledRefs)
+	}
+
+	// refs recorded by a notification before the first poll are recorded again with the poll time
+	pipeline = &v3.Pipeline{}
+	pipeline.Status.PolledRefs = heads
+	assert.NotNil(recordPoll(pipeline, heads, now))
+}
+
+func TestCreateExecutionsOfOnePoll(t *testing.T) {
+	assert := assert.New(t)
+
+	stored := &v3.Pipeline{}
+	stored.Namespace = "p-abc"
+	stored.Name = "pipeline"
+	stored.ResourceVersion = "1"
+	stored.Status.NextRun = 1
+	executions := map[string]*v3.PipelineExecution{
+		// created by a webhook, the execution controller has not counted it yet
+		"pipeline-1": {},
+	}
+	// the execution controller updates the pipeline between the reads of the poller once
+	controllerUpdates := 1
+
+	pipelines := &fakes.PipelineInterfaceMock{
+		GetNamespacedFunc: func(namespace, name string, opts metav1.GetOptions) (*v3.Pipeline, error) {
+			return stored.DeepCopy(), nil
+		},
+		UpdateFunc: func(in *v3.Pipeline) (*v3.Pipeline, error) {
+			if controllerUpdates > 0 {
+				controllerUpdates--
+				stored.ResourceVersion = bumpVersion(stored.ResourceVersion)
+			}
+			if in.ResourceVersion != stored.ResourceVersion {
+				return nil, apierrors.NewConflict(schema.GroupResource{}, in.Name, nil)
+			}
+			stored = in.DeepCopy()
+			stored.ResourceVersion = bumpVersion(stored.ResourceVersion)
+			return stored.DeepCopy(), nil
+		},
+	}
+	pipelineExecutions := &fakes.PipelineExecutionInterfaceMock{
+		CreateFunc: func(in *v3.PipelineExecution) (*v3.PipelineExecution, error) {
+			if _, ok := executions[in.Name]; ok {
+				return nil, apierrors.NewAlreadyExists(schema.GroupResource{}, in.Name)
+			}
+			executions[in.Name] = in
+			return in, nil
+		},
+	}
+	p := &Poller{
+		pipelines:          pipelines,
+		pipelineExecutions: pipelineExecutions,
+	}
+
+	// the lister still shows the pipeline as it was before the poll
+	listed := stored.DeepCopy()
+	for _, branch := range []string{"dev", "main"} {
+		info := &model.BuildInfo{
+			TriggerType: utils.TriggerTypeWebhook,
+			Event:       utils.WebhookEventPush,
+			Ref:         refsBranchPrefix + branch,
+			Branch:      branch,
+		}
+		assert.Nil(p.createExecution(listed, &v32.PipelineConfig{}, info))
+	}
+
+	assert.Len(executions, 3)
+	if assert.Contains(executions, "pipeline-2") && assert.Contains(executions, "pipeline-3") {
+		assert.Equal("dev", executions["pipeline-2"].Spec.Branch)
+		assert.Equal("main", executions["pipeline-3"].Spec.Branch)
+	}
+	assert.Equal(4, stored.Status.NextRun)
+	assert.Equal("p-abc:pipeline-3", stored.Status.LastExecutionID)
+}
+
+func bumpVersion(version string) string {
+	v, _ := strconv.Atoi(version)
+	return strconv.Itoa(v + 1)
+}
@@ -0,0 +1,112 @@
+package pipelineexecution
+
+import (
+	"github.com/pkg/errors"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/pipeline/providers"
+	"github.com/rancher/rancher/pkg/pipeline/remote"
+	"github.com/rancher/rancher/pkg/pipeline/remote/model"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	"github.com/rancher/rancher/pkg/ref"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// This controller is responsible for reporting the states of pipeline executions
+// as commit statuses to the source code providers, where they show up on the
+// commits and pull requests that were built.
+
+type CommitStatusReporter struct {
+	pipelineLister             v3.PipelineLister
+	pipelineExecutions         v3.PipelineExecutionInterface
+	sourceCodeCredentialLister v3.SourceCodeCredentialLister
+	sourceCodeCredentials      v3.SourceCodeCredentialInterface
+}
+
+func (r *CommitStatusReporter) sync(key string, obj *v3.PipelineExecution) (runtime.Object, error) {
+	if obj == nil || obj.DeletionTimestamp != nil ||
+		obj.Spec.Commit == "" || obj.Status.ExecutionState == obj.Status.ReportedState {
+		return obj, nil
+	}
+	status := commitStatus(obj)
+	if status == nil {
+		return obj, nil
+	}
+
+	if err := r.report(obj, status); err != nil {
+		return obj, errors.Wrapf(err, "failed to report commit status of pipeline execution %s", ref.Ref(obj))
+	}
+
+	toUpdate := obj.DeepCopy()
+	toUpdate.Status.ReportedState = obj.Status.ExecutionState
+	return r.pipelineExecutions.Update(toUpdate)
+}
+
+func (r *CommitStatusReporter) report(obj *v3.PipelineExecution, status *model.CommitStatus) error {
+	ns, name := ref.Parse(obj.Spec.PipelineName)
+	pipeline, err := r.pipelineLister.Get(ns, name)
+	if err != nil {
+		return err
+	}
+	if pipeline.Spec.SourceCodeCredentialName == "" {
+		return nil
+	}
+	ns, name = ref.Parse(pipeline.Spec.SourceCodeCredentialName)
+	credential, err := r.sourceCodeCredentialLister.Get(ns, name)
+	if err != nil {
+		return err
+	}
+	_, projID := ref.Parse(obj.Spec.ProjectName)
+	scpConfig, err := providers.GetSourceCodeProviderConfig(credential.Spec.SourceCodeType, projID)
+	if err != nil {
+		return err
+	}
+	rm, err := remote.New(scpConfig)
+	if err != nil {
+		return err
+	}
+	reporter, ok := rm.(model.StatusReporter)
+	if !ok {
+		return nil
+	}
+	accessToken, err := utils.EnsureAccessToken(r.sourceCodeCredentials, rm, credential)
+	if err != nil {
+		return err
+	}
+	return reporter.SetCommitStatus(obj.Spec.RepositoryURL, status, accessToken)
+}
+
+// commitStatus converts the state of an execution to a commit status, it returns nil
+// for states that are not reported
+func commitStatus(obj *v3.PipelineExecution) *model.CommitStatus {
+	status := &model.CommitStatus{
+		Commit:    obj.Spec.Commit,
+		TargetURL: executionLink(obj),
+		Context:   model.CommitStatusContext,
+	}
+	switch obj.Status.ExecutionState {
+	case utils.StateWaiting, utils.StateQueueing, utils.StatePending:
+		status.State = model.CommitStatusPending
+		status.Description = "The pipeline is waiting to run"
+	case utils.StateAwaitingApproval:
+		status.State = model.CommitStatusPending
+		status.Description = "The pipeline is awaiting approval by a project member"
+	case utils.StateBuilding:
+		status.State = model.CommitStatusPending
+		status.Description = "The pipeline is running"
+	case utils.StateSuccess:
+		status.State = model.CommitStatusSuccess
+		status.Description = "The pipeline succeeded"
+	case utils.StateFailed:
+		status.State = model.CommitStatusFailure
+		status.Description = "The pipeline failed"
+	case utils.StateAborted:
+		status.State = model.CommitStatusError
+		status.Description = "The pipeline was aborted"
+	case utils.StateDenied:
+		status.State = model.CommitStatusError
+		status.Description = "The pipeline was denied"
+	default:
+		return nil
+	}
+	return status
+}
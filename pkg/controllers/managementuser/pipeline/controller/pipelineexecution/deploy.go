@@ -43,6 +43,9 @@ func (l *Lifecycle) deploy(projectName string) error {
 	clusterID, projectID := ref.Parse(projectName)
 	ns := getPipelineNamespace(clusterID, projectID)
 	if _, err := l.namespaceLister.Get("", ns.Name); err == nil {
+		if err := l.reconcileJenkins(projectName); err != nil {
+			return err
+		}
 		return l.reconcileRb(projectName)
 	} else if !apierrors.IsNotFound(err) {
 		return err
@@ -102,13 +105,8 @@ func (l *Lifecycle) deploy(projectName string) error {
 	if _, err := l.networkPolicies.Create(np); err != nil && !apierrors.IsAlreadyExists(err) {
 		return errors.Wrapf(err, "Error create a pipeline networkpolicy")
 	}
-	jenkinsService := getJenkinsService(nsName)
-	if _, err := l.services.Create(jenkinsService); err != nil && !apierrors.IsAlreadyExists(err) {
-		return errors.Wrapf(err, "Error creating the jenkins service")
-	}
-	jenkinsDeployment := GetJenkinsDeployment(nsName)
-	if _, err := l.deployments.Create(jenkinsDeployment); err != nil && !apierrors.IsAlreadyExists(err) {
-		return errors.Wrapf(err, "Error creating the jenkins deployment")
+	if err := l.reconcileJenkins(projectName); err != nil {
+		return err
 	}
 	registryService := getRegistryService(nsName)
 	if _, err := l.services.Create(registryService); err != nil && !apierrors.IsAlreadyExists(err) {
@@ -142,6 +140,34 @@ func (l *Lifecycle) deploy(projectName string) error {
 	return l.reconcileRb(projectName)
 }
 
+// reconcileJenkins deploys jenkins for projects running pipelines on it. Projects using the native
+// engine run steps as pods directly and do not need it.
+func (l *Lifecycle) reconcileJenkins(projectName string) error {
+	_, projectID := ref.Parse(projectName)
+	engine, err := utils.GetPipelineSettingValue(l.pipelineSettingLister, projectID, utils.SettingEngine, utils.SettingEngineDefault)
+	if err != nil {
+		return err
+	}
+	if engine != utils.EngineJenkins {
+		return nil
+	}
+	nsName := utils.GetPipelineCommonName(projectName)
+	if _, err := l.serviceLister.Get(nsName, utils.JenkinsName); err == nil {
+		return nil
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+	jenkinsService := getJenkinsService(nsName)
+	if _, err := l.services.Create(jenkinsService); err != nil && !apierrors.IsAlreadyExists(err) {
+		return errors.Wrapf(err, "Error creating the jenkins service")
+	}
+	jenkinsDeployment := GetJenkinsDeployment(nsName)
+	if _, err := l.deployments.Create(jenkinsDeployment); err != nil && !apierrors.IsAlreadyExists(err) {
+		return errors.Wrapf(err, "Error creating the jenkins deployment")
+	}
+	return nil
+}
+
 func (l *Lifecycle) waitResourceQuotaInitCondition(namespace string) error {
 	tries := 0
 	for tries <= 3 {
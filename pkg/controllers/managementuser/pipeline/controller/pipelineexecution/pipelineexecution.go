@@ -127,7 +127,8 @@ func Register(ctx context.Context, cluster *config.UserContext) {
 	pipelineExecutions := cluster.Management.Project.PipelineExecutions("")
 	pipelineExecutionLister := pipelineExecutions.Controller().Lister()
 	pipelineSettingLister := cluster.Management.Project.PipelineSettings("").Controller().Lister()
-	sourceCodeCredentialLister := cluster.Management.Project.SourceCodeCredentials("").Controller().Lister()
+	sourceCodeCredentials := cluster.Management.Project.SourceCodeCredentials("")
+	sourceCodeCredentialLister := sourceCodeCredentials.Controller().Lister()
 	notifierLister := cluster.Management.Management.Notifiers("").Controller().Lister()
 
 	pipelineEngine := engine.New(cluster, true)
@@ -181,7 +182,15 @@ func Register(ctx context.Context, cluster *config.UserContext) {
 		pipelineSettingLister:   pipelineSettingLister,
 	}
 
+	commitStatusReporter := &CommitStatusReporter{
+		pipelineLister:             pipelineLister,
+		pipelineExecutions:         pipelineExecutions,
+		sourceCodeCredentialLister: sourceCodeCredentialLister,
+		sourceCodeCredentials:      sourceCodeCredentials,
+	}
+
 	pipelineExecutions.AddClusterScopedLifecycle(ctx, pipelineExecutionLifecycle.GetName(), cluster.ClusterName, pipelineExecutionLifecycle)
+	pipelineExecutions.AddClusterScopedHandler(ctx, "pipeline-execution-commit-status", cluster.ClusterName, commitStatusReporter.sync)
 
 	go stateSyncer.sync(ctx, syncStateInterval)
 	go registryCertSyncer.sync(ctx, checkCertRotateInterval)
@@ -218,6 +227,26 @@ func (l *Lifecycle) Sync(obj *v3.PipelineExecution) (runtime.Object, error) {
 		return obj, nil
 	}
 
+	//doIfNeedApproval
+	needApproval, err := l.needApproval(obj)
+	if err != nil {
+		return obj, err
+	}
+	if needApproval {
+		if obj.Status.ExecutionState == utils.StateAwaitingApproval {
+			return obj, nil
+		}
+		obj.Status.ExecutionState = utils.StateAwaitingApproval
+		v32.PipelineExecutionConditionApproved.Unknown(obj)
+		v32.PipelineExecutionConditionApproved.Message(obj, "pull request from a fork needs to be approved by a project member")
+
+		if err := l.newExecutionUpdateLastRunState(obj); err != nil {
+			return obj, err
+		}
+
+		return obj, nil
+	}
+
 	//doIfExceedQuota
 	exceed, err := l.exceedQuota(obj)
 	if err != nil {
@@ -337,20 +366,55 @@ func (l *Lifecycle) startQueueingExecution(obj *v3.PipelineExecution) error {
 	if len(queueingExecutions) == 0 {
 		return nil
 	}
-	oldestTime := queueingExecutions[0].CreationTimestamp
-	toRunExecution := queueingExecutions[0]
+	var toRunExecution *v3.PipelineExecution
 	for _, e := range queueingExecutions {
-		if e.CreationTimestamp.Before(&oldestTime) {
-			oldestTime = e.CreationTimestamp
+		//executions awaiting approval do not run until they are approved
+		if e.Status.ExecutionState == utils.StateAwaitingApproval {
+			continue
+		}
+		if toRunExecution == nil || e.CreationTimestamp.Before(&toRunExecution.CreationTimestamp) {
 			toRunExecution = e
 		}
 	}
+	if toRunExecution == nil {
+		return nil
+	}
 	toRunExecution = toRunExecution.DeepCopy()
 	toRunExecution.Status.ExecutionState = utils.StateWaiting
 	_, err = l.pipelineExecutions.Update(toRunExecution)
 	return err
 }
 
+// needApproval checks whether an execution of a pull request from a fork has to be approved
+// by a project member before it runs. Only the native engine runs steps without the token of
+// the pipeline service account, so executions on Jenkins always need approval unless it is
+// disabled.
+func (l *Lifecycle) needApproval(obj *v3.PipelineExecution) (bool, error) {
+	if obj.Spec.Event != utils.WebhookEventPullRequest || !obj.Spec.FromFork ||
+		v32.PipelineExecutionConditionApproved.IsTrue(obj) {
+		return false, nil
+	}
+	_, projectID := ref.Parse(obj.Spec.ProjectName)
+	approval, err := utils.GetPipelineSettingValue(l.pipelineSettingLister, projectID, utils.SettingForkApproval, utils.SettingForkApprovalDefault)
+	if err != nil {
+		return false, err
+	}
+	switch approval {
+	case utils.ForkApprovalNever:
+		return false, nil
+	case utils.ForkApprovalAlways:
+		return true, nil
+	}
+	engine, err := utils.GetPipelineEngine(l.pipelineSettingLister, obj)
+	if err != nil {
+		return false, err
+	}
+	if engine != utils.EngineNative {
+		return true, nil
+	}
+	return utils.HasPrivilegedSteps(&obj.Spec.PipelineConfig), nil
+}
+
 func (l *Lifecycle) exceedQuota(obj *v3.PipelineExecution) (bool, error) {
 	_, projectID := ref.Parse(obj.Spec.ProjectName)
 	quotaSetting, err := l.pipelineSettingLister.Get(projectID, utils.SettingExecutorQuota)
@@ -368,14 +432,18 @@ func (l *Lifecycle) exceedQuota(obj *v3.PipelineExecution) (bool, error) {
 		return false, nil
 	}
 	set := labels.Set(map[string]string{utils.PipelineFinishLabel: "false"})
-	runningExecutions, err := l.pipelineExecutionLister.List(projectID, set.AsSelector())
+	executions, err := l.pipelineExecutionLister.List(projectID, set.AsSelector())
 	if err != nil {
 		return false, err
 	}
-	if len(runningExecutions) >= quota {
-		return true, nil
+	running := 0
+	for _, e := range executions {
+		//executions awaiting approval are not finished but do not run yet
+		if e.Status.ExecutionState != utils.StateAwaitingApproval {
+			running++
+		}
 	}
-	return false, nil
+	return running >= quota, nil
 }
 
 func (l *Lifecycle) doStop(obj *v3.PipelineExecution) error {
@@ -593,12 +661,7 @@ func defaultNotificationMessage(execution *v3.PipelineExecution) (string, error)
 	} else {
 		logrus.Warnf("cannot parse duration of pipeline execution %s: %v,%v", execution.Name, err1, err2)
 	}
-	buildLink := fmt.Sprintf("%s/p/%s/pipeline/pipelines/%s/run/%d",
-		settings.ServerURL.Get(),
-		execution.Spec.ProjectName,
-		execution.Spec.PipelineName,
-		execution.Spec.Run,
-	)
+	buildLink := executionLink(execution)
 	builtMessage := "Success"
 	if v32.PipelineExecutionConditionBuilt.IsFalse(execution) {
 		builtMessage = v32.PipelineExecutionConditionBuilt.GetMessage(execution)
@@ -626,6 +689,16 @@ func defaultNotificationMessage(execution *v3.PipelineExecution) (string, error)
 	return buf.String(), nil
 }
 
+// executionLink returns the link to the execution in the UI
+func executionLink(execution *v3.PipelineExecution) string {
+	return fmt.Sprintf("%s/p/%s/pipeline/pipelines/%s/run/%d",
+		settings.ServerURL.Get(),
+		execution.Spec.ProjectName,
+		execution.Spec.PipelineName,
+		execution.Spec.Run,
+	)
+}
+
 func getRepoNameFromURL(repoURL string) string {
 	reg := regexp.MustCompile(".*/([^/]*?)/([^/]*?).git")
 	match := reg.FindStringSubmatch(repoURL)
@@ -0,0 +1,155 @@
+package pipelineexecution
+
+import (
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/project.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3/fakes"
+	"github.com/rancher/rancher/pkg/pipeline/remote/model"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func newSettingLister(values map[string]string) *fakes.PipelineSettingListerMock {
+	return &fakes.PipelineSettingListerMock{
+		GetFunc: func(namespace string, name string) (*v3.PipelineSetting, error) {
+			value, ok := values[name]
+			if !ok {
+				return nil, apierrors.NewNotFound(schema.GroupResource{}, name)
+			}
+			return &v3.PipelineSetting{Value: value}, nil
+		},
+	}
+}
+
+func newForkExecution(steps ...v32.Step) *v3.PipelineExecution {
+	return &v3.PipelineExecution{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{}},
+		Spec: v32.PipelineExecutionSpec{
+			ProjectName: "c-test:p-test",
+			Event:       utils.WebhookEventPullRequest,
+			FromFork:    true,
+			PipelineConfig: v32.PipelineConfig{
+				Stages: []v32.Stage{{Name: "test", Steps: steps}},
+			},
+		},
+	}
+}
+
+func TestNeedApproval(t *testing.T) {
+	assert := assert.New(t)
+
+	script := v32.Step{RunScriptConfig: &v32.RunScriptConfig{Image: "golang", ShellScript: "go test ./..."}}
+	publish := v32.Step{PublishImageConfig: &v32.PublishImageConfig{Tag: "app:dev"}}
+
+	approved := newForkExecution(publish)
+	v32.PipelineExecutionConditionApproved.True(approved)
+	push := newForkExecution(publish)
+	push.Spec.Event = utils.WebhookEventPush
+	sameRepo := newForkExecution(publish)
+	sameRepo.Spec.FromFork = false
+
+	testCases := []struct {
+		name      string
+		settings  map[string]string
+		execution *v3.PipelineExecution
+		expected  bool
+	}{
+		{"push", nil, push, false},
+		{"same repository", nil, sameRepo, false},
+		{"approved", nil, approved, false},
+		{"default", nil, newForkExecution(script), true},
+		{"always", map[string]string{utils.SettingForkApproval: utils.ForkApprovalAlways}, newForkExecution(script), true},
+		{"never", map[string]string{utils.SettingForkApproval: utils.ForkApprovalNever}, newForkExecution(publish), false},
+		{"privileged on jenkins", map[string]string{utils.SettingForkApproval: utils.ForkApprovalPrivileged}, newForkExecution(script), true},
+		{"privileged on native with scripts", map[string]string{
+			utils.SettingForkApproval: utils.ForkApprovalPrivileged,
+			utils.SettingEngine:       utils.EngineNative,
+		}, newForkExecution(script), false},
+		{"privileged on native with privileged steps", map[string]string{
+			utils.SettingForkApproval: utils.ForkApprovalPrivileged,
+			utils.SettingEngine:       utils.EngineNative,
+		}, newForkExecution(script, publish), true},
+	}
+	for _, tc := range testCases {
+		l := &Lifecycle{pipelineSettingLister: newSettingLister(tc.settings)}
+		need, err := l.needApproval(tc.execution)
+		assert.Nil(err, tc.name)
+		assert.Equal(tc.expected, need, tc.name)
+	}
+}
+
+func TestExceedQuota(t *testing.T) {
+	assert := assert.New(t)
+
+	executions := []*v3.PipelineExecution{
+		{Status: v32.PipelineExecutionStatus{ExecutionState: utils.StateBuilding}},
+		{Status: v32.PipelineExecutionStatus{ExecutionState: utils.StateAwaitingApproval}},
+		{Status: v32.PipelineExecutionStatus{ExecutionState: utils.StateAwaitingApproval}},
+	}
+	l := &Lifecycle{
+		pipelineSettingLister: newSettingLister(map[string]string{utils.SettingExecutorQuota: "2"}),
+		pipelineExecutionLister: &fakes.PipelineExecutionListerMock{
+			ListFunc: func(namespace string, selector labels.Selector) ([]*v3.PipelineExecution, error) {
+				return executions, nil
+			},
+		},
+	}
+	obj := &v3.PipelineExecution{Spec: v32.PipelineExecutionSpec{ProjectName: "c-test:p-test"}}
+
+	exceed, err := l.exceedQuota(obj)
+	assert.Nil(err)
+	assert.False(exceed)
+
+	executions = append(executions, &v3.PipelineExecution{Status: v32.PipelineExecutionStatus{ExecutionState: utils.StateBuilding}})
+	exceed, err = l.exceedQuota(obj)
+	assert.Nil(err)
+	assert.True(exceed)
+}
+
+func TestCommitStatus(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		state    string
+		expected string
+	}{
+		{utils.StateWaiting, model.CommitStatusPending},
+		{utils.StateQueueing, model.CommitStatusPending},
+		{utils.StatePending, model.CommitStatusPending},
+		{utils.StateAwaitingApproval, model.CommitStatusPending},
+		{utils.StateBuilding, model.CommitStatusPending},
+		{utils.StateSuccess, model.CommitStatusSuccess},
+		{utils.StateFailed, model.CommitStatusFailure},
+		{utils.StateAborted, model.CommitStatusError},
+		{utils.StateDenied, model.CommitStatusError},
+	}
+	for _, tc := range testCases {
+		obj := &v3.PipelineExecution{
+			Spec: v32.PipelineExecutionSpec{
+				ProjectName:  "c-test:p-test",
+				PipelineName: "p-test:p-abc",
+				Commit:       "0123abc",
+				Run:          3,
+			},
+			Status: v32.PipelineExecutionStatus{ExecutionState: tc.state},
+		}
+		status := commitStatus(obj)
+		if !assert.NotNil(status, tc.state) {
+			continue
+		}
+		assert.Equal(tc.expected, status.State, tc.state)
+		assert.Equal("0123abc", status.Commit, tc.state)
+		assert.Equal(model.CommitStatusContext, status.Context, tc.state)
+		assert.Contains(status.TargetURL, "/p/c-test:p-test/pipeline/pipelines/p-test:p-abc/run/3", tc.state)
+		assert.NotEmpty(status.Description, tc.state)
+	}
+
+	obj := &v3.PipelineExecution{Status: v32.PipelineExecutionStatus{ExecutionState: utils.StateSkipped}}
+	assert.Nil(commitStatus(obj))
+}
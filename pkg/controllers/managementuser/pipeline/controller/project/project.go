@@ -30,6 +30,9 @@
 	utils.SettingExecutorMemoryLimit:   utils.SettingExecutorMemoryLimitDefault,
 	utils.SettingExecutorCPURequest:    utils.SettingExecutorCPURequestDefault,
 	utils.SettingExecutorCPULimit:      utils.SettingExecutorCPULimitDefault,
+	utils.SettingEngine:                utils.SettingEngineDefault,
+	utils.SettingWorkspaceSize:         utils.SettingWorkspaceSizeDefault,
+	utils.SettingForkApproval:          utils.SettingForkApprovalDefault,
 }
 
 func Register(ctx context.Context, cluster *config.UserContext) {
@@ -83,6 +86,8 @@ func (l *Syncer) addSourceCodeProviderConfigs(obj *v3.Project) error {
 		model.GitlabType:          pclient.GitlabPipelineConfigType,
 		model.BitbucketCloudType:  pclient.BitbucketCloudPipelineConfigType,
 		model.BitbucketServerType: pclient.BitbucketServerPipelineConfigType,
+		model.GiteaType:           pclient.GiteaPipelineConfigType,
+		model.GitType:             pclient.GitPipelineConfigType,
 	}
 	for name, pType := range supportedProviders {
 		if err := l.addSourceCodeProviderConfig(name, pType, false, obj); err != nil {
@@ -129,13 +134,20 @@ func (l *Syncer) addPipelineSettings(obj *v3.Project) error {
 }
 
 func (l *Syncer) addPipelineSetting(settingName string, value string, obj *v3.Project) error {
-	_, err := l.pipelineSettingLister.Get(obj.Name, settingName)
+	existing, err := l.pipelineSettingLister.Get(obj.Name, settingName)
 	if err != nil {
 		if !apierrors.IsNotFound(err) {
 			return err
 		}
 	} else {
-		return nil
+		if existing.Default == value {
+			return nil
+		}
+		//update the defaults of existing projects, values set by users are kept
+		toUpdate := existing.DeepCopy()
+		toUpdate.Default = value
+		_, err := l.pipelineSettings.Update(toUpdate)
+		return err
 	}
 
 	setting := &pv3.PipelineSetting{
@@ -41,6 +41,8 @@ func Register(ctx context.Context, cluster *config.UserContext) {
 		nsIndexer:     nsInformer.GetIndexer(),
 		projectLister: cluster.Management.Management.Projects(cluster.ClusterName).Controller().Lister(),
 		projects:      cluster.Management.Management.Projects(cluster.ClusterName),
+		clusterLister: cluster.Management.Management.Clusters("").Controller().Lister(),
+		clusters:      cluster.Management.Management.Clusters(""),
 		clusterName:   cluster.ClusterName,
 	}
 	cluster.Core.Namespaces("").AddHandler(ctx, "resourceQuotaUsedLimitController", calculate.calculateResourceQuotaUsed)
@@ -51,6 +53,15 @@ func Register(ctx context.Context, cluster *config.UserContext) {
 		namespaces: cluster.Core.Namespaces(""),
 	}
 	cluster.Management.Management.Projects(cluster.ClusterName).AddHandler(ctx, "namespaceResourceQuotaResetController", reset.resetNamespaceQuota)
+
+	request := &requestController{
+		requests:        cluster.Management.Management.ResourceQuotaRequests(""),
+		projectLister:   cluster.Management.Management.Projects(cluster.ClusterName).Controller().Lister(),
+		namespaces:      cluster.Core.Namespaces(""),
+		namespaceLister: cluster.Core.Namespaces("").Controller().Lister(),
+		nsIndexer:       nsInformer.GetIndexer(),
+	}
+	cluster.Management.Management.ResourceQuotaRequests("").AddClusterScopedHandler(ctx, "resourceQuotaRequestController", cluster.ClusterName, request.syncRequest)
 }
 
 func nsByProjectID(obj interface{}) ([]string, error) {
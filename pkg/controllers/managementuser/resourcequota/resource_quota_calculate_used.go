@@ -11,6 +11,7 @@
 	namespaceutil "github.com/rancher/rancher/pkg/namespace"
 	validate "github.com/rancher/rancher/pkg/resourcequota"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	clientcache "k8s.io/client-go/tools/cache"
 	"k8s.io/kubernetes/pkg/quota/v1"
 )
@@ -22,6 +23,8 @@
 type calculateLimitController struct {
 	projectLister v3.ProjectLister
 	projects      v3.ProjectInterface
+	clusterLister v3.ClusterLister
+	clusters      v3.ClusterInterface
 	nsIndexer     clientcache.Indexer
 	clusterName   string
 }
@@ -39,10 +42,14 @@ func (c *calculateLimitController) calculateResourceQuotaUsed(key string, ns *co
 
 func (c *calculateLimitController) calculateResourceQuotaUsedProject(key string, p *v3.Project) (runtime.Object, error) {
 	if p == nil || p.DeletionTimestamp != nil {
-		return nil, nil
+		// the quota of a removed project goes back to the cluster pool
+		return nil, c.calculateClusterResourceQuota()
 	}
 
-	return nil, c.calculateProjectResourceQuota(fmt.Sprintf("%s:%s", c.clusterName, p.Name))
+	if err := c.calculateProjectResourceQuota(fmt.Sprintf("%s:%s", c.clusterName, p.Name)); err != nil {
+		return nil, err
+	}
+	return nil, c.calculateClusterResourceQuota()
 }
 
 func (c *calculateLimitController) calculateProjectResourceQuota(projectID string) error {
@@ -93,3 +100,41 @@ func (c *calculateLimitController) calculateProjectResourceQuota(projectID strin
 	_, err = c.projects.Update(toUpdate)
 	return err
 }
+
+// calculateClusterResourceQuota sets the combined limit of the projects of the cluster as the used limit of the
+// cluster quota pool.
+func (c *calculateLimitController) calculateClusterResourceQuota() error {
+	cluster, err := c.clusterLister.Get("", c.clusterName)
+	if err != nil || cluster.Spec.ResourceQuota == nil {
+		return err
+	}
+
+	projects, err := c.projectLister.List(c.clusterName, labels.Everything())
+	if err != nil {
+		return err
+	}
+	projectsResourceList := corev1.ResourceList{}
+	for _, p := range projects {
+		if p.DeletionTimestamp != nil || p.Spec.ResourceQuota == nil {
+			continue
+		}
+		projectResourceList, err := validate.ConvertLimitToResourceList(&p.Spec.ResourceQuota.Limit)
+		if err != nil {
+			return err
+		}
+		projectsResourceList = quota.Add(projectsResourceList, projectResourceList)
+	}
+	limit, err := convertResourceListToLimit(projectsResourceList)
+	if err != nil {
+		return err
+	}
+
+	if reflect.DeepEqual(cluster.Spec.ResourceQuota.UsedLimit, *limit) {
+		return nil
+	}
+
+	toUpdate := cluster.DeepCopy()
+	toUpdate.Spec.ResourceQuota.UsedLimit = *limit
+	_, err = c.clusters.Update(toUpdate)
+	return err
+}
@@ -9,6 +9,7 @@
 	"github.com/rancher/norman/types/convert"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/ref"
+	validate "github.com/rancher/rancher/pkg/resourcequota"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 )
@@ -24,10 +25,7 @@ func convertResourceListToLimit(rList corev1.ResourceList) (*v32.ResourceQuotaLi
 		convertedMap[key] = convert.ToString(value)
 	}
 
-	toReturn := &v32.ResourceQuotaLimit{}
-	err = convert.ToObj(convertedMap, toReturn)
-
-	return toReturn, err
+	return validate.MapToLimit(convertedMap)
 }
 
 func convertResourceLimitResourceQuotaSpec(limit *v32.ResourceQuotaLimit) (*corev1.ResourceQuotaSpec, error) {
@@ -42,12 +40,7 @@ func convertResourceLimitResourceQuotaSpec(limit *v32.ResourceQuotaLimit) (*core
 }
 
 func convertProjectResourceLimitToResourceList(limit *v32.ResourceQuotaLimit) (corev1.ResourceList, error) {
-	in, err := json.Marshal(limit)
-	if err != nil {
-		return nil, err
-	}
-	limitsMap := map[string]string{}
-	err = json.Unmarshal(in, &limitsMap)
+	limitsMap, err := validate.LimitToMap(limit)
 	if err != nil {
 		return nil, err
 	}
@@ -165,7 +158,9 @@ func getProjectResourceQuotaLimit(ns *corev1.Namespace, projectLister v3.Project
 	if err != nil || project.Spec.ResourceQuota == nil {
 		return nil, "", err
 	}
-	return &project.Spec.ResourceQuota.Limit, projectID, nil
+	// the namespaces of the project are allotted up to the overcommitted project limit
+	limit, err := validate.ApplyOvercommit(&project.Spec.ResourceQuota.Limit, project.Spec.ResourceQuota.OvercommitRatio)
+	return limit, projectID, err
 }
 
 func getProjectNamespaceDefaultQuota(ns *corev1.Namespace, projectLister v3.ProjectLister) (*v32.NamespaceResourceQuota, error) {
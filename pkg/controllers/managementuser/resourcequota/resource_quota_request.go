@@ -0,0 +1,225 @@
+package resourcequota
+
+import (
+	"encoding/json"
+	"fmt"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	validate "github.com/rancher/rancher/pkg/resourcequota"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientcache "k8s.io/client-go/tools/cache"
+	"k8s.io/kubernetes/pkg/quota/v1"
+)
+
+/*
+requestController applies the approved resource quota requests of the projects of the cluster
+to their namespace, moving the quota from the source namespace of the request if any
+*/
+type requestController struct {
+	requests        v3.ResourceQuotaRequestInterface
+	projectLister   v3.ProjectLister
+	namespaces      v1.NamespaceInterface
+	namespaceLister v1.NamespaceLister
+	nsIndexer       clientcache.Indexer
+}
+
+// requestError is an approved request that cannot be applied, it fails the request instead of being retried.
+type requestError struct {
+	msg string
+}
+
+func (e *requestError) Error() string {
+	return e.msg
+}
+
+func (c *requestController) syncRequest(key string, request *v3.ResourceQuotaRequest) (runtime.Object, error) {
+	if request == nil || request.DeletionTimestamp != nil || request.Status.Phase != v32.ResourceQuotaRequestPhaseApproved {
+		return request, nil
+	}
+
+	toUpdate := request.DeepCopy()
+	if err := c.applyRequest(request); err != nil {
+		if _, ok := err.(*requestError); !ok {
+			return request, err
+		}
+		logrus.Infof("Failed to apply resource quota request %s/%s: %v", request.Namespace, request.Name, err)
+		toUpdate.Status.Phase = v32.ResourceQuotaRequestPhaseFailed
+		toUpdate.Status.Message = err.Error()
+	} else {
+		logrus.Infof("Applied resource quota request %s/%s to namespace %s", request.Namespace, request.Name, request.Spec.NamespaceName)
+		toUpdate.Status.Phase = v32.ResourceQuotaRequestPhaseApplied
+		toUpdate.Status.Message = ""
+	}
+	return c.requests.Update(toUpdate)
+}
+
+func (c *requestController) applyRequest(request *v3.ResourceQuotaRequest) error {
+	projectID := request.Spec.ProjectName
+	ns, err := c.getProjectNamespace(projectID, request.Spec.NamespaceName)
+	if err != nil {
+		return err
+	}
+	projectLimit, _, err := getProjectResourceQuotaLimit(ns, c.projectLister)
+	if err != nil {
+		return err
+	}
+	if projectLimit == nil {
+		return &requestError{msg: fmt.Sprintf("project %s has no resource quota", projectID)}
+	}
+	requested := &request.Spec.Limit
+	if err := validate.ValidateLimit(requested); err != nil {
+		return &requestError{msg: err.Error()}
+	}
+	if err := hasAllFields(requested, projectLimit); err != nil {
+		return err
+	}
+
+	mu := validate.GetProjectLock(projectID)
+	mu.Lock()
+	defer mu.Unlock()
+
+	current, err := getNamespaceResourceQuotaLimit(ns)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		current = &v32.ResourceQuotaLimit{}
+	}
+
+	var source *corev1.Namespace
+	var sourceLimit *v32.ResourceQuotaLimit
+	if request.Spec.SourceNamespaceName != "" {
+		if request.Spec.SourceNamespaceName == ns.Name {
+			return &requestError{msg: "source namespace must be another namespace of the project"}
+		}
+		source, err = c.getProjectNamespace(projectID, request.Spec.SourceNamespaceName)
+		if err != nil {
+			return err
+		}
+		sourceCurrent, err := getNamespaceResourceQuotaLimit(source)
+		if err != nil {
+			return err
+		}
+		if sourceCurrent == nil {
+			return &requestError{msg: fmt.Sprintf("namespace %s has no resource quota to move", source.Name)}
+		}
+		sourceLimit, err = movedQuota(current, requested, sourceCurrent)
+		if err != nil {
+			return err
+		}
+	}
+
+	objects, err := c.nsIndexer.ByIndex(nsByProjectIndex, projectID)
+	if err != nil {
+		return err
+	}
+	var nsLimits []*v32.ResourceQuotaLimit
+	for _, o := range objects {
+		other := o.(*corev1.Namespace)
+		if other.Name == ns.Name || (source != nil && other.Name == source.Name) {
+			continue
+		}
+		nsLimit, err := getNamespaceResourceQuotaLimit(other)
+		if err != nil {
+			return err
+		}
+		nsLimits = append(nsLimits, nsLimit)
+	}
+	if sourceLimit != nil {
+		nsLimits = append(nsLimits, sourceLimit)
+	}
+	isFit, msg, err := validate.IsQuotaFit(requested, nsLimits, projectLimit)
+	if err != nil {
+		return err
+	}
+	if !isFit {
+		return &requestError{msg: fmt.Sprintf("Resource quota [%v] exceeds project limit", msg)}
+	}
+
+	// the source gives its quota first so that the namespaces of the project never exceed the project limit
+	if source != nil {
+		if err := c.setNamespaceQuota(source, sourceLimit); err != nil {
+			return err
+		}
+	}
+	return c.setNamespaceQuota(ns, requested)
+}
+
+func (c *requestController) getProjectNamespace(projectID, name string) (*corev1.Namespace, error) {
+	ns, err := c.namespaceLister.Get("", name)
+	if apierrors.IsNotFound(err) {
+		return nil, &requestError{msg: fmt.Sprintf("namespace %s not found", name)}
+	} else if err != nil {
+		return nil, err
+	}
+	if getProjectID(ns) != projectID {
+		return nil, &requestError{msg: fmt.Sprintf("namespace %s is not in project %s", name, projectID)}
+	}
+	return ns, nil
+}
+
+func (c *requestController) setNamespaceQuota(ns *corev1.Namespace, limit *v32.ResourceQuotaLimit) error {
+	b, err := json.Marshal(v32.NamespaceResourceQuota{Limit: *limit})
+	if err != nil {
+		return err
+	}
+	toUpdate := ns.DeepCopy()
+	if toUpdate.Annotations == nil {
+		toUpdate.Annotations = map[string]string{}
+	}
+	toUpdate.Annotations[resourceQuotaAnnotation] = string(b)
+	_, err = c.namespaces.Update(toUpdate)
+	return err
+}
+
+// hasAllFields checks that the requested limit sets every limit of the project quota and no other.
+func hasAllFields(requested, projectLimit *v32.ResourceQuotaLimit) error {
+	requestedMap, err := validate.LimitToMap(requested)
+	if err != nil {
+		return err
+	}
+	projectMap, err := validate.LimitToMap(projectLimit)
+	if err != nil {
+		return err
+	}
+	for k := range projectMap {
+		if _, ok := requestedMap[k]; !ok {
+			return &requestError{msg: fmt.Sprintf("limit misses %s defined on the project quota", k)}
+		}
+	}
+	for k := range requestedMap {
+		if _, ok := projectMap[k]; !ok {
+			return &requestError{msg: fmt.Sprintf("limit sets %s that is not defined on the project quota", k)}
+		}
+	}
+	return nil
+}
+
+// movedQuota returns the quota of the source namespace once it gave what the namespace is granted on top of its
+// current quota.
+func movedQuota(current, requested, source *v32.ResourceQuotaLimit) (*v32.ResourceQuotaLimit, error) {
+	currentList, err := validate.ConvertLimitToResourceList(current)
+	if err != nil {
+		return nil, err
+	}
+	requestedList, err := validate.ConvertLimitToResourceList(requested)
+	if err != nil {
+		return nil, err
+	}
+	sourceList, err := validate.ConvertLimitToResourceList(source)
+	if err != nil {
+		return nil, err
+	}
+
+	granted := quota.SubtractWithNonNegativeResult(requestedList, currentList)
+	remaining := quota.Subtract(sourceList, granted)
+	if negative := quota.IsNegative(remaining); len(negative) > 0 {
+		return nil, &requestError{msg: fmt.Sprintf("source namespace does not have enough quota to move on fields: %v", negative)}
+	}
+	return convertResourceListToLimit(quota.Mask(remaining, quota.ResourceNames(sourceList)))
+}
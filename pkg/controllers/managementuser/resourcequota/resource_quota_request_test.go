@@ -0,0 +1,33 @@
+package resourcequota
+
+import (
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMovedQuota(t *testing.T) {
+	assert := assert.New(t)
+
+	current := &v32.ResourceQuotaLimit{RequestsCPU: "500m", Pods: "10"}
+	source := &v32.ResourceQuotaLimit{RequestsCPU: "2", Pods: "10"}
+
+	// the source only gives what the namespace is granted on top of its current quota
+	result, err := movedQuota(current, &v32.ResourceQuotaLimit{RequestsCPU: "1500m", Pods: "5"}, source)
+	assert.Nil(err)
+	assert.Equal(&v32.ResourceQuotaLimit{RequestsCPU: "1", Pods: "10"}, result)
+
+	_, err = movedQuota(current, &v32.ResourceQuotaLimit{RequestsCPU: "3", Pods: "10"}, source)
+	assert.EqualError(err, "source namespace does not have enough quota to move on fields: [requestsCpu]")
+}
+
+func TestHasAllFields(t *testing.T) {
+	assert := assert.New(t)
+
+	projectLimit := &v32.ResourceQuotaLimit{RequestsCPU: "4", Pods: "20"}
+	assert.Nil(hasAllFields(&v32.ResourceQuotaLimit{RequestsCPU: "1", Pods: "5"}, projectLimit))
+	assert.EqualError(hasAllFields(&v32.ResourceQuotaLimit{RequestsCPU: "1"}, projectLimit), "limit misses pods defined on the project quota")
+	assert.EqualError(hasAllFields(&v32.ResourceQuotaLimit{RequestsCPU: "1", Pods: "5", Secrets: "5"}, projectLimit),
+		"limit sets secrets that is not defined on the project quota")
+}
@@ -357,6 +357,12 @@ func (c *SyncController) validateAndSetNamespaceQuota(ns *corev1.Namespace, quot
 	if err != nil {
 		return false, updatedNs, err
 	}
+	if nsLimit != nil {
+		if err := validate.ValidateLimit(nsLimit); err != nil {
+			validated, err := c.setValidated(updatedNs, false, err.Error())
+			return false, validated, err
+		}
+	}
 	isFit, msg, err := validate.IsQuotaFit(nsLimit, nsLimits, projectLimit)
 	if err != nil {
 		return false, updatedNs, err
@@ -459,11 +465,11 @@ func completeQuota(existingQuota *v32.NamespaceResourceQuota, defaultQuota *v32.
 	if defaultQuota == nil {
 		return nil, nil
 	}
-	existingLimitMap, err := convert.EncodeToMap(existingQuota.Limit)
+	existingLimitMap, err := validate.LimitToMap(&existingQuota.Limit)
 	if err != nil {
 		return nil, err
 	}
-	newLimitMap, err := convert.EncodeToMap(defaultQuota.Limit)
+	newLimitMap, err := validate.LimitToMap(&defaultQuota.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -478,11 +484,11 @@ func completeQuota(existingQuota *v32.NamespaceResourceQuota, defaultQuota *v32.
 	}
 
 	toReturn := existingQuota.DeepCopy()
-	newLimit := v32.ResourceQuotaLimit{}
-	if err := mapstructure.Decode(newLimitMap, &newLimit); err != nil {
+	newLimit, err := validate.MapToLimit(newLimitMap)
+	if err != nil {
 		return nil, err
 	}
-	toReturn.Limit = newLimit
+	toReturn.Limit = *newLimit
 	return toReturn, nil
 }
 
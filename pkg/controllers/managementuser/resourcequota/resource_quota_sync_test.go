@@ -107,3 +107,69 @@ func TestSemanticDeepEqual(t *testing.T) {
 	}
 
 }
+
+func TestCompleteQuotaExtended(t *testing.T) {
+	assert := assert.New(t)
+
+	existing := &v32.NamespaceResourceQuota{
+		Limit: v32.ResourceQuotaLimit{
+			Pods: "5",
+			Extended: map[string]string{
+				"requests.nvidia.com/gpu": "1",
+				"count/jobs.batch":        "10",
+			},
+		},
+	}
+	defaultQuota := &v32.NamespaceResourceQuota{
+		Limit: v32.ResourceQuotaLimit{
+			Pods: "10",
+			Extended: map[string]string{
+				"requests.nvidia.com/gpu":    "2",
+				"requests.ephemeral-storage": "1Gi",
+			},
+		},
+	}
+	completed, err := completeQuota(existing, defaultQuota)
+	assert.Nil(err)
+	assert.Equal(&v32.NamespaceResourceQuota{
+		Limit: v32.ResourceQuotaLimit{
+			Pods: "5",
+			Extended: map[string]string{
+				"requests.nvidia.com/gpu":    "1",
+				"requests.ephemeral-storage": "1Gi",
+			},
+		},
+	}, completed)
+}
+
+func TestConvertExtendedLimit(t *testing.T) {
+	assert := assert.New(t)
+
+	limit := &v32.ResourceQuotaLimit{
+		RequestsCPU: "1",
+		Extended: map[string]string{
+			"requests.nvidia.com/gpu":                           "2",
+			"gold.storageclass.storage.k8s.io/requests.storage": "10Gi",
+		},
+	}
+	resourceList, err := convertProjectResourceLimitToResourceList(limit)
+	assert.Nil(err)
+	assert.Equal(corev1.ResourceList{
+		corev1.ResourceRequestsCPU:                          resource.MustParse("1"),
+		"requests.nvidia.com/gpu":                           resource.MustParse("2"),
+		"gold.storageclass.storage.k8s.io/requests.storage": resource.MustParse("10Gi"),
+	}, resourceList)
+
+	// the used limit of a project sums the extended resources of its namespaces like the other limits
+	used, err := convertResourceListToLimit(corev1.ResourceList{
+		"requestsCpu":             resource.MustParse("1500m"),
+		"requests.nvidia.com/gpu": resource.MustParse("3"),
+	})
+	assert.Nil(err)
+	assert.Equal(&v32.ResourceQuotaLimit{
+		RequestsCPU: "1500m",
+		Extended: map[string]string{
+			"requests.nvidia.com/gpu": "3",
+		},
+	}, used)
+}
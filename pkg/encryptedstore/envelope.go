@@ -0,0 +1,114 @@
+package encryptedstore
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	keyIDAnnotation    = "encryptedstore.cattle.io/key-id"
+	encryptedKeyField  = "encrypted-key"
+	encryptedDataField = "encrypted-data"
+	dataKeySize        = 32
+)
+
+// needsReencryption returns whether the secret is stored in plain text or with a key other than the one new
+// secrets are encrypted with.
+func (k KeyProviders) needsReencryption(secret *corev1.Secret) bool {
+	p := k.primary()
+	return p != nil && secret.Annotations[keyIDAnnotation] != p.KeyID()
+}
+
+// reencrypt returns a copy of the secret encrypted with the current key.
+func (k KeyProviders) reencrypt(secret *corev1.Secret) (*corev1.Secret, error) {
+	data, err := k.decryptSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+	result := secret.DeepCopy()
+	return result, k.encodeSecret(result, data)
+}
+
+// decryptSecret returns the stored values, reading secrets written before encryption was enabled as they are.
+func (k KeyProviders) decryptSecret(secret *corev1.Secret) (map[string]string, error) {
+	keyID, ok := secret.Annotations[keyIDAnnotation]
+	if !ok {
+		result := map[string]string{}
+		for k, v := range secret.Data {
+			result[k] = string(v)
+		}
+		return result, nil
+	}
+
+	p, err := k.providerFor(keyID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decrypt secret %s/%s", secret.Namespace, secret.Name)
+	}
+	dek, err := p.Unwrap(keyID, secret.Data[encryptedKeyField])
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to unwrap data key of secret %s/%s", secret.Namespace, secret.Name)
+	}
+	aead, err := newGCM(dek)
+	if err != nil {
+		return nil, err
+	}
+	// the secret name is authenticated so that encrypted values cannot be moved between secrets
+	plaintext, err := open(aead, secret.Data[encryptedDataField], []byte(secret.Name))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decrypt secret %s/%s", secret.Namespace, secret.Name)
+	}
+
+	result := map[string]string{}
+	return result, json.Unmarshal(plaintext, &result)
+}
+
+// encodeSecret replaces the data of the secret with values, encrypted with a new data key if a key provider is
+// configured.
+func (k KeyProviders) encodeSecret(secret *corev1.Secret, values map[string]string) error {
+	secret.StringData = nil
+	p := k.primary()
+	if p == nil {
+		delete(secret.Annotations, keyIDAnnotation)
+		secret.Data = map[string][]byte{}
+		for k, v := range values {
+			secret.Data[k] = []byte(v)
+		}
+		return nil
+	}
+
+	dek := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(rand.Reader, dek); err != nil {
+		return err
+	}
+	wrapped, err := p.Wrap(dek)
+	if err != nil {
+		return errors.Wrapf(err, "failed to wrap data key of secret %s/%s", secret.Namespace, secret.Name)
+	}
+
+	plaintext, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	aead, err := newGCM(dek)
+	if err != nil {
+		return err
+	}
+	ciphertext, err := seal(aead, plaintext, []byte(secret.Name))
+	if err != nil {
+		return err
+	}
+
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[keyIDAnnotation] = p.KeyID()
+	secret.Data = map[string][]byte{
+		encryptedKeyField:  wrapped,
+		encryptedDataField: ciphertext,
+	}
+	return nil
+}
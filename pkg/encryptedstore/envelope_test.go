@@ -0,0 +1,110 @@
+package encryptedstore
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func writeKeyFile(t *testing.T, keys ...string) string {
+	f, err := ioutil.TempFile("", "encryption-keys")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	for _, name := range keys {
+		key := base64.StdEncoding.EncodeToString([]byte(strings.Repeat(name[:1], 32)))
+		f.WriteString(name + ":" + key + "\n")
+	}
+	return f.Name()
+}
+
+func TestEnvelopeEncryption(t *testing.T) {
+	assert := assert.New(t)
+
+	plaintext := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "mc-node1", Namespace: defaultNamespace},
+		Data:       map[string][]byte{"extractedConfig": []byte("config")},
+	}
+
+	// without a key provider secrets are read and written in plain text
+	var keys KeyProviders
+	assert.False(keys.needsReencryption(plaintext))
+	updated, changed, err := keys.prepareSecretForUpdate(plaintext, map[string]string{"extractedConfig": "config"})
+	assert.Nil(err)
+	assert.False(changed)
+	assert.Equal(plaintext, updated)
+
+	oldKeys := writeKeyFile(t, "old")
+	defer os.Remove(oldKeys)
+	p, err := NewAESKeyProvider(oldKeys)
+	assert.Nil(err)
+	keys = KeyProviders{p}
+
+	// existing plain text secrets stay readable and are encrypted on the next write
+	data, err := keys.decryptSecret(plaintext)
+	assert.Nil(err)
+	assert.Equal(map[string]string{"extractedConfig": "config"}, data)
+	assert.True(keys.needsReencryption(plaintext))
+
+	encrypted, changed, err := keys.prepareSecretForUpdate(plaintext, map[string]string{"driver": "amazonec2"})
+	assert.Nil(err)
+	assert.True(changed)
+	assert.Equal("aes:old", encrypted.Annotations[keyIDAnnotation])
+	assert.NotContains(encrypted.Data, "extractedConfig")
+	assert.False(keys.needsReencryption(encrypted))
+
+	data, err = keys.decryptSecret(encrypted)
+	assert.Nil(err)
+	assert.Equal(map[string]string{"extractedConfig": "config", "driver": "amazonec2"}, data)
+
+	_, changed, err = keys.prepareSecretForUpdate(encrypted, map[string]string{"driver": "amazonec2"})
+	assert.Nil(err)
+	assert.False(changed)
+
+	// the ciphertext is bound to the secret name
+	moved := encrypted.DeepCopy()
+	moved.Name = "mc-node2"
+	_, err = keys.decryptSecret(moved)
+	assert.NotNil(err)
+
+	// prepending a new key rotates it, secrets written with the old key are re-encrypted
+	rotatedKeys := writeKeyFile(t, "new", "old")
+	defer os.Remove(rotatedKeys)
+	p, err = NewAESKeyProvider(rotatedKeys)
+	assert.Nil(err)
+	keys = KeyProviders{p}
+
+	assert.True(keys.needsReencryption(encrypted))
+	reencrypted, err := keys.reencrypt(encrypted)
+	assert.Nil(err)
+	assert.Equal("aes:new", reencrypted.Annotations[keyIDAnnotation])
+	data, err = keys.decryptSecret(reencrypted)
+	assert.Nil(err)
+	assert.Equal(map[string]string{"extractedConfig": "config", "driver": "amazonec2"}, data)
+
+	// a removed key can no longer decrypt
+	newKeys := writeKeyFile(t, "new")
+	defer os.Remove(newKeys)
+	p, err = NewAESKeyProvider(newKeys)
+	assert.Nil(err)
+	keys = KeyProviders{p}
+	_, err = keys.decryptSecret(encrypted)
+	assert.NotNil(err)
+}
+
+func TestGenericEncryptedStoreOwns(t *testing.T) {
+	assert := assert.New(t)
+
+	store := &GenericEncryptedStore{prefix: "mc-", namespace: defaultNamespace}
+	assert.True(store.Owns(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "mc-node1", Namespace: defaultNamespace}}))
+	assert.False(store.Owns(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "mc-node1", Namespace: "default"}}))
+	assert.False(store.Owns(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "tls-rancher", Namespace: defaultNamespace}}))
+}
@@ -0,0 +1,209 @@
+package encryptedstore
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apiserver/pkg/storage/value/encrypt/envelope"
+)
+
+const (
+	aesProviderName = "aes"
+	kmsProviderName = "kms"
+	kmsCallTimeout  = 3 * time.Second
+)
+
+// KeyProvider wraps the per-secret data encryption keys with a key encryption key. Key IDs are prefixed with the
+// provider name, so that data keys wrapped by a previous provider can still be unwrapped after switching.
+type KeyProvider interface {
+	Name() string
+	// KeyID is the ID of the key new data keys are wrapped with.
+	KeyID() string
+	Wrap(dek []byte) ([]byte, error)
+	Unwrap(keyID string, wrapped []byte) ([]byte, error)
+}
+
+// KeyProviders wrap the data keys of the secrets of a store, the first provider wraps new data keys. Without providers
+// secrets are stored in plain text.
+type KeyProviders []KeyProvider
+
+// NewKeyProviders returns the providers enabling envelope encryption of the stores. When both are configured the KMS
+// plugin wraps new keys and the local key file is kept to read secrets that were encrypted before. With neither,
+// secrets are stored in plain text.
+func NewKeyProviders(keyFile, kmsEndpoint string) (KeyProviders, error) {
+	var result KeyProviders
+	if kmsEndpoint != "" {
+		p, err := NewKMSKeyProvider(kmsEndpoint)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, p)
+	}
+	if keyFile != "" {
+		p, err := NewAESKeyProvider(keyFile)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, p)
+	}
+	return result, nil
+}
+
+func (k KeyProviders) primary() KeyProvider {
+	if len(k) == 0 {
+		return nil
+	}
+	return k[0]
+}
+
+func (k KeyProviders) providerFor(keyID string) (KeyProvider, error) {
+	name := strings.SplitN(keyID, ":", 2)[0]
+	for _, p := range k {
+		if p.Name() == name {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("no key provider configured for key %s", keyID)
+}
+
+// aesKeyProvider reads named 32 byte AES keys from a file with one "name:base64 key" per line. The first key wraps
+// new data keys, the others are only used for unwrapping so that keys can be rotated by prepending a new line.
+type aesKeyProvider struct {
+	primary string
+	keys    map[string]cipher.AEAD
+}
+
+func NewAESKeyProvider(path string) (KeyProvider, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read encryption key file %s", path)
+	}
+
+	p := &aesKeyProvider{
+		keys: map[string]cipher.AEAD{},
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid line in encryption key file %s, expected name:base64 key", path)
+		}
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode encryption key %s", parts[0])
+		}
+		if len(key) != 32 {
+			return nil, fmt.Errorf("encryption key %s must be 32 bytes, found %d", parts[0], len(key))
+		}
+		aead, err := newGCM(key)
+		if err != nil {
+			return nil, err
+		}
+
+		keyID := aesProviderName + ":" + parts[0]
+		if _, ok := p.keys[keyID]; ok {
+			return nil, fmt.Errorf("duplicate encryption key %s in %s", parts[0], path)
+		}
+		if p.primary == "" {
+			p.primary = keyID
+		}
+		p.keys[keyID] = aead
+	}
+	if p.primary == "" {
+		return nil, fmt.Errorf("no encryption keys found in %s", path)
+	}
+
+	return p, nil
+}
+
+func (p *aesKeyProvider) Name() string {
+	return aesProviderName
+}
+
+func (p *aesKeyProvider) KeyID() string {
+	return p.primary
+}
+
+func (p *aesKeyProvider) Wrap(dek []byte) ([]byte, error) {
+	return seal(p.keys[p.primary], dek, []byte(p.primary))
+}
+
+func (p *aesKeyProvider) Unwrap(keyID string, wrapped []byte) ([]byte, error) {
+	aead, ok := p.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("encryption key %s not found", keyID)
+	}
+	return open(aead, wrapped, []byte(keyID))
+}
+
+// kmsKeyProvider delegates wrapping to a plugin implementing the Kubernetes KMS v1 gRPC API. Key rotation is
+// handled by the plugin itself, so all keys share one ID.
+type kmsKeyProvider struct {
+	service envelope.Service
+}
+
+func NewKMSKeyProvider(endpoint string) (KeyProvider, error) {
+	service, err := envelope.NewGRPCService(endpoint, kmsCallTimeout)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect to KMS plugin at %s", endpoint)
+	}
+	return &kmsKeyProvider{
+		service: service,
+	}, nil
+}
+
+func (p *kmsKeyProvider) Name() string {
+	return kmsProviderName
+}
+
+func (p *kmsKeyProvider) KeyID() string {
+	return kmsProviderName + ":v1"
+}
+
+func (p *kmsKeyProvider) Wrap(dek []byte) ([]byte, error) {
+	return p.service.Encrypt(dek)
+}
+
+func (p *kmsKeyProvider) Unwrap(keyID string, wrapped []byte) ([]byte, error) {
+	return p.service.Decrypt(wrapped)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal returns the nonce followed by the ciphertext.
+func seal(aead cipher.AEAD, plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func open(aead cipher.AEAD, ciphertext, additionalData []byte) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+	nonce := ciphertext[:aead.NonceSize()]
+	return aead.Open(nil, nonce, ciphertext[aead.NonceSize():], additionalData)
+}
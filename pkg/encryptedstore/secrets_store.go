@@ -2,6 +2,7 @@
 
 import (
 	"reflect"
+	"strings"
 	"time"
 
 	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
@@ -21,9 +22,10 @@ type GenericEncryptedStore struct {
 	namespace    string
 	secrets      v1.SecretInterface
 	secretLister v1.SecretLister
+	keys         KeyProviders
 }
 
-func NewGenericEncrypedStore(prefix, namespace string, namespaceInterface v1.NamespaceInterface, secretsGetter v1.SecretsGetter) (*GenericEncryptedStore, error) {
+func NewGenericEncrypedStore(prefix, namespace string, namespaceInterface v1.NamespaceInterface, secretsGetter v1.SecretsGetter, keys KeyProviders) (*GenericEncryptedStore, error) {
 	if namespace == "" {
 		namespace = defaultNamespace
 	}
@@ -44,6 +46,7 @@ func NewGenericEncrypedStore(prefix, namespace string, namespaceInterface v1.Nam
 		namespace:    namespace,
 		secrets:      secretsGetter.Secrets(namespace),
 		secretLister: secretsGetter.Secrets(namespace).Controller().Lister(),
+		keys:         keys,
 	}, nil
 }
 
@@ -53,12 +56,23 @@ func (g *GenericEncryptedStore) Get(name string) (map[string]string, error) {
 		return nil, err
 	}
 
-	result := map[string]string{}
-	for k, v := range sec.Data {
-		result[k] = string(v)
-	}
+	return g.keys.decryptSecret(sec)
+}
+
+// Owns returns whether the secret was written by this store.
+func (g *GenericEncryptedStore) Owns(secret *corev1.Secret) bool {
+	return secret.Namespace == g.namespace && strings.HasPrefix(secret.Name, g.prefix)
+}
+
+// NeedsReencryption returns whether a secret of the store is stored in plain text or with a key other than the one
+// new secrets are encrypted with.
+func (g *GenericEncryptedStore) NeedsReencryption(secret *corev1.Secret) bool {
+	return g.keys.needsReencryption(secret)
+}
 
-	return result, nil
+// Reencrypt returns a copy of a secret of the store encrypted with the current key.
+func (g *GenericEncryptedStore) Reencrypt(secret *corev1.Secret) (*corev1.Secret, error) {
+	return g.keys.reencrypt(secret)
 }
 
 func (g *GenericEncryptedStore) getKey(name string) string {
@@ -76,7 +90,10 @@ func (g *GenericEncryptedStore) set(name string, data map[string]string) error {
 		logrus.Debugf("[GenericEncryptedStore]: Creating secret for %v", g.getKey(name))
 		sec = &corev1.Secret{}
 		sec.Name = g.getKey(name)
-		sec.StringData = data
+		sec.Namespace = g.namespace
+		if err := g.keys.encodeSecret(sec, data); err != nil {
+			return err
+		}
 		if _, err := g.secrets.Create(sec); err != nil {
 			if !errors.IsAlreadyExists(err) {
 				return err
@@ -90,8 +107,11 @@ func (g *GenericEncryptedStore) set(name string, data map[string]string) error {
 		return err
 	}
 
-	secToUpdate := prepareSecretForUpdate(sec, data)
-	if !reflect.DeepEqual(secToUpdate.Data, sec.Data) {
+	secToUpdate, changed, err := g.keys.prepareSecretForUpdate(sec, data)
+	if err != nil {
+		return err
+	}
+	if changed {
 		logrus.Debugf("[GenericEncryptedStore]: updating secret %v", g.getKey(name))
 		_, err = g.secrets.Update(secToUpdate)
 		if err != nil {
@@ -118,8 +138,11 @@ func (g *GenericEncryptedStore) updateSecretWithBackoff(name string, data map[st
 			logrus.Errorf("[GenericEncryptedStore]: error getting secret %v from db: %v", g.getKey(name), err)
 			return false, err
 		}
-		secToUpdate := prepareSecretForUpdate(secret, data)
-		if !reflect.DeepEqual(secToUpdate.Data, secret.Data) {
+		secToUpdate, changed, err := g.keys.prepareSecretForUpdate(secret, data)
+		if err != nil {
+			return false, err
+		}
+		if changed {
 			_, err = g.secrets.Update(secToUpdate)
 			if err != nil {
 				if errors.IsConflict(err) {
@@ -135,15 +158,27 @@ func (g *GenericEncryptedStore) updateSecretWithBackoff(name string, data map[st
 	})
 }
 
-func prepareSecretForUpdate(secret *corev1.Secret, data map[string]string) *corev1.Secret {
-	secToUpdate := secret.DeepCopy()
-	if secToUpdate.Data == nil {
-		secToUpdate.Data = map[string][]byte{}
+// prepareSecretForUpdate merges data into the values stored in the secret, and reports whether the secret has to
+// be updated because a value changed or it is not encrypted with the current key.
+func (k KeyProviders) prepareSecretForUpdate(secret *corev1.Secret, data map[string]string) (*corev1.Secret, bool, error) {
+	current, err := k.decryptSecret(secret)
+	if err != nil {
+		return nil, false, err
+	}
+
+	merged := map[string]string{}
+	for k, v := range current {
+		merged[k] = v
 	}
 	for k, v := range data {
-		secToUpdate.Data[k] = []byte(v)
+		merged[k] = v
+	}
+	if reflect.DeepEqual(merged, current) && !k.needsReencryption(secret) {
+		return secret, false, nil
 	}
-	return secToUpdate
+
+	secToUpdate := secret.DeepCopy()
+	return secToUpdate, true, k.encodeSecret(secToUpdate, merged)
 }
 
 func (g *GenericEncryptedStore) Remove(name string) error {
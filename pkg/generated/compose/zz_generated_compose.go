@@ -71,6 +71,8 @@ type Config struct {
 	CisConfigs                               map[string]managementClient.CisConfig                               `json:"cisConfigs,omitempty" yaml:"cisConfigs,omitempty"`
 	CisBenchmarkVersions                     map[string]managementClient.CisBenchmarkVersion                     `json:"cisBenchmarkVersions,omitempty" yaml:"cisBenchmarkVersions,omitempty"`
 	FleetWorkspaces                          map[string]managementClient.FleetWorkspace                          `json:"fleetWorkspaces,omitempty" yaml:"fleetWorkspaces,omitempty"`
+	GitSyncs                                 map[string]managementClient.GitSync                                 `json:"gitSyncs,omitempty" yaml:"gitSyncs,omitempty"`
+	ResourceQuotaRequests                    map[string]managementClient.ResourceQuotaRequest                    `json:"resourceQuotaRequests,omitempty" yaml:"resourceQuotaRequests,omitempty"`
 
 	// Cluster Client
 	Namespaces        map[string]clusterClient.Namespace        `json:"namespaces,omitempty" yaml:"namespaces,omitempty"`
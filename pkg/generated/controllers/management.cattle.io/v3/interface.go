@@ -59,6 +59,7 @@ type Interface interface {
 	Feature() FeatureController
 	FleetWorkspace() FleetWorkspaceController
 	FreeIpaProvider() FreeIpaProviderController
+	GitSync() GitSyncController
 	GithubProvider() GithubProviderController
 	GlobalDns() GlobalDnsController
 	GlobalDnsProvider() GlobalDnsProviderController
@@ -77,6 +78,7 @@ type Interface interface {
 	NodePool() NodePoolController
 	NodeTemplate() NodeTemplateController
 	Notifier() NotifierController
+	OIDCProvider() OIDCProviderController
 	OpenLdapProvider() OpenLdapProviderController
 	PodSecurityPolicyTemplate() PodSecurityPolicyTemplateController
 	PodSecurityPolicyTemplateProjectBinding() PodSecurityPolicyTemplateProjectBindingController
@@ -91,6 +93,7 @@ type Interface interface {
 	ProjectMonitorGraph() ProjectMonitorGraphController
 	ProjectNetworkPolicy() ProjectNetworkPolicyController
 	ProjectRoleTemplateBinding() ProjectRoleTemplateBindingController
+	ResourceQuotaRequest() ResourceQuotaRequestController
 	RkeAddon() RkeAddonController
 	RkeK8sServiceOption() RkeK8sServiceOptionController
 	RkeK8sSystemImage() RkeK8sSystemImageController
@@ -203,6 +206,9 @@ func (c *version) FleetWorkspace() FleetWorkspaceController {
 func (c *version) FreeIpaProvider() FreeIpaProviderController {
 	return NewFreeIpaProviderController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "FreeIpaProvider"}, "freeipaproviders", false, c.controllerFactory)
 }
+func (c *version) GitSync() GitSyncController {
+	return NewGitSyncController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "GitSync"}, "gitsyncs", false, c.controllerFactory)
+}
 func (c *version) GithubProvider() GithubProviderController {
 	return NewGithubProviderController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "GithubProvider"}, "githubproviders", false, c.controllerFactory)
 }
@@ -257,6 +263,9 @@ func (c *version) NodeTemplate() NodeTemplateController {
 func (c *version) Notifier() NotifierController {
 	return NewNotifierController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "Notifier"}, "notifiers", true, c.controllerFactory)
 }
+func (c *version) OIDCProvider() OIDCProviderController {
+	return NewOIDCProviderController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "OIDCProvider"}, "oidcproviders", false, c.controllerFactory)
+}
 func (c *version) OpenLdapProvider() OpenLdapProviderController {
 	return NewOpenLdapProviderController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "OpenLdapProvider"}, "openldapproviders", false, c.controllerFactory)
 }
@@ -299,6 +308,9 @@ func (c *version) ProjectNetworkPolicy() ProjectNetworkPolicyController {
 func (c *version) ProjectRoleTemplateBinding() ProjectRoleTemplateBindingController {
 	return NewProjectRoleTemplateBindingController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "ProjectRoleTemplateBinding"}, "projectroletemplatebindings", true, c.controllerFactory)
 }
+func (c *version) ResourceQuotaRequest() ResourceQuotaRequestController {
+	return NewResourceQuotaRequestController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "ResourceQuotaRequest"}, "resourcequotarequests", true, c.controllerFactory)
+}
 func (c *version) RkeAddon() RkeAddonController {
 	return NewRkeAddonController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "RkeAddon"}, "rkeaddons", true, c.controllerFactory)
 }
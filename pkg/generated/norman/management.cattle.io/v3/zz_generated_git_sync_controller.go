@@ -0,0 +1,325 @@
+package v3
+
+import (
+	"context"
+	"time"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/objectclient"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	GitSyncGroupVersionKind = schema.GroupVersionKind{
+		Version: Version,
+		Group:   GroupName,
+		Kind:    "GitSync",
+	}
+	GitSyncResource = metav1.APIResource{
+		Name:         "gitsyncs",
+		SingularName: "gitsync",
+		Namespaced:   false,
+		Kind:         GitSyncGroupVersionKind.Kind,
+	}
+
+	GitSyncGroupVersionResource = schema.GroupVersionResource{
+		Group:    GroupName,
+		Version:  Version,
+		Resource: "gitsyncs",
+	}
+)
+
+func init() {
+	resource.Put(GitSyncGroupVersionResource)
+}
+
+// Deprecated use v3.GitSync instead
+type GitSync = v3.GitSync
+
+func NewGitSync(namespace, name string, obj v3.GitSync) *v3.GitSync {
+	obj.APIVersion, obj.Kind = GitSyncGroupVersionKind.ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+type GitSyncHandlerFunc func(key string, obj *v3.GitSync) (runtime.Object, error)
+
+type GitSyncChangeHandlerFunc func(obj *v3.GitSync) (runtime.Object, error)
+
+type GitSyncLister interface {
+	List(namespace string, selector labels.Selector) (ret []*v3.GitSync, err error)
+	Get(namespace, name string) (*v3.GitSync, error)
+}
+
+type GitSyncController interface {
+	Generic() controller.GenericController
+	Informer() cache.SharedIndexInformer
+	Lister() GitSyncLister
+	AddHandler(ctx context.Context, name string, handler GitSyncHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync GitSyncHandlerFunc)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, handler GitSyncHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, handler GitSyncHandlerFunc)
+	Enqueue(namespace, name string)
+	EnqueueAfter(namespace, name string, after time.Duration)
+}
+
+type GitSyncInterface interface {
+	ObjectClient() *objectclient.ObjectClient
+	Create(*v3.GitSync) (*v3.GitSync, error)
+	GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.GitSync, error)
+	Get(name string, opts metav1.GetOptions) (*v3.GitSync, error)
+	Update(*v3.GitSync) (*v3.GitSync, error)
+	Delete(name string, options *metav1.DeleteOptions) error
+	DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error
+	List(opts metav1.ListOptions) (*v3.GitSyncList, error)
+	ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.GitSyncList, error)
+	Watch(opts metav1.ListOptions) (watch.Interface, error)
+	DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Controller() GitSyncController
+	AddHandler(ctx context.Context, name string, sync GitSyncHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync GitSyncHandlerFunc)
+	AddLifecycle(ctx context.Context, name string, lifecycle GitSyncLifecycle)
+	AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle GitSyncLifecycle)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync GitSyncHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync GitSyncHandlerFunc)
+	AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle GitSyncLifecycle)
+	AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle GitSyncLifecycle)
+}
+
+type gitSyncLister struct {
+	ns         string
+	controller *gitSyncController
+}
+
+func (l *gitSyncLister) List(namespace string, selector labels.Selector) (ret []*v3.GitSync, err error) {
+	if namespace == "" {
+		namespace = l.ns
+	}
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.GitSync))
+	})
+	return
+}
+
+func (l *gitSyncLister) Get(namespace, name string) (*v3.GitSync, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    GitSyncGroupVersionKind.Group,
+			Resource: GitSyncGroupVersionResource.Resource,
+		}, key)
+	}
+	return obj.(*v3.GitSync), nil
+}
+
+type gitSyncController struct {
+	ns string
+	controller.GenericController
+}
+
+func (c *gitSyncController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *gitSyncController) Lister() GitSyncLister {
+	return &gitSyncLister{
+		ns:         c.ns,
+		controller: c,
+	}
+}
+
+func (c *gitSyncController) AddHandler(ctx context.Context, name string, handler GitSyncHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.GitSync); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *gitSyncController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler GitSyncHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.GitSync); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *gitSyncController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler GitSyncHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.GitSync); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *gitSyncController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler GitSyncHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.GitSync); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type gitSyncFactory struct {
+}
+
+func (c gitSyncFactory) Object() runtime.Object {
+	return &v3.GitSync{}
+}
+
+func (c gitSyncFactory) List() runtime.Object {
+	return &v3.GitSyncList{}
+}
+
+func (s *gitSyncClient) Controller() GitSyncController {
+	genericController := controller.NewGenericController(s.ns, GitSyncGroupVersionKind.Kind+"Controller",
+		s.client.controllerFactory.ForResourceKind(GitSyncGroupVersionResource, GitSyncGroupVersionKind.Kind, false))
+
+	return &gitSyncController{
+		ns:                s.ns,
+		GenericController: genericController,
+	}
+}
+
+type gitSyncClient struct {
+	client       *Client
+	ns           string
+	objectClient *objectclient.ObjectClient
+	controller   GitSyncController
+}
+
+func (s *gitSyncClient) ObjectClient() *objectclient.ObjectClient {
+	return s.objectClient
+}
+
+func (s *gitSyncClient) Create(o *v3.GitSync) (*v3.GitSync, error) {
+	obj, err := s.objectClient.Create(o)
+	return obj.(*v3.GitSync), err
+}
+
+func (s *gitSyncClient) Get(name string, opts metav1.GetOptions) (*v3.GitSync, error) {
+	obj, err := s.objectClient.Get(name, opts)
+	return obj.(*v3.GitSync), err
+}
+
+func (s *gitSyncClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.GitSync, error) {
+	obj, err := s.objectClient.GetNamespaced(namespace, name, opts)
+	return obj.(*v3.GitSync), err
+}
+
+func (s *gitSyncClient) Update(o *v3.GitSync) (*v3.GitSync, error) {
+	obj, err := s.objectClient.Update(o.Name, o)
+	return obj.(*v3.GitSync), err
+}
+
+func (s *gitSyncClient) UpdateStatus(o *v3.GitSync) (*v3.GitSync, error) {
+	obj, err := s.objectClient.UpdateStatus(o.Name, o)
+	return obj.(*v3.GitSync), err
+}
+
+func (s *gitSyncClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.Delete(name, options)
+}
+
+func (s *gitSyncClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *gitSyncClient) List(opts metav1.ListOptions) (*v3.GitSyncList, error) {
+	obj, err := s.objectClient.List(opts)
+	return obj.(*v3.GitSyncList), err
+}
+
+func (s *gitSyncClient) ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.GitSyncList, error) {
+	obj, err := s.objectClient.ListNamespaced(namespace, opts)
+	return obj.(*v3.GitSyncList), err
+}
+
+func (s *gitSyncClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.objectClient.Watch(opts)
+}
+
+// Patch applies the patch and returns the patched deployment.
+func (s *gitSyncClient) Patch(o *v3.GitSync, patchType types.PatchType, data []byte, subresources ...string) (*v3.GitSync, error) {
+	obj, err := s.objectClient.Patch(o.Name, o, patchType, data, subresources...)
+	return obj.(*v3.GitSync), err
+}
+
+func (s *gitSyncClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.objectClient.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *gitSyncClient) AddHandler(ctx context.Context, name string, sync GitSyncHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *gitSyncClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync GitSyncHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *gitSyncClient) AddLifecycle(ctx context.Context, name string, lifecycle GitSyncLifecycle) {
+	sync := NewGitSyncLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *gitSyncClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle GitSyncLifecycle) {
+	sync := NewGitSyncLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *gitSyncClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync GitSyncHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *gitSyncClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync GitSyncHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *gitSyncClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle GitSyncLifecycle) {
+	sync := NewGitSyncLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *gitSyncClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle GitSyncLifecycle) {
+	sync := NewGitSyncLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
@@ -0,0 +1,67 @@
+package v3
+
+import (
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type GitSyncLifecycle interface {
+	Create(obj *v3.GitSync) (runtime.Object, error)
+	Remove(obj *v3.GitSync) (runtime.Object, error)
+	Updated(obj *v3.GitSync) (runtime.Object, error)
+}
+
+type gitSyncLifecycleAdapter struct {
+	lifecycle GitSyncLifecycle
+}
+
+func (w *gitSyncLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *gitSyncLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *gitSyncLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.GitSync))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *gitSyncLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.GitSync))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *gitSyncLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.GitSync))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func NewGitSyncLifecycleAdapter(name string, clusterScoped bool, client GitSyncInterface, l GitSyncLifecycle) GitSyncHandlerFunc {
+	if clusterScoped {
+		resource.PutClusterScoped(GitSyncGroupVersionResource)
+	}
+	adapter := &gitSyncLifecycleAdapter{lifecycle: l}
+	syncFn := lifecycle.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.ObjectClient())
+	return func(key string, obj *v3.GitSync) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
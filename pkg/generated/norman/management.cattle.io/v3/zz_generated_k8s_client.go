@@ -75,6 +75,8 @@ type Interface interface {
 	CisConfigsGetter
 	CisBenchmarkVersionsGetter
 	FleetWorkspacesGetter
+	GitSyncsGetter
+	ResourceQuotaRequestsGetter
 }
 
 type Client struct {
@@ -1010,3 +1012,31 @@ func (c *Client) FleetWorkspaces(namespace string) FleetWorkspaceInterface {
 		objectClient: objectClient,
 	}
 }
+
+type GitSyncsGetter interface {
+	GitSyncs(namespace string) GitSyncInterface
+}
+
+func (c *Client) GitSyncs(namespace string) GitSyncInterface {
+	sharedClient := c.clientFactory.ForResourceKind(GitSyncGroupVersionResource, GitSyncGroupVersionKind.Kind, false)
+	objectClient := objectclient.NewObjectClient(namespace, sharedClient, &GitSyncResource, GitSyncGroupVersionKind, gitSyncFactory{})
+	return &gitSyncClient{
+		ns:           namespace,
+		client:       c,
+		objectClient: objectClient,
+	}
+}
+
+type ResourceQuotaRequestsGetter interface {
+	ResourceQuotaRequests(namespace string) ResourceQuotaRequestInterface
+}
+
+func (c *Client) ResourceQuotaRequests(namespace string) ResourceQuotaRequestInterface {
+	sharedClient := c.clientFactory.ForResourceKind(ResourceQuotaRequestGroupVersionResource, ResourceQuotaRequestGroupVersionKind.Kind, true)
+	objectClient := objectclient.NewObjectClient(namespace, sharedClient, &ResourceQuotaRequestResource, ResourceQuotaRequestGroupVersionKind, resourceQuotaRequestFactory{})
+	return &resourceQuotaRequestClient{
+		ns:           namespace,
+		client:       c,
+		objectClient: objectClient,
+	}
+}
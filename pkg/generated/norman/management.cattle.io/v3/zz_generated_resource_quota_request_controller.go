@@ -0,0 +1,326 @@
+package v3
+
+import (
+	"context"
+	"time"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/objectclient"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	ResourceQuotaRequestGroupVersionKind = schema.GroupVersionKind{
+		Version: Version,
+		Group:   GroupName,
+		Kind:    "ResourceQuotaRequest",
+	}
+	ResourceQuotaRequestResource = metav1.APIResource{
+		Name:         "resourcequotarequests",
+		SingularName: "resourcequotarequest",
+		Namespaced:   true,
+
+		Kind: ResourceQuotaRequestGroupVersionKind.Kind,
+	}
+
+	ResourceQuotaRequestGroupVersionResource = schema.GroupVersionResource{
+		Group:    GroupName,
+		Version:  Version,
+		Resource: "resourcequotarequests",
+	}
+)
+
+func init() {
+	resource.Put(ResourceQuotaRequestGroupVersionResource)
+}
+
+// Deprecated use v3.ResourceQuotaRequest instead
+type ResourceQuotaRequest = v3.ResourceQuotaRequest
+
+func NewResourceQuotaRequest(namespace, name string, obj v3.ResourceQuotaRequest) *v3.ResourceQuotaRequest {
+	obj.APIVersion, obj.Kind = ResourceQuotaRequestGroupVersionKind.ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+type ResourceQuotaRequestHandlerFunc func(key string, obj *v3.ResourceQuotaRequest) (runtime.Object, error)
+
+type ResourceQuotaRequestChangeHandlerFunc func(obj *v3.ResourceQuotaRequest) (runtime.Object, error)
+
+type ResourceQuotaRequestLister interface {
+	List(namespace string, selector labels.Selector) (ret []*v3.ResourceQuotaRequest, err error)
+	Get(namespace, name string) (*v3.ResourceQuotaRequest, error)
+}
+
+type ResourceQuotaRequestController interface {
+	Generic() controller.GenericController
+	Informer() cache.SharedIndexInformer
+	Lister() ResourceQuotaRequestLister
+	AddHandler(ctx context.Context, name string, handler ResourceQuotaRequestHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ResourceQuotaRequestHandlerFunc)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, handler ResourceQuotaRequestHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, handler ResourceQuotaRequestHandlerFunc)
+	Enqueue(namespace, name string)
+	EnqueueAfter(namespace, name string, after time.Duration)
+}
+
+type ResourceQuotaRequestInterface interface {
+	ObjectClient() *objectclient.ObjectClient
+	Create(*v3.ResourceQuotaRequest) (*v3.ResourceQuotaRequest, error)
+	GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ResourceQuotaRequest, error)
+	Get(name string, opts metav1.GetOptions) (*v3.ResourceQuotaRequest, error)
+	Update(*v3.ResourceQuotaRequest) (*v3.ResourceQuotaRequest, error)
+	Delete(name string, options *metav1.DeleteOptions) error
+	DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error
+	List(opts metav1.ListOptions) (*v3.ResourceQuotaRequestList, error)
+	ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.ResourceQuotaRequestList, error)
+	Watch(opts metav1.ListOptions) (watch.Interface, error)
+	DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Controller() ResourceQuotaRequestController
+	AddHandler(ctx context.Context, name string, sync ResourceQuotaRequestHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ResourceQuotaRequestHandlerFunc)
+	AddLifecycle(ctx context.Context, name string, lifecycle ResourceQuotaRequestLifecycle)
+	AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle ResourceQuotaRequestLifecycle)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync ResourceQuotaRequestHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync ResourceQuotaRequestHandlerFunc)
+	AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle ResourceQuotaRequestLifecycle)
+	AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle ResourceQuotaRequestLifecycle)
+}
+
+type resourceQuotaRequestLister struct {
+	ns         string
+	controller *resourceQuotaRequestController
+}
+
+func (l *resourceQuotaRequestLister) List(namespace string, selector labels.Selector) (ret []*v3.ResourceQuotaRequest, err error) {
+	if namespace == "" {
+		namespace = l.ns
+	}
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ResourceQuotaRequest))
+	})
+	return
+}
+
+func (l *resourceQuotaRequestLister) Get(namespace, name string) (*v3.ResourceQuotaRequest, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    ResourceQuotaRequestGroupVersionKind.Group,
+			Resource: ResourceQuotaRequestGroupVersionResource.Resource,
+		}, key)
+	}
+	return obj.(*v3.ResourceQuotaRequest), nil
+}
+
+type resourceQuotaRequestController struct {
+	ns string
+	controller.GenericController
+}
+
+func (c *resourceQuotaRequestController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *resourceQuotaRequestController) Lister() ResourceQuotaRequestLister {
+	return &resourceQuotaRequestLister{
+		ns:         c.ns,
+		controller: c,
+	}
+}
+
+func (c *resourceQuotaRequestController) AddHandler(ctx context.Context, name string, handler ResourceQuotaRequestHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ResourceQuotaRequest); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *resourceQuotaRequestController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler ResourceQuotaRequestHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ResourceQuotaRequest); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *resourceQuotaRequestController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler ResourceQuotaRequestHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ResourceQuotaRequest); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *resourceQuotaRequestController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler ResourceQuotaRequestHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ResourceQuotaRequest); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type resourceQuotaRequestFactory struct {
+}
+
+func (c resourceQuotaRequestFactory) Object() runtime.Object {
+	return &v3.ResourceQuotaRequest{}
+}
+
+func (c resourceQuotaRequestFactory) List() runtime.Object {
+	return &v3.ResourceQuotaRequestList{}
+}
+
+func (s *resourceQuotaRequestClient) Controller() ResourceQuotaRequestController {
+	genericController := controller.NewGenericController(s.ns, ResourceQuotaRequestGroupVersionKind.Kind+"Controller",
+		s.client.controllerFactory.ForResourceKind(ResourceQuotaRequestGroupVersionResource, ResourceQuotaRequestGroupVersionKind.Kind, true))
+
+	return &resourceQuotaRequestController{
+		ns:                s.ns,
+		GenericController: genericController,
+	}
+}
+
+type resourceQuotaRequestClient struct {
+	client       *Client
+	ns           string
+	objectClient *objectclient.ObjectClient
+	controller   ResourceQuotaRequestController
+}
+
+func (s *resourceQuotaRequestClient) ObjectClient() *objectclient.ObjectClient {
+	return s.objectClient
+}
+
+func (s *resourceQuotaRequestClient) Create(o *v3.ResourceQuotaRequest) (*v3.ResourceQuotaRequest, error) {
+	obj, err := s.objectClient.Create(o)
+	return obj.(*v3.ResourceQuotaRequest), err
+}
+
+func (s *resourceQuotaRequestClient) Get(name string, opts metav1.GetOptions) (*v3.ResourceQuotaRequest, error) {
+	obj, err := s.objectClient.Get(name, opts)
+	return obj.(*v3.ResourceQuotaRequest), err
+}
+
+func (s *resourceQuotaRequestClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ResourceQuotaRequest, error) {
+	obj, err := s.objectClient.GetNamespaced(namespace, name, opts)
+	return obj.(*v3.ResourceQuotaRequest), err
+}
+
+func (s *resourceQuotaRequestClient) Update(o *v3.ResourceQuotaRequest) (*v3.ResourceQuotaRequest, error) {
+	obj, err := s.objectClient.Update(o.Name, o)
+	return obj.(*v3.ResourceQuotaRequest), err
+}
+
+func (s *resourceQuotaRequestClient) UpdateStatus(o *v3.ResourceQuotaRequest) (*v3.ResourceQuotaRequest, error) {
+	obj, err := s.objectClient.UpdateStatus(o.Name, o)
+	return obj.(*v3.ResourceQuotaRequest), err
+}
+
+func (s *resourceQuotaRequestClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.Delete(name, options)
+}
+
+func (s *resourceQuotaRequestClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *resourceQuotaRequestClient) List(opts metav1.ListOptions) (*v3.ResourceQuotaRequestList, error) {
+	obj, err := s.objectClient.List(opts)
+	return obj.(*v3.ResourceQuotaRequestList), err
+}
+
+func (s *resourceQuotaRequestClient) ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.ResourceQuotaRequestList, error) {
+	obj, err := s.objectClient.ListNamespaced(namespace, opts)
+	return obj.(*v3.ResourceQuotaRequestList), err
+}
+
+func (s *resourceQuotaRequestClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.objectClient.Watch(opts)
+}
+
+// Patch applies the patch and returns the patched deployment.
+func (s *resourceQuotaRequestClient) Patch(o *v3.ResourceQuotaRequest, patchType types.PatchType, data []byte, subresources ...string) (*v3.ResourceQuotaRequest, error) {
+	obj, err := s.objectClient.Patch(o.Name, o, patchType, data, subresources...)
+	return obj.(*v3.ResourceQuotaRequest), err
+}
+
+func (s *resourceQuotaRequestClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.objectClient.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *resourceQuotaRequestClient) AddHandler(ctx context.Context, name string, sync ResourceQuotaRequestHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *resourceQuotaRequestClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ResourceQuotaRequestHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *resourceQuotaRequestClient) AddLifecycle(ctx context.Context, name string, lifecycle ResourceQuotaRequestLifecycle) {
+	sync := NewResourceQuotaRequestLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *resourceQuotaRequestClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle ResourceQuotaRequestLifecycle) {
+	sync := NewResourceQuotaRequestLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *resourceQuotaRequestClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync ResourceQuotaRequestHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *resourceQuotaRequestClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync ResourceQuotaRequestHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *resourceQuotaRequestClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle ResourceQuotaRequestLifecycle) {
+	sync := NewResourceQuotaRequestLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *resourceQuotaRequestClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle ResourceQuotaRequestLifecycle) {
+	sync := NewResourceQuotaRequestLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
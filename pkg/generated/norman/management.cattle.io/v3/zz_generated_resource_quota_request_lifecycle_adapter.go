@@ -0,0 +1,67 @@
+package v3
+
+import (
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type ResourceQuotaRequestLifecycle interface {
+	Create(obj *v3.ResourceQuotaRequest) (runtime.Object, error)
+	Remove(obj *v3.ResourceQuotaRequest) (runtime.Object, error)
+	Updated(obj *v3.ResourceQuotaRequest) (runtime.Object, error)
+}
+
+type resourceQuotaRequestLifecycleAdapter struct {
+	lifecycle ResourceQuotaRequestLifecycle
+}
+
+func (w *resourceQuotaRequestLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *resourceQuotaRequestLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *resourceQuotaRequestLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ResourceQuotaRequest))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *resourceQuotaRequestLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ResourceQuotaRequest))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *resourceQuotaRequestLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ResourceQuotaRequest))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func NewResourceQuotaRequestLifecycleAdapter(name string, clusterScoped bool, client ResourceQuotaRequestInterface, l ResourceQuotaRequestLifecycle) ResourceQuotaRequestHandlerFunc {
+	if clusterScoped {
+		resource.PutClusterScoped(ResourceQuotaRequestGroupVersionResource)
+	}
+	adapter := &resourceQuotaRequestLifecycleAdapter{lifecycle: l}
+	syncFn := lifecycle.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.ObjectClient())
+	return func(key string, obj *v3.ResourceQuotaRequest) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
@@ -19,6 +19,7 @@
 	"github.com/rancher/rancher/pkg/cron"
 	managementdata "github.com/rancher/rancher/pkg/data/management"
 	"github.com/rancher/rancher/pkg/dialer"
+	"github.com/rancher/rancher/pkg/encryptedstore"
 	"github.com/rancher/rancher/pkg/jailer"
 	"github.com/rancher/rancher/pkg/metrics"
 	"github.com/rancher/rancher/pkg/namespace"
@@ -37,6 +38,8 @@ type Options struct {
 	HTTPSListenPort     int
 	Debug               bool
 	Trace               bool
+	// SecretsEncryptionKeys encrypt the secrets of the stores of node and cluster drivers.
+	SecretsEncryptionKeys encryptedstore.KeyProviders
 }
 
 type mcm struct {
@@ -62,6 +65,7 @@ func buildScaledContext(ctx context.Context, wranglerContext *wrangler.Context,
 	}
 
 	scaledContext.CatalogManager = manager.New(scaledContext.Management, scaledContext.Project)
+	scaledContext.SecretsEncryptionKeys = cfg.SecretsEncryptionKeys
 
 	if err := managementcrds.Create(ctx, wranglerContext.RESTConfig); err != nil {
 		return nil, nil, err
@@ -31,8 +31,8 @@ type NodeConfig struct {
 	cm              map[string]string
 }
 
-func NewStore(namespaceInterface v1.NamespaceInterface, secretsGetter v1.SecretsGetter) (*encryptedstore.GenericEncryptedStore, error) {
-	return encryptedstore.NewGenericEncrypedStore("mc-", "", namespaceInterface, secretsGetter)
+func NewStore(namespaceInterface v1.NamespaceInterface, secretsGetter v1.SecretsGetter, keys encryptedstore.KeyProviders) (*encryptedstore.GenericEncryptedStore, error) {
+	return encryptedstore.NewGenericEncrypedStore("mc-", "", namespaceInterface, secretsGetter, keys)
 }
 
 func NewNodeConfig(store *encryptedstore.GenericEncryptedStore, node *v3.Node) (*NodeConfig, error) {
@@ -29,7 +29,12 @@
 	"github.com/rancher/rancher/pkg/types/config/dialer"
 )
 
-const contentTypeJSON = "application/json"
+const (
+	contentTypeJSON        = "application/json"
+	defaultOpsgenieURL     = "https://api.opsgenie.com/"
+	DefaultTelegramURL     = "https://api.telegram.org"
+	DefaultSignatureHeader = "X-Rancher-Signature"
+)
 
 type Message struct {
 	Title   string
@@ -50,6 +55,22 @@ type dingtalkResponse struct {
 	Errmsg  string `json:"errmsg"`
 }
 
+type telegramResponse struct {
+	OK          bool   `json:"ok"`
+	Description string `json:"description"`
+}
+
+type opsgenieResponder struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type opsgenieAlert struct {
+	Message    string              `json:"message"`
+	Source     string              `json:"source"`
+	Responders []opsgenieResponder `json:"responders,omitempty"`
+}
+
 func SendMessage(ctx context.Context, notifier *v3.Notifier, recipient string, msg *Message, dialer dialer.Dialer) error {
 	if notifier.Spec.SlackConfig != nil {
 		if recipient == "" {
@@ -91,6 +112,34 @@ func SendMessage(ctx context.Context, notifier *v3.Notifier, recipient string, m
 		return TestMicrosoftTeams(notifier.Spec.MSTeamsConfig.URL, msg.Content, notifier.Spec.MSTeamsConfig.HTTPClientConfig, dialer)
 	}
 
+	if notifier.Spec.OpsgenieConfig != nil {
+		s := notifier.Spec.OpsgenieConfig
+		if recipient == "" {
+			recipient = s.DefaultRecipient
+		}
+		return TestOpsgenie(s.APIKey, s.APIURL, recipient, msg.Content, s.HTTPClientConfig, dialer)
+	}
+
+	if notifier.Spec.MattermostConfig != nil {
+		s := notifier.Spec.MattermostConfig
+		if recipient == "" {
+			recipient = s.DefaultRecipient
+		}
+		return TestMattermost(s.URL, recipient, msg.Content, s.HTTPClientConfig, dialer)
+	}
+
+	if notifier.Spec.TelegramConfig != nil {
+		s := notifier.Spec.TelegramConfig
+		if recipient == "" {
+			recipient = s.DefaultRecipient
+		}
+		return TestTelegram(s.BotToken, s.APIURL, recipient, msg.Content, s.HTTPClientConfig, dialer)
+	}
+
+	if notifier.Spec.HTTPTemplateConfig != nil {
+		return TestHTTPTemplate(notifier.Spec.HTTPTemplateConfig, msg.Title, msg.Content, dialer)
+	}
+
 	return errors.New("Notifier not configured")
 }
 
@@ -305,6 +354,187 @@ func TestMicrosoftTeams(url, msg string, cfg *v32.HTTPClientConfig, dialer diale
 	return nil
 }
 
+func TestOpsgenie(apiKey, apiURL, team, msg string, cfg *v32.HTTPClientConfig, dialer dialer.Dialer) error {
+	if msg == "" {
+		msg = "Opsgenie setting validated"
+	}
+	if apiURL == "" {
+		apiURL = defaultOpsgenieURL
+	}
+
+	alert := &opsgenieAlert{
+		Message: msg,
+		Source:  "rancher",
+	}
+	if team != "" {
+		alert.Responders = []opsgenieResponder{{Name: team, Type: "team"}}
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(alert); err != nil {
+		return err
+	}
+
+	client, err := NewClientFromConfig(cfg, dialer)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(apiURL, "/")+"/v2/alerts", &buf)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentTypeJSON)
+	req.Header.Set("Authorization", "GenieKey "+apiKey)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("HTTP status code is %d, not included in the 2xx success HTTP status codes", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func TestMattermost(url, channel, msg string, cfg *v32.HTTPClientConfig, dialer dialer.Dialer) error {
+	if msg == "" {
+		msg = "Mattermost setting validated"
+	}
+	req := struct {
+		Text    string `json:"text"`
+		Channel string `json:"channel,omitempty"`
+	}{
+		Text:    msg,
+		Channel: channel,
+	}
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	client, err := NewClientFromConfig(cfg, dialer)
+	if err != nil {
+		return err
+	}
+
+	resp, err := post(client, url, contentTypeJSON, bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("HTTP status code is %d, not included in the 2xx success HTTP status codes", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func TestTelegram(botToken, apiURL, chatID, msg string, cfg *v32.HTTPClientConfig, dialer dialer.Dialer) error {
+	if msg == "" {
+		msg = "Telegram setting validated"
+	}
+	if apiURL == "" {
+		apiURL = DefaultTelegramURL
+	}
+	req := struct {
+		ChatID string `json:"chat_id"`
+		Text   string `json:"text"`
+	}{
+		ChatID: chatID,
+		Text:   msg,
+	}
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	client, err := NewClientFromConfig(cfg, dialer)
+	if err != nil {
+		return err
+	}
+
+	resp, err := post(client, fmt.Sprintf("%s/bot%s/sendMessage", strings.TrimSuffix(apiURL, "/"), botToken), contentTypeJSON, bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	var tgResp telegramResponse
+	if err := json.Unmarshal(respBytes, &tgResp); err != nil {
+		return fmt.Errorf("HTTP status code is %d, failed to read Telegram response: %v", resp.StatusCode, err)
+	}
+
+	if !tgResp.OK {
+		return fmt.Errorf("Failed to send Telegram message. %s", tgResp.Description)
+	}
+
+	return nil
+}
+
+func TestHTTPTemplate(cfg *v32.HTTPTemplateConfig, title, msg string, dialer dialer.Dialer) error {
+	if msg == "" {
+		msg = "HTTP template setting validated"
+	}
+
+	body, err := RenderHTTPTemplate(cfg.BodyTemplate, NewTemplateData(title, msg))
+	if err != nil {
+		return err
+	}
+
+	method := cfg.Method
+	if method == "" {
+		method = http.MethodPost
+	}
+	req, err := http.NewRequest(method, cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, v := range cfg.Headers {
+		req.Header.Set(k, v)
+	}
+	contentType := cfg.ContentType
+	if contentType == "" {
+		contentType = contentTypeJSON
+	}
+	req.Header.Set("Content-Type", contentType)
+	if cfg.Secret != "" {
+		signatureHeader := cfg.SignatureHeader
+		if signatureHeader == "" {
+			signatureHeader = DefaultSignatureHeader
+		}
+		req.Header.Set(signatureHeader, Sign(cfg.Secret, body))
+	}
+
+	client, err := NewClientFromConfig(cfg.HTTPClientConfig, dialer)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("HTTP status code is %d, not included in the 2xx success HTTP status codes", resp.StatusCode)
+	}
+
+	return nil
+}
+
 func TestWebhook(url, msg string, cfg *v32.HTTPClientConfig, dialer dialer.Dialer) error {
 	if msg == "" {
 		msg = "Webhook setting validated"
@@ -1,6 +1,10 @@
 package notifiers
 
 import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
@@ -39,3 +43,89 @@ type testCase struct {
 	}
 
 }
+
+func TestSendHTTPTemplate(t *testing.T) {
+	assert := assert.New(t)
+
+	var (
+		method, contentType, token, signature string
+		body                                  []byte
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		method = req.Method
+		contentType = req.Header.Get("Content-Type")
+		token = req.Header.Get("X-Token")
+		signature = req.Header.Get("X-Signature")
+		body, _ = ioutil.ReadAll(req.Body)
+	}))
+	defer server.Close()
+
+	cfg := &v32.HTTPTemplateConfig{
+		URL:             server.URL,
+		Method:          http.MethodPut,
+		Headers:         map[string]string{"X-Token": "abc"},
+		BodyTemplate:    `{"status":"{{ .Status }}","text":{{ (index .Alerts 0).Annotations.message | toJson }}}`,
+		Secret:          "secret",
+		SignatureHeader: "X-Signature",
+	}
+	assert.Nil(TestHTTPTemplate(cfg, "", `disk "sda" is full`, nil))
+
+	assert.Equal(http.MethodPut, method)
+	assert.Equal(contentTypeJSON, contentType)
+	assert.Equal("abc", token)
+	assert.Equal(Sign("secret", body), signature)
+	payload := map[string]string{}
+	assert.Nil(json.Unmarshal(body, &payload))
+	assert.Equal("firing", payload["status"])
+	assert.Equal(`disk "sda" is full`, payload["text"])
+
+	cfg.BodyTemplate = "{{ .Status"
+	assert.NotNil(TestHTTPTemplate(cfg, "", "", nil))
+}
+
+func TestSendTelegram(t *testing.T) {
+	assert := assert.New(t)
+
+	var path, chatID string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		path = req.URL.Path
+		msg := map[string]string{}
+		json.NewDecoder(req.Body).Decode(&msg)
+		chatID = msg["chat_id"]
+		if chatID == "unknown" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"ok":false,"description":"Bad Request: chat not found"}`))
+			return
+		}
+		w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	assert.Nil(TestTelegram("123:token", server.URL, "-100200", "", nil, nil))
+	assert.Equal("/bot123:token/sendMessage", path)
+	assert.Equal("-100200", chatID)
+
+	err := TestTelegram("123:token", server.URL, "unknown", "", nil, nil)
+	if assert.NotNil(err) {
+		assert.Contains(err.Error(), "chat not found")
+	}
+}
+
+func TestSendOpsgenie(t *testing.T) {
+	assert := assert.New(t)
+
+	var path, authorization string
+	alert := opsgenieAlert{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		path = req.URL.Path
+		authorization = req.Header.Get("Authorization")
+		json.NewDecoder(req.Body).Decode(&alert)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	assert.Nil(TestOpsgenie("key", server.URL+"/", "ops", "", nil, nil))
+	assert.Equal("/v2/alerts", path)
+	assert.Equal("GenieKey key", authorization)
+	assert.Equal([]opsgenieResponder{{Name: "ops", Type: "team"}}, alert.Responders)
+}
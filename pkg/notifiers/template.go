@@ -0,0 +1,96 @@
+package notifiers
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"text/template"
+	"time"
+)
+
+const signaturePrefix = "sha256="
+
+// TemplateData is the data the body template of an HTTP template notifier is rendered with. It has the fields of the
+// data Alertmanager renders its templates with, so that the same template works for test messages and for alerts.
+type TemplateData struct {
+	Receiver          string
+	Status            string
+	Alerts            []TemplateAlert
+	GroupLabels       map[string]string
+	CommonLabels      map[string]string
+	CommonAnnotations map[string]string
+	ExternalURL       string
+}
+
+type TemplateAlert struct {
+	Status       string
+	Labels       map[string]string
+	Annotations  map[string]string
+	StartsAt     time.Time
+	EndsAt       time.Time
+	GeneratorURL string
+}
+
+var templateFuncs = template.FuncMap{
+	"toJson": func(v interface{}) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+	"toUpper": strings.ToUpper,
+	"toLower": strings.ToLower,
+	"join": func(sep string, s []string) string {
+		return strings.Join(s, sep)
+	},
+}
+
+// NewTemplateData returns the data of a single firing alert carrying the message, used for test messages and for
+// messages that are not sent through Alertmanager.
+func NewTemplateData(title, msg string) *TemplateData {
+	if title == "" {
+		title = "Rancher notification"
+	}
+	labels := map[string]string{
+		"alert_name": title,
+		"severity":   "info",
+	}
+	annotations := map[string]string{
+		"message": msg,
+	}
+	return &TemplateData{
+		Receiver: "rancher",
+		Status:   "firing",
+		Alerts: []TemplateAlert{{
+			Status:      "firing",
+			Labels:      labels,
+			Annotations: annotations,
+			StartsAt:    time.Now(),
+		}},
+		GroupLabels:       map[string]string{},
+		CommonLabels:      labels,
+		CommonAnnotations: annotations,
+	}
+}
+
+// RenderHTTPTemplate renders the body template of an HTTP template notifier.
+func RenderHTTPTemplate(bodyTemplate string, data interface{}) ([]byte, error) {
+	tmpl, err := template.New("body").Funcs(templateFuncs).Option("missingkey=zero").Parse(bodyTemplate)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Sign returns the HMAC-SHA256 signature of the body in the form sha256=<hex>, so that the receiver can verify the
+// request was sent by Rancher.
+func Sign(secret string, body []byte) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write(body)
+	return signaturePrefix + hex.EncodeToString(h.Sum(nil))
+}
@@ -1,8 +1,13 @@
 package engine
 
 import (
+	"fmt"
+	"io"
+
 	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/pipeline/engine/jenkins"
+	"github.com/rancher/rancher/pkg/pipeline/engine/native"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
 	"github.com/rancher/rancher/pkg/types/config"
 )
 
@@ -13,11 +18,13 @@ type PipelineEngine interface {
 	StopExecution(execution *v3.PipelineExecution) error
 	GetStepLog(execution *v3.PipelineExecution, stage int, step int) (string, error)
 	SyncExecution(execution *v3.PipelineExecution) (bool, error)
+	GetArtifact(execution *v3.PipelineExecution, stage int, step int, name string) (io.ReadCloser, error)
 }
 
 func New(cluster *config.UserContext, useCache bool) PipelineEngine {
 	serviceLister := cluster.Core.Services("").Controller().Lister()
-	podLister := cluster.Core.Pods("").Controller().Lister()
+	pods := cluster.Core.Pods("")
+	podLister := pods.Controller().Lister()
 	secrets := cluster.Core.Secrets("")
 	secretLister := secrets.Controller().Lister()
 	managementSecretLister := cluster.Management.Core.Secrets("").Controller().Lister()
@@ -27,7 +34,7 @@ func New(cluster *config.UserContext, useCache bool) PipelineEngine {
 	pipelineSettingLister := cluster.Management.Project.PipelineSettings("").Controller().Lister()
 	dialer := cluster.Management.Dialer
 
-	engine := &jenkins.Engine{
+	jenkinsEngine := &jenkins.Engine{
 		UseCache:                   useCache,
 		ServiceLister:              serviceLister,
 		PodLister:                  podLister,
@@ -42,5 +49,111 @@ func New(cluster *config.UserContext, useCache bool) PipelineEngine {
 		Dialer:      dialer,
 		ClusterName: cluster.ClusterName,
 	}
-	return engine
+	nativeEngine := &native.Engine{
+		UseCache:                   useCache,
+		K8sClient:                  cluster.K8sClient,
+		Pods:                       pods,
+		PodLister:                  podLister,
+		PersistentVolumeClaims:     cluster.Core.PersistentVolumeClaims(""),
+		ServiceLister:              serviceLister,
+		Secrets:                    secrets,
+		SecretLister:               secretLister,
+		ManagementSecretLister:     managementSecretLister,
+		SourceCodeCredentials:      sourceCodeCredentials,
+		SourceCodeCredentialLister: sourceCodeCredentialLister,
+		PipelineLister:             pipelineLister,
+		PipelineSettingLister:      pipelineSettingLister,
+
+		Dialer:      dialer,
+		ClusterName: cluster.ClusterName,
+	}
+	return &selector{
+		pipelineSettingLister: pipelineSettingLister,
+		engines: map[string]PipelineEngine{
+			utils.EngineJenkins: jenkinsEngine,
+			utils.EngineNative:  nativeEngine,
+		},
+	}
+}
+
+// selector runs each execution on the engine chosen by the project it belongs to
+type selector struct {
+	pipelineSettingLister v3.PipelineSettingLister
+	engines               map[string]PipelineEngine
+}
+
+func (s *selector) get(execution *v3.PipelineExecution) (PipelineEngine, error) {
+	name, err := utils.GetPipelineEngine(s.pipelineSettingLister, execution)
+	if err != nil {
+		return nil, err
+	}
+	engine, ok := s.engines[name]
+	if !ok {
+		return nil, fmt.Errorf("unsupported pipeline engine %q", name)
+	}
+	return engine, nil
+}
+
+func (s *selector) PreCheck(execution *v3.PipelineExecution) (bool, error) {
+	engine, err := s.get(execution)
+	if err != nil {
+		return false, err
+	}
+	return engine.PreCheck(execution)
+}
+
+func (s *selector) RunPipelineExecution(execution *v3.PipelineExecution) error {
+	name, err := utils.GetPipelineEngine(s.pipelineSettingLister, execution)
+	if err != nil {
+		return err
+	}
+	engine, err := s.get(execution)
+	if err != nil {
+		return err
+	}
+	if execution.Labels == nil {
+		execution.Labels = map[string]string{}
+	}
+	execution.Labels[utils.PipelineEngineLabel] = name
+	return engine.RunPipelineExecution(execution)
+}
+
+func (s *selector) RerunExecution(execution *v3.PipelineExecution) error {
+	engine, err := s.get(execution)
+	if err != nil {
+		return err
+	}
+	return engine.RerunExecution(execution)
+}
+
+func (s *selector) StopExecution(execution *v3.PipelineExecution) error {
+	engine, err := s.get(execution)
+	if err != nil {
+		return err
+	}
+	return engine.StopExecution(execution)
+}
+
+func (s *selector) GetStepLog(execution *v3.PipelineExecution, stage int, step int) (string, error) {
+	engine, err := s.get(execution)
+	if err != nil {
+		return "", err
+	}
+	return engine.GetStepLog(execution, stage, step)
+}
+
+func (s *selector) SyncExecution(execution *v3.PipelineExecution) (bool, error) {
+	engine, err := s.get(execution)
+	if err != nil {
+		return false, err
+	}
+	return engine.SyncExecution(execution)
+}
+
+func (s *selector) GetArtifact(execution *v3.PipelineExecution, stage int, step int, name string) (io.ReadCloser, error) {
+	engine, err := s.get(execution)
+	if err != nil {
+		return nil, err
+	}
+	return engine.GetArtifact(execution, stage, step, name)
 }
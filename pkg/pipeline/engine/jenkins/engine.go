@@ -5,6 +5,7 @@
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
+	"io"
 	"net/http"
 	"regexp"
 	"strconv"
@@ -15,18 +16,18 @@
 
 	"github.com/pkg/errors"
 	"github.com/rancher/norman/httperror"
-	"github.com/rancher/norman/types/convert"
 	appsv1 "github.com/rancher/rancher/pkg/generated/norman/apps/v1"
 	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/pipeline/engine/steps"
 	"github.com/rancher/rancher/pkg/pipeline/providers"
 	"github.com/rancher/rancher/pkg/pipeline/remote"
+	"github.com/rancher/rancher/pkg/pipeline/remote/model"
 	"github.com/rancher/rancher/pkg/pipeline/utils"
 	"github.com/rancher/rancher/pkg/ref"
 	"github.com/rancher/rancher/pkg/types/config/dialer"
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 )
@@ -125,6 +126,12 @@ func (j *Engine) getJenkinsClient(execution *v3.PipelineExecution) (*Client, err
 
 func (j *Engine) RunPipelineExecution(execution *v3.PipelineExecution) error {
 	logrus.Debug("start RunPipelineExecution")
+	if utils.HasCacheOrArtifacts(&execution.Spec.PipelineConfig) {
+		return fmt.Errorf("cache and artifacts require the %s pipeline engine", utils.EngineNative)
+	}
+	if err := j.checkBoundSecrets(execution); err != nil {
+		return err
+	}
 	jobName := getJobName(execution)
 	client, err := j.getJenkinsClient(execution)
 	if err != nil {
@@ -151,7 +158,7 @@ func (j *Engine) RunPipelineExecution(execution *v3.PipelineExecution) error {
 		return err
 	}
 
-	if err := j.preparePipeline(execution); err != nil {
+	if err := steps.PrepareRegistryCredentials(execution, j.ManagementSecretLister, j.Secrets); err != nil {
 		return err
 	}
 	if _, err := client.buildJob(jobName, map[string]string{}); err != nil {
@@ -160,83 +167,6 @@ func (j *Engine) RunPipelineExecution(execution *v3.PipelineExecution) error {
 	return nil
 }
 
-func (j *Engine) preparePipeline(execution *v3.PipelineExecution) error {
-	for _, stage := range execution.Spec.PipelineConfig.Stages {
-		for _, step := range stage.Steps {
-			if step.PublishImageConfig != nil {
-				//prepare docker credential for publishimage step
-				registry := utils.DefaultRegistry
-				if step.PublishImageConfig.PushRemote && step.PublishImageConfig.Registry != "" {
-					registry = step.PublishImageConfig.Registry
-				} else {
-					_, projectID := ref.Parse(execution.Spec.ProjectName)
-					registry = fmt.Sprintf("%s.%s-pipeline", utils.LocalRegistry, projectID)
-				}
-				if err := j.prepareRegistryCredential(execution, registry); err != nil {
-					return err
-				}
-			}
-		}
-	}
-	return nil
-}
-
-func (j *Engine) prepareRegistryCredential(execution *v3.PipelineExecution, registry string) error {
-	secrets, err := j.ManagementSecretLister.List(execution.Namespace, labels.Everything())
-	if err != nil {
-		return err
-	}
-	username := ""
-	password := ""
-	for _, s := range secrets {
-		if s.Type == "kubernetes.io/dockerconfigjson" {
-			m := map[string]interface{}{}
-			if err := json.Unmarshal(s.Data[".dockerconfigjson"], &m); err != nil {
-				return err
-			}
-			auths := convert.ToMapInterface(m["auths"])
-			for k, v := range auths {
-				if registry != k {
-					//find matching registry credential
-					continue
-				}
-				cred := convert.ToMapInterface(v)
-				username, _ = cred["username"].(string)
-				password, _ = cred["password"].(string)
-			}
-
-		}
-
-	}
-
-	//store dockercredential in pipeline namespace
-	//TODO key-key mapping instead of registry-key mapping
-	reg, _ := regexp.Compile("[^a-zA-Z0-9]+")
-	proceccedRegistry := strings.ToLower(reg.ReplaceAllString(registry, ""))
-
-	secretName := fmt.Sprintf("%s-%s", execution.Namespace, proceccedRegistry)
-	logrus.Debugf("preparing registry credential %s for %s", secretName, registry)
-	ns := utils.GetPipelineCommonName(execution.Spec.ProjectName)
-	secret := &corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: ns,
-			Name:      secretName,
-		},
-		Data: map[string][]byte{
-			utils.PublishSecretUserKey: []byte(username),
-			utils.PublishSecretPwKey:   []byte(password),
-		},
-	}
-	_, err = j.Secrets.Create(secret)
-	if apierrors.IsAlreadyExists(err) {
-		if _, err := j.Secrets.Update(secret); err != nil {
-			return err
-		}
-		return nil
-	}
-	return err
-}
-
 func (j *Engine) createPipelineJob(client *Client, execution *v3.PipelineExecution) error {
 	logrus.Debug("create jenkins job for pipeline")
 	converter, err := initJenkinsPipelineConverter(execution, j.PipelineSettingLister, j.SecretLister)
@@ -581,7 +511,11 @@ func (j Engine) GetStepLog(execution *v3.PipelineExecution, stage int, step int)
 	} else if curStep.State != utils.StateBuilding {
 		return j.getStepLogFromMinioStore(execution, stage, step)
 	}
-	return j.getStepLogFromJenkins(execution, stage, step)
+	message, err := j.getStepLogFromJenkins(execution, stage, step)
+	if err != nil {
+		return "", err
+	}
+	return j.maskSecrets(execution, message)
 }
 
 func (j Engine) getStepLogFromJenkins(execution *v3.PipelineExecution, stage int, step int) (string, error) {
@@ -624,6 +558,10 @@ func (j Engine) getStepLogFromJenkins(execution *v3.PipelineExecution, stage int
 	return nodeLog.Text, nil
 }
 
+func (j Engine) GetArtifact(execution *v3.PipelineExecution, stage int, step int, name string) (io.ReadCloser, error) {
+	return nil, fmt.Errorf("artifacts require the %s pipeline engine", utils.EngineNative)
+}
+
 func getJobName(execution *v3.PipelineExecution) string {
 	if execution == nil {
 		return ""
@@ -670,6 +608,11 @@ func (j Engine) setCredential(client *Client, execution *v3.PipelineExecution, c
 	} else if accessToken != credential.Spec.AccessToken {
 		jenkinsCred.Password = accessToken
 	}
+	if credential.Spec.SourceCodeType == model.GitType {
+		// the access token of plain git credentials is not a password, and SSH keys are
+		// not supported by the Jenkins engine
+		jenkinsCred.Password = credential.Spec.GitCloneToken
+	}
 
 	bodyContent := map[string]interface{}{}
 	bodyContent["credentials"] = jenkinsCred
@@ -3,16 +3,14 @@
 import (
 	"bytes"
 	"fmt"
-	"strings"
 
 	v33 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 
-	v32 "github.com/rancher/rancher/pkg/apis/project.cattle.io/v3"
-
 	"github.com/pkg/errors"
 	apiv1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
 	images "github.com/rancher/rancher/pkg/image"
+	"github.com/rancher/rancher/pkg/pipeline/engine/steps"
 	"github.com/rancher/rancher/pkg/pipeline/utils"
 	"github.com/rancher/rancher/pkg/ref"
 	"github.com/sirupsen/logrus"
@@ -105,7 +103,7 @@ func (c *jenkinsPipelineConverter) convertPipelineExecutionToJenkinsPipeline() (
 	if err := utils.ValidPipelineConfig(c.execution.Spec.PipelineConfig); err != nil {
 		return nil, err
 	}
-	parsePreservedEnvVar(c.execution)
+	steps.SubstituteEnvVars(c.execution)
 	script, err := c.convertPipelineExecutionToPipelineScript()
 	if err != nil {
 		return nil, err
@@ -156,7 +154,7 @@ func (c *jenkinsPipelineConverter) convertPipelineExecutionToPipelineScript() (s
 		pipelinebuffer.WriteString(c.convertStage(j))
 		pipelinebuffer.WriteString("\n")
 		for k := range stage.Steps {
-			container, err := c.getStepContainer(j, k)
+			container, err := steps.Container(c.execution, j, k, c.opts.gitCaCerts)
 			if err != nil {
 				return "", err
 			}
@@ -271,7 +269,7 @@ func (c *jenkinsPipelineConverter) injectGitCaCert(pod *v1.Pod) {
 	}
 	for i, container := range pod.Spec.Containers {
 		if container.Name == utils.JenkinsAgentContainerName {
-			c.injectGitCaCertToContainer(&pod.Spec.Containers[i])
+			steps.InjectGitCaCert(&pod.Spec.Containers[i])
 			break
 		}
 	}
@@ -283,71 +281,8 @@ func (c *jenkinsPipelineConverter) injectGitCaCert(pod *v1.Pod) {
 	})
 }
 
-func (c *jenkinsPipelineConverter) injectGitCaCertToContainer(container *v1.Container) {
-	container.Env = append(container.Env, v1.EnvVar{
-		Name:  "GIT_SSL_CAINFO",
-		Value: utils.GitCaCertPath + "/ca.crt",
-	})
-	container.VolumeMounts = append(container.VolumeMounts, v1.VolumeMount{
-		Name:      utils.GitCaCertVolumeName,
-		MountPath: utils.GitCaCertPath,
-	})
-}
-
 func (c *jenkinsPipelineConverter) injectAgentResources(container *v1.Container) error {
-	return injectResources(container, c.opts.executorCPULimit, c.opts.executorCPURequest, c.opts.executorMemoryLimit, c.opts.executorMemoryRequest)
-}
-
-func injectSetpContainerResources(container *v1.Container, step *v32.Step) error {
-	return injectResources(container, step.CPULimit, step.CPURequest, step.MemoryLimit, step.MemoryRequest)
-}
-
-func injectResources(container *v1.Container, cpuLimit string, cpuRequest string, memoryLimit string, memoryRequest string) error {
-	if cpuLimit != "" {
-		if container.Resources.Limits == nil {
-			container.Resources.Limits = v1.ResourceList{}
-		}
-		quantity, err := resource.ParseQuantity(cpuLimit)
-		if err != nil {
-			return errors.Wrapf(err, "invalid CPU limit %q", cpuLimit)
-		}
-
-		container.Resources.Limits[v1.ResourceCPU] = quantity
-	}
-	if cpuRequest != "" {
-		if container.Resources.Requests == nil {
-			container.Resources.Requests = v1.ResourceList{}
-		}
-		quantity, err := resource.ParseQuantity(cpuRequest)
-		if err != nil {
-			return errors.Wrapf(err, "invalid CPU request %q", cpuRequest)
-		}
-
-		container.Resources.Requests[v1.ResourceCPU] = quantity
-	}
-	if memoryLimit != "" {
-		if container.Resources.Limits == nil {
-			container.Resources.Limits = v1.ResourceList{}
-		}
-		quantity, err := resource.ParseQuantity(memoryLimit)
-		if err != nil {
-			return errors.Wrapf(err, "invalid memory limit %q", memoryLimit)
-		}
-
-		container.Resources.Limits[v1.ResourceMemory] = quantity
-	}
-	if memoryRequest != "" {
-		if container.Resources.Requests == nil {
-			container.Resources.Requests = v1.ResourceList{}
-		}
-		quantity, err := resource.ParseQuantity(memoryRequest)
-		if err != nil {
-			return errors.Wrapf(err, "invalid memory request %q", memoryRequest)
-		}
-
-		container.Resources.Requests[v1.ResourceMemory] = quantity
-	}
-	return nil
+	return steps.InjectResources(container, c.opts.executorCPULimit, c.opts.executorCPURequest, c.opts.executorMemoryLimit, c.opts.executorMemoryRequest)
 }
 
 func getImagePullSecretNames(secretLister apiv1.SecretLister, execution *v3.PipelineExecution) ([]string, error) {
@@ -373,48 +308,6 @@ func getPipelineSettingValue(setting *v3.PipelineSetting) string {
 	return setting.Default
 }
 
-func parsePreservedEnvVar(execution *v3.PipelineExecution) {
-	m := utils.GetEnvVarMap(execution)
-	pipelineConfig := execution.Spec.PipelineConfig
-
-	//environment variables substitution in configs
-	for _, stage := range pipelineConfig.Stages {
-		for _, step := range stage.Steps {
-			if step.RunScriptConfig != nil {
-				step.RunScriptConfig.Image = substituteEnvVar(m, step.RunScriptConfig.Image)
-			} else if step.PublishImageConfig != nil {
-				step.PublishImageConfig.Tag = substituteEnvVar(m, step.PublishImageConfig.Tag)
-			} else if step.ApplyYamlConfig != nil {
-				step.ApplyYamlConfig.Path = substituteEnvVar(m, step.ApplyYamlConfig.Path)
-				step.ApplyYamlConfig.Content = substituteEnvVar(m, step.ApplyYamlConfig.Content)
-			} else if step.PublishCatalogConfig != nil {
-				step.PublishCatalogConfig.Path = substituteEnvVar(m, step.PublishCatalogConfig.Path)
-				step.PublishCatalogConfig.CatalogTemplate = substituteEnvVar(m, step.PublishCatalogConfig.CatalogTemplate)
-				step.PublishCatalogConfig.Version = substituteEnvVar(m, step.PublishCatalogConfig.Version)
-			} else if step.ApplyAppConfig != nil {
-				step.ApplyAppConfig.CatalogTemplate = substituteEnvVar(m, step.ApplyAppConfig.CatalogTemplate)
-				step.ApplyAppConfig.Version = substituteEnvVar(m, step.ApplyAppConfig.Version)
-				step.ApplyAppConfig.Name = substituteEnvVar(m, step.ApplyAppConfig.Name)
-				step.ApplyAppConfig.TargetNamespace = substituteEnvVar(m, step.ApplyAppConfig.TargetNamespace)
-				for k, v := range step.ApplyAppConfig.Answers {
-					step.ApplyAppConfig.Answers[k] = substituteEnvVar(m, v)
-				}
-			}
-			for k, v := range step.Env {
-				step.Env[k] = substituteEnvVar(m, v)
-			}
-		}
-	}
-}
-
-func substituteEnvVar(envvar map[string]string, raw string) string {
-	result := raw
-	for k, v := range envvar {
-		result = strings.Replace(result, "${"+k+"}", v, -1)
-	}
-	return result
-}
-
 const stageBlock = `stage('%s'){
 %s
 parallel %s
@@ -117,6 +117,11 @@ func (j *Engine) saveStepLogToMinio(execution *v3.PipelineExecution, stage int,
 	if err != nil {
 		return err
 	}
+	//mask with the values of the secrets at the time of the execution, they may be rotated later
+	message, err = j.maskSecrets(execution, message)
+	if err != nil {
+		return err
+	}
 
 	_, err = client.PutObject(bucketName, logName, strings.NewReader(message), int64(len(message)), minio.PutObjectOptions{})
 	return err
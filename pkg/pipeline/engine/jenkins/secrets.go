@@ -0,0 +1,71 @@
+package jenkins
+
+import (
+	"fmt"
+
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/pipeline/engine/steps"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func (j Engine) getSecret(namespace, name string) (*corev1.Secret, error) {
+	if j.UseCache {
+		return j.SecretLister.Get(namespace, name)
+	}
+	return j.Secrets.GetNamespaced(namespace, name, metav1.GetOptions{})
+}
+
+func (j Engine) maskSecrets(execution *v3.PipelineExecution, content string) (string, error) {
+	values, err := steps.SecretValues(execution, j.getSecret)
+	if err != nil {
+		return "", err
+	}
+	return utils.MaskSecrets(content, values), nil
+}
+
+// checkBoundSecrets fails executions that may not use a secret of the pipeline namespace. The steps of the
+// Jenkins engine share the token of the agent pod, which reads every secret of the namespace, so secrets that
+// are bound to branches can only be kept from an execution by not running it.
+func (j Engine) checkBoundSecrets(execution *v3.PipelineExecution) error {
+	ns := utils.GetPipelineCommonName(execution.Spec.ProjectName)
+	var secrets []*corev1.Secret
+	if j.UseCache {
+		list, err := j.SecretLister.List(ns, labels.Everything())
+		if err != nil {
+			return err
+		}
+		secrets = list
+	} else {
+		list, err := j.Secrets.ListNamespaced(ns, metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+		for i := range list.Items {
+			secrets = append(secrets, &list.Items[i])
+		}
+	}
+	if name := unavailableSecret(secrets, execution); name != "" {
+		return fmt.Errorf("secret %s is bound to other branches and the steps of the %s engine can read every secret of the pipeline, use the %s engine to run %s", name, utils.EngineJenkins, utils.EngineNative, executionSubject(execution))
+	}
+	return nil
+}
+
+// unavailableSecret returns the name of the first secret the execution may not use
+func unavailableSecret(secrets []*corev1.Secret, execution *v3.PipelineExecution) string {
+	for _, s := range secrets {
+		if !utils.SecretAllowsExecution(s, execution) {
+			return s.Name
+		}
+	}
+	return ""
+}
+
+func executionSubject(execution *v3.PipelineExecution) string {
+	if execution.Spec.Event == utils.WebhookEventPullRequest {
+		return "pull requests"
+	}
+	return execution.Spec.Branch
+}
@@ -0,0 +1,35 @@
+package jenkins
+
+import (
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestUnavailableSecret(t *testing.T) {
+	assert := assert.New(t)
+	secrets := []*corev1.Secret{
+		{ObjectMeta: metav1.ObjectMeta{Name: "registry"}},
+		{ObjectMeta: metav1.ObjectMeta{
+			Name:        "deploy-key",
+			Annotations: map[string]string{utils.SecretBranchesAnnotation: "main, release-*"},
+		}},
+	}
+
+	execution := &v3.PipelineExecution{}
+	execution.Spec.Event = utils.WebhookEventPush
+	execution.Spec.Branch = "release-1.0"
+	assert.Equal("", unavailableSecret(secrets, execution))
+
+	// the steps of other branches could read the bound secret with the token of the agent pod
+	execution.Spec.Branch = "feature"
+	assert.Equal("deploy-key", unavailableSecret(secrets, execution))
+
+	execution.Spec.Branch = "main"
+	execution.Spec.Event = utils.WebhookEventPullRequest
+	assert.Equal("deploy-key", unavailableSecret(secrets, execution))
+}
@@ -0,0 +1,350 @@
+package native
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/project.cattle.io/v3"
+
+	"github.com/pkg/errors"
+	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/pipeline/engine/steps"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	"github.com/rancher/rancher/pkg/types/config/dialer"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Engine runs each step of a pipeline execution as a pod in the pipeline namespace of the project.
+// Steps of a stage run concurrently and share a workspace volume claimed for the execution.
+// Step logs are read from the pods while they run and kept in minio once they finish.
+type Engine struct {
+	// UseCache affects resources that is not cached in follower instances of HA mode
+	UseCache   bool
+	HTTPClient *http.Client
+	K8sClient  kubernetes.Interface
+
+	Pods                       v1.PodInterface
+	PodLister                  v1.PodLister
+	PersistentVolumeClaims     v1.PersistentVolumeClaimInterface
+	ServiceLister              v1.ServiceLister
+	Secrets                    v1.SecretInterface
+	SecretLister               v1.SecretLister
+	ManagementSecretLister     v1.SecretLister
+	SourceCodeCredentials      v3.SourceCodeCredentialInterface
+	SourceCodeCredentialLister v3.SourceCodeCredentialLister
+	PipelineLister             v3.PipelineLister
+	PipelineSettingLister      v3.PipelineSettingLister
+
+	ClusterName string
+	Dialer      dialer.Factory
+}
+
+// PreCheck waits for minio, which keeps the logs of finished steps
+func (e *Engine) PreCheck(execution *v3.PipelineExecution) (bool, error) {
+	set := labels.Set(map[string]string{utils.LabelKeyApp: utils.MinioName})
+	ns := utils.GetPipelineCommonName(execution.Spec.ProjectName)
+	pods, err := e.PodLister.List(ns, set.AsSelector())
+	if err != nil {
+		return false, err
+	}
+	if len(pods) <= 0 {
+		return false, errors.New("minio pod not found")
+	}
+	for _, cond := range pods[0].Status.Conditions {
+		if cond.Type == corev1.PodReady && cond.Status == corev1.ConditionTrue {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (e *Engine) RunPipelineExecution(execution *v3.PipelineExecution) error {
+	logrus.Debug("start RunPipelineExecution")
+	if err := utils.ValidPipelineConfig(execution.Spec.PipelineConfig); err != nil {
+		return err
+	}
+	if err := e.ensureGitCredential(execution); err != nil {
+		return err
+	}
+	if err := steps.PrepareRegistryCredentials(execution, e.ManagementSecretLister, e.Secrets); err != nil {
+		return err
+	}
+	return e.ensureWorkspace(execution)
+}
+
+func (e *Engine) RerunExecution(execution *v3.PipelineExecution) error {
+	if err := e.StopExecution(execution); err != nil {
+		return err
+	}
+	return e.RunPipelineExecution(execution)
+}
+
+// StopExecution removes the step pods, the workspace and the git credential of an execution.
+// Logs of the steps still running are kept so that they remain readable once aborted.
+func (e *Engine) StopExecution(execution *v3.PipelineExecution) error {
+	for i := range execution.Status.Stages {
+		for j := range execution.Status.Stages[i].Steps {
+			if execution.Status.Stages[i].Steps[j].State != utils.StateBuilding {
+				continue
+			}
+			if err := e.saveStepLogToMinio(execution, i, j); err != nil {
+				logrus.Warnf("failed to save log of step %d-%d of pipeline execution %s: %v", i, j, execution.Name, err)
+			}
+		}
+	}
+	ns := utils.GetPipelineCommonName(execution.Spec.ProjectName)
+	set := labels.Set(map[string]string{
+		utils.LabelKeyApp:       utils.PipelineName,
+		utils.LabelKeyExecution: execution.Name,
+	})
+	pods, err := e.PodLister.List(ns, set.AsSelector())
+	if err != nil {
+		return err
+	}
+	for _, pod := range pods {
+		if err := e.Pods.DeleteNamespaced(ns, pod.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	if err := e.PersistentVolumeClaims.DeleteNamespaced(ns, workspaceName(execution), &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if err := e.Secrets.DeleteNamespaced(ns, gitCredentialName(execution), &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// SyncExecution starts the steps of the current stage and records the state of their pods.
+// The next stage starts once every step of the current one has succeeded or was skipped.
+func (e *Engine) SyncExecution(execution *v3.PipelineExecution) (bool, error) {
+	if execution.Status.ExecutionState != utils.StateWaiting && execution.Status.ExecutionState != utils.StateBuilding {
+		return false, nil
+	}
+	if len(execution.Status.Stages) != len(execution.Spec.PipelineConfig.Stages) {
+		return false, errors.New("error sync execution - stage status out of range")
+	}
+	now := time.Now()
+	if timedOut(execution, now) {
+		return true, e.timeoutExecution(execution, now)
+	}
+
+	updated := false
+	for i, stage := range execution.Spec.PipelineConfig.Stages {
+		if len(execution.Status.Stages[i].Steps) != len(stage.Steps) {
+			return false, errors.New("error sync execution - step status out of range")
+		}
+		for j := range stage.Steps {
+			changed, err := e.syncStep(execution, i, j, now)
+			if err != nil {
+				return false, err
+			}
+			updated = updated || changed
+		}
+		state := execution.Status.Stages[i].State
+		if state != utils.StateSuccess && state != utils.StateSkipped {
+			break
+		}
+	}
+	return updated, nil
+}
+
+func (e *Engine) syncStep(execution *v3.PipelineExecution, stage int, step int, now time.Time) (bool, error) {
+	stepStatus := execution.Status.Stages[stage].Steps[step]
+	switch stepStatus.State {
+	case utils.StateWaiting:
+		stageConfig := execution.Spec.PipelineConfig.Stages[stage]
+		if !utils.MatchAll(stageConfig.When, execution) || !utils.MatchAll(stageConfig.Steps[step].When, execution) {
+			skipStep(execution, stage, step, now)
+			return true, nil
+		}
+		if waitForCache(execution, stage, step) {
+			return false, nil
+		}
+		if err := e.createStepPod(execution, stage, step); err != nil {
+			return false, err
+		}
+		buildingStep(execution, stage, step, now)
+		return true, nil
+	case utils.StateBuilding:
+		pod, err := e.getStepPod(execution, stage, step)
+		if apierrors.IsNotFound(err) {
+			return true, e.failStep(execution, stage, step, now, "step pod is not found")
+		} else if err != nil {
+			return false, err
+		}
+		switch pod.Status.Phase {
+		case corev1.PodSucceeded:
+			if err := e.saveStepLogToMinio(execution, stage, step); err != nil {
+				return false, err
+			}
+			addArtifacts(execution, stage, step, now)
+			successStep(execution, stage, step, now)
+			return true, nil
+		case corev1.PodFailed:
+			return true, e.failStep(execution, stage, step, now, uploadError(pod))
+		case corev1.PodPending:
+			if reason := waitingError(pod); reason != "" {
+				return true, e.failStep(execution, stage, step, now, reason)
+			}
+		}
+	}
+	return false, nil
+}
+
+// waitForCache reports whether a step with a cache has to wait for a step with a cache before it in the stage. Such
+// steps, like the steps a matrix expands to, restore and save their cache paths in the workspace the steps share, so
+// they run one after another.
+func waitForCache(execution *v3.PipelineExecution, stage int, step int) bool {
+	steps := execution.Spec.PipelineConfig.Stages[stage].Steps
+	if steps[step].Cache == nil {
+		return false
+	}
+	for i := 0; i < step; i++ {
+		state := execution.Status.Stages[stage].Steps[i].State
+		if steps[i].Cache != nil && (state == utils.StateWaiting || state == utils.StateBuilding) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *Engine) GetStepLog(execution *v3.PipelineExecution, stage int, step int) (string, error) {
+	if len(execution.Status.Stages) <= stage || len(execution.Status.Stages[stage].Steps) <= step {
+		return "", errors.New("invalid step index")
+	}
+	switch execution.Status.Stages[stage].Steps[step].State {
+	case utils.StateWaiting, utils.StateSkipped, "":
+		return "", nil
+	case utils.StateBuilding:
+		message, err := e.getStepLogFromPod(execution, stage, step)
+		if err != nil {
+			return "", err
+		}
+		return e.maskSecrets(execution, message)
+	}
+	return e.getStepLogFromMinioStore(execution, stage, step)
+}
+
+func (e *Engine) getStepLogFromPod(execution *v3.PipelineExecution, stage int, step int) (string, error) {
+	pod, err := e.getStepPod(execution, stage, step)
+	if err != nil {
+		return "", err
+	}
+	if !stepStarted(pod) {
+		return "", nil
+	}
+	content, err := e.K8sClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		Container: stepContainerName,
+	}).DoRaw(context.TODO())
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+func (e *Engine) getSecret(namespace, name string) (*corev1.Secret, error) {
+	if e.UseCache {
+		return e.SecretLister.Get(namespace, name)
+	}
+	return e.Secrets.GetNamespaced(namespace, name, metav1.GetOptions{})
+}
+
+func (e *Engine) maskSecrets(execution *v3.PipelineExecution, content string) (string, error) {
+	values, err := steps.SecretValues(execution, e.getSecret)
+	if err != nil {
+		return "", err
+	}
+	return utils.MaskSecrets(content, values), nil
+}
+
+func (e *Engine) getStepPod(execution *v3.PipelineExecution, stage int, step int) (*corev1.Pod, error) {
+	ns := utils.GetPipelineCommonName(execution.Spec.ProjectName)
+	if e.UseCache {
+		return e.PodLister.Get(ns, stepPodName(execution, stage, step))
+	}
+	return e.Pods.GetNamespaced(ns, stepPodName(execution, stage, step), metav1.GetOptions{})
+}
+
+func (e *Engine) failStep(execution *v3.PipelineExecution, stage int, step int, now time.Time, reason string) error {
+	if err := e.saveStepLogToMinio(execution, stage, step); err != nil {
+		logrus.Warnf("failed to save log of step %d-%d of pipeline execution %s: %v", stage, step, execution.Name, err)
+	}
+	message := fmt.Sprintf("Got FAILED status in '%s' stage", execution.Spec.PipelineConfig.Stages[stage].Name)
+	if reason != "" {
+		message = fmt.Sprintf("%s: %s", message, reason)
+	}
+	failStep(execution, stage, step, now, message)
+
+	//abort concurrent building steps
+	for i := range execution.Status.Stages[stage].Steps {
+		if execution.Status.Stages[stage].Steps[i].State != utils.StateBuilding {
+			continue
+		}
+		if err := e.saveStepLogToMinio(execution, stage, i); err != nil {
+			logrus.Warnf("failed to save log of step %d-%d of pipeline execution %s: %v", stage, i, execution.Name, err)
+		}
+		execution.Status.Stages[stage].Steps[i].State = utils.StateAborted
+		execution.Status.Stages[stage].Steps[i].Ended = now.Format(time.RFC3339)
+	}
+	return nil
+}
+
+func (e *Engine) timeoutExecution(execution *v3.PipelineExecution, now time.Time) error {
+	for i := range execution.Status.Stages {
+		for j := range execution.Status.Stages[i].Steps {
+			if execution.Status.Stages[i].Steps[j].State == utils.StateBuilding {
+				return e.failStep(execution, i, j, now, "pipeline timed out")
+			}
+		}
+	}
+	execution.Status.ExecutionState = utils.StateFailed
+	v32.PipelineExecutionConditionBuilt.False(execution)
+	v32.PipelineExecutionConditionBuilt.Message(execution, "pipeline timed out")
+	return nil
+}
+
+func timedOut(execution *v3.PipelineExecution, now time.Time) bool {
+	started, err := time.Parse(time.RFC3339, execution.Status.Started)
+	if err != nil {
+		return false
+	}
+	timeout := utils.DefaultTimeout
+	if execution.Spec.PipelineConfig.Timeout > 0 {
+		timeout = execution.Spec.PipelineConfig.Timeout
+	}
+	return now.Sub(started) > time.Duration(timeout)*time.Minute
+}
+
+// stepStarted reports whether the step container of a pod has started. The step runs as an init container
+// when the pod uploads a cache or artifacts afterwards.
+func stepStarted(pod *corev1.Pod) bool {
+	for _, status := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+		if status.Name == stepContainerName {
+			return status.State.Running != nil || status.State.Terminated != nil
+		}
+	}
+	return false
+}
+
+// waitingError returns the reason a pending step pod will not start by itself
+func waitingError(pod *corev1.Pod) string {
+	for _, status := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+		if status.State.Waiting == nil {
+			continue
+		}
+		switch status.State.Waiting.Reason {
+		case "ImagePullBackOff", "InvalidImageName", "CreateContainerConfigError":
+			return fmt.Sprintf("%s: %s", status.State.Waiting.Reason, status.State.Waiting.Message)
+		}
+	}
+	return ""
+}
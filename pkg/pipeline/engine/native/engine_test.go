@@ -0,0 +1,209 @@
+package native
+
+import (
+	"testing"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/project.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testExecution() *v3.PipelineExecution {
+	return &v3.PipelineExecution{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "p-test",
+			Name:      "pipeline-1",
+			Labels:    map[string]string{},
+		},
+		Spec: v32.PipelineExecutionSpec{
+			ProjectName: "c-test:p-test",
+			PipelineConfig: v32.PipelineConfig{
+				Stages: []v32.Stage{
+					{Name: "clone", Steps: []v32.Step{{SourceCodeConfig: &v32.SourceCodeConfig{}}}},
+					{Name: "test", Steps: []v32.Step{
+						{RunScriptConfig: &v32.RunScriptConfig{Image: "golang", ShellScript: "go test ./..."}},
+						{RunScriptConfig: &v32.RunScriptConfig{Image: "golang", ShellScript: "go vet ./..."}},
+					}},
+				},
+			},
+		},
+		Status: v32.PipelineExecutionStatus{
+			ExecutionState: utils.StateWaiting,
+			Stages: []v32.StageStatus{
+				{State: utils.StateWaiting, Steps: []v32.StepStatus{{State: utils.StateWaiting}}},
+				{State: utils.StateWaiting, Steps: []v32.StepStatus{{State: utils.StateWaiting}, {State: utils.StateWaiting}}},
+			},
+		},
+	}
+}
+
+func TestStepStates(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	execution := testExecution()
+	buildingStep(execution, 0, 0, now)
+	assert.Equal(utils.StateBuilding, execution.Status.ExecutionState)
+	assert.Equal(utils.StateBuilding, execution.Status.Stages[0].State)
+	assert.True(v32.PipelineExecutionConditionProvisioned.IsTrue(execution))
+	assert.Equal("Running 'clone' stage", v32.PipelineExecutionConditionBuilt.GetMessage(execution))
+
+	successStep(execution, 0, 0, now)
+	assert.Equal(utils.StateSuccess, execution.Status.Stages[0].State)
+	assert.Equal(utils.StateBuilding, execution.Status.ExecutionState)
+
+	buildingStep(execution, 1, 0, now)
+	skipStep(execution, 1, 1, now)
+	assert.Equal(utils.StateBuilding, execution.Status.Stages[1].State)
+	successStep(execution, 1, 0, now)
+	assert.Equal(utils.StateSuccess, execution.Status.Stages[1].State)
+	assert.Equal(utils.StateSuccess, execution.Status.ExecutionState)
+	assert.Equal("true", execution.Labels[utils.PipelineFinishLabel])
+	assert.True(v32.PipelineExecutionConditionBuilt.IsTrue(execution))
+
+	execution = testExecution()
+	buildingStep(execution, 0, 0, now)
+	failStep(execution, 0, 0, now, "Got FAILED status in 'clone' stage")
+	assert.Equal(utils.StateFailed, execution.Status.ExecutionState)
+	assert.Equal(utils.StateFailed, execution.Status.Stages[0].State)
+	assert.Equal("", execution.Status.Stages[1].State)
+	assert.Equal("", execution.Status.Stages[1].Steps[0].State)
+	assert.True(v32.PipelineExecutionConditionBuilt.IsFalse(execution))
+}
+
+func TestNewStepPod(t *testing.T) {
+	assert := assert.New(t)
+
+	execution := testExecution()
+	step := &execution.Spec.PipelineConfig.Stages[1].Steps[0]
+	pod := newStepPod(execution, 1, 0, step, corev1.Container{Name: "step-1-0", Image: "golang", TTY: true, Command: []string{"cat"}}, "", nil)
+	assert.Equal("p-test-pipeline", pod.Namespace)
+	assert.Equal("pipeline-1-1-0", pod.Name)
+	assert.Equal(corev1.RestartPolicyNever, pod.Spec.RestartPolicy)
+	assert.Len(pod.Spec.Containers, 1)
+	container := pod.Spec.Containers[0]
+	assert.Equal(stepContainerName, container.Name)
+	assert.False(container.TTY)
+	assert.Equal([]string{"sh", "-xec", "go test ./..."}, container.Command)
+	assert.Equal(workspacePath, container.WorkingDir)
+	assert.Equal("pipeline-1-workspace", pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	assert.Empty(pod.Spec.InitContainers)
+	assert.False(*pod.Spec.AutomountServiceAccountToken)
+
+	step = &execution.Spec.PipelineConfig.Stages[0].Steps[0]
+	pod = newStepPod(execution, 0, 0, step, corev1.Container{}, "cert", nil)
+	assert.Len(pod.Spec.InitContainers, 1)
+	envs := map[string]bool{}
+	for _, env := range pod.Spec.Containers[0].Env {
+		envs[env.Name] = true
+	}
+	assert.True(envs["GIT_USERNAME"])
+	assert.True(envs["GIT_PASSWORD"])
+	assert.True(envs["GIT_SSL_CAINFO"])
+
+	step = &v32.Step{ApplyYamlConfig: &v32.ApplyYamlConfig{Path: "deployment.yaml"}}
+	pod = newStepPod(execution, 1, 0, step, corev1.Container{}, "", nil)
+	assert.True(*pod.Spec.AutomountServiceAccountToken)
+}
+
+func TestTimedOut(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2020, 1, 1, 2, 0, 0, 0, time.UTC)
+
+	execution := testExecution()
+	assert.False(timedOut(execution, now))
+	execution.Status.Started = now.Add(-30 * time.Minute).Format(time.RFC3339)
+	assert.False(timedOut(execution, now))
+	execution.Spec.PipelineConfig.Timeout = 10
+	assert.True(timedOut(execution, now))
+}
+
+func TestWaitingError(t *testing.T) {
+	assert := assert.New(t)
+
+	pod := &corev1.Pod{Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{
+		{State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"}}},
+	}}}
+	assert.Equal("", waitingError(pod))
+	pod.Status.ContainerStatuses[0].State.Waiting = &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "image not found"}
+	assert.Equal("ImagePullBackOff: image not found", waitingError(pod))
+}
+
+func TestWithTransfer(t *testing.T) {
+	assert := assert.New(t)
+
+	execution := testExecution()
+	step := &execution.Spec.PipelineConfig.Stages[1].Steps[0]
+	step.Cache = &v32.CacheConfig{Key: "go-${CICD_GIT_BRANCH}", Paths: []string{"/go/pkg"}}
+	step.Artifacts = []v32.ArtifactConfig{{Name: "coverage", Paths: []string{"cover.out", "*.xml"}}}
+	execution.Spec.PipelineName = "p-test:p-abc"
+	execution.Spec.Branch = "master"
+	assert.Equal("p-abc/master/go-master.tgz", cacheObjectName(execution, step))
+	execution.Spec.Branch = "feature/cache"
+	assert.Equal("p-abc/feature%2Fcache/go-feature%2Fcache.tgz", cacheObjectName(execution, step))
+	step.Cache.Key = "../../master/go-master"
+	assert.Equal("p-abc/feature%2Fcache/..%2F..%2Fmaster%2Fgo-master.tgz", cacheObjectName(execution, step))
+	step.Cache.Key = "go-${CICD_GIT_BRANCH}"
+	assert.Equal("pipeline-1/1-0-coverage.tgz", artifactObjectName(execution, 1, 0, "coverage"))
+
+	pod := newStepPod(execution, 1, 0, step, corev1.Container{}, "cert", nil)
+	withTransfer(pod, step, &transfer{
+		restoreCacheURL: "http://minio/restore",
+		saveCacheURL:    "http://minio/save",
+		artifactURLs:    []string{"http://minio/coverage"},
+	})
+	var names []string
+	for _, c := range pod.Spec.InitContainers {
+		names = append(names, c.Name)
+	}
+	assert.Equal([]string{"config-crt", restoreCacheContainerName, stepContainerName}, names)
+	assert.Len(pod.Spec.Containers, 1)
+	upload := pod.Spec.Containers[0]
+	assert.Equal(uploadContainerName, upload.Name)
+	envs := map[string]string{}
+	for _, env := range upload.Env {
+		envs[env.Name] = env.Value
+	}
+	assert.Equal("http://minio/save", envs["CACHE_URL"])
+	assert.Equal("/go/pkg", envs["CACHE_PATHS"])
+	assert.Equal("http://minio/coverage", envs["ARTIFACT_0_URL"])
+	assert.Equal("cover.out *.xml", envs["ARTIFACT_0_PATHS"])
+
+	pod.Status.InitContainerStatuses = []corev1.ContainerStatus{
+		{Name: stepContainerName, State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{}}},
+	}
+	assert.False(stepStarted(pod))
+	pod.Status.InitContainerStatuses[0].State = corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}
+	assert.True(stepStarted(pod))
+
+	pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+		{Name: uploadContainerName, State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1}}},
+	}
+	assert.Equal("failed to upload artifacts", uploadError(pod))
+
+	addArtifacts(execution, 1, 0, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.Equal([]v32.ArtifactStatus{{Name: "coverage", Stage: 1, Step: 0, Created: "2020-01-01T00:00:00Z"}}, execution.Status.Artifacts)
+}
+
+func TestWaitForCache(t *testing.T) {
+	assert := assert.New(t)
+
+	execution := testExecution()
+	steps := execution.Spec.PipelineConfig.Stages[1].Steps
+	assert.False(waitForCache(execution, 1, 1))
+
+	steps[1].Cache = &v32.CacheConfig{Key: "go", Paths: []string{"/go/pkg"}}
+	assert.False(waitForCache(execution, 1, 1))
+
+	steps[0].Cache = &v32.CacheConfig{Key: "go", Paths: []string{"/go/pkg"}}
+	assert.False(waitForCache(execution, 1, 0))
+	assert.True(waitForCache(execution, 1, 1))
+	execution.Status.Stages[1].Steps[0].State = utils.StateBuilding
+	assert.True(waitForCache(execution, 1, 1))
+	execution.Status.Stages[1].Steps[0].State = utils.StateSuccess
+	assert.False(waitForCache(execution, 1, 1))
+}
@@ -0,0 +1,106 @@
+package native
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func (e *Engine) getMinioClient(ns string) (*minio.Client, error) {
+	svc, err := e.ServiceLister.Get(ns, utils.MinioName)
+	if err != nil {
+		return nil, err
+	}
+	url := fmt.Sprintf("%s:%d", svc.Spec.ClusterIP, utils.MinioPort)
+
+	var secret *corev1.Secret
+	if e.UseCache {
+		secret, err = e.SecretLister.Get(ns, utils.PipelineSecretName)
+	} else {
+		secret, err = e.Secrets.GetNamespaced(ns, utils.PipelineSecretName, metav1.GetOptions{})
+	}
+	if err != nil || secret.Data == nil {
+		return nil, fmt.Errorf("error get minio token - %v", err)
+	}
+	token := string(secret.Data[utils.PipelineSecretTokenKey])
+
+	client, err := minio.New(url, utils.PipelineSecretDefaultUser, token, false)
+	if err != nil {
+		return nil, err
+	}
+	if e.HTTPClient == nil {
+		dial, err := e.Dialer.ClusterDialer(e.ClusterName)
+		if err != nil {
+			return nil, err
+		}
+		e.HTTPClient = &http.Client{
+			Transport: &http.Transport{
+				DialContext: dial,
+			},
+			Timeout: 15 * time.Second,
+		}
+	}
+	client.SetCustomTransport(e.HTTPClient.Transport)
+	return client, nil
+}
+
+func (e *Engine) getStepLogFromMinioStore(execution *v3.PipelineExecution, stage int, step int) (string, error) {
+	logName := fmt.Sprintf("%s-%d-%d", execution.Name, stage, step)
+	client, err := e.getMinioClient(utils.GetPipelineCommonName(execution.Spec.ProjectName))
+	if err != nil {
+		return "", err
+	}
+	reader, err := client.GetObject(utils.MinioLogBucket, logName, minio.GetObjectOptions{})
+	if err != nil {
+		return "", err
+	}
+	content, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+func (e *Engine) saveStepLogToMinio(execution *v3.PipelineExecution, stage int, step int) error {
+	bucketName := utils.MinioLogBucket
+	logName := fmt.Sprintf("%s-%d-%d", execution.Name, stage, step)
+	client, err := e.getMinioClient(utils.GetPipelineCommonName(execution.Spec.ProjectName))
+	if err != nil {
+		return err
+	}
+	if err := ensureBucket(client, bucketName); err != nil {
+		return err
+	}
+
+	message, err := e.getStepLogFromPod(execution, stage, step)
+	if err != nil {
+		return err
+	}
+	//mask with the values of the secrets at the time of the execution, they may be rotated later
+	message, err = e.maskSecrets(execution, message)
+	if err != nil {
+		return err
+	}
+	_, err = client.PutObject(bucketName, logName, strings.NewReader(message), int64(len(message)), minio.PutObjectOptions{})
+	return err
+}
+
+func ensureBucket(client *minio.Client, bucketName string) error {
+	exists, err := client.BucketExists(bucketName)
+	if err != nil {
+		logrus.Error(err)
+	}
+	if !exists {
+		return client.MakeBucket(bucketName, utils.MinioBucketLocation)
+	}
+	return nil
+}
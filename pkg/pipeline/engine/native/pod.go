@@ -0,0 +1,351 @@
+package native
+
+import (
+	"fmt"
+
+	v33 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v32 "github.com/rancher/rancher/pkg/apis/project.cattle.io/v3"
+
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	images "github.com/rancher/rancher/pkg/image"
+	"github.com/rancher/rancher/pkg/pipeline/engine/steps"
+	"github.com/rancher/rancher/pkg/pipeline/providers"
+	"github.com/rancher/rancher/pkg/pipeline/remote"
+	"github.com/rancher/rancher/pkg/pipeline/remote/git"
+	"github.com/rancher/rancher/pkg/pipeline/remote/model"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	"github.com/rancher/rancher/pkg/ref"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	stepContainerName   = "step"
+	workspaceVolumeName = "workspace"
+	workspacePath       = "/workspace"
+
+	gitSSHKeyKey     = "sshPrivateKey"
+	gitKnownHostsKey = "knownHosts"
+
+	cloneScript = `git init -q . && \
+if [ -n "$GIT_SSH_KEY" ]; then printf '%s\n' "$GIT_SSH_KEY" > /tmp/git-ssh-key && chmod 600 /tmp/git-ssh-key && \
+printf '%s\n' "$GIT_KNOWN_HOSTS" > /tmp/git-known-hosts && \
+export GIT_SSH_COMMAND="ssh -i /tmp/git-ssh-key -o IdentitiesOnly=yes -o StrictHostKeyChecking=yes -o UserKnownHostsFile=/tmp/git-known-hosts"; fi && \
+if [ -n "$GIT_PASSWORD" ]; then git config credential.helper '!f() { echo "username=$GIT_USERNAME"; echo "password=$GIT_PASSWORD"; }; f'; fi && \
+git fetch -q "$CICD_GIT_URL" "+$CICD_GIT_REF:refs/remotes/local/temp" && \
+git checkout -qf local/temp`
+)
+
+func stepPodName(execution *v3.PipelineExecution, stage int, step int) string {
+	return fmt.Sprintf("%s-%d-%d", execution.Name, stage, step)
+}
+
+func workspaceName(execution *v3.PipelineExecution) string {
+	return fmt.Sprintf("%s-workspace", execution.Name)
+}
+
+func gitCredentialName(execution *v3.PipelineExecution) string {
+	return fmt.Sprintf("%s-git", execution.Name)
+}
+
+// stepCommand returns the command running a step, matching what Jenkins runs inside the step container
+func stepCommand(step *v32.Step) []string {
+	switch {
+	case step.SourceCodeConfig != nil:
+		return []string{"sh", "-c", cloneScript}
+	case step.RunScriptConfig != nil:
+		return []string{"sh", "-xec", step.RunScriptConfig.ShellScript}
+	case step.PublishImageConfig != nil:
+		return []string{"sh", "-c", "/usr/local/bin/dockerd-entrypoint.sh /bin/drone-docker"}
+	case step.ApplyYamlConfig != nil:
+		return []string{"sh", "-c", "kube-apply"}
+	case step.PublishCatalogConfig != nil:
+		return []string{"sh", "-c", "publish-catalog"}
+	case step.ApplyAppConfig != nil:
+		return []string{"sh", "-c", "apply-app"}
+	}
+	return nil
+}
+
+// accessesCluster checks whether a step deploys to the cluster with the pipeline service account.
+// Other steps run without its token, so that scripts cannot read the secrets of the pipeline
+// namespace, including secrets bound to other branches.
+func accessesCluster(step *v32.Step) bool {
+	return step.ApplyYamlConfig != nil || step.PublishCatalogConfig != nil || step.ApplyAppConfig != nil
+}
+
+func (e *Engine) createStepPod(execution *v3.PipelineExecution, stage int, step int) error {
+	pod, err := e.getStepPodSpec(execution, stage, step)
+	if err != nil {
+		return err
+	}
+	if _, err := e.Pods.Create(pod); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+func (e *Engine) getStepPodSpec(execution *v3.PipelineExecution, stage int, step int) (*corev1.Pod, error) {
+	_, projectID := ref.Parse(execution.Spec.ProjectName)
+	gitCaCerts, err := utils.GetPipelineSettingValue(e.PipelineSettingLister, projectID, utils.SettingGitCaCerts, "")
+	if err != nil {
+		return nil, err
+	}
+	substituted := execution.DeepCopy()
+	steps.SubstituteEnvVars(substituted)
+	container, err := steps.Container(substituted, stage, step, gitCaCerts)
+	if err != nil {
+		return nil, err
+	}
+	imagePullSecrets, err := e.getImagePullSecrets(execution)
+	if err != nil {
+		return nil, err
+	}
+	transfer, err := e.getTransfer(execution, stage, step)
+	if err != nil {
+		return nil, err
+	}
+	stepConfig := &execution.Spec.PipelineConfig.Stages[stage].Steps[step]
+	pod := newStepPod(execution, stage, step, stepConfig, container, gitCaCerts, imagePullSecrets)
+	if transfer != nil {
+		withTransfer(pod, stepConfig, transfer)
+	}
+	return pod, nil
+}
+
+func newStepPod(execution *v3.PipelineExecution, stage int, step int, stepConfig *v32.Step, container corev1.Container, gitCaCerts string, imagePullSecrets []corev1.LocalObjectReference) *corev1.Pod {
+	ns := utils.GetPipelineCommonName(execution.Spec.ProjectName)
+	podLabels := map[string]string{
+		utils.LabelKeyApp:       utils.PipelineName,
+		utils.LabelKeyExecution: execution.Name,
+	}
+
+	container.Name = stepContainerName
+	container.TTY = false
+	container.Command = stepCommand(stepConfig)
+	container.WorkingDir = workspacePath
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      workspaceVolumeName,
+		MountPath: workspacePath,
+	})
+	if stepConfig.SourceCodeConfig != nil {
+		optional := true
+		for _, env := range []struct{ name, key string }{
+			{"GIT_USERNAME", utils.PublishSecretUserKey},
+			{"GIT_PASSWORD", utils.PublishSecretPwKey},
+			{"GIT_SSH_KEY", gitSSHKeyKey},
+			{"GIT_KNOWN_HOSTS", gitKnownHostsKey},
+		} {
+			container.Env = append(container.Env, corev1.EnvVar{
+				Name: env.name,
+				ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: gitCredentialName(execution),
+					},
+					Key:      env.key,
+					Optional: &optional,
+				}}})
+		}
+	}
+
+	automountToken := accessesCluster(stepConfig)
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ns,
+			Name:      stepPodName(execution, stage, step),
+			Labels:    podLabels,
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:                corev1.RestartPolicyNever,
+			ServiceAccountName:           utils.JenkinsName,
+			AutomountServiceAccountToken: &automountToken,
+			ImagePullSecrets:             imagePullSecrets,
+			// concurrent steps share the workspace, which is usually a ReadWriteOnce volume
+			Affinity: &corev1.Affinity{
+				PodAffinity: &corev1.PodAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{
+						{
+							LabelSelector: &metav1.LabelSelector{MatchLabels: podLabels},
+							TopologyKey:   "kubernetes.io/hostname",
+						},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name: workspaceVolumeName,
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: workspaceName(execution),
+						},
+					},
+				},
+				{
+					Name: utils.RegistryCrtVolumeName,
+					VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{
+							SecretName: utils.RegistryCrtSecretName,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if gitCaCerts != "" {
+		if stepConfig.SourceCodeConfig != nil {
+			container.Env = append(container.Env, corev1.EnvVar{
+				Name:  "GIT_SSL_CAINFO",
+				Value: utils.GitCaCertPath + "/ca.crt",
+			})
+			container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+				Name:      utils.GitCaCertVolumeName,
+				MountPath: utils.GitCaCertPath,
+			})
+		}
+		pod.Spec.InitContainers = []corev1.Container{
+			{
+				Name:    "config-crt",
+				Image:   images.Resolve(v33.ToolsSystemImages.PipelineSystemImages.AlpineGit),
+				Command: []string{"sh", "-c", "printf \"%s\" \"$CA_CERT\" > " + utils.GitCaCertPath + "/ca.crt"},
+				Env: []corev1.EnvVar{
+					{
+						Name:  "CA_CERT",
+						Value: gitCaCerts,
+					},
+				},
+				VolumeMounts: []corev1.VolumeMount{
+					{
+						Name:      utils.GitCaCertVolumeName,
+						MountPath: utils.GitCaCertPath,
+					},
+				},
+			},
+		}
+		pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+			Name: utils.GitCaCertVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		})
+	}
+	pod.Spec.Containers = []corev1.Container{container}
+	return pod
+}
+
+func (e *Engine) getImagePullSecrets(execution *v3.PipelineExecution) ([]corev1.LocalObjectReference, error) {
+	ns := utils.GetPipelineCommonName(execution.Spec.ProjectName)
+	secrets, err := e.SecretLister.List(ns, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var refs []corev1.LocalObjectReference
+	for _, s := range secrets {
+		if s.Type == corev1.SecretTypeDockerConfigJson {
+			refs = append(refs, corev1.LocalObjectReference{Name: s.Name})
+		}
+	}
+	return refs, nil
+}
+
+// ensureWorkspace claims the volume shared by the steps of an execution
+func (e *Engine) ensureWorkspace(execution *v3.PipelineExecution) error {
+	_, projectID := ref.Parse(execution.Spec.ProjectName)
+	size, err := utils.GetPipelineSettingValue(e.PipelineSettingLister, projectID, utils.SettingWorkspaceSize, utils.SettingWorkspaceSizeDefault)
+	if err != nil {
+		return err
+	}
+	quantity, err := resource.ParseQuantity(size)
+	if err != nil {
+		return fmt.Errorf("invalid workspace size %q: %v", size, err)
+	}
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: utils.GetPipelineCommonName(execution.Spec.ProjectName),
+			Name:      workspaceName(execution),
+			Labels: map[string]string{
+				utils.LabelKeyApp:       utils.PipelineName,
+				utils.LabelKeyExecution: execution.Name,
+			},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: quantity,
+				},
+			},
+		},
+	}
+	if _, err := e.PersistentVolumeClaims.Create(pvc); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// ensureGitCredential stores the credential the clone step uses to fetch the repository
+func (e *Engine) ensureGitCredential(execution *v3.PipelineExecution) error {
+	ns, name := ref.Parse(execution.Spec.PipelineName)
+	pipeline, err := e.PipelineLister.Get(ns, name)
+	if err != nil {
+		return err
+	}
+	if pipeline.Spec.SourceCodeCredentialName == "" {
+		return nil
+	}
+	ns, name = ref.Parse(pipeline.Spec.SourceCodeCredentialName)
+	credential, err := e.SourceCodeCredentialLister.Get(ns, name)
+	if err != nil {
+		return err
+	}
+	_, projectID := ref.Parse(execution.Spec.ProjectName)
+	scpConfig, err := providers.GetSourceCodeProviderConfig(credential.Spec.SourceCodeType, projectID)
+	if err != nil {
+		return err
+	}
+	remote, err := remote.New(scpConfig)
+	if err != nil {
+		return err
+	}
+	password := credential.Spec.AccessToken
+	if credential.Spec.GitCloneToken != "" {
+		password = credential.Spec.GitCloneToken
+	}
+	accessToken, err := utils.EnsureAccessToken(e.SourceCodeCredentials, remote, credential)
+	if err != nil {
+		return err
+	} else if accessToken != credential.Spec.AccessToken {
+		password = accessToken
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: utils.GetPipelineCommonName(execution.Spec.ProjectName),
+			Name:      gitCredentialName(execution),
+		},
+		Data: map[string][]byte{
+			utils.PublishSecretUserKey: []byte(credential.Spec.GitLoginName),
+			utils.PublishSecretPwKey:   []byte(password),
+		},
+	}
+	if credential.Spec.SourceCodeType == model.GitType {
+		// the access token of plain git credentials holds the password and the SSH key
+		gitCredential, err := git.DecodeCredential(accessToken)
+		if err != nil {
+			return err
+		}
+		secret.Data[utils.PublishSecretPwKey] = []byte(gitCredential.Password)
+		secret.Data[gitSSHKeyKey] = []byte(gitCredential.SSHPrivateKey)
+		if gitConfig, ok := scpConfig.(*v32.GitPipelineConfig); ok {
+			secret.Data[gitKnownHostsKey] = []byte(gitConfig.KnownHosts)
+		}
+	}
+	_, err = e.Secrets.Create(secret)
+	if apierrors.IsAlreadyExists(err) {
+		_, err = e.Secrets.Update(secret)
+	}
+	return err
+}
@@ -0,0 +1,117 @@
+package native
+
+import (
+	"fmt"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/project.cattle.io/v3"
+
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+)
+
+func buildingStep(execution *v3.PipelineExecution, stage int, step int, now time.Time) {
+	startTime := now.Format(time.RFC3339)
+	execution.Status.Stages[stage].Steps[step].State = utils.StateBuilding
+	if execution.Status.Stages[stage].Steps[step].Started == "" {
+		execution.Status.Stages[stage].Steps[step].Started = startTime
+	}
+	if execution.Status.Stages[stage].State == utils.StateWaiting || execution.Status.Stages[stage].State == "" {
+		execution.Status.Stages[stage].State = utils.StateBuilding
+	}
+	if execution.Status.Stages[stage].Started == "" {
+		execution.Status.Stages[stage].Started = startTime
+	}
+	if execution.Status.ExecutionState == utils.StateWaiting {
+		execution.Status.ExecutionState = utils.StateBuilding
+	}
+	if execution.Status.Started == "" {
+		execution.Status.Started = startTime
+	}
+
+	v32.PipelineExecutionConditionProvisioned.True(execution)
+	stageName := execution.Spec.PipelineConfig.Stages[stage].Name
+	v32.PipelineExecutionConditionBuilt.CreateUnknownIfNotExists(execution)
+	v32.PipelineExecutionConditionBuilt.Message(execution, fmt.Sprintf("Running '%s' stage", stageName))
+}
+
+// addArtifacts records the artifacts uploaded by a step
+func addArtifacts(execution *v3.PipelineExecution, stage int, step int, now time.Time) {
+	for _, artifact := range execution.Spec.PipelineConfig.Stages[stage].Steps[step].Artifacts {
+		execution.Status.Artifacts = append(execution.Status.Artifacts, v32.ArtifactStatus{
+			Name:    artifact.Name,
+			Stage:   stage,
+			Step:    step,
+			Created: now.Format(time.RFC3339),
+		})
+	}
+}
+
+func successStep(execution *v3.PipelineExecution, stage int, step int, now time.Time) {
+	execution.Status.Stages[stage].Steps[step].State = utils.StateSuccess
+	execution.Status.Stages[stage].Steps[step].Ended = now.Format(time.RFC3339)
+	finishStage(execution, stage, now)
+}
+
+func skipStep(execution *v3.PipelineExecution, stage int, step int, now time.Time) {
+	execution.Status.Stages[stage].Steps[step].State = utils.StateSkipped
+	finishStage(execution, stage, now)
+}
+
+// finishStage completes a stage whose steps all succeeded or were skipped,
+// and the execution when it is the last stage
+func finishStage(execution *v3.PipelineExecution, stage int, now time.Time) {
+	endTime := now.Format(time.RFC3339)
+	skipStage := true
+	for _, curStep := range execution.Status.Stages[stage].Steps {
+		if curStep.State != utils.StateSkipped {
+			skipStage = false
+		}
+	}
+	if skipStage {
+		execution.Status.Stages[stage].State = utils.StateSkipped
+	} else if utils.IsStageSuccess(execution.Status.Stages[stage]) {
+		execution.Status.Stages[stage].State = utils.StateSuccess
+		execution.Status.Stages[stage].Ended = endTime
+	} else {
+		return
+	}
+
+	if stage == len(execution.Status.Stages)-1 {
+		execution.Status.ExecutionState = utils.StateSuccess
+		execution.Status.Ended = endTime
+		execution.Labels[utils.PipelineFinishLabel] = "true"
+		v32.PipelineExecutionConditionProvisioned.True(execution)
+		v32.PipelineExecutionConditionBuilt.True(execution)
+	}
+}
+
+func failStep(execution *v3.PipelineExecution, stage int, step int, now time.Time, message string) {
+	endTime := now.Format(time.RFC3339)
+	execution.Status.Stages[stage].Steps[step].State = utils.StateFailed
+	execution.Status.Stages[stage].Steps[step].Ended = endTime
+	execution.Status.Stages[stage].State = utils.StateFailed
+	if execution.Status.Stages[stage].Ended == "" {
+		execution.Status.Stages[stage].Ended = endTime
+	}
+	if execution.Status.ExecutionState != utils.StateAborted {
+		execution.Status.ExecutionState = utils.StateFailed
+		v32.PipelineExecutionConditionBuilt.False(execution)
+		v32.PipelineExecutionConditionBuilt.Message(execution, message)
+	}
+	if execution.Status.Ended == "" {
+		execution.Status.Ended = endTime
+	}
+
+	//clean waiting status of other stages/steps
+	for i := range execution.Status.Stages {
+		if execution.Status.Stages[i].State == utils.StateWaiting {
+			execution.Status.Stages[i].State = ""
+		}
+		for j := range execution.Status.Stages[i].Steps {
+			if execution.Status.Stages[i].Steps[j].State == utils.StateWaiting {
+				execution.Status.Stages[i].Steps[j].State = ""
+			}
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package native
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	v33 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v32 "github.com/rancher/rancher/pkg/apis/project.cattle.io/v3"
+
+	"github.com/minio/minio-go"
+	"github.com/pkg/errors"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	images "github.com/rancher/rancher/pkg/image"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	"github.com/rancher/rancher/pkg/ref"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	restoreCacheContainerName = "restore-cache"
+	uploadContainerName       = "upload"
+
+	// presigned minio URLs are valid for a week at most
+	maxTransferExpiry = 7 * 24 * time.Hour
+
+	restoreCacheScript = `if curl -sf -o /tmp/cache.tgz "$CACHE_URL"; then tar xzf /tmp/cache.tgz; else echo "no cache restored"; fi`
+)
+
+// transfer holds the presigned minio URLs a step pod restores its cache from
+// and uploads its cache and artifacts to
+type transfer struct {
+	restoreCacheURL string
+	saveCacheURL    string
+	// artifactURLs is indexed like the artifacts of the step
+	artifactURLs []string
+}
+
+// cacheObjectName returns the object a cache is kept in. Caches are shared by the executions of a pipeline on the
+// same branch, so that the builds of a branch do not restore a cache saved by the builds of another one. Env vars of
+// the execution and the step are substituted in the key, which is escaped so that it cannot name the object of
+// another branch.
+func cacheObjectName(execution *v3.PipelineExecution, stepConfig *v32.Step) string {
+	_, pipelineName := ref.Parse(execution.Spec.PipelineName)
+	envs := utils.GetEnvVarMap(execution)
+	for k, v := range stepConfig.Env {
+		envs[k] = v
+	}
+	key := os.Expand(stepConfig.Cache.Key, func(name string) string {
+		return envs[name]
+	})
+	return fmt.Sprintf("%s/%s/%s.tgz", pipelineName, url.PathEscape(execution.Spec.Branch), url.PathEscape(key))
+}
+
+func artifactObjectName(execution *v3.PipelineExecution, stage int, step int, name string) string {
+	return fmt.Sprintf("%s/%d-%d-%s.tgz", execution.Name, stage, step, name)
+}
+
+func transferExpiry(execution *v3.PipelineExecution) time.Duration {
+	timeout := utils.DefaultTimeout
+	if execution.Spec.PipelineConfig.Timeout > 0 {
+		timeout = execution.Spec.PipelineConfig.Timeout
+	}
+	expiry := time.Duration(timeout)*time.Minute + time.Hour
+	if expiry > maxTransferExpiry {
+		return maxTransferExpiry
+	}
+	return expiry
+}
+
+func (e *Engine) getTransfer(execution *v3.PipelineExecution, stage int, step int) (*transfer, error) {
+	stepConfig := &execution.Spec.PipelineConfig.Stages[stage].Steps[step]
+	if stepConfig.Cache == nil && len(stepConfig.Artifacts) == 0 {
+		return nil, nil
+	}
+	client, err := e.getMinioClient(utils.GetPipelineCommonName(execution.Spec.ProjectName))
+	if err != nil {
+		return nil, err
+	}
+	expiry := transferExpiry(execution)
+	result := &transfer{}
+	if stepConfig.Cache != nil {
+		if err := ensureBucket(client, utils.MinioCacheBucket); err != nil {
+			return nil, err
+		}
+		objectName := cacheObjectName(execution, stepConfig)
+		restoreURL, err := client.PresignedGetObject(utils.MinioCacheBucket, objectName, expiry, nil)
+		if err != nil {
+			return nil, err
+		}
+		result.restoreCacheURL = restoreURL.String()
+		// pull requests build code that is not on the branch yet, they restore the cache of the branch but do not
+		// save it
+		if execution.Spec.Event != utils.WebhookEventPullRequest {
+			saveURL, err := client.PresignedPutObject(utils.MinioCacheBucket, objectName, expiry)
+			if err != nil {
+				return nil, err
+			}
+			result.saveCacheURL = saveURL.String()
+		}
+	}
+	if len(stepConfig.Artifacts) > 0 {
+		if err := ensureBucket(client, utils.MinioArtifactBucket); err != nil {
+			return nil, err
+		}
+	}
+	for _, artifact := range stepConfig.Artifacts {
+		artifactURL, err := client.PresignedPutObject(utils.MinioArtifactBucket, artifactObjectName(execution, stage, step, artifact.Name), expiry)
+		if err != nil {
+			return nil, err
+		}
+		result.artifactURLs = append(result.artifactURLs, artifactURL.String())
+	}
+	return result, nil
+}
+
+// withTransfer moves the step into the init containers of its pod, after a container restoring the cache,
+// so that the cache and artifacts are uploaded by the main container once the step succeeds
+func withTransfer(pod *corev1.Pod, stepConfig *v32.Step, t *transfer) {
+	image := images.Resolve(v33.ToolsSystemImages.PipelineSystemImages.AlpineGit)
+	workspaceMounts := []corev1.VolumeMount{
+		{
+			Name:      workspaceVolumeName,
+			MountPath: workspacePath,
+		},
+	}
+
+	if t.restoreCacheURL != "" {
+		pod.Spec.InitContainers = append(pod.Spec.InitContainers, corev1.Container{
+			Name:       restoreCacheContainerName,
+			Image:      image,
+			Command:    []string{"sh", "-c", restoreCacheScript},
+			WorkingDir: workspacePath,
+			Env: []corev1.EnvVar{
+				{
+					Name:  "CACHE_URL",
+					Value: t.restoreCacheURL,
+				},
+			},
+			VolumeMounts: workspaceMounts,
+		})
+	}
+	pod.Spec.InitContainers = append(pod.Spec.InitContainers, pod.Spec.Containers...)
+
+	upload := corev1.Container{
+		Name:         uploadContainerName,
+		Image:        image,
+		WorkingDir:   workspacePath,
+		VolumeMounts: workspaceMounts,
+	}
+	// paths are left unquoted in the script so that globs are expanded
+	script := []string{"set -e"}
+	if t.saveCacheURL != "" {
+		upload.Env = append(upload.Env,
+			corev1.EnvVar{Name: "CACHE_URL", Value: t.saveCacheURL},
+			corev1.EnvVar{Name: "CACHE_PATHS", Value: strings.Join(stepConfig.Cache.Paths, " ")},
+		)
+		script = append(script, `tar czf /tmp/cache.tgz $CACHE_PATHS && curl -sf -T /tmp/cache.tgz "$CACHE_URL" || echo "failed to save cache"`)
+	}
+	for i, artifact := range stepConfig.Artifacts {
+		upload.Env = append(upload.Env,
+			corev1.EnvVar{Name: fmt.Sprintf("ARTIFACT_%d_URL", i), Value: t.artifactURLs[i]},
+			corev1.EnvVar{Name: fmt.Sprintf("ARTIFACT_%d_PATHS", i), Value: strings.Join(artifact.Paths, " ")},
+		)
+		script = append(script,
+			fmt.Sprintf(`tar czf /tmp/artifact-%d.tgz $ARTIFACT_%d_PATHS`, i, i),
+			fmt.Sprintf(`curl -sf -T /tmp/artifact-%d.tgz "$ARTIFACT_%d_URL"`, i, i),
+		)
+	}
+	upload.Command = []string{"sh", "-c", strings.Join(script, "\n")}
+	pod.Spec.Containers = []corev1.Container{upload}
+}
+
+// uploadError returns the reason a step pod failed after its step succeeded
+func uploadError(pod *corev1.Pod) string {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == uploadContainerName && status.State.Terminated != nil && status.State.Terminated.ExitCode != 0 {
+			return "failed to upload artifacts"
+		}
+	}
+	return ""
+}
+
+func (e *Engine) GetArtifact(execution *v3.PipelineExecution, stage int, step int, name string) (io.ReadCloser, error) {
+	found := false
+	for _, artifact := range execution.Status.Artifacts {
+		if artifact.Stage == stage && artifact.Step == step && artifact.Name == name {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, errors.New("artifact not found")
+	}
+	client, err := e.getMinioClient(utils.GetPipelineCommonName(execution.Spec.ProjectName))
+	if err != nil {
+		return nil, err
+	}
+	object, err := client.GetObject(utils.MinioArtifactBucket, artifactObjectName(execution, stage, step, name), minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := object.Stat(); err != nil {
+		object.Close()
+		return nil, err
+	}
+	return object, nil
+}
@@ -0,0 +1,355 @@
+package steps
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	v33 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v32 "github.com/rancher/rancher/pkg/apis/project.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	images "github.com/rancher/rancher/pkg/image"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	"github.com/rancher/rancher/pkg/ref"
+	"github.com/rancher/rancher/pkg/settings"
+	"gopkg.in/yaml.v2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Container returns the container that runs a step of an execution. Preserved environment variables
+// in the pipeline config should be substituted with SubstituteEnvVars beforehand. gitCaCerts is the
+// CA certificate that git commands of publish catalog steps trust.
+func Container(execution *v3.PipelineExecution, stageOrdinal int, stepOrdinal int, gitCaCerts string) (v1.Container, error) {
+	stage := execution.Spec.PipelineConfig.Stages[stageOrdinal]
+	step := &stage.Steps[stepOrdinal]
+
+	container := v1.Container{
+		Name:    fmt.Sprintf("step-%d-%d", stageOrdinal, stepOrdinal),
+		TTY:     true,
+		Command: []string{"cat"},
+		Env:     []v1.EnvVar{},
+	}
+	if step.SourceCodeConfig != nil {
+		if err := configCloneStepContainer(&container); err != nil {
+			return container, err
+		}
+	} else if step.RunScriptConfig != nil {
+		configRunScriptStepContainer(&container, step)
+	} else if step.PublishImageConfig != nil {
+		configPublishStepContainer(&container, execution, step)
+	} else if step.ApplyYamlConfig != nil {
+		if err := configApplyYamlStepContainer(&container, execution, step, stageOrdinal); err != nil {
+			return container, err
+		}
+	} else if step.PublishCatalogConfig != nil {
+		if err := configPublishCatalogContainer(&container, step, gitCaCerts); err != nil {
+			return container, err
+		}
+	} else if step.ApplyAppConfig != nil {
+		if err := configApplyAppContainer(&container, step); err != nil {
+			return container, err
+		}
+	}
+
+	//common step configurations
+	for k, v := range utils.GetEnvVarMap(execution) {
+		container.Env = append(container.Env, v1.EnvVar{Name: k, Value: v})
+	}
+	for k, v := range step.Env {
+		container.Env = append(container.Env, v1.EnvVar{Name: k, Value: v})
+	}
+	if execution.Spec.Event != utils.WebhookEventPullRequest &&
+		utils.MatchAll(stage.When, execution) && utils.MatchAll(step.When, execution) {
+		//expose no secrets on pull_request events, nor to skipped steps
+		for _, e := range step.EnvFrom {
+			envName := e.SourceKey
+			if e.TargetKey != "" {
+				envName = e.TargetKey
+			}
+			container.Env = append(container.Env, v1.EnvVar{
+				Name: envName,
+				ValueFrom: &v1.EnvVarSource{SecretKeyRef: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{
+						Name: e.SourceName,
+					},
+					Key: e.SourceKey,
+				}}})
+		}
+	}
+	if step.Privileged {
+		container.SecurityContext = &v1.SecurityContext{Privileged: &step.Privileged}
+	}
+	err := InjectResources(&container, step.CPULimit, step.CPURequest, step.MemoryLimit, step.MemoryRequest)
+	return container, err
+}
+
+func configCloneStepContainer(container *v1.Container) error {
+	container.Image = images.Resolve(v33.ToolsSystemImages.PipelineSystemImages.AlpineGit)
+	return InjectResources(container, utils.PipelineToolsCPULimitDefault, utils.PipelineToolsCPURequestDefault, utils.PipelineToolsMemoryLimitDefault, utils.PipelineToolsMemoryRequestDefault)
+}
+
+func configRunScriptStepContainer(container *v1.Container, step *v32.Step) {
+	container.Image = step.RunScriptConfig.Image
+}
+
+func configPublishStepContainer(container *v1.Container, execution *v3.PipelineExecution, step *v32.Step) {
+	ns := utils.GetPipelineCommonName(execution.Spec.ProjectName)
+	config := step.PublishImageConfig
+	m := utils.GetEnvVarMap(execution)
+	config.Tag = substituteEnvVar(m, config.Tag)
+
+	registry, repo, tag := utils.SplitImageTag(config.Tag)
+
+	if config.PushRemote {
+		registry = config.Registry
+	} else {
+		_, projectID := ref.Parse(execution.Spec.ProjectName)
+		registry = fmt.Sprintf("%s.%s-pipeline", utils.LocalRegistry, projectID)
+	}
+
+	secretName := registryCredentialName(execution, registry)
+	secretUserKey := utils.PublishSecretUserKey
+	secretPwKey := utils.PublishSecretPwKey
+	if !config.PushRemote {
+		//use local registry credential
+		secretName = utils.PipelineSecretName
+		secretUserKey = utils.PipelineSecretUserKey
+		secretPwKey = utils.PipelineSecretTokenKey
+	}
+	pluginRepo := fmt.Sprintf("%s/%s", registry, repo)
+	if registry == utils.DefaultRegistry {
+		//the `plugins/docker` image fails when setting DOCKER_REGISTRY to index.docker.io
+		registry = ""
+	}
+
+	container.Image = images.Resolve(v33.ToolsSystemImages.PipelineSystemImages.PluginsDocker)
+	publishEnv := map[string]string{
+		"DOCKER_REGISTRY":   registry,
+		"PLUGIN_REPO":       pluginRepo,
+		"PLUGIN_TAG":        tag,
+		"PLUGIN_DOCKERFILE": config.DockerfilePath,
+		"PLUGIN_CONTEXT":    config.BuildContext,
+	}
+	for k, v := range publishEnv {
+		container.Env = append(container.Env, v1.EnvVar{Name: k, Value: v})
+	}
+	container.Env = append(container.Env, v1.EnvVar{
+		Name: "DOCKER_USERNAME",
+		ValueFrom: &v1.EnvVarSource{SecretKeyRef: &v1.SecretKeySelector{
+			LocalObjectReference: v1.LocalObjectReference{
+				Name: secretName,
+			},
+			Key: secretUserKey,
+		}}})
+	container.Env = append(container.Env, v1.EnvVar{
+		Name: "DOCKER_PASSWORD",
+		ValueFrom: &v1.EnvVarSource{SecretKeyRef: &v1.SecretKeySelector{
+			LocalObjectReference: v1.LocalObjectReference{
+				Name: secretName,
+			},
+			Key: secretPwKey,
+		}}})
+	privileged := true
+	container.SecurityContext = &v1.SecurityContext{Privileged: &privileged}
+	container.VolumeMounts = []v1.VolumeMount{
+		{
+			Name:      utils.RegistryCrtVolumeName,
+			MountPath: fmt.Sprintf("/etc/docker/certs.d/docker-registry.%s", ns),
+			ReadOnly:  true,
+		},
+	}
+}
+
+func configApplyYamlStepContainer(container *v1.Container, execution *v3.PipelineExecution, step *v32.Step, stageOrdinal int) error {
+	config := step.ApplyYamlConfig
+	container.Image = images.Resolve(v33.ToolsSystemImages.PipelineSystemImages.KubeApply)
+
+	applyEnv := map[string]string{
+		"YAML_PATH":    config.Path,
+		"YAML_CONTENT": config.Content,
+		"NAMESPACE":    config.Namespace,
+	}
+
+	//for deploy step, get registry & image variable from a previous publish step
+	var registry, imageRepo string
+StageLoop:
+	for i := stageOrdinal; i >= 0; i-- {
+		stage := execution.Spec.PipelineConfig.Stages[i]
+		for j := len(stage.Steps) - 1; j >= 0; j-- {
+			step := stage.Steps[j]
+			if step.PublishImageConfig != nil {
+				config := step.PublishImageConfig
+				if config.PushRemote {
+					registry = step.PublishImageConfig.Registry
+				}
+				_, imageRepo, _ = utils.SplitImageTag(step.PublishImageConfig.Tag)
+				break StageLoop
+			}
+		}
+	}
+
+	applyEnv[utils.EnvRegistry] = registry
+	applyEnv[utils.EnvImageRepo] = imageRepo
+
+	for k, v := range applyEnv {
+		container.Env = append(container.Env, v1.EnvVar{Name: k, Value: v})
+	}
+	return InjectResources(container, utils.PipelineToolsCPULimitDefault, utils.PipelineToolsCPURequestDefault, utils.PipelineToolsMemoryLimitDefault, utils.PipelineToolsMemoryRequestDefault)
+}
+
+func configPublishCatalogContainer(container *v1.Container, step *v32.Step, gitCaCerts string) error {
+	if gitCaCerts != "" {
+		InjectGitCaCert(container)
+	}
+	config := step.PublishCatalogConfig
+	container.Image = images.Resolve(v33.ToolsSystemImages.PipelineSystemImages.KubeApply)
+	envs := map[string]string{
+		"CATALOG_PATH":          config.Path,
+		"CATALOG_TEMPLATE_NAME": config.CatalogTemplate,
+		"VERSION":               config.Version,
+		"GIT_AUTHOR":            config.GitAuthor,
+		"GIT_EMAIL":             config.GitEmail,
+		"GIT_URL":               config.GitURL,
+		"GIT_BRANCH":            config.GitBranch,
+	}
+	for k, v := range envs {
+		container.Env = append(container.Env, v1.EnvVar{Name: k, Value: v})
+	}
+	var customEnvs []string
+	for k := range step.Env {
+		customEnvs = append(customEnvs, k)
+	}
+	container.Env = append(container.Env, v1.EnvVar{Name: "CICD_SUBSTITUTE_VARS", Value: strings.Join(customEnvs, ",")})
+	return InjectResources(container, utils.PipelineToolsCPULimitDefault, utils.PipelineToolsCPURequestDefault, utils.PipelineToolsMemoryLimitDefault, utils.PipelineToolsMemoryRequestDefault)
+}
+
+func configApplyAppContainer(container *v1.Container, step *v32.Step) error {
+	config := step.ApplyAppConfig
+	container.Image = images.Resolve(v33.ToolsSystemImages.PipelineSystemImages.KubeApply)
+	answerBytes, _ := yaml.Marshal(config.Answers)
+	envs := map[string]string{
+		"APP_NAME":              config.Name,
+		"ANSWERS":               string(answerBytes),
+		"CATALOG_TEMPLATE_NAME": config.CatalogTemplate,
+		"VERSION":               config.Version,
+		"TARGET_NAMESPACE":      config.TargetNamespace,
+		"RANCHER_URL":           settings.ServerURL.Get(),
+	}
+	for k, v := range envs {
+		container.Env = append(container.Env, v1.EnvVar{Name: k, Value: v})
+	}
+	container.Env = append(container.Env, v1.EnvVar{
+		Name: utils.PipelineSecretAPITokenKey,
+		ValueFrom: &v1.EnvVarSource{SecretKeyRef: &v1.SecretKeySelector{
+			LocalObjectReference: v1.LocalObjectReference{
+				Name: utils.PipelineAPIKeySecretName,
+			},
+			Key: utils.PipelineSecretAPITokenKey,
+		}}})
+	return InjectResources(container, utils.PipelineToolsCPULimitDefault, utils.PipelineToolsCPURequestDefault, utils.PipelineToolsMemoryLimitDefault, utils.PipelineToolsMemoryRequestDefault)
+}
+
+// InjectGitCaCert makes git commands in a container trust the CA certificate mounted at utils.GitCaCertPath
+func InjectGitCaCert(container *v1.Container) {
+	container.Env = append(container.Env, v1.EnvVar{
+		Name:  "GIT_SSL_CAINFO",
+		Value: utils.GitCaCertPath + "/ca.crt",
+	})
+	container.VolumeMounts = append(container.VolumeMounts, v1.VolumeMount{
+		Name:      utils.GitCaCertVolumeName,
+		MountPath: utils.GitCaCertPath,
+	})
+}
+
+// InjectResources sets the resource requirements of a container, leaving the empty ones unset
+func InjectResources(container *v1.Container, cpuLimit string, cpuRequest string, memoryLimit string, memoryRequest string) error {
+	if cpuLimit != "" {
+		if container.Resources.Limits == nil {
+			container.Resources.Limits = v1.ResourceList{}
+		}
+		quantity, err := resource.ParseQuantity(cpuLimit)
+		if err != nil {
+			return errors.Wrapf(err, "invalid CPU limit %q", cpuLimit)
+		}
+
+		container.Resources.Limits[v1.ResourceCPU] = quantity
+	}
+	if cpuRequest != "" {
+		if container.Resources.Requests == nil {
+			container.Resources.Requests = v1.ResourceList{}
+		}
+		quantity, err := resource.ParseQuantity(cpuRequest)
+		if err != nil {
+			return errors.Wrapf(err, "invalid CPU request %q", cpuRequest)
+		}
+
+		container.Resources.Requests[v1.ResourceCPU] = quantity
+	}
+	if memoryLimit != "" {
+		if container.Resources.Limits == nil {
+			container.Resources.Limits = v1.ResourceList{}
+		}
+		quantity, err := resource.ParseQuantity(memoryLimit)
+		if err != nil {
+			return errors.Wrapf(err, "invalid memory limit %q", memoryLimit)
+		}
+
+		container.Resources.Limits[v1.ResourceMemory] = quantity
+	}
+	if memoryRequest != "" {
+		if container.Resources.Requests == nil {
+			container.Resources.Requests = v1.ResourceList{}
+		}
+		quantity, err := resource.ParseQuantity(memoryRequest)
+		if err != nil {
+			return errors.Wrapf(err, "invalid memory request %q", memoryRequest)
+		}
+
+		container.Resources.Requests[v1.ResourceMemory] = quantity
+	}
+	return nil
+}
+
+// SubstituteEnvVars substitutes preserved environment variables in the step configs of an execution
+func SubstituteEnvVars(execution *v3.PipelineExecution) {
+	m := utils.GetEnvVarMap(execution)
+	pipelineConfig := execution.Spec.PipelineConfig
+
+	//environment variables substitution in configs
+	for _, stage := range pipelineConfig.Stages {
+		for _, step := range stage.Steps {
+			if step.RunScriptConfig != nil {
+				step.RunScriptConfig.Image = substituteEnvVar(m, step.RunScriptConfig.Image)
+			} else if step.PublishImageConfig != nil {
+				step.PublishImageConfig.Tag = substituteEnvVar(m, step.PublishImageConfig.Tag)
+			} else if step.ApplyYamlConfig != nil {
+				step.ApplyYamlConfig.Path = substituteEnvVar(m, step.ApplyYamlConfig.Path)
+				step.ApplyYamlConfig.Content = substituteEnvVar(m, step.ApplyYamlConfig.Content)
+			} else if step.PublishCatalogConfig != nil {
+				step.PublishCatalogConfig.Path = substituteEnvVar(m, step.PublishCatalogConfig.Path)
+				step.PublishCatalogConfig.CatalogTemplate = substituteEnvVar(m, step.PublishCatalogConfig.CatalogTemplate)
+				step.PublishCatalogConfig.Version = substituteEnvVar(m, step.PublishCatalogConfig.Version)
+			} else if step.ApplyAppConfig != nil {
+				step.ApplyAppConfig.CatalogTemplate = substituteEnvVar(m, step.ApplyAppConfig.CatalogTemplate)
+				step.ApplyAppConfig.Version = substituteEnvVar(m, step.ApplyAppConfig.Version)
+				step.ApplyAppConfig.Name = substituteEnvVar(m, step.ApplyAppConfig.Name)
+				step.ApplyAppConfig.TargetNamespace = substituteEnvVar(m, step.ApplyAppConfig.TargetNamespace)
+				for k, v := range step.ApplyAppConfig.Answers {
+					step.ApplyAppConfig.Answers[k] = substituteEnvVar(m, v)
+				}
+			}
+			for k, v := range step.Env {
+				step.Env[k] = substituteEnvVar(m, v)
+			}
+		}
+	}
+}
+
+func substituteEnvVar(envvar map[string]string, raw string) string {
+	result := raw
+	for k, v := range envvar {
+		result = strings.Replace(result, "${"+k+"}", v, -1)
+	}
+	return result
+}
@@ -0,0 +1,50 @@
+package steps
+
+import (
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/project.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestContainerEnvFrom(t *testing.T) {
+	assert := assert.New(t)
+
+	execution := &v3.PipelineExecution{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "p-test", Name: "pipeline-1"},
+		Spec: v32.PipelineExecutionSpec{
+			ProjectName: "c-test:p-test",
+			Branch:      "master",
+			PipelineConfig: v32.PipelineConfig{
+				Stages: []v32.Stage{{Name: "test", Steps: []v32.Step{{
+					RunScriptConfig: &v32.RunScriptConfig{Image: "golang:${CICD_GIT_BRANCH}", ShellScript: "go test ./..."},
+					EnvFrom:         []v32.EnvFrom{{SourceName: "token", SourceKey: "value", TargetKey: "TOKEN"}},
+				}}}},
+			},
+		},
+	}
+
+	SubstituteEnvVars(execution)
+	container, err := Container(execution, 0, 0, "")
+	assert.Nil(err)
+	assert.Equal("step-0-0", container.Name)
+	assert.Equal("golang:master", container.Image)
+	hasSecret := false
+	for _, env := range container.Env {
+		if env.Name == "TOKEN" {
+			hasSecret = true
+			assert.Equal("token", env.ValueFrom.SecretKeyRef.Name)
+		}
+	}
+	assert.True(hasSecret)
+
+	execution.Spec.Event = utils.WebhookEventPullRequest
+	container, err = Container(execution, 0, 0, "")
+	assert.Nil(err)
+	for _, env := range container.Env {
+		assert.NotEqual("TOKEN", env.Name, "secrets are not exposed to pull requests")
+	}
+}
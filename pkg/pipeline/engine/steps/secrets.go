@@ -0,0 +1,199 @@
+package steps
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/rancher/norman/types/convert"
+	v32 "github.com/rancher/rancher/pkg/apis/project.cattle.io/v3"
+	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	"github.com/rancher/rancher/pkg/ref"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// SecretGetter gets a secret of the cluster that runs the pipeline
+type SecretGetter func(namespace, name string) (*corev1.Secret, error)
+
+func registryCredentialName(execution *v3.PipelineExecution, registry string) string {
+	reg, _ := regexp.Compile("[^a-zA-Z0-9]+")
+	processedRegistry := strings.ToLower(reg.ReplaceAllString(registry, ""))
+	return fmt.Sprintf("%s-%s", execution.Namespace, processedRegistry)
+}
+
+func secretNotAvailable(kind string, name string, execution *v3.PipelineExecution) error {
+	if execution.Spec.Event == utils.WebhookEventPullRequest {
+		return fmt.Errorf("%s %s is not available to pull requests", kind, name)
+	}
+	return fmt.Errorf("%s %s is not available to %s", kind, name, execution.Spec.Branch)
+}
+
+// PrepareRegistryCredentials stores the credentials of the registries that publish steps push to
+// in the pipeline namespace. It fails when a step that is not skipped uses a secret or a registry
+// credential that is bound to other branches.
+func PrepareRegistryCredentials(execution *v3.PipelineExecution, managementSecretLister v1.SecretLister, secrets v1.SecretInterface) error {
+	//registries in order of appearance, and whether steps that are not skipped push to them
+	var registries []string
+	registryUsed := map[string]bool{}
+	for _, stage := range execution.Spec.PipelineConfig.Stages {
+		for _, step := range stage.Steps {
+			skipped := !utils.MatchAll(stage.When, execution) || !utils.MatchAll(step.When, execution)
+			if !skipped {
+				if err := checkEnvFromSecrets(execution, &step, secrets); err != nil {
+					return err
+				}
+			}
+			if step.PublishImageConfig != nil {
+				//prepare docker credential for publishimage step
+				registry := utils.DefaultRegistry
+				if step.PublishImageConfig.PushRemote && step.PublishImageConfig.Registry != "" {
+					registry = step.PublishImageConfig.Registry
+				} else {
+					_, projectID := ref.Parse(execution.Spec.ProjectName)
+					registry = fmt.Sprintf("%s.%s-pipeline", utils.LocalRegistry, projectID)
+				}
+				if _, ok := registryUsed[registry]; !ok {
+					registries = append(registries, registry)
+				}
+				registryUsed[registry] = registryUsed[registry] || !skipped
+			}
+		}
+	}
+	for _, registry := range registries {
+		if err := prepareRegistryCredential(execution, registry, registryUsed[registry], managementSecretLister, secrets); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkEnvFromSecrets fails when a step reads a secret that is bound to other branches
+func checkEnvFromSecrets(execution *v3.PipelineExecution, step *v32.Step, secrets v1.SecretInterface) error {
+	if execution.Spec.Event == utils.WebhookEventPullRequest {
+		//secrets are not exposed on pull_request events
+		return nil
+	}
+	ns := utils.GetPipelineCommonName(execution.Spec.ProjectName)
+	for _, e := range step.EnvFrom {
+		secret, err := secrets.GetNamespaced(ns, e.SourceName, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if !utils.SecretAllowsExecution(secret, execution) {
+			return secretNotAvailable("secret", e.SourceName, execution)
+		}
+	}
+	return nil
+}
+
+// prepareRegistryCredential stores the credential of a registry in the pipeline namespace. Credentials
+// bound to other branches are left empty when only skipped steps use them, and fail the execution otherwise.
+func prepareRegistryCredential(execution *v3.PipelineExecution, registry string, used bool, managementSecretLister v1.SecretLister, secrets v1.SecretInterface) error {
+	credentials, err := managementSecretLister.List(execution.Namespace, labels.Everything())
+	if err != nil {
+		return err
+	}
+	username := ""
+	password := ""
+	for _, s := range credentials {
+		if s.Type == "kubernetes.io/dockerconfigjson" {
+			m := map[string]interface{}{}
+			if err := json.Unmarshal(s.Data[".dockerconfigjson"], &m); err != nil {
+				return err
+			}
+			auths := convert.ToMapInterface(m["auths"])
+			for k, v := range auths {
+				if registry != k {
+					//find matching registry credential
+					continue
+				}
+				if !utils.SecretAllowsExecution(s, execution) {
+					if used {
+						return secretNotAvailable("registry credential", s.Name, execution)
+					}
+					continue
+				}
+				cred := convert.ToMapInterface(v)
+				username, _ = cred["username"].(string)
+				password, _ = cred["password"].(string)
+			}
+
+		}
+
+	}
+
+	//store dockercredential in pipeline namespace
+	//TODO key-key mapping instead of registry-key mapping
+	secretName := registryCredentialName(execution, registry)
+	logrus.Debugf("preparing registry credential %s for %s", secretName, registry)
+	ns := utils.GetPipelineCommonName(execution.Spec.ProjectName)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ns,
+			Name:      secretName,
+		},
+		Data: map[string][]byte{
+			utils.PublishSecretUserKey: []byte(username),
+			utils.PublishSecretPwKey:   []byte(password),
+		},
+	}
+	_, err = secrets.Create(secret)
+	if apierrors.IsAlreadyExists(err) {
+		if _, err := secrets.Update(secret); err != nil {
+			return err
+		}
+		return nil
+	}
+	return err
+}
+
+// SecretValues returns the values of the secrets that the steps of an execution read and of
+// the registry credentials that they push images with, so that they can be masked in logs
+func SecretValues(execution *v3.PipelineExecution, getSecret SecretGetter) ([]string, error) {
+	ns := utils.GetPipelineCommonName(execution.Spec.ProjectName)
+	var values []string
+	addValue := func(name string, key string) error {
+		secret, err := getSecret(ns, name)
+		if apierrors.IsNotFound(err) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if value := string(secret.Data[key]); value != "" {
+			values = append(values, value)
+		}
+		return nil
+	}
+
+	for _, stage := range execution.Spec.PipelineConfig.Stages {
+		for _, step := range stage.Steps {
+			for _, e := range step.EnvFrom {
+				if err := addValue(e.SourceName, e.SourceKey); err != nil {
+					return nil, err
+				}
+			}
+			if step.PublishImageConfig == nil {
+				continue
+			}
+			if !step.PublishImageConfig.PushRemote {
+				if err := addValue(utils.PipelineSecretName, utils.PipelineSecretTokenKey); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			if err := addValue(registryCredentialName(execution, step.PublishImageConfig.Registry), utils.PublishSecretPwKey); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return values, nil
+}
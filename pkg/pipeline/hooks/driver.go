@@ -14,7 +14,8 @@ type Driver interface {
 }
 
 func RegisterDrivers(Management *config.ScaledContext) {
-	pipelineLister := Management.Project.Pipelines("").Controller().Lister()
+	pipelines := Management.Project.Pipelines("")
+	pipelineLister := pipelines.Controller().Lister()
 	pipelineExecutions := Management.Project.PipelineExecutions("")
 	sourceCodeCredentials := Management.Project.SourceCodeCredentials("")
 	sourceCodeCredentialLister := Management.Project.SourceCodeCredentials("").Controller().Lister()
@@ -44,4 +45,17 @@ func RegisterDrivers(Management *config.ScaledContext) {
 		SourceCodeCredentials:      sourceCodeCredentials,
 		SourceCodeCredentialLister: sourceCodeCredentialLister,
 	}
+	Drivers[drivers.GiteaWebhookHeader] = drivers.GiteaDriver{
+		PipelineLister:             pipelineLister,
+		PipelineExecutions:         pipelineExecutions,
+		SourceCodeCredentials:      sourceCodeCredentials,
+		SourceCodeCredentialLister: sourceCodeCredentialLister,
+	}
+	Drivers[drivers.GitWebhookHeader] = drivers.GitDriver{
+		PipelineLister:             pipelineLister,
+		Pipelines:                  pipelines,
+		PipelineExecutions:         pipelineExecutions,
+		SourceCodeCredentials:      sourceCodeCredentials,
+		SourceCodeCredentialLister: sourceCodeCredentialLister,
+	}
 }
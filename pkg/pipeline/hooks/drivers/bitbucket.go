@@ -111,6 +111,7 @@ func parseBitbucketPullRequestPayload(raw []byte) (*model.BuildInfo, error) {
 	info.Title = payload.PullRequest.Title
 	info.Message = payload.PullRequest.Title
 	info.Commit = payload.PullRequest.Source.Commit.Hash
+	info.FromFork = payload.PullRequest.Source.Repository.FullName != payload.PullRequest.Destination.Repository.FullName
 	info.Author = payload.PullRequest.Author.UserName
 	info.AvatarURL = payload.PullRequest.Author.Links.Avatar.Href
 	return info, nil
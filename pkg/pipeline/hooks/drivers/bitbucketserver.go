@@ -131,6 +131,7 @@ func parseBitbucketServerPullRequestPayload(raw []byte) (*model.BuildInfo, error
 	info.Title = payload.PullRequest.Title
 	info.Message = payload.PullRequest.Title
 	info.Commit = payload.PullRequest.FromRef.LatestCommit
+	info.FromFork = payload.PullRequest.FromRef.Repository.ID != payload.PullRequest.ToRef.Repository.ID
 	info.Author = payload.PullRequest.Author.User.Name
 	if len(payload.PullRequest.Author.User.Links.Self) > 0 {
 		info.AvatarURL = payload.PullRequest.Author.User.Links.Self[0].Href + "/avatar.png"
@@ -0,0 +1,124 @@
+package drivers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/pipeline/remote/model"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	"github.com/rancher/rancher/pkg/ref"
+)
+
+const (
+	// GitWebhookHeader is sent by the post-receive hooks of plain git servers to notify pushes
+	// without waiting for the next poll of the repository
+	GitWebhookHeader = "X-Git-Event"
+	GitTokenHeader   = "X-Git-Token"
+	gitPushEvent     = "push"
+)
+
+type GitDriver struct {
+	PipelineLister             v3.PipelineLister
+	Pipelines                  v3.PipelineInterface
+	PipelineExecutions         v3.PipelineExecutionInterface
+	SourceCodeCredentials      v3.SourceCodeCredentialInterface
+	SourceCodeCredentialLister v3.SourceCodeCredentialLister
+}
+
+type gitPushPayload struct {
+	Ref     string `json:"ref"`
+	Commit  string `json:"commit"`
+	Message string `json:"message,omitempty"`
+	Author  string `json:"author,omitempty"`
+	Email   string `json:"email,omitempty"`
+}
+
+func (g GitDriver) Execute(req *http.Request) (int, error) {
+	var token string
+	if token = req.Header.Get(GitTokenHeader); len(token) == 0 {
+		return http.StatusUnprocessableEntity, errors.New("git webhook missing token")
+	}
+	event := req.Header.Get(GitWebhookHeader)
+	if event != gitPushEvent {
+		return http.StatusUnprocessableEntity, fmt.Errorf("not trigger for event:%s", event)
+	}
+
+	pipelineID := req.URL.Query().Get("pipelineId")
+	ns, name := ref.Parse(pipelineID)
+	pipeline, err := g.PipelineLister.Get(ns, name)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return http.StatusUnprocessableEntity, err
+	}
+
+	if pipeline.Status.Token != token {
+		return http.StatusUnprocessableEntity, errors.New("git webhook invalid token")
+	}
+
+	if pipeline.Status.PipelineState == "inactive" {
+		return http.StatusUnavailableForLegalReasons, errors.New("pipeline is not active")
+	}
+
+	info, err := gitParsePushPayload(body)
+	if err != nil {
+		return http.StatusUnprocessableEntity, err
+	}
+
+	code, err := validateAndGeneratePipelineExecution(g.PipelineExecutions, g.SourceCodeCredentials, g.SourceCodeCredentialLister, info, pipeline)
+	if err != nil {
+		return code, err
+	}
+	// record the notified commit so that the poller does not trigger it again
+	if pipeline.Status.PolledRefs[info.Ref] != info.Commit {
+		toUpdate := pipeline.DeepCopy()
+		if toUpdate.Status.PolledRefs == nil {
+			toUpdate.Status.PolledRefs = map[string]string{}
+		}
+		toUpdate.Status.PolledRefs[info.Ref] = info.Commit
+		if _, err := g.Pipelines.Update(toUpdate); err != nil {
+			return http.StatusInternalServerError, err
+		}
+	}
+	return code, nil
+}
+
+func gitParsePushPayload(raw []byte) (*model.BuildInfo, error) {
+	payload := &gitPushPayload{}
+	if err := json.Unmarshal(raw, payload); err != nil {
+		return nil, err
+	}
+	if payload.Ref == "" || payload.Commit == "" {
+		return nil, errors.New("git webhook requires both a ref and a commit")
+	}
+
+	info := &model.BuildInfo{}
+	info.TriggerType = utils.TriggerTypeWebhook
+	info.Commit = payload.Commit
+	info.Ref = payload.Ref
+	info.Message = payload.Message
+	info.Author = payload.Author
+	info.Sender = payload.Author
+	info.Email = payload.Email
+
+	if strings.HasPrefix(payload.Ref, RefsTagPrefix) {
+		info.Event = utils.WebhookEventTag
+		info.Branch = strings.TrimPrefix(payload.Ref, RefsTagPrefix)
+		if info.Message == "" {
+			info.Message = "tag " + info.Branch
+		}
+	} else if strings.HasPrefix(payload.Ref, RefsBranchPrefix) {
+		info.Event = utils.WebhookEventPush
+		info.Branch = strings.TrimPrefix(payload.Ref, RefsBranchPrefix)
+	} else {
+		return nil, fmt.Errorf("no trigger for ref %s", payload.Ref)
+	}
+	return info, nil
+}
@@ -0,0 +1,220 @@
+package drivers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/pipeline/remote/model"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	"github.com/rancher/rancher/pkg/ref"
+)
+
+const (
+	GiteaWebhookHeader   = "X-Gitea-Event"
+	giteaSignatureHeader = "X-Gitea-Signature"
+	giteaPushEvent       = "push"
+	giteaPREvent         = "pull_request"
+
+	giteaActionOpen   = "opened"
+	giteaActionReopen = "reopened"
+	giteaActionSync   = "synchronized"
+
+	giteaStateOpen = "open"
+)
+
+type GiteaDriver struct {
+	PipelineLister             v3.PipelineLister
+	PipelineExecutions         v3.PipelineExecutionInterface
+	SourceCodeCredentials      v3.SourceCodeCredentialInterface
+	SourceCodeCredentialLister v3.SourceCodeCredentialLister
+}
+
+type giteaUser struct {
+	Login     string `json:"login"`
+	Email     string `json:"email"`
+	AvatarURL string `json:"avatar_url"`
+}
+
+type giteaCommit struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
+	URL     string `json:"url"`
+	Author  struct {
+		Name     string `json:"name"`
+		Email    string `json:"email"`
+		UserName string `json:"username"`
+	} `json:"author"`
+}
+
+type giteaPushPayload struct {
+	Ref        string         `json:"ref"`
+	After      string         `json:"after"`
+	CompareURL string         `json:"compare_url"`
+	HeadCommit *giteaCommit   `json:"head_commit"`
+	Commits    []*giteaCommit `json:"commits"`
+	Pusher     *giteaUser     `json:"pusher"`
+	Sender     *giteaUser     `json:"sender"`
+}
+
+type giteaPullRequestPayload struct {
+	Action      string `json:"action"`
+	Number      int64  `json:"number"`
+	PullRequest *struct {
+		Number  int64      `json:"number"`
+		Title   string     `json:"title"`
+		HTMLURL string     `json:"html_url"`
+		State   string     `json:"state"`
+		User    *giteaUser `json:"user"`
+		Head    struct {
+			Ref    string `json:"ref"`
+			SHA    string `json:"sha"`
+			RepoID int64  `json:"repo_id"`
+		} `json:"head"`
+		Base struct {
+			Ref    string `json:"ref"`
+			RepoID int64  `json:"repo_id"`
+		} `json:"base"`
+	} `json:"pull_request"`
+	Sender *giteaUser `json:"sender"`
+}
+
+func (g GiteaDriver) Execute(req *http.Request) (int, error) {
+	var signature string
+	if signature = req.Header.Get(giteaSignatureHeader); len(signature) == 0 {
+		return http.StatusUnprocessableEntity, errors.New("gitea webhook missing signature")
+	}
+	event := req.Header.Get(GiteaWebhookHeader)
+	if event != giteaPushEvent && event != giteaPREvent {
+		return http.StatusUnprocessableEntity, fmt.Errorf("not trigger for event:%s", event)
+	}
+
+	pipelineID := req.URL.Query().Get("pipelineId")
+	ns, name := ref.Parse(pipelineID)
+	pipeline, err := g.PipelineLister.Get(ns, name)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return http.StatusUnprocessableEntity, err
+	}
+	if match := verifyGiteaWebhookSignature([]byte(pipeline.Status.Token), signature, body); !match {
+		return http.StatusUnprocessableEntity, errors.New("gitea webhook invalid signature")
+	}
+
+	if pipeline.Status.PipelineState == "inactive" {
+		return http.StatusUnavailableForLegalReasons, errors.New("pipeline is not active")
+	}
+
+	info := &model.BuildInfo{}
+	if event == giteaPushEvent {
+		info, err = giteaParsePushPayload(body)
+		if err != nil {
+			return http.StatusUnprocessableEntity, err
+		}
+	} else if event == giteaPREvent {
+		info, err = giteaParsePullRequestPayload(body)
+		if err != nil {
+			return http.StatusUnavailableForLegalReasons, err
+		}
+	}
+
+	return validateAndGeneratePipelineExecution(g.PipelineExecutions, g.SourceCodeCredentials, g.SourceCodeCredentialLister, info, pipeline)
+}
+
+func verifyGiteaWebhookSignature(secret []byte, signature string, body []byte) bool {
+	actual, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	computed := hmac.New(sha256.New, secret)
+	computed.Write(body)
+
+	return hmac.Equal(computed.Sum(nil), actual)
+}
+
+func giteaParsePushPayload(raw []byte) (*model.BuildInfo, error) {
+	info := &model.BuildInfo{}
+	payload := &giteaPushPayload{}
+	if err := json.Unmarshal(raw, payload); err != nil {
+		return nil, err
+	}
+	head := payload.HeadCommit
+	if head == nil && len(payload.Commits) > 0 {
+		head = payload.Commits[len(payload.Commits)-1]
+	}
+
+	info.TriggerType = utils.TriggerTypeWebhook
+	info.Commit = payload.After
+	info.Ref = payload.Ref
+	info.HTMLLink = payload.CompareURL
+	if head != nil {
+		info.HTMLLink = head.URL
+		info.Message = head.Message
+		info.Email = head.Author.Email
+	}
+	if payload.Sender != nil {
+		info.AvatarURL = payload.Sender.AvatarURL
+		info.Author = payload.Sender.Login
+		info.Sender = payload.Sender.Login
+	}
+
+	if strings.HasPrefix(payload.Ref, RefsTagPrefix) {
+		//git tag is triggered as a push event
+		info.Event = utils.WebhookEventTag
+		info.Branch = strings.TrimPrefix(payload.Ref, RefsTagPrefix)
+		info.Message = "tag " + info.Branch
+	} else {
+		info.Event = utils.WebhookEventPush
+		info.Branch = strings.TrimPrefix(payload.Ref, RefsBranchPrefix)
+	}
+	return info, nil
+}
+
+func giteaParsePullRequestPayload(raw []byte) (*model.BuildInfo, error) {
+	info := &model.BuildInfo{}
+	payload := &giteaPullRequestPayload{}
+	if err := json.Unmarshal(raw, payload); err != nil {
+		return nil, err
+	}
+
+	action := payload.Action
+	if action != giteaActionOpen && action != giteaActionReopen && action != giteaActionSync {
+		return nil, fmt.Errorf("no trigger for %s action", action)
+	}
+	pr := payload.PullRequest
+	if pr == nil {
+		return nil, errors.New("gitea webhook missing pull request")
+	}
+	if pr.State != giteaStateOpen {
+		return nil, fmt.Errorf("no trigger for closed pull requests")
+	}
+
+	info.TriggerType = utils.TriggerTypeWebhook
+	info.Event = utils.WebhookEventPullRequest
+	info.Branch = pr.Base.Ref
+	info.Ref = fmt.Sprintf("refs/pull/%d/head", pr.Number)
+	info.HTMLLink = pr.HTMLURL
+	info.Title = pr.Title
+	info.Message = pr.Title
+	info.Commit = pr.Head.SHA
+	info.FromFork = pr.Head.RepoID != pr.Base.RepoID
+	if pr.User != nil {
+		info.Author = pr.User.Login
+		info.AvatarURL = pr.User.AvatarURL
+		info.Email = pr.User.Email
+	}
+	if payload.Sender != nil {
+		info.Sender = payload.Sender.Login
+	}
+	return info, nil
+}
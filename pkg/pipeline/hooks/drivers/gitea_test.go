@@ -0,0 +1,99 @@
+package drivers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testGiteaPushPayload = `{
+  "ref": "refs/heads/main",
+  "after": "a1b2c3",
+  "head_commit": {"id": "a1b2c3", "message": "fix build", "url": "https://gitea.example.com/org/app/commit/a1b2c3", "author": {"email": "dev@example.com"}},
+  "sender": {"login": "dev", "avatar_url": "https://gitea.example.com/avatar/dev"}
+}`
+	testGiteaTagPayload = `{
+  "ref": "refs/tags/v1.0.0",
+  "after": "d4e5f6",
+  "sender": {"login": "dev"}
+}`
+	testGiteaPullRequestPayload = `{
+  "action": "synchronized",
+  "number": 7,
+  "pull_request": {
+    "number": 7,
+    "title": "Add feature",
+    "html_url": "https://gitea.example.com/org/app/pulls/7",
+    "state": "open",
+    "user": {"login": "contributor"},
+    "head": {"ref": "feature", "sha": "0f9e8d", "repo_id": 12},
+    "base": {"ref": "main", "repo_id": 3}
+  },
+  "sender": {"login": "contributor"}
+}`
+)
+
+func TestVerifyGiteaWebhookSignature(t *testing.T) {
+	assert := assert.New(t)
+	body := []byte(testGiteaPushPayload)
+	mac := hmac.New(sha256.New, []byte("token"))
+	mac.Write(body)
+	signature := hex.EncodeToString(mac.Sum(nil))
+
+	assert.True(verifyGiteaWebhookSignature([]byte("token"), signature, body))
+	assert.False(verifyGiteaWebhookSignature([]byte("other"), signature, body))
+	assert.False(verifyGiteaWebhookSignature([]byte("token"), "not-hex", body))
+}
+
+func TestGiteaParsePayloads(t *testing.T) {
+	assert := assert.New(t)
+
+	info, err := giteaParsePushPayload([]byte(testGiteaPushPayload))
+	assert.Nil(err)
+	assert.Equal(utils.WebhookEventPush, info.Event)
+	assert.Equal("main", info.Branch)
+	assert.Equal("a1b2c3", info.Commit)
+	assert.Equal("fix build", info.Message)
+	assert.Equal("dev", info.Author)
+
+	info, err = giteaParsePushPayload([]byte(testGiteaTagPayload))
+	assert.Nil(err)
+	assert.Equal(utils.WebhookEventTag, info.Event)
+	assert.Equal("v1.0.0", info.Branch)
+	assert.Equal("d4e5f6", info.Commit)
+
+	info, err = giteaParsePullRequestPayload([]byte(testGiteaPullRequestPayload))
+	assert.Nil(err)
+	assert.Equal(utils.WebhookEventPullRequest, info.Event)
+	assert.Equal("main", info.Branch)
+	assert.Equal("refs/pull/7/head", info.Ref)
+	assert.Equal("0f9e8d", info.Commit)
+	assert.True(info.FromFork)
+
+	_, err = giteaParsePullRequestPayload([]byte(`{"action": "closed", "pull_request": {"state": "closed"}}`))
+	assert.NotNil(err)
+}
+
+func TestGitParsePushPayload(t *testing.T) {
+	assert := assert.New(t)
+
+	info, err := gitParsePushPayload([]byte(`{"ref": "refs/heads/release", "commit": "abc"}`))
+	assert.Nil(err)
+	assert.Equal(utils.WebhookEventPush, info.Event)
+	assert.Equal("release", info.Branch)
+
+	info, err = gitParsePushPayload([]byte(`{"ref": "refs/tags/v2", "commit": "def"}`))
+	assert.Nil(err)
+	assert.Equal(utils.WebhookEventTag, info.Event)
+	assert.Equal("v2", info.Branch)
+
+	_, err = gitParsePushPayload([]byte(`{"ref": "refs/heads/release"}`))
+	assert.NotNil(err)
+	_, err = gitParsePushPayload([]byte(`{"ref": "refs/notes/commits", "commit": "abc"}`))
+	assert.NotNil(err)
+}
@@ -156,5 +156,6 @@ func parsePullRequestPayload(raw []byte) (*model.BuildInfo, error) {
 	info.AvatarURL = payload.PullRequest.User.GetAvatarURL()
 	info.Email = payload.PullRequest.User.GetEmail()
 	info.Sender = payload.Sender.GetLogin()
+	info.FromFork = payload.PullRequest.Head.Repo.GetFullName() != payload.PullRequest.Base.Repo.GetFullName()
 	return info, nil
 }
@@ -142,6 +142,7 @@ func gitlabParseMergeRequestPayload(raw []byte) (*model.BuildInfo, error) {
 	info.AvatarURL = payload.User.AvatarURL
 	info.Email = payload.User.Email
 	info.Sender = payload.User.Name
+	info.FromFork = payload.ObjectAttributes.SourceProjectID != payload.ObjectAttributes.TargetProjectID
 
 	return info, nil
 }
@@ -3,6 +3,7 @@
 import (
 	"net/http"
 
+	"github.com/rancher/rancher/pkg/pipeline/hooks/drivers"
 	"github.com/rancher/rancher/pkg/types/config"
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/util/json"
@@ -23,6 +24,10 @@ func (h *WebhookHandler) initDrivers(management *config.ScaledContext) {
 
 func (h *WebhookHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	for key, driver := range Drivers {
+		// gitea also sends the github headers along with its own
+		if key == drivers.GithubWebhookHeader && req.Header.Get(drivers.GiteaWebhookHeader) != "" {
+			continue
+		}
 		if exist := req.Header.Get(key); exist != "" {
 			code, err := driver.Execute(req)
 			if err != nil {
@@ -146,6 +146,14 @@ func (b BaseProvider) AuthAddAccount(userID string, code string, config interfac
 	if err != nil {
 		return nil, err
 	}
+	return b.AddAccount(userID, account, projectID, sourceCodeType)
+}
+
+// AddAccount stores the source code credential of a user, replacing the one the user already has for the same login
+func (b BaseProvider) AddAccount(userID string, account *v3.SourceCodeCredential, projectID string, sourceCodeType string) (*v3.SourceCodeCredential, error) {
+	if userID == "" {
+		return nil, errors.New("unauth")
+	}
 	_, projectName := ref.Parse(projectID)
 	account.Name = normalizeName(fmt.Sprintf("%s-%s-%s", projectName, sourceCodeType, account.Spec.LoginName))
 	account.Namespace = userID
@@ -166,7 +174,7 @@ func (b BaseProvider) AuthAddAccount(userID string, code string, config interfac
 			return nil, err
 		}
 	}
-	_, err = b.SourceCodeCredentials.Create(account)
+	_, err := b.SourceCodeCredentials.Create(account)
 	if apierror.IsAlreadyExists(err) {
 		exist, err := b.SourceCodeCredentialLister.Get(userID, account.Name)
 		if err != nil {
@@ -0,0 +1,139 @@
+package git
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/rancher/norman/api/access"
+	"github.com/rancher/norman/httperror"
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+	v32 "github.com/rancher/rancher/pkg/apis/project.cattle.io/v3"
+	client "github.com/rancher/rancher/pkg/client/generated/project/v3"
+	"github.com/rancher/rancher/pkg/pipeline/remote/git"
+	"github.com/rancher/rancher/pkg/pipeline/remote/model"
+	"github.com/rancher/rancher/pkg/ref"
+)
+
+const (
+	actionDisable      = "disable"
+	actionTestAndApply = "testAndApply"
+	actionLogin        = "login"
+
+	defaultPollInterval = 60
+	minPollInterval     = 15
+)
+
+func (g *GitProvider) Formatter(apiContext *types.APIContext, resource *types.RawResource) {
+	if convert.ToBool(resource.Values["enabled"]) {
+		resource.AddAction(apiContext, actionDisable)
+	}
+
+	resource.AddAction(apiContext, actionTestAndApply)
+}
+
+func (g *GitProvider) ActionHandler(actionName string, action *types.Action, request *types.APIContext) error {
+	if actionName == actionTestAndApply {
+		return g.testAndApply(actionName, action, request)
+	} else if actionName == actionDisable {
+		return g.DisableAction(request, g.GetName())
+	}
+
+	return httperror.NewAPIError(httperror.ActionNotAvailable, "")
+}
+
+func (g *GitProvider) providerFormatter(apiContext *types.APIContext, resource *types.RawResource) {
+	resource.AddAction(apiContext, actionLogin)
+}
+
+func (g *GitProvider) providerActionHandler(actionName string, action *types.Action, request *types.APIContext) error {
+	if actionName == actionLogin {
+		return g.authuser(request)
+	}
+
+	return httperror.NewAPIError(httperror.ActionNotAvailable, "")
+}
+
+func (g *GitProvider) testAndApply(actionName string, action *types.Action, apiContext *types.APIContext) error {
+	applyInput := &v32.GitApplyInput{}
+
+	if err := json.NewDecoder(apiContext.Request.Body).Decode(applyInput); err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent,
+			fmt.Sprintf("Failed to parse body: %v", err))
+	}
+	if applyInput.PollInterval == 0 {
+		applyInput.PollInterval = defaultPollInterval
+	} else if applyInput.PollInterval < minPollInterval {
+		return httperror.NewAPIError(httperror.InvalidBodyContent,
+			fmt.Sprintf("poll interval must be at least %d seconds", minPollInterval))
+	}
+	if len(applyInput.AllowedHosts) == 0 {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, "at least one allowed host is required")
+	}
+
+	ns, _ := ref.Parse(apiContext.ID)
+	pConfig, err := g.GetProviderConfig(ns)
+	if err != nil {
+		return err
+	}
+	storedGitPipelineConfig, ok := pConfig.(*v32.GitPipelineConfig)
+	if !ok {
+		return fmt.Errorf("Failed to get git provider config")
+	}
+	toUpdate := storedGitPipelineConfig.DeepCopy()
+	toUpdate.PollInterval = applyInput.PollInterval
+	toUpdate.AllowedHosts = applyInput.AllowedHosts
+	toUpdate.KnownHosts = applyInput.KnownHosts
+	toUpdate.Enabled = true
+	//update git pipeline config
+	if _, err = g.SourceCodeProviderConfigs.ObjectClient().Update(toUpdate.Name, toUpdate); err != nil {
+		return err
+	}
+
+	apiContext.WriteResponse(http.StatusOK, nil)
+	return nil
+}
+
+func (g *GitProvider) authuser(apiContext *types.APIContext) error {
+	loginInput := v32.GitLoginInput{}
+	requestBytes, err := ioutil.ReadAll(apiContext.Request.Body)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(requestBytes, &loginInput); err != nil {
+		return err
+	}
+
+	ns, _ := ref.Parse(apiContext.ID)
+	pConfig, err := g.GetProviderConfig(ns)
+	if err != nil {
+		return err
+	}
+	config, ok := pConfig.(*v32.GitPipelineConfig)
+	if !ok {
+		return fmt.Errorf("Failed to get git provider config")
+	}
+	if !config.Enabled {
+		return errors.New("git provider is not configured")
+	}
+
+	account, err := git.Account(loginInput.Username, loginInput.Password, loginInput.SSHPrivateKey)
+	if err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, err.Error())
+	}
+	userName := apiContext.Request.Header.Get("Impersonate-User")
+	account, err = g.AddAccount(userName, account, config.ProjectName, model.GitType)
+	if err != nil {
+		return err
+	}
+	data := map[string]interface{}{}
+	if err := access.ByID(apiContext, apiContext.Version, client.SourceCodeCredentialType, account.Name, &data); err != nil {
+		return err
+	}
+
+	apiContext.WriteResponse(http.StatusOK, data)
+	return nil
+}
@@ -25,8 +25,10 @@
 	"golang.org/x/oauth2"
 )
 
+// apiEndpoint is the Bitbucket Cloud API, it is a variable so that tests can replace it
+var apiEndpoint = "https://api.bitbucket.org/2.0"
+
 const (
-	apiEndpoint   = "https://api.bitbucket.org/2.0"
 	authURL       = "https://bitbucket.org/site/oauth2/authorize"
 	tokenURL      = "https://bitbucket.org/site/oauth2/access_token"
 	maxPerPage    = "100"
@@ -307,6 +309,40 @@ func (c *client) GetHeadInfo(repoURL string, branch string, accessToken string)
 	return info, nil
 }
 
+func (c *client) SetCommitStatus(repoURL string, status *model.CommitStatus, accessToken string) error {
+	owner, repo, err := getUserRepoFromURL(repoURL)
+	if err != nil {
+		return err
+	}
+	buildStatus := BuildStatus{
+		State:       convertCommitState(status.State),
+		Key:         status.Context,
+		Name:        status.Context,
+		URL:         status.TargetURL,
+		Description: status.Description,
+	}
+
+	url := fmt.Sprintf("%s/repositories/%s/%s/commit/%s/statuses/build", apiEndpoint, owner, repo, status.Commit)
+	b, err := json.Marshal(buildStatus)
+	if err != nil {
+		return err
+	}
+	_, err = doRequestToBitbucket(http.MethodPost, url, accessToken, nil, bytes.NewReader(b))
+	return err
+}
+
+func convertCommitState(state string) string {
+	switch state {
+	case model.CommitStatusSuccess:
+		return "SUCCESSFUL"
+	case model.CommitStatusFailure:
+		return "FAILED"
+	case model.CommitStatusError:
+		return "STOPPED"
+	}
+	return "INPROGRESS"
+}
+
 func convertUser(bitbucketUser *User) *v3.SourceCodeCredential {
 
 	if bitbucketUser == nil {
@@ -0,0 +1,54 @@
+package bitbucketcloud
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rancher/rancher/pkg/pipeline/remote/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetCommitStatus(t *testing.T) {
+	assert := assert.New(t)
+
+	var path, token string
+	var buildStatus BuildStatus
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.Method + " " + r.URL.Path
+		token = r.URL.Query().Get("access_token")
+		assert.Nil(json.NewDecoder(r.Body).Decode(&buildStatus))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+	defer func(endpoint string) { apiEndpoint = endpoint }(apiEndpoint)
+	apiEndpoint = server.URL
+
+	c := &client{}
+	testCases := map[string]string{
+		model.CommitStatusPending: "INPROGRESS",
+		model.CommitStatusSuccess: "SUCCESSFUL",
+		model.CommitStatusFailure: "FAILED",
+		model.CommitStatusError:   "STOPPED",
+	}
+	for state, expected := range testCases {
+		err := c.SetCommitStatus("https://bitbucket.org/owner/repo.git", &model.CommitStatus{
+			Commit:      "0123abc",
+			State:       state,
+			TargetURL:   "https://rancher/run/3",
+			Description: "The pipeline is running",
+			Context:     model.CommitStatusContext,
+		}, "token")
+		assert.Nil(err, state)
+		assert.Equal("POST /repositories/owner/repo/commit/0123abc/statuses/build", path, state)
+		assert.Equal("token", token, state)
+		assert.Equal(BuildStatus{
+			State:       expected,
+			Key:         model.CommitStatusContext,
+			Name:        model.CommitStatusContext,
+			URL:         "https://rancher/run/3",
+			Description: "The pipeline is running",
+		}, buildStatus, state)
+	}
+}
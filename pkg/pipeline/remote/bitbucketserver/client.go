@@ -446,6 +446,36 @@ func (c *client) GetHeadInfo(repoURL string, branch string, accessToken string)
 	return info, nil
 }
 
+func (c *client) SetCommitStatus(repoURL string, status *model.CommitStatus, accessToken string) error {
+	buildStatus := BuildStatus{
+		State:       convertCommitState(status.State),
+		Key:         status.Context,
+		Name:        status.Context,
+		URL:         status.TargetURL,
+		Description: status.Description,
+	}
+
+	url := fmt.Sprintf("%s/rest/build-status/1.0/commits/%s", c.BaseURL, status.Commit)
+	b, err := json.Marshal(buildStatus)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequestToBitbucket(http.MethodPost, url, accessToken, nil, bytes.NewReader(b))
+	return err
+}
+
+// convertCommitState maps a commit state to a bitbucket server build state, which has no
+// state for stopped builds
+func convertCommitState(state string) string {
+	switch state {
+	case model.CommitStatusSuccess:
+		return "SUCCESSFUL"
+	case model.CommitStatusFailure, model.CommitStatusError:
+		return "FAILED"
+	}
+	return "INPROGRESS"
+}
+
 func convertUser(bitbucketUser *User) *v3.SourceCodeCredential {
 
 	if bitbucketUser == nil {
@@ -0,0 +1,64 @@
+package bitbucketserver
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rancher/rancher/pkg/pipeline/remote/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetCommitStatus(t *testing.T) {
+	assert := assert.New(t)
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	var path, auth string
+	var buildStatus BuildStatus
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.Method + " " + r.URL.Path
+		auth = r.Header.Get("Authorization")
+		assert.Nil(json.NewDecoder(r.Body).Decode(&buildStatus))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := &client{BaseURL: server.URL, ConsumerKey: "rancher", PrivateKey: string(privateKey)}
+	testCases := map[string]string{
+		model.CommitStatusPending: "INPROGRESS",
+		model.CommitStatusSuccess: "SUCCESSFUL",
+		model.CommitStatusFailure: "FAILED",
+		model.CommitStatusError:   "FAILED",
+	}
+	for state, expected := range testCases {
+		err := c.SetCommitStatus(server.URL+"/scm/project/repo.git", &model.CommitStatus{
+			Commit:      "0123abc",
+			State:       state,
+			TargetURL:   "https://rancher/run/3",
+			Description: "The pipeline is running",
+			Context:     model.CommitStatusContext,
+		}, "token")
+		assert.Nil(err, state)
+		assert.Equal("POST /rest/build-status/1.0/commits/0123abc", path, state)
+		assert.True(strings.HasPrefix(auth, "OAuth "), state)
+		assert.Contains(auth, `oauth_token="token"`, state)
+		assert.Equal(BuildStatus{
+			State:       expected,
+			Key:         model.CommitStatusContext,
+			Name:        model.CommitStatusContext,
+			URL:         "https://rancher/run/3",
+			Description: "The pipeline is running",
+		}, buildStatus, state)
+	}
+}
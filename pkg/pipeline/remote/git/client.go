@@ -0,0 +1,365 @@
+package git
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/project.cattle.io/v3"
+
+	"github.com/pkg/errors"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/pipeline/remote/model"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// PollHookID is recorded as the webhook of pipelines on plain git repositories, which are polled instead
+	PollHookID = "poll"
+
+	refsHeadsPrefix = "refs/heads/"
+	refsTagsPrefix  = "refs/tags/"
+	peeledSuffix    = "^{}"
+
+	credentialHelper = `!f() { echo "username=$GIT_USERNAME"; echo "password=$GIT_PASSWORD"; }; f`
+
+	// gitTimeout bounds every git command, so that a stalled remote does not hang the poller
+	gitTimeout = 2 * time.Minute
+)
+
+var scpLikeURL = regexp.MustCompile(`^[A-Za-z0-9._-]+@([A-Za-z0-9.-]+):[^/]`)
+
+type client struct {
+	AllowedHosts []string
+	KnownHosts   string
+}
+
+func New(config *v32.GitPipelineConfig) (model.Remote, error) {
+	if config == nil {
+		return nil, errors.New("empty git config")
+	}
+	return &client{
+		AllowedHosts: config.AllowedHosts,
+		KnownHosts:   config.KnownHosts,
+	}, nil
+}
+
+func (c *client) Type() string {
+	return model.GitType
+}
+
+func (c *client) Login(code string) (*v3.SourceCodeCredential, error) {
+	return nil, errors.New("git provider does not support oauth login")
+}
+
+// Repos returns no repositories, as plain git servers cannot list them. Pipelines are
+// created with the URL of the repository instead.
+func (c *client) Repos(account *v3.SourceCodeCredential) ([]v3.SourceCodeRepository, error) {
+	return []v3.SourceCodeRepository{}, nil
+}
+
+func (c *client) CreateHook(pipeline *v3.Pipeline, accessToken string) (string, error) {
+	return PollHookID, nil
+}
+
+func (c *client) DeleteHook(pipeline *v3.Pipeline, accessToken string) error {
+	return nil
+}
+
+func (c *client) GetPipelineFileInRepo(repoURL string, ref string, accessToken string) ([]byte, error) {
+	cred, err := DecodeCredential(accessToken)
+	if err != nil {
+		return nil, err
+	}
+	if ref == "" {
+		ref = "HEAD"
+	} else {
+		ref = refsHeadsPrefix + ref
+	}
+	dir, err := c.fetch(cred, repoURL, ref)
+	if dir != "" {
+		defer os.RemoveAll(dir)
+	}
+	if err != nil {
+		logrus.Debugf("error GetPipelineFileInRepo - %v", err)
+		return nil, nil
+	}
+	for _, filename := range []string{utils.PipelineFileYml, utils.PipelineFileYaml} {
+		if content, err := c.gitOutput(cred, dir, "show", "FETCH_HEAD:"+filename); err == nil {
+			return content, nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *client) SetPipelineFileInRepo(repoURL string, branch string, accessToken string, content []byte) error {
+	cred, err := DecodeCredential(accessToken)
+	if err != nil {
+		return err
+	}
+	dir, err := c.fetch(cred, repoURL, refsHeadsPrefix+branch)
+	if dir != "" {
+		defer os.RemoveAll(dir)
+	}
+	if err != nil {
+		return err
+	}
+	if _, err := c.gitOutput(cred, dir, "checkout", "-q", "FETCH_HEAD"); err != nil {
+		return err
+	}
+
+	currentFileName := utils.PipelineFileYml
+	message := "Create .rancher-pipeline.yml file"
+	for _, filename := range []string{utils.PipelineFileYml, utils.PipelineFileYaml} {
+		if _, err := os.Stat(filepath.Join(dir, filename)); err == nil {
+			currentFileName = filename
+			message = fmt.Sprintf("Update %s file", filename)
+			break
+		}
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, currentFileName), content, 0644); err != nil {
+		return err
+	}
+	if _, err := c.gitOutput(cred, dir, "add", currentFileName); err != nil {
+		return err
+	}
+	if _, err := c.gitOutput(cred, dir, "-c", "user.name="+cred.Username, "-c", "user.email=", "commit", "-q", "-m", message); err != nil {
+		return err
+	}
+	_, err = c.gitOutput(cred, dir, "push", "-q", repoURL, "HEAD:"+refsHeadsPrefix+branch)
+	return err
+}
+
+func (c *client) GetBranches(repoURL string, accessToken string) ([]string, error) {
+	refs, err := c.Heads(repoURL, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	var result []string
+	for ref := range refs {
+		if strings.HasPrefix(ref, refsHeadsPrefix) {
+			result = append(result, strings.TrimPrefix(ref, refsHeadsPrefix))
+		}
+	}
+	return result, nil
+}
+
+func (c *client) GetHeadInfo(repoURL string, branch string, accessToken string) (*model.BuildInfo, error) {
+	cred, err := DecodeCredential(accessToken)
+	if err != nil {
+		return nil, err
+	}
+	dir, err := c.fetch(cred, repoURL, refsHeadsPrefix+branch)
+	if dir != "" {
+		defer os.RemoveAll(dir)
+	}
+	if err != nil {
+		return nil, err
+	}
+	out, err := c.gitOutput(cred, dir, "log", "-1", "--format=%H%n%an%n%ae%n%B", "FETCH_HEAD")
+	if err != nil {
+		return nil, err
+	}
+	info := parseCommit(string(out))
+	info.Ref = refsHeadsPrefix + branch
+	info.Branch = branch
+	return info, nil
+}
+
+// Heads returns the commit of each branch and tag of the repository, keyed by their full ref names
+func (c *client) Heads(repoURL string, accessToken string) (map[string]string, error) {
+	cred, err := DecodeCredential(accessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.validateURL(repoURL); err != nil {
+		return nil, err
+	}
+	out, err := c.gitOutput(cred, "", "ls-remote", "--heads", "--tags", repoURL)
+	if err != nil {
+		return nil, err
+	}
+	return parseLsRemote(string(out)), nil
+}
+
+// parseLsRemote maps the refs listed by git ls-remote to their commits. Annotated tags
+// are mapped to the commit they point to rather than to the tag object.
+func parseLsRemote(output string) map[string]string {
+	result := map[string]string{}
+	peeled := map[string]string{}
+	for _, line := range strings.Split(output, "\n") {
+		parts := strings.Fields(line)
+		if len(parts) != 2 {
+			continue
+		}
+		commit, ref := parts[0], parts[1]
+		if strings.HasSuffix(ref, peeledSuffix) {
+			peeled[strings.TrimSuffix(ref, peeledSuffix)] = commit
+			continue
+		}
+		result[ref] = commit
+	}
+	for ref, commit := range peeled {
+		result[ref] = commit
+	}
+	return result
+}
+
+func parseCommit(output string) *model.BuildInfo {
+	lines := strings.SplitN(output, "\n", 4)
+	for len(lines) < 4 {
+		lines = append(lines, "")
+	}
+	return &model.BuildInfo{
+		Commit:  lines[0],
+		Author:  lines[1],
+		Email:   lines[2],
+		Message: strings.TrimSpace(lines[3]),
+	}
+}
+
+// fetch shallowly fetches a ref of the repository into a new temporary directory. The caller removes the directory.
+func (c *client) fetch(cred *Credential, repoURL string, ref string) (string, error) {
+	if err := c.validateURL(repoURL); err != nil {
+		return "", err
+	}
+	if err := validateRef(ref); err != nil {
+		return "", err
+	}
+	dir, err := ioutil.TempDir("", "pipeline-git")
+	if err != nil {
+		return "", err
+	}
+	if _, err := c.gitOutput(cred, dir, "init", "-q"); err != nil {
+		return dir, err
+	}
+	_, err = c.gitOutput(cred, dir, "fetch", "-q", "--depth=1", "--", repoURL, ref)
+	return dir, err
+}
+
+// validateRef rejects refs that git would parse as an option or that are not valid ref names
+func validateRef(ref string) error {
+	if ref == "HEAD" {
+		return nil
+	}
+	if strings.HasPrefix(ref, "-") {
+		return errors.Errorf("invalid git ref %q", ref)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), gitTimeout)
+	defer cancel()
+	if err := exec.CommandContext(ctx, "git", "check-ref-format", ref).Run(); err != nil {
+		return errors.Errorf("invalid git ref %q", ref)
+	}
+	return nil
+}
+
+// gitOutput runs git with the credential. The password is handed to git through a credential
+// helper reading the environment, so that it never shows up in the command line or in errors.
+// SSH only connects to hosts whose key is in the known hosts of the provider, and redirects are
+// not followed as they may lead to hosts that are not allowed.
+func (c *client) gitOutput(cred *Credential, dir string, args ...string) ([]byte, error) {
+	command := args[0]
+	args = append([]string{"-c", "http.followRedirects=false"}, args...)
+	env := append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	if cred.Password != "" {
+		args = append([]string{"-c", "credential.helper=" + credentialHelper}, args...)
+		env = append(env, "GIT_USERNAME="+cred.Username, "GIT_PASSWORD="+cred.Password)
+	}
+	if cred.SSHPrivateKey != "" {
+		keyFile, err := writeTempFile("pipeline-ssh", cred.SSHPrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		defer os.Remove(keyFile)
+		knownHostsFile, err := writeTempFile("pipeline-known-hosts", c.KnownHosts)
+		if err != nil {
+			return nil, err
+		}
+		defer os.Remove(knownHostsFile)
+		env = append(env, fmt.Sprintf("GIT_SSH_COMMAND=ssh -i %s -o IdentitiesOnly=yes -o StrictHostKeyChecking=yes -o UserKnownHostsFile=%s", keyFile, knownHostsFile))
+	}
+	if dir != "" {
+		args = append([]string{"-C", dir}, args...)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), gitTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Env = env
+	stderr := &bytes.Buffer{}
+	cmd.Stderr = stderr
+	out, err := cmd.Output()
+	if ctx.Err() == context.DeadlineExceeded {
+		return nil, errors.Errorf("git %s timed out after %v", command, gitTimeout)
+	} else if err != nil {
+		return nil, errors.Wrap(err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
+
+// writeTempFile writes the content to a new temporary file only readable by its owner, the caller removes the file
+func writeTempFile(prefix string, content string) (string, error) {
+	f, err := ioutil.TempFile("", prefix)
+	if err != nil {
+		return "", err
+	}
+	_, err = f.WriteString(strings.TrimSpace(content) + "\n")
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// validateURL only accepts repositories reachable over http(s), ssh or git, so that
+// neither local paths nor git transport helpers are used on the server, on the allowed
+// hosts of the provider, so that the server cannot be used to reach other hosts of its network
+func (c *client) validateURL(repoURL string) error {
+	var host string
+	if match := scpLikeURL.FindStringSubmatch(repoURL); match != nil {
+		host = match[1]
+	} else {
+		u, err := url.Parse(repoURL)
+		if err != nil {
+			return err
+		}
+		switch u.Scheme {
+		case "http", "https", "ssh", "git":
+			host = u.Hostname()
+		}
+		if host == "" {
+			return fmt.Errorf("unsupported git repository url %q", repoURL)
+		}
+	}
+	if !hostAllowed(host, c.AllowedHosts) {
+		return fmt.Errorf("git repository host %q is not allowed", host)
+	}
+	return nil
+}
+
+// hostAllowed checks a host against host names and *.domain wildcards
+func hostAllowed(host string, allowedHosts []string) bool {
+	host = strings.ToLower(host)
+	for _, allowed := range allowedHosts {
+		allowed = strings.ToLower(strings.TrimSpace(allowed))
+		if strings.HasPrefix(allowed, "*.") {
+			if strings.HasSuffix(host, allowed[1:]) {
+				return true
+			}
+		} else if host == allowed {
+			return true
+		}
+	}
+	return false
+}
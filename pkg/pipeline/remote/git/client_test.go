@@ -0,0 +1,70 @@
+package git
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLsRemote(t *testing.T) {
+	assert := assert.New(t)
+	output := "1111111111111111111111111111111111111111\trefs/heads/main\n" +
+		"2222222222222222222222222222222222222222\trefs/heads/dev\n" +
+		"3333333333333333333333333333333333333333\trefs/tags/v1\n" +
+		"4444444444444444444444444444444444444444\trefs/tags/v1^{}\n" +
+		"5555555555555555555555555555555555555555\trefs/tags/v2\n"
+
+	refs := parseLsRemote(output)
+	assert.Equal(map[string]string{
+		"refs/heads/main": "1111111111111111111111111111111111111111",
+		"refs/heads/dev":  "2222222222222222222222222222222222222222",
+		"refs/tags/v1":    "4444444444444444444444444444444444444444",
+		"refs/tags/v2":    "5555555555555555555555555555555555555555",
+	}, refs)
+}
+
+func TestCredential(t *testing.T) {
+	assert := assert.New(t)
+
+	account, err := Account("deploy", "secret", "")
+	assert.Nil(err)
+	assert.Equal("deploy", account.Spec.GitLoginName)
+	assert.Equal("secret", account.Spec.GitCloneToken)
+	cred, err := DecodeCredential(account.Spec.AccessToken)
+	assert.Nil(err)
+	assert.Equal(&Credential{Username: "deploy", Password: "secret"}, cred)
+
+	_, err = Account("deploy", "", "")
+	assert.NotNil(err)
+	_, err = DecodeCredential("not base64!")
+	assert.NotNil(err)
+}
+
+func TestValidateURL(t *testing.T) {
+	assert := assert.New(t)
+	c := &client{AllowedHosts: []string{"git.example.com", "*.corp.example.org"}}
+	assert.Nil(c.validateURL("https://git.example.com/org/app.git"))
+	assert.Nil(c.validateURL("ssh://git@git.example.com:2222/org/app.git"))
+	assert.Nil(c.validateURL("git@git.example.com:org/app.git"))
+	assert.Nil(c.validateURL("https://GIT.dev.corp.example.org/org/app.git"))
+	assert.NotNil(c.validateURL("/var/lib/repos/app.git"))
+	assert.NotNil(c.validateURL("file:///var/lib/repos/app.git"))
+	assert.NotNil(c.validateURL("ext::sh -c touch% /tmp/pwned"))
+	assert.EqualError(c.validateURL("http://10.43.0.1:6443/api.git"), `git repository host "10.43.0.1" is not allowed`)
+	assert.EqualError(c.validateURL("git@corp.example.org:org/app.git"), `git repository host "corp.example.org" is not allowed`)
+	assert.EqualError(c.validateURL("https://git.example.com.attacker.io/org/app.git"), `git repository host "git.example.com.attacker.io" is not allowed`)
+
+	c.AllowedHosts = nil
+	assert.NotNil(c.validateURL("https://git.example.com/org/app.git"))
+}
+
+func TestValidateRef(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, ref := range []string{"HEAD", "refs/heads/main", "refs/heads/feature/cache", "refs/tags/v1.0.0"} {
+		assert.Nil(validateRef(ref), ref)
+	}
+	for _, ref := range []string{"--upload-pack=touch /tmp/pwned", "-q", "refs/heads/a..b", "refs/heads/a b", "main"} {
+		assert.NotNil(validateRef(ref), ref)
+	}
+}
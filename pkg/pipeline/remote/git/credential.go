@@ -0,0 +1,68 @@
+package git
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/pipeline/remote/model"
+)
+
+// Credential is what a git source code credential authenticates with. Plain git servers have
+// no API tokens, so the credential is kept encoded in the access token of the source code credential.
+type Credential struct {
+	Username      string `json:"username,omitempty"`
+	Password      string `json:"password,omitempty"`
+	SSHPrivateKey string `json:"sshPrivateKey,omitempty"`
+}
+
+func EncodeCredential(cred *Credential) (string, error) {
+	b, err := json.Marshal(cred)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+func DecodeCredential(accessToken string) (*Credential, error) {
+	cred := &Credential{}
+	if accessToken == "" {
+		return cred, nil
+	}
+	b, err := base64.StdEncoding.DecodeString(accessToken)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid git credential")
+	}
+	if err := json.Unmarshal(b, cred); err != nil {
+		return nil, errors.Wrap(err, "invalid git credential")
+	}
+	return cred, nil
+}
+
+// Account returns the source code credential of a user authenticating with a username and a password or an SSH key
+func Account(username, password, sshPrivateKey string) (*v3.SourceCodeCredential, error) {
+	if username == "" {
+		return nil, errors.New("username is required")
+	}
+	if password == "" && sshPrivateKey == "" {
+		return nil, errors.New("either a password or an SSH private key is required")
+	}
+	accessToken, err := EncodeCredential(&Credential{
+		Username:      username,
+		Password:      password,
+		SSHPrivateKey: sshPrivateKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	cred := &v3.SourceCodeCredential{}
+	cred.Spec.SourceCodeType = model.GitType
+	cred.Spec.LoginName = username
+	cred.Spec.DisplayName = username
+	// clone steps authenticate over https with the username and password
+	cred.Spec.GitLoginName = username
+	cred.Spec.GitCloneToken = password
+	cred.Spec.AccessToken = accessToken
+	return cred, nil
+}
@@ -0,0 +1,518 @@
+package gitea
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/project.cattle.io/v3"
+
+	"github.com/pkg/errors"
+	"github.com/rancher/norman/httperror"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/pipeline/remote/model"
+	"github.com/rancher/rancher/pkg/pipeline/utils"
+	"github.com/rancher/rancher/pkg/ref"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/sirupsen/logrus"
+	"github.com/tomnomnom/linkheader"
+	"golang.org/x/oauth2"
+)
+
+const (
+	giteaAPI      = "%s%s/api/v1"
+	maxPerPage    = "50"
+	cloneUserName = "oauth2"
+	hookType      = "gitea"
+)
+
+type client struct {
+	Scheme       string
+	Host         string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	API          string
+}
+
+func New(config *v32.GiteaPipelineConfig) (model.Remote, error) {
+	if config == nil {
+		return nil, errors.New("empty gitea config")
+	}
+	if config.Hostname == "" {
+		return nil, errors.New("gitea hostname is required")
+	}
+	gtClient := &client{
+		Host:         config.Hostname,
+		ClientID:     config.ClientID,
+		ClientSecret: config.ClientSecret,
+		RedirectURL:  config.RedirectURL,
+	}
+	if config.TLS {
+		gtClient.Scheme = "https://"
+	} else {
+		gtClient.Scheme = "http://"
+	}
+	gtClient.API = fmt.Sprintf(giteaAPI, gtClient.Scheme, gtClient.Host)
+	return gtClient, nil
+}
+
+func (c *client) Type() string {
+	return model.GiteaType
+}
+
+func (c *client) oauthConfig() *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     c.ClientID,
+		ClientSecret: c.ClientSecret,
+		RedirectURL:  c.RedirectURL,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  fmt.Sprintf("%s%s/login/oauth/authorize", c.Scheme, c.Host),
+			TokenURL: fmt.Sprintf("%s%s/login/oauth/access_token", c.Scheme, c.Host),
+		},
+	}
+}
+
+func (c *client) Login(code string) (*v3.SourceCodeCredential, error) {
+	token, err := c.oauthConfig().Exchange(oauth2.NoContext, code)
+	if err != nil {
+		return nil, err
+	} else if strings.ToLower(token.TokenType) != "bearer" || token.AccessToken == "" {
+		return nil, fmt.Errorf("Fail to get accesstoken with oauth config")
+	}
+
+	user, err := c.getUser(token.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	cred := c.convertUser(user)
+	cred.Spec.AccessToken = token.AccessToken
+	cred.Spec.RefreshToken = token.RefreshToken
+	cred.Spec.Expiry = token.Expiry.Format(time.RFC3339)
+	return cred, nil
+}
+
+func (c *client) Repos(account *v3.SourceCodeCredential) ([]v3.SourceCodeRepository, error) {
+	if account == nil {
+		return nil, fmt.Errorf("empty account")
+	}
+	responseBodies, err := paginateGitea(c.API+"/user/repos", account.Spec.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	var repos []Repository
+	for _, b := range responseBodies {
+		var pageRepos []Repository
+		if err := json.Unmarshal(b, &pageRepos); err != nil {
+			return nil, err
+		}
+		repos = append(repos, pageRepos...)
+	}
+
+	return convertRepos(repos), nil
+}
+
+func (c *client) CreateHook(pipeline *v3.Pipeline, accessToken string) (string, error) {
+	owner, repo, err := getOwnerRepoFromURL(pipeline.Spec.RepositoryURL)
+	if err != nil {
+		return "", err
+	}
+	hookURL := fmt.Sprintf("%s/hooks?pipelineId=%s", settings.ServerURL.Get(), ref.Ref(pipeline))
+	hook := Hook{
+		Type: hookType,
+		Config: map[string]string{
+			"url":          hookURL,
+			"content_type": "json",
+			"secret":       pipeline.Status.Token,
+		},
+		Events: []string{
+			"push",
+			"pull_request",
+		},
+		Active: true,
+	}
+	b, err := json.Marshal(hook)
+	if err != nil {
+		return "", err
+	}
+
+	url := fmt.Sprintf("%s/repos/%s/%s/hooks", c.API, owner, repo)
+	resp, err := doRequestToGitea(http.MethodPost, url, accessToken, bytes.NewReader(b))
+	if err != nil {
+		return "", err
+	}
+	if err := json.Unmarshal(resp, &hook); err != nil {
+		return "", err
+	}
+
+	return strconv.FormatInt(hook.ID, 10), nil
+}
+
+func (c *client) DeleteHook(pipeline *v3.Pipeline, accessToken string) error {
+	owner, repo, err := getOwnerRepoFromURL(pipeline.Spec.RepositoryURL)
+	if err != nil {
+		return err
+	}
+
+	hook, err := c.getHook(pipeline, accessToken)
+	if err != nil {
+		return err
+	}
+	if hook != nil {
+		url := fmt.Sprintf("%s/repos/%s/%s/hooks/%d", c.API, owner, repo, hook.ID)
+		if _, err := doRequestToGitea(http.MethodDelete, url, accessToken, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *client) getHook(pipeline *v3.Pipeline, accessToken string) (*Hook, error) {
+	owner, repo, err := getOwnerRepoFromURL(pipeline.Spec.RepositoryURL)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBodies, err := paginateGitea(fmt.Sprintf("%s/repos/%s/%s/hooks", c.API, owner, repo), accessToken)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range responseBodies {
+		var hooks []Hook
+		if err := json.Unmarshal(b, &hooks); err != nil {
+			return nil, err
+		}
+		for _, hook := range hooks {
+			if strings.HasSuffix(hook.Config["url"], fmt.Sprintf("hooks?pipelineId=%s", ref.Ref(pipeline))) {
+				return &hook, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (c *client) getFileFromRepo(filename string, owner string, repo string, branch string, accessToken string) (*ContentsResponse, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/contents/%s", c.API, owner, repo, filename)
+	if branch != "" {
+		url = url + "?ref=" + branch
+	}
+	b, err := getFromGitea(url, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	file := &ContentsResponse{}
+	if err := json.Unmarshal(b, file); err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+func (c *client) GetPipelineFileInRepo(repoURL string, branch string, accessToken string) ([]byte, error) {
+	owner, repo, err := getOwnerRepoFromURL(repoURL)
+	if err != nil {
+		return nil, err
+	}
+	file, err := c.getFileFromRepo(utils.PipelineFileYml, owner, repo, branch, accessToken)
+	if err != nil {
+		//look for both suffix
+		file, err = c.getFileFromRepo(utils.PipelineFileYaml, owner, repo, branch, accessToken)
+	}
+	if err != nil {
+		logrus.Debugf("error GetPipelineFileInRepo - %v", err)
+		return nil, nil
+	}
+	if file.Content != "" {
+		return base64.StdEncoding.DecodeString(file.Content)
+	}
+	return nil, nil
+}
+
+func (c *client) SetPipelineFileInRepo(repoURL string, branch string, accessToken string, content []byte) error {
+	owner, repo, err := getOwnerRepoFromURL(repoURL)
+	if err != nil {
+		return err
+	}
+
+	currentFile, err := c.getFileFromRepo(utils.PipelineFileYml, owner, repo, branch, accessToken)
+	currentFileName := utils.PipelineFileYml
+	if err != nil {
+		if httpErr, ok := err.(*httperror.APIError); !ok || httpErr.Code.Status != http.StatusNotFound {
+			return err
+		}
+		//look for both suffix
+		currentFile, err = c.getFileFromRepo(utils.PipelineFileYaml, owner, repo, branch, accessToken)
+		if err != nil {
+			if httpErr, ok := err.(*httperror.APIError); !ok || httpErr.Code.Status != http.StatusNotFound {
+				return err
+			}
+		} else {
+			currentFileName = utils.PipelineFileYaml
+		}
+	}
+
+	url := fmt.Sprintf("%s/repos/%s/%s/contents/%s", c.API, owner, repo, currentFileName)
+	method := http.MethodPost
+	option := FileOptions{
+		Message: "Create .rancher-pipeline.yml file",
+		Branch:  branch,
+		Content: base64.StdEncoding.EncodeToString(content),
+	}
+	if currentFile != nil {
+		//update pipeline file
+		method = http.MethodPut
+		option.Message = fmt.Sprintf("Update %s file", currentFileName)
+		option.SHA = currentFile.SHA
+	}
+	b, err := json.Marshal(option)
+	if err != nil {
+		return err
+	}
+	_, err = doRequestToGitea(method, url, accessToken, bytes.NewReader(b))
+
+	return err
+}
+
+func (c *client) GetBranches(repoURL string, accessToken string) ([]string, error) {
+	owner, repo, err := getOwnerRepoFromURL(repoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBodies, err := paginateGitea(fmt.Sprintf("%s/repos/%s/%s/branches", c.API, owner, repo), accessToken)
+	if err != nil {
+		return nil, err
+	}
+	var result []string
+	for _, b := range responseBodies {
+		var branches []Branch
+		if err := json.Unmarshal(b, &branches); err != nil {
+			return nil, err
+		}
+		for _, branch := range branches {
+			result = append(result, branch.Name)
+		}
+	}
+
+	return result, nil
+}
+
+func (c *client) GetHeadInfo(repoURL string, branch string, accessToken string) (*model.BuildInfo, error) {
+	owner, repo, err := getOwnerRepoFromURL(repoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := getFromGitea(fmt.Sprintf("%s/repos/%s/%s/branches/%s", c.API, owner, repo, branch), accessToken)
+	if err != nil {
+		return nil, err
+	}
+	branchObj := &Branch{}
+	if err := json.Unmarshal(b, branchObj); err != nil {
+		return nil, err
+	}
+	if branchObj.Commit == nil {
+		return nil, errors.New("no commit found")
+	}
+	info := &model.BuildInfo{}
+	info.Commit = branchObj.Commit.ID
+	info.Ref = "refs/heads/" + branch
+	info.Branch = branch
+	info.Message = branchObj.Commit.Message
+	info.HTMLLink = branchObj.Commit.URL
+	if author := branchObj.Commit.Author; author != nil {
+		info.Author = author.UserName
+		info.Email = author.Email
+		if info.Author == "" {
+			info.Author = author.Name
+		}
+	}
+	user, err := c.getUser(accessToken)
+	if err != nil {
+		return nil, err
+	}
+	info.AvatarURL = user.AvatarURL
+
+	return info, nil
+}
+
+func (c *client) SetCommitStatus(repoURL string, status *model.CommitStatus, accessToken string) error {
+	owner, repo, err := getOwnerRepoFromURL(repoURL)
+	if err != nil {
+		return err
+	}
+	// gitea uses the same commit states as the model
+	commitStatus := CommitStatus{
+		State:       status.State,
+		TargetURL:   status.TargetURL,
+		Description: status.Description,
+		Context:     status.Context,
+	}
+	b, err := json.Marshal(commitStatus)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/repos/%s/%s/statuses/%s", c.API, owner, repo, status.Commit)
+	_, err = doRequestToGitea(http.MethodPost, url, accessToken, bytes.NewReader(b))
+	return err
+}
+
+func (c *client) Refresh(cred *v3.SourceCodeCredential) (bool, error) {
+	if cred == nil {
+		return false, errors.New("cannot refresh empty credentials")
+	}
+	source := c.oauthConfig().TokenSource(
+		oauth2.NoContext, &oauth2.Token{RefreshToken: cred.Spec.RefreshToken})
+
+	token, err := source.Token()
+	if err != nil || len(token.AccessToken) == 0 {
+		return false, err
+	}
+
+	cred.Spec.AccessToken = token.AccessToken
+	cred.Spec.RefreshToken = token.RefreshToken
+	cred.Spec.Expiry = token.Expiry.Format(time.RFC3339)
+
+	return true, nil
+}
+
+func (c *client) convertUser(giteaUser *User) *v3.SourceCodeCredential {
+	if giteaUser == nil {
+		return nil
+	}
+	cred := &v3.SourceCodeCredential{}
+	cred.Spec.SourceCodeType = model.GiteaType
+
+	cred.Spec.AvatarURL = giteaUser.AvatarURL
+	cred.Spec.HTMLURL = fmt.Sprintf("%s%s/%s", c.Scheme, c.Host, giteaUser.Login)
+	cred.Spec.LoginName = giteaUser.Login
+	cred.Spec.GitLoginName = cloneUserName
+	cred.Spec.DisplayName = giteaUser.FullName
+	if cred.Spec.DisplayName == "" {
+		cred.Spec.DisplayName = giteaUser.Login
+	}
+
+	return cred
+}
+
+func (c *client) getUser(accessToken string) (*User, error) {
+	b, err := getFromGitea(c.API+"/user", accessToken)
+	if err != nil {
+		return nil, err
+	}
+	user := &User{}
+	if err := json.Unmarshal(b, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func convertRepos(repos []Repository) []v3.SourceCodeRepository {
+	result := []v3.SourceCodeRepository{}
+	for _, repo := range repos {
+		r := v3.SourceCodeRepository{}
+		r.Spec.URL = repo.CloneURL
+		r.Spec.DefaultBranch = repo.DefaultBranch
+		if repo.Permissions != nil {
+			r.Spec.Permissions.Admin = repo.Permissions.Admin
+			r.Spec.Permissions.Push = repo.Permissions.Push
+			r.Spec.Permissions.Pull = repo.Permissions.Pull
+		}
+		result = append(result, r)
+	}
+	return result
+}
+
+func getFromGitea(url string, accessToken string) ([]byte, error) {
+	b, _, err := doRequestWithHeader(http.MethodGet, url, accessToken, nil)
+	return b, err
+}
+
+func doRequestToGitea(method string, url string, accessToken string, body io.Reader) ([]byte, error) {
+	b, _, err := doRequestWithHeader(method, url, accessToken, body)
+	return b, err
+}
+
+func doRequestWithHeader(method string, url string, accessToken string, body io.Reader) ([]byte, http.Header, error) {
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	client := &http.Client{
+		Timeout: 30 * time.Second,
+	}
+	//set to max page size to reduce query time
+	if method == http.MethodGet {
+		q := req.URL.Query()
+		if q.Get("limit") == "" {
+			q.Set("limit", maxPerPage)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+	if accessToken != "" {
+		req.Header.Add("Authorization", "token "+accessToken)
+	}
+	req.Header.Add("Accept", "application/json")
+	if body != nil {
+		req.Header.Add("Content-Type", "application/json")
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	// Check the status code
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+		var body bytes.Buffer
+		io.Copy(&body, resp.Body)
+		return nil, nil, httperror.NewAPIErrorLong(resp.StatusCode, "", body.String())
+	}
+	r, err := ioutil.ReadAll(resp.Body)
+	return r, resp.Header, err
+}
+
+func paginateGitea(url string, accessToken string) ([][]byte, error) {
+	var responseBodies [][]byte
+	nextURL := url
+	for nextURL != "" {
+		b, header, err := doRequestWithHeader(http.MethodGet, nextURL, accessToken, nil)
+		if err != nil {
+			return nil, err
+		}
+		responseBodies = append(responseBodies, b)
+		nextURL = nextGiteaPage(header)
+	}
+	return responseBodies, nil
+}
+
+func nextGiteaPage(header http.Header) string {
+	if link := header.Get("Link"); link != "" {
+		for _, l := range linkheader.Parse(link) {
+			if l.Rel == "next" {
+				return l.URL
+			}
+		}
+	}
+	return ""
+}
+
+func getOwnerRepoFromURL(repoURL string) (string, string, error) {
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return "", "", err
+	}
+	parts := strings.Split(strings.Trim(strings.TrimSuffix(u.Path, ".git"), "/"), "/")
+	if len(parts) < 2 {
+		return "", "", fmt.Errorf("error getting owner/repo from gitrepoUrl:%v", repoURL)
+	}
+	// gitea may be served under a sub path, the owner and repo are the last two elements
+	return parts[len(parts)-2], parts[len(parts)-1], nil
+}
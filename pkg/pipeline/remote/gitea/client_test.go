@@ -0,0 +1,43 @@
+package gitea
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rancher/rancher/pkg/pipeline/remote/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetCommitStatus(t *testing.T) {
+	assert := assert.New(t)
+
+	var path, auth string
+	var commitStatus CommitStatus
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.Method + " " + r.URL.Path
+		auth = r.Header.Get("Authorization")
+		assert.Nil(json.NewDecoder(r.Body).Decode(&commitStatus))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	c := &client{API: server.URL + "/api/v1"}
+	err := c.SetCommitStatus("https://gitea.example.com/git/owner/repo.git", &model.CommitStatus{
+		Commit:      "0123abc",
+		State:       model.CommitStatusFailure,
+		TargetURL:   "https://rancher/run/3",
+		Description: "The pipeline failed",
+		Context:     model.CommitStatusContext,
+	}, "token")
+	assert.Nil(err)
+	assert.Equal("POST /api/v1/repos/owner/repo/statuses/0123abc", path)
+	assert.Equal("token token", auth)
+	assert.Equal(CommitStatus{
+		State:       model.CommitStatusFailure,
+		TargetURL:   "https://rancher/run/3",
+		Description: "The pipeline failed",
+		Context:     model.CommitStatusContext,
+	}, commitStatus)
+}
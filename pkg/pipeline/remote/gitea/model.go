@@ -0,0 +1,75 @@
+package gitea
+
+type User struct {
+	ID        int64  `json:"id"`
+	Login     string `json:"login"`
+	FullName  string `json:"full_name"`
+	Email     string `json:"email"`
+	AvatarURL string `json:"avatar_url"`
+}
+
+type Permission struct {
+	Admin bool `json:"admin"`
+	Push  bool `json:"push"`
+	Pull  bool `json:"pull"`
+}
+
+type Repository struct {
+	ID            int64       `json:"id"`
+	Owner         *User       `json:"owner"`
+	Name          string      `json:"name"`
+	FullName      string      `json:"full_name"`
+	Private       bool        `json:"private"`
+	HTMLURL       string      `json:"html_url"`
+	CloneURL      string      `json:"clone_url"`
+	DefaultBranch string      `json:"default_branch"`
+	Permissions   *Permission `json:"permissions"`
+}
+
+type Hook struct {
+	ID     int64             `json:"id,omitempty"`
+	Type   string            `json:"type"`
+	Config map[string]string `json:"config"`
+	Events []string          `json:"events"`
+	Active bool              `json:"active"`
+}
+
+type CommitStatus struct {
+	State       string `json:"state"`
+	TargetURL   string `json:"target_url"`
+	Description string `json:"description"`
+	Context     string `json:"context"`
+}
+
+type ContentsResponse struct {
+	Name     string `json:"name"`
+	Path     string `json:"path"`
+	SHA      string `json:"sha"`
+	Encoding string `json:"encoding"`
+	Content  string `json:"content"`
+}
+
+type FileOptions struct {
+	Message string `json:"message"`
+	Branch  string `json:"branch"`
+	Content string `json:"content"`
+	SHA     string `json:"sha,omitempty"`
+}
+
+type CommitUser struct {
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	UserName string `json:"username"`
+}
+
+type PayloadCommit struct {
+	ID      string      `json:"id"`
+	Message string      `json:"message"`
+	URL     string      `json:"url"`
+	Author  *CommitUser `json:"author"`
+}
+
+type Branch struct {
+	Name   string         `json:"name"`
+	Commit *PayloadCommit `json:"commit"`
+}
@@ -0,0 +1,42 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-github/github"
+	"github.com/rancher/rancher/pkg/pipeline/remote/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetCommitStatus(t *testing.T) {
+	assert := assert.New(t)
+
+	var path, auth string
+	var status github.RepoStatus
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.Method + " " + r.URL.Path
+		auth = r.Header.Get("Authorization")
+		assert.Nil(json.NewDecoder(r.Body).Decode(&status))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	c := &client{API: server.URL}
+	err := c.SetCommitStatus("https://github.com/octocat/hello.git", &model.CommitStatus{
+		Commit:      "0123abc",
+		State:       model.CommitStatusSuccess,
+		TargetURL:   "https://rancher/p/c-test:p-test/pipeline/pipelines/p-test:p-abc/run/3",
+		Description: "The pipeline succeeded",
+		Context:     model.CommitStatusContext,
+	}, "token")
+	assert.Nil(err)
+	assert.Equal("POST /repos/octocat/hello/statuses/0123abc", path)
+	assert.Equal("token token", auth)
+	assert.Equal(model.CommitStatusSuccess, status.GetState())
+	assert.Equal("The pipeline succeeded", status.GetDescription())
+	assert.Equal(model.CommitStatusContext, status.GetContext())
+	assert.Equal("https://rancher/p/c-test:p-test/pipeline/pipelines/p-test:p-abc/run/3", status.GetTargetURL())
+}
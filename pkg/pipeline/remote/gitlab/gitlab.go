@@ -392,6 +392,38 @@ func (c *client) GetHeadInfo(repoURL string, branch string, accessToken string)
 	return info, nil
 }
 
+func (c *client) SetCommitStatus(repoURL string, status *model.CommitStatus, accessToken string) error {
+	project, err := getProjectNameFromURL(repoURL)
+	if err != nil {
+		return err
+	}
+	opt := &gitlab.SetCommitStatusOptions{
+		State:       convertCommitState(status.State),
+		Name:        gitlab.String(status.Context),
+		TargetURL:   gitlab.String(status.TargetURL),
+		Description: gitlab.String(status.Description),
+	}
+	url := fmt.Sprintf("%s/projects/%s/statuses/%s", c.API, project, status.Commit)
+
+	resp, err := doRequestToGitlab(http.MethodPost, url, accessToken, opt)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func convertCommitState(state string) gitlab.BuildStateValue {
+	switch state {
+	case model.CommitStatusSuccess:
+		return gitlab.Success
+	case model.CommitStatusFailure:
+		return gitlab.Failed
+	case model.CommitStatusError:
+		return gitlab.Canceled
+	}
+	return gitlab.Pending
+}
+
 func (c *client) GetAccount(accessToken string) (*v3.SourceCodeCredential, error) {
 	account, err := c.getGitlabUser(accessToken)
 	if err != nil {
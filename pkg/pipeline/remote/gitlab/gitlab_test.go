@@ -0,0 +1,48 @@
+package gitlab
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rancher/rancher/pkg/pipeline/remote/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetCommitStatus(t *testing.T) {
+	assert := assert.New(t)
+
+	var path, auth string
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.Method + " " + r.URL.EscapedPath()
+		auth = r.Header.Get("Authorization")
+		query = r.URL.Query()
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	c := &client{API: server.URL}
+	testCases := map[string]string{
+		model.CommitStatusPending: "pending",
+		model.CommitStatusSuccess: "success",
+		model.CommitStatusFailure: "failed",
+		model.CommitStatusError:   "canceled",
+	}
+	for state, expected := range testCases {
+		err := c.SetCommitStatus("https://gitlab.com/group/project.git", &model.CommitStatus{
+			Commit:      "0123abc",
+			State:       state,
+			TargetURL:   "https://rancher/run/3",
+			Description: "The pipeline is running",
+			Context:     model.CommitStatusContext,
+		}, "token")
+		assert.Nil(err, state)
+		assert.Equal("POST /projects/group%2Fproject/statuses/0123abc", path, state)
+		assert.Equal("Bearer token", auth, state)
+		assert.Equal([]string{expected}, query["state"], state)
+		assert.Equal([]string{model.CommitStatusContext}, query["name"], state)
+		assert.Equal([]string{"https://rancher/run/3"}, query["target_url"], state)
+		assert.Equal([]string{"The pipeline is running"}, query["description"], state)
+	}
+}
@@ -16,4 +16,14 @@ type BuildInfo struct {
 	Author          string `json:"author,omitempty"`
 	AvatarURL       string `json:"avatarUrl,omitempty"`
 	Email           string `json:"email,omitempty"`
+	FromFork        bool   `json:"fromFork,omitempty"`
+}
+
+// CommitStatus is the state of a pipeline execution reported on the commit it built
+type CommitStatus struct {
+	Commit      string
+	State       string
+	TargetURL   string
+	Description string
+	Context     string
 }
@@ -5,4 +5,15 @@
 	GithubType          = "github"
 	BitbucketCloudType  = "bitbucketcloud"
 	BitbucketServerType = "bitbucketserver"
+	GiteaType           = "gitea"
+	GitType             = "git"
+)
+
+const (
+	CommitStatusPending = "pending"
+	CommitStatusSuccess = "success"
+	CommitStatusFailure = "failure"
+	CommitStatusError   = "error"
+
+	CommitStatusContext = "rancher-pipeline"
 )
@@ -28,3 +28,15 @@ type Remote interface {
 type Refresher interface {
 	Refresh(cred *v3.SourceCodeCredential) (bool, error)
 }
+
+// Poller is implemented by remotes that cannot send webhooks. Their repositories are polled
+// for the commit of each branch and tag, keyed by their full ref names.
+type Poller interface {
+	Heads(repoURL string, accessToken string) (map[string]string, error)
+}
+
+// StatusReporter is implemented by remotes that can show the state of a pipeline
+// execution on the built commit, e.g. as a check on the pull request.
+type StatusReporter interface {
+	SetCommitStatus(repoURL string, status *CommitStatus, accessToken string) error
+}
@@ -38,6 +38,8 @@
 	MinioName                      = "minio"
 	MinioBucketLocation            = "local"
 	MinioLogBucket                 = "pipeline-logs"
+	MinioCacheBucket               = "pipeline-cache"
+	MinioArtifactBucket            = "pipeline-artifacts"
 	NetWorkPolicyName              = "pipeline-np"
 	LabelKeyApp                    = "app"
 	LabelKeyJenkins                = "jenkins"
@@ -68,11 +70,17 @@
 	StatePending  = "Pending"
 	StateDenied   = "Denied"
 
+	StateAwaitingApproval = "AwaitingApproval"
+
 	ConditionChanged = "Changed"
 
 	PipelineFinishLabel    = "pipeline.project.cattle.io/finish"
 	LocalRegistryPortLabel = "pipeline.project.cattle.io/local-registry-port"
 	PipelineNamespaceLabel = "pipeline.project.cattle.io/pipeline-namespace"
+	PipelineEngineLabel    = "pipeline.project.cattle.io/engine"
+
+	SecretBranchesAnnotation = "pipeline.project.cattle.io/branches"
+	MaskedSecret             = "********"
 
 	PipelineFileYml  = ".rancher-pipeline.yml"
 	PipelineFileYaml = ".rancher-pipeline.yaml"
@@ -107,6 +115,19 @@
 	SettingExecutorCPURequestDefault    = "10m"
 	SettingExecutorCPULimit             = "executor-cpu-limit"
 	SettingExecutorCPULimitDefault      = "1"
+	SettingEngine                       = "engine"
+	SettingEngineDefault                = EngineJenkins
+	SettingWorkspaceSize                = "workspace-size"
+	SettingWorkspaceSizeDefault         = "1Gi"
+	SettingForkApproval                 = "fork-pull-request-approval"
+	SettingForkApprovalDefault          = ForkApprovalAlways
+
+	ForkApprovalAlways     = "always"
+	ForkApprovalPrivileged = "privileged"
+	ForkApprovalNever      = "never"
+
+	EngineJenkins = "jenkins"
+	EngineNative  = "native"
 
 	PipelineToolsMemoryRequestDefault = "10Mi"
 	PipelineToolsMemoryLimitDefault   = "100Mi"
@@ -0,0 +1,160 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	v32 "github.com/rancher/rancher/pkg/apis/project.cattle.io/v3"
+)
+
+const maxMatrixSteps = 32
+
+var artifactNameRe = regexp.MustCompile(`^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$`)
+
+// ValidStages checks the cache, artifact and matrix definitions of a pipeline config
+func ValidStages(config *v32.PipelineConfig) error {
+	for _, stage := range config.Stages {
+		if err := validStageOrStep(stage.Cache, stage.Artifacts, stage.Matrix); err != nil {
+			return fmt.Errorf("invalid definition for stage %q: %v", stage.Name, err)
+		}
+		for _, step := range stage.Steps {
+			if err := validStageOrStep(step.Cache, step.Artifacts, step.Matrix); err != nil {
+				return fmt.Errorf("invalid definition for stage %q: %v", stage.Name, err)
+			}
+			if n := len(matrixCombinations(mergeMatrix(stage.Matrix, step.Matrix))); n > maxMatrixSteps {
+				return fmt.Errorf("invalid definition for stage %q: matrix expands to %d steps, at most %d are allowed", stage.Name, n, maxMatrixSteps)
+			}
+		}
+	}
+	return nil
+}
+
+func validStageOrStep(cache *v32.CacheConfig, artifacts []v32.ArtifactConfig, matrix map[string][]string) error {
+	for axis, values := range matrix {
+		if len(values) == 0 {
+			return fmt.Errorf("matrix axis %s has no values", axis)
+		}
+	}
+	if cache != nil && (cache.Key == "" || len(cache.Paths) == 0) {
+		return fmt.Errorf("cache requires a key and paths")
+	}
+	names := map[string]bool{}
+	for _, artifact := range artifacts {
+		if !artifactNameRe.MatchString(artifact.Name) {
+			return fmt.Errorf("artifact name %q must consist of lower case alphanumeric characters, '-' or '.'", artifact.Name)
+		}
+		if names[artifact.Name] {
+			return fmt.Errorf("artifact %q is defined twice", artifact.Name)
+		}
+		if len(artifact.Paths) == 0 {
+			return fmt.Errorf("artifact %q requires paths", artifact.Name)
+		}
+		names[artifact.Name] = true
+	}
+	return nil
+}
+
+// HasCacheOrArtifacts reports whether any step of a config saves a cache or artifacts
+func HasCacheOrArtifacts(config *v32.PipelineConfig) bool {
+	for _, stage := range config.Stages {
+		if stage.Cache != nil || len(stage.Artifacts) > 0 {
+			return true
+		}
+		for _, step := range stage.Steps {
+			if step.Cache != nil || len(step.Artifacts) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// expandStages applies the cache and artifacts of each stage to its steps, and expands every
+// step with a matrix into one step per combination of the matrix values, exposed as env vars
+func expandStages(config *v32.PipelineConfig) {
+	for i := range config.Stages {
+		stage := &config.Stages[i]
+		var steps []v32.Step
+		for _, step := range stage.Steps {
+			if step.Cache == nil && stage.Cache != nil {
+				step.Cache = stage.Cache.DeepCopy()
+			}
+			for _, artifact := range stage.Artifacts {
+				if !hasArtifact(step.Artifacts, artifact.Name) {
+					step.Artifacts = append(step.Artifacts, *artifact.DeepCopy())
+				}
+			}
+			combinations := matrixCombinations(mergeMatrix(stage.Matrix, step.Matrix))
+			step.Matrix = nil
+			if len(combinations) == 0 {
+				steps = append(steps, step)
+				continue
+			}
+			for _, combination := range combinations {
+				expanded := *step.DeepCopy()
+				if expanded.Env == nil {
+					expanded.Env = map[string]string{}
+				}
+				for k, v := range combination {
+					expanded.Env[k] = v
+				}
+				steps = append(steps, expanded)
+			}
+		}
+		stage.Steps = steps
+		stage.Cache = nil
+		stage.Artifacts = nil
+		stage.Matrix = nil
+	}
+}
+
+func hasArtifact(artifacts []v32.ArtifactConfig, name string) bool {
+	for _, artifact := range artifacts {
+		if artifact.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeMatrix returns the stage matrix with the axes of the step matrix overriding it
+func mergeMatrix(stageMatrix, stepMatrix map[string][]string) map[string][]string {
+	result := map[string][]string{}
+	for k, v := range stageMatrix {
+		result[k] = v
+	}
+	for k, v := range stepMatrix {
+		result[k] = v
+	}
+	return result
+}
+
+// matrixCombinations returns every combination of the matrix values, ordered by axis name
+func matrixCombinations(matrix map[string][]string) []map[string]string {
+	if len(matrix) == 0 {
+		return nil
+	}
+	var axes []string
+	for axis := range matrix {
+		axes = append(axes, axis)
+	}
+	sort.Strings(axes)
+
+	combinations := []map[string]string{{}}
+	for _, axis := range axes {
+		var next []map[string]string
+		for _, combination := range combinations {
+			for _, value := range matrix[axis] {
+				c := make(map[string]string, len(combination)+1)
+				for k, v := range combination {
+					c[k] = v
+				}
+				c[axis] = value
+				next = append(next, c)
+			}
+		}
+		combinations = next
+	}
+	return combinations
+}
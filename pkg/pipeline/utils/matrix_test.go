@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/project.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandStages(t *testing.T) {
+	assert := assert.New(t)
+
+	config := &v32.PipelineConfig{
+		Stages: []v32.Stage{
+			{
+				Name:      "test",
+				Cache:     &v32.CacheConfig{Key: "go", Paths: []string{"/go/pkg"}},
+				Artifacts: []v32.ArtifactConfig{{Name: "coverage", Paths: []string{"cover.out"}}},
+				Matrix:    map[string][]string{"GO_VERSION": {"1.13", "1.14"}},
+				Steps: []v32.Step{
+					{
+						RunScriptConfig: &v32.RunScriptConfig{Image: "golang", ShellScript: "go test ./..."},
+						Env:             map[string]string{"CGO_ENABLED": "0"},
+						Matrix:          map[string][]string{"GOOS": {"linux", "windows"}},
+					},
+					{
+						RunScriptConfig: &v32.RunScriptConfig{Image: "golang", ShellScript: "go vet ./..."},
+						Cache:           &v32.CacheConfig{Key: "vet", Paths: []string{"/root/.cache"}},
+						Artifacts:       []v32.ArtifactConfig{{Name: "coverage", Paths: []string{"vet.out"}}},
+						Matrix:          map[string][]string{"GO_VERSION": {"1.14"}},
+					},
+				},
+			},
+		},
+	}
+	assert.Nil(ValidStages(config))
+
+	expandStages(config)
+	stage := config.Stages[0]
+	assert.Nil(stage.Cache)
+	assert.Nil(stage.Artifacts)
+	assert.Nil(stage.Matrix)
+	assert.Len(stage.Steps, 5)
+
+	assert.Equal(map[string]string{"CGO_ENABLED": "0", "GO_VERSION": "1.13", "GOOS": "linux"}, stage.Steps[0].Env)
+	assert.Equal(map[string]string{"CGO_ENABLED": "0", "GO_VERSION": "1.14", "GOOS": "linux"}, stage.Steps[1].Env)
+	assert.Equal(map[string]string{"CGO_ENABLED": "0", "GO_VERSION": "1.13", "GOOS": "windows"}, stage.Steps[2].Env)
+	assert.Equal(map[string]string{"CGO_ENABLED": "0", "GO_VERSION": "1.14", "GOOS": "windows"}, stage.Steps[3].Env)
+	for _, step := range stage.Steps[:4] {
+		assert.Nil(step.Matrix)
+		assert.Equal("go", step.Cache.Key)
+		assert.Equal([]string{"cover.out"}, step.Artifacts[0].Paths)
+	}
+
+	assert.Equal(map[string]string{"GO_VERSION": "1.14"}, stage.Steps[4].Env)
+	assert.Equal("vet", stage.Steps[4].Cache.Key)
+	assert.Len(stage.Steps[4].Artifacts, 1)
+	assert.Equal([]string{"vet.out"}, stage.Steps[4].Artifacts[0].Paths)
+}
+
+func TestValidStages(t *testing.T) {
+	assert := assert.New(t)
+
+	step := v32.Step{RunScriptConfig: &v32.RunScriptConfig{Image: "busybox", ShellScript: "true"}}
+	for _, stage := range []v32.Stage{
+		{Name: "cache", Steps: []v32.Step{step}, Cache: &v32.CacheConfig{Key: "key"}},
+		{Name: "artifact", Steps: []v32.Step{step}, Artifacts: []v32.ArtifactConfig{{Name: "Dist", Paths: []string{"dist"}}}},
+		{Name: "duplicated", Steps: []v32.Step{step}, Artifacts: []v32.ArtifactConfig{{Name: "dist", Paths: []string{"a"}}, {Name: "dist", Paths: []string{"b"}}}},
+		{Name: "empty", Steps: []v32.Step{step}, Matrix: map[string][]string{"A": {}}},
+		{Name: "large", Steps: []v32.Step{step}, Matrix: map[string][]string{
+			"A": {"1", "2", "3", "4"},
+			"B": {"1", "2", "3", "4"},
+			"C": {"1", "2", "3"},
+		}},
+	} {
+		assert.Error(ValidStages(&v32.PipelineConfig{Stages: []v32.Stage{stage}}), stage.Name)
+	}
+}
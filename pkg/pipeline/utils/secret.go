@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	v32 "github.com/rancher/rancher/pkg/apis/project.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// SecretAllowsExecution checks whether an execution may use a secret. Secrets annotated with
+// a comma-separated list of branch patterns are only available to executions of matching
+// branches or tags, and never to pull requests, which build code that is not on the branch yet.
+//
+// The native engine runs steps that do not deploy to the cluster without the token of the pipeline
+// service account, so their scripts cannot read the secret from the pipeline namespace either.
+// Steps of the Jenkins engine share the token of the agent pod, so the Jenkins engine does not
+// run executions that may not use every secret of the pipeline namespace.
+func SecretAllowsExecution(secret *corev1.Secret, execution *v3.PipelineExecution) bool {
+	value := strings.TrimSpace(secret.Annotations[SecretBranchesAnnotation])
+	if value == "" {
+		return true
+	}
+	if execution.Spec.Event == WebhookEventPullRequest {
+		return false
+	}
+	constraint := &v32.Constraint{}
+	for _, pattern := range strings.Split(value, ",") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			constraint.Include = append(constraint.Include, pattern)
+		}
+	}
+	return Includes(constraint, execution.Spec.Branch)
+}
+
+// minMaskedLineLength is the minimum length of the lines of multi-line values that are masked
+// on their own, shorter lines are too common in logs to be masked everywhere
+const minMaskedLineLength = 6
+
+// structuralLine matches lines of multi-line values that only carry their structure, like the
+// braces and keys of a docker config or the armor of a PEM block
+var structuralLine = regexp.MustCompile(`^(-----[A-Z0-9 ]+-----|[^a-zA-Z0-9]*|"[^"]*"\s*:\s*[{\[]?\s*)$`)
+
+// MaskSecrets replaces the secret values in a log. Each line of multi-line values is also
+// masked on its own as tools usually print them line by line, unless it is short or only
+// carries the structure of the value. Masking keeps the lines of the log, so that logs can be
+// streamed line by line while they are masked as a whole.
+func MaskSecrets(content string, values []string) string {
+	var toMask []string
+	for _, value := range values {
+		toMask = append(toMask, value)
+		if !strings.Contains(value, "\n") {
+			continue
+		}
+		for _, line := range strings.Split(value, "\n") {
+			line = strings.TrimSpace(line)
+			if len(line) >= minMaskedLineLength && !structuralLine.MatchString(line) {
+				toMask = append(toMask, line)
+			}
+		}
+	}
+	//mask longer values first so that values containing others are fully masked
+	sort.Slice(toMask, func(i, j int) bool {
+		return len(toMask[i]) > len(toMask[j])
+	})
+	for _, value := range toMask {
+		if value == "" {
+			continue
+		}
+		mask := MaskedSecret + strings.Repeat("\n"+MaskedSecret, strings.Count(value, "\n"))
+		content = strings.Replace(content, value, mask, -1)
+	}
+	return content
+}
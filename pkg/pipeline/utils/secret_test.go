@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/generated/norman/project.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestSecretAllowsExecution(t *testing.T) {
+	assert := assert.New(t)
+	secret := &corev1.Secret{}
+	execution := &v3.PipelineExecution{}
+	execution.Spec.Event = WebhookEventPush
+	execution.Spec.Branch = "feature-login"
+
+	assert.True(SecretAllowsExecution(secret, execution))
+
+	secret.Annotations = map[string]string{SecretBranchesAnnotation: "master, release-*"}
+	assert.False(SecretAllowsExecution(secret, execution))
+	execution.Spec.Branch = "release-2.5"
+	assert.True(SecretAllowsExecution(secret, execution))
+	execution.Spec.Branch = "master"
+	assert.True(SecretAllowsExecution(secret, execution))
+
+	execution.Spec.Event = WebhookEventPullRequest
+	assert.False(SecretAllowsExecution(secret, execution))
+}
+
+func TestMaskSecrets(t *testing.T) {
+	assert := assert.New(t)
+	log := "+ docker login -p s3cr3t\nLogin Succeeded\n-----BEGIN KEY-----\nabcdef\n-----END KEY-----\ntoken=s3cr3t-extended\n"
+	masked := MaskSecrets(log, []string{"", "s3cr3t", "s3cr3t-extended", "-----BEGIN KEY-----\nabcdef\n-----END KEY-----"})
+	assert.Equal("+ docker login -p ********\nLogin Succeeded\n********\n********\n********\ntoken=********\n", masked)
+
+	dockerConfig := "{\n  \"auths\": {\n    \"registry.example.com\": {\n      \"auth\": \"dXNlcjpwYXNzd29yZA==\"\n    }\n  }\n}"
+	log = "{\"status\": \"ok\"}\n\"auth\": \"dXNlcjpwYXNzd29yZA==\"\n-----BEGIN CERTIFICATE-----\nMIIBszCCAVmgAwIBAgIU\n"
+	masked = MaskSecrets(log, []string{dockerConfig, "-----BEGIN CERTIFICATE-----\nMIIBszCCAVmgAwIBAgIU\n-----END CERTIFICATE-----"})
+	assert.Equal("{\"status\": \"ok\"}\n********\n-----BEGIN CERTIFICATE-----\n********\n", masked)
+}
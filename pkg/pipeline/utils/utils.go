@@ -16,6 +16,7 @@
 	"golang.org/x/crypto/bcrypt"
 	"gopkg.in/yaml.v2"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -23,6 +24,7 @@ func initExecution(p *v3.Pipeline, config *v32.PipelineConfig) *v3.PipelineExecu
 	//add Clone stage/step at the start
 
 	toRunConfig := configWithCloneStage(config)
+	expandStages(toRunConfig)
 	execution := &v3.PipelineExecution{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      GetNextExecutionName(p),
@@ -94,14 +96,37 @@ func IsFinishState(state string) bool {
 	if state == StateBuilding ||
 		state == StateWaiting ||
 		state == StateQueueing ||
-		state == StatePending {
+		state == StatePending ||
+		state == StateAwaitingApproval {
 		return false
 	}
 	return true
 }
 
+// HasPrivilegedSteps checks whether a pipeline config has steps that get access to
+// secrets, registries or the cluster, or run in privileged containers
+func HasPrivilegedSteps(config *v32.PipelineConfig) bool {
+	for _, stage := range config.Stages {
+		for _, step := range stage.Steps {
+			if step.Privileged ||
+				len(step.EnvFrom) > 0 ||
+				step.PublishImageConfig != nil ||
+				step.ApplyYamlConfig != nil ||
+				step.PublishCatalogConfig != nil ||
+				step.ApplyAppConfig != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func GenerateExecution(executions v3.PipelineExecutionInterface, pipeline *v3.Pipeline, pipelineConfig *v32.PipelineConfig, info *model.BuildInfo) (*v3.PipelineExecution, error) {
 
+	if err := ValidStages(pipelineConfig); err != nil {
+		return nil, err
+	}
+
 	//Generate a new pipeline execution
 	execution := initExecution(pipeline, pipelineConfig)
 	execution.Spec.TriggeredBy = info.TriggerType
@@ -116,6 +141,7 @@ func GenerateExecution(executions v3.PipelineExecutionInterface, pipeline *v3.Pi
 	execution.Spec.Ref = info.Ref
 	execution.Spec.Commit = info.Commit
 	execution.Spec.Event = info.Event
+	execution.Spec.FromFork = info.FromFork
 
 	if info.RepositoryURL != "" {
 		execution.Spec.RepositoryURL = info.RepositoryURL
@@ -298,3 +324,31 @@ func EnsureAccessToken(credentialInterface v3.SourceCodeCredentialInterface, rem
 	}
 	return credential.Spec.AccessToken, nil
 }
+
+// GetPipelineSettingValue returns the value of a project pipeline setting, falling back to its
+// default, or to defaultValue when the setting has not been initialized for the project yet
+func GetPipelineSettingValue(lister v3.PipelineSettingLister, projectID, name, defaultValue string) (string, error) {
+	setting, err := lister.Get(projectID, name)
+	if apierrors.IsNotFound(err) {
+		return defaultValue, nil
+	} else if err != nil {
+		return "", err
+	}
+	if setting.Value != "" {
+		return setting.Value, nil
+	}
+	if setting.Default != "" {
+		return setting.Default, nil
+	}
+	return defaultValue, nil
+}
+
+// GetPipelineEngine returns the engine an execution runs on. The engine is recorded on the
+// execution once it starts, so changing the project setting only affects new executions.
+func GetPipelineEngine(lister v3.PipelineSettingLister, execution *v3.PipelineExecution) (string, error) {
+	if engine := execution.Labels[PipelineEngineLabel]; engine != "" {
+		return engine, nil
+	}
+	_, projectID := ref.Parse(execution.Spec.ProjectName)
+	return GetPipelineSettingValue(lister, projectID, SettingEngine, SettingEngineDefault)
+}
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/project.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasPrivilegedSteps(t *testing.T) {
+	assert := assert.New(t)
+
+	config := &v32.PipelineConfig{
+		Stages: []v32.Stage{
+			{
+				Name: "test",
+				Steps: []v32.Step{
+					{RunScriptConfig: &v32.RunScriptConfig{Image: "golang", ShellScript: "go test ./..."}},
+				},
+			},
+		},
+	}
+	assert.False(HasPrivilegedSteps(config))
+
+	privilegedSteps := []v32.Step{
+		{Privileged: true},
+		{EnvFrom: []v32.EnvFrom{{SourceName: "secret", SourceKey: "token"}}},
+		{PublishImageConfig: &v32.PublishImageConfig{Tag: "app:dev"}},
+		{ApplyYamlConfig: &v32.ApplyYamlConfig{Path: "deployment.yaml"}},
+		{PublishCatalogConfig: &v32.PublishCatalogConfig{Path: "charts/app"}},
+		{ApplyAppConfig: &v32.ApplyAppConfig{Name: "app"}},
+	}
+	for _, step := range privilegedSteps {
+		config.Stages[0].Steps = []v32.Step{{}, step}
+		assert.True(HasPrivilegedSteps(config))
+	}
+}
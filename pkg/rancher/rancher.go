@@ -17,6 +17,7 @@
 	managementauth "github.com/rancher/rancher/pkg/controllers/management/auth"
 	crds "github.com/rancher/rancher/pkg/crds/dashboard"
 	dashboarddata "github.com/rancher/rancher/pkg/data/dashboard"
+	"github.com/rancher/rancher/pkg/encryptedstore"
 	"github.com/rancher/rancher/pkg/features"
 	"github.com/rancher/rancher/pkg/multiclustermanager"
 	"github.com/rancher/rancher/pkg/tls"
@@ -32,23 +33,27 @@
 )
 
 type Options struct {
-	ACMEDomains       cli.StringSlice
-	AddLocal          string
-	Embedded          bool
-	BindHost          string
-	HTTPListenPort    int
-	HTTPSListenPort   int
-	K8sMode           string
-	Debug             bool
-	Trace             bool
-	NoCACerts         bool
-	AuditLogPath      string
-	AuditLogMaxage    int
-	AuditLogMaxsize   int
-	AuditLogMaxbackup int
-	AuditLevel        int
-	Agent             bool
-	Features          string
+	ACMEDomains                  cli.StringSlice
+	AddLocal                     string
+	Embedded                     bool
+	BindHost                     string
+	HTTPListenPort               int
+	HTTPSListenPort              int
+	K8sMode                      string
+	Debug                        bool
+	Trace                        bool
+	NoCACerts                    bool
+	AuditLogPath                 string
+	AuditPolicyFile              string
+	AuditLogChainKeyFile         string
+	AuditLogMaxage               int
+	AuditLogMaxsize              int
+	AuditLogMaxbackup            int
+	AuditLevel                   int
+	Agent                        bool
+	SecretsEncryptionKeyFile     string
+	SecretsEncryptionKMSEndpoint string
+	Features                     string
 }
 
 type Rancher struct {
@@ -90,7 +95,12 @@ func New(ctx context.Context, clientConfg clientcmd.ClientConfig, opts *Options)
 	if err != nil {
 		return nil, err
 	}
-	wranglerContext.MultiClusterManager = newMCM(wranglerContext, opts)
+	secretsEncryptionKeys, err := encryptedstore.NewKeyProviders(opts.SecretsEncryptionKeyFile, opts.SecretsEncryptionKMSEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	wranglerContext.MultiClusterManager = newMCM(wranglerContext, opts, secretsEncryptionKeys)
 	wranglerContext.Agent = opts.Agent
 
 	podsecuritypolicytemplate.RegisterIndexers(wranglerContext)
@@ -143,7 +153,10 @@ func New(ctx context.Context, clientConfg clientcmd.ClientConfig, opts *Options)
 		return nil, err
 	}
 
-	auditLogWriter := audit.NewLogWriter(opts.AuditLogPath, opts.AuditLevel, opts.AuditLogMaxage, opts.AuditLogMaxbackup, opts.AuditLogMaxsize)
+	auditLogWriter, err := audit.NewLogWriter(opts.AuditLogPath, opts.AuditPolicyFile, opts.AuditLogChainKeyFile, opts.AuditLevel, opts.AuditLogMaxage, opts.AuditLogMaxbackup, opts.AuditLogMaxsize)
+	if err != nil {
+		return nil, err
+	}
 	auditFilter := audit.NewAuditLogMiddleware(auditLogWriter)
 
 	return &Rancher{
@@ -220,14 +233,15 @@ func (r *Rancher) ListenAndServe(ctx context.Context) error {
 	return ctx.Err()
 }
 
-func newMCM(wrangler *wrangler.Context, opts *Options) wrangler.MultiClusterManager {
+func newMCM(wrangler *wrangler.Context, opts *Options, secretsEncryptionKeys encryptedstore.KeyProviders) wrangler.MultiClusterManager {
 	return multiclustermanager.NewDeferredServer(wrangler, &multiclustermanager.Options{
-		RemoveLocalCluster:  opts.AddLocal == "false",
-		LocalClusterEnabled: localClusterEnabled(opts),
-		Embedded:            opts.Embedded,
-		HTTPSListenPort:     opts.HTTPSListenPort,
-		Debug:               opts.Debug,
-		Trace:               opts.Trace,
+		RemoveLocalCluster:    opts.AddLocal == "false",
+		LocalClusterEnabled:   localClusterEnabled(opts),
+		Embedded:              opts.Embedded,
+		HTTPSListenPort:       opts.HTTPSListenPort,
+		Debug:                 opts.Debug,
+		Trace:                 opts.Trace,
+		SecretsEncryptionKeys: secretsEncryptionKeys,
 	})
 }
 
@@ -1,7 +1,10 @@
 package resourcequota
 
 import (
+	"fmt"
+	"math"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -12,11 +15,48 @@
 	api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/kubernetes/pkg/quota/v1"
 )
 
 var (
 	projectLockCache = cache.NewLRUExpireCache(1000)
+
+	// limitFields are the json names of the fields of ResourceQuotaLimit
+	limitFields = map[string]bool{
+		"pods":                   true,
+		"services":               true,
+		"replicationControllers": true,
+		"secrets":                true,
+		"configMaps":             true,
+		"persistentVolumeClaims": true,
+		"servicesNodePorts":      true,
+		"servicesLoadBalancers":  true,
+		"requestsCpu":            true,
+		"requestsMemory":         true,
+		"requestsStorage":        true,
+		"limitsCpu":              true,
+		"limitsMemory":           true,
+	}
+
+	// fieldResourceNames are the resource names the fields of ResourceQuotaLimit set, they cannot be extended resources
+	fieldResourceNames = map[string]bool{
+		"pods":                   true,
+		"services":               true,
+		"replicationcontrollers": true,
+		"secrets":                true,
+		"configmaps":             true,
+		"persistentvolumeclaims": true,
+		"services.nodeports":     true,
+		"services.loadbalancers": true,
+		"cpu":                    true,
+		"memory":                 true,
+		"requests.cpu":           true,
+		"requests.memory":        true,
+		"requests.storage":       true,
+		"limits.cpu":             true,
+		"limits.memory":          true,
+	}
 )
 
 func GetProjectLock(projectID string) *sync.Mutex {
@@ -60,12 +100,12 @@ func IsQuotaFit(nsLimit *v32.ResourceQuotaLimit, nsLimits []*v32.ResourceQuotaLi
 
 func ConvertLimitToResourceList(limit *v32.ResourceQuotaLimit) (api.ResourceList, error) {
 	toReturn := api.ResourceList{}
-	converted, err := convert.EncodeToMap(limit)
+	converted, err := LimitToMap(limit)
 	if err != nil {
 		return nil, err
 	}
 	for key, value := range converted {
-		q, err := resource.ParseQuantity(convert.ToString(value))
+		q, err := resource.ParseQuantity(value)
 		if err != nil {
 			return nil, err
 		}
@@ -74,6 +114,122 @@ func ConvertLimitToResourceList(limit *v32.ResourceQuotaLimit) (api.ResourceList
 	return toReturn, nil
 }
 
+// LimitToMap returns the quantities of the limit keyed by field name, the extended resources are keyed by their
+// resource name.
+func LimitToMap(limit *v32.ResourceQuotaLimit) (map[string]string, error) {
+	if limit == nil {
+		return map[string]string{}, nil
+	}
+	converted, err := convert.EncodeToMap(limit)
+	if err != nil {
+		return nil, err
+	}
+	toReturn := map[string]string{}
+	for key, value := range converted {
+		if limitFields[key] {
+			toReturn[key] = convert.ToString(value)
+		}
+	}
+	for key, value := range limit.Extended {
+		toReturn[key] = value
+	}
+	return toReturn, nil
+}
+
+// MapToLimit returns the limit of quantities keyed as by LimitToMap.
+func MapToLimit(values map[string]string) (*v32.ResourceQuotaLimit, error) {
+	fields := map[string]interface{}{}
+	extended := map[string]string{}
+	for key, value := range values {
+		if limitFields[key] {
+			fields[key] = value
+		} else {
+			extended[key] = value
+		}
+	}
+	toReturn := &v32.ResourceQuotaLimit{}
+	if err := convert.ToObj(fields, toReturn); err != nil {
+		return nil, err
+	}
+	if len(extended) > 0 {
+		toReturn.Extended = extended
+	}
+	return toReturn, nil
+}
+
+// ValidateLimit checks that the extended resources of the limit are resource names no field of the limit sets, and
+// that every quantity of the limit parses.
+func ValidateLimit(limit *v32.ResourceQuotaLimit) error {
+	for key := range limit.Extended {
+		if err := validateExtendedResourceName(key); err != nil {
+			return err
+		}
+	}
+	converted, err := LimitToMap(limit)
+	if err != nil {
+		return err
+	}
+	for key, value := range converted {
+		if _, err := resource.ParseQuantity(value); err != nil {
+			return fmt.Errorf("invalid quantity %q for %s: %v", value, key, err)
+		}
+	}
+	return nil
+}
+
+func validateExtendedResourceName(name string) error {
+	if fieldResourceNames[name] {
+		return fmt.Errorf("%s is set by a resource quota limit field and cannot be an extended resource", name)
+	}
+	if errs := validation.IsQualifiedName(name); len(errs) > 0 {
+		return fmt.Errorf("invalid extended resource name %s: %s", name, strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// ValidateOvercommitRatio checks that the ratios are keyed by limit field or extended resource name and are numbers no
+// lower than 1.
+func ValidateOvercommitRatio(ratios map[string]string) error {
+	for key, value := range ratios {
+		if !limitFields[key] && validateExtendedResourceName(key) != nil {
+			return fmt.Errorf("%s is not a resource quota limit", key)
+		}
+		ratio, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(ratio) || math.IsInf(ratio, 0) || ratio < 1 {
+			return fmt.Errorf("ratio of %s must be a number no lower than 1", key)
+		}
+	}
+	return nil
+}
+
+// ApplyOvercommit returns the limit with each field scaled by its overcommit ratio, fields without a ratio are kept as
+// is.
+func ApplyOvercommit(limit *v32.ResourceQuotaLimit, ratios map[string]string) (*v32.ResourceQuotaLimit, error) {
+	if len(ratios) == 0 {
+		return limit, nil
+	}
+	converted, err := LimitToMap(limit)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range converted {
+		ratio, ok := ratios[key]
+		if !ok {
+			continue
+		}
+		r, err := strconv.ParseFloat(ratio, 64)
+		if err != nil {
+			return nil, err
+		}
+		q, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, err
+		}
+		converted[key] = resource.NewMilliQuantity(int64(float64(q.MilliValue())*r), q.Format).String()
+	}
+	return MapToLimit(converted)
+}
+
 func prettyPrint(item api.ResourceList) string {
 	parts := []string{}
 	keys := []string{}
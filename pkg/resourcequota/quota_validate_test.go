@@ -0,0 +1,99 @@
+package resourcequota
+
+import (
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateOvercommitRatio(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(ValidateOvercommitRatio(nil))
+	assert.Nil(ValidateOvercommitRatio(map[string]string{"requestsCpu": "1.5", "pods": "1"}))
+	assert.Nil(ValidateOvercommitRatio(map[string]string{"requests.nvidia.com/gpu": "2"}))
+	assert.EqualError(ValidateOvercommitRatio(map[string]string{"requests.cpu": "2"}), "requests.cpu is not a resource quota limit")
+	assert.EqualError(ValidateOvercommitRatio(map[string]string{"requests/nvidia.com/gpu": "2"}), "requests/nvidia.com/gpu is not a resource quota limit")
+	assert.EqualError(ValidateOvercommitRatio(map[string]string{"limitsMemory": "0.5"}), "ratio of limitsMemory must be a number no lower than 1")
+	assert.EqualError(ValidateOvercommitRatio(map[string]string{"limitsMemory": "twice"}), "ratio of limitsMemory must be a number no lower than 1")
+	assert.EqualError(ValidateOvercommitRatio(map[string]string{"limitsMemory": "NaN"}), "ratio of limitsMemory must be a number no lower than 1")
+	assert.EqualError(ValidateOvercommitRatio(map[string]string{"limitsMemory": "+Inf"}), "ratio of limitsMemory must be a number no lower than 1")
+}
+
+func TestApplyOvercommit(t *testing.T) {
+	assert := assert.New(t)
+
+	limit := &v32.ResourceQuotaLimit{
+		Pods:           "10",
+		RequestsCPU:    "2000m",
+		RequestsMemory: "1Gi",
+		Extended: map[string]string{
+			"requests.nvidia.com/gpu": "4",
+		},
+	}
+	result, err := ApplyOvercommit(limit, nil)
+	assert.Nil(err)
+	assert.Equal(limit, result)
+
+	result, err = ApplyOvercommit(limit, map[string]string{
+		"requestsCpu":             "1.5",
+		"requestsMemory":          "2",
+		"limitsCpu":               "3",
+		"requests.nvidia.com/gpu": "1.5",
+	})
+	assert.Nil(err)
+	assert.Equal(&v32.ResourceQuotaLimit{
+		Pods:           "10",
+		RequestsCPU:    "3",
+		RequestsMemory: "2Gi",
+		Extended: map[string]string{
+			"requests.nvidia.com/gpu": "6",
+		},
+	}, result)
+}
+
+func TestValidateLimit(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(ValidateLimit(&v32.ResourceQuotaLimit{
+		Pods: "10",
+		Extended: map[string]string{
+			"requests.nvidia.com/gpu":                           "2",
+			"requests.ephemeral-storage":                        "10Gi",
+			"gold.storageclass.storage.k8s.io/requests.storage": "100Gi",
+			"count/deployments.apps":                            "20",
+		},
+	}))
+	assert.EqualError(ValidateLimit(&v32.ResourceQuotaLimit{
+		Extended: map[string]string{"requests.memory": "1Gi"},
+	}), "requests.memory is set by a resource quota limit field and cannot be an extended resource")
+	assert.EqualError(ValidateLimit(&v32.ResourceQuotaLimit{
+		Extended: map[string]string{"count/deployments.apps": "many"},
+	}), `invalid quantity "many" for count/deployments.apps: quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'`)
+}
+
+func TestLimitToMap(t *testing.T) {
+	assert := assert.New(t)
+
+	limit := &v32.ResourceQuotaLimit{
+		RequestsCPU: "1",
+		Extended: map[string]string{
+			"count/deployments.apps": "20",
+		},
+	}
+	converted, err := LimitToMap(limit)
+	assert.Nil(err)
+	assert.Equal(map[string]string{
+		"requestsCpu":            "1",
+		"count/deployments.apps": "20",
+	}, converted)
+
+	result, err := MapToLimit(converted)
+	assert.Nil(err)
+	assert.Equal(limit, result)
+
+	result, err = MapToLimit(map[string]string{"pods": "5"})
+	assert.Nil(err)
+	assert.Equal(&v32.ResourceQuotaLimit{Pods: "5"}, result)
+}
@@ -4,6 +4,7 @@
 	"fmt"
 
 	"github.com/rancher/rancher/pkg/controllers/management/clusterprovisioner"
+	"github.com/rancher/rancher/pkg/encryptedstore"
 	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/kontainer-engine/cluster"
@@ -16,9 +17,9 @@ type BundleLookup struct {
 	engineStore cluster.PersistentStore
 }
 
-func NewLookup(namespaces v1.NamespaceInterface, secrets v1.SecretsGetter) *BundleLookup {
+func NewLookup(namespaces v1.NamespaceInterface, secrets v1.SecretsGetter, keys encryptedstore.KeyProviders) *BundleLookup {
 	return &BundleLookup{
-		engineStore: clusterprovisioner.NewPersistentStore(namespaces, secrets),
+		engineStore: clusterprovisioner.NewPersistentStore(namespaces, secrets, keys),
 	}
 }
 
@@ -50,7 +50,7 @@ type RKENodeConfigServer struct {
 func Handler(auth *tunnelserver.Authorizer, scaledContext *config.ScaledContext) http.Handler {
 	return &RKENodeConfigServer{
 		auth:                 auth,
-		lookup:               NewLookup(scaledContext.Core.Namespaces(""), scaledContext.Core),
+		lookup:               NewLookup(scaledContext.Core.Namespaces(""), scaledContext.Core, scaledContext.SecretsEncryptionKeys),
 		systemAccountManager: systemaccount.NewManagerFromScale(scaledContext),
 		serviceOptionsLister: scaledContext.Management.RkeK8sServiceOptions("").Controller().Lister(),
 		serviceOptions:       scaledContext.Management.RkeK8sServiceOptions(""),
@@ -44,6 +44,8 @@ type ResourceQuotaLimit struct {
 	RequestsStorage        string `json:"requestsStorage,omitempty"`
 	LimitsCPU              string `json:"limitsCpu,omitempty"`
 	LimitsMemory           string `json:"limitsMemory,omitempty"`
+	// Extended holds the quota of the resources that have no field above keyed by their Kubernetes resource name
+	Extended map[string]string `json:"extended,omitempty"`
 }
 
 type NamespaceMove struct {
@@ -51,7 +51,9 @@
 		Init(driverMetadataTypes).
 		Init(driverMetadataCisTypes).
 		Init(encryptionTypes).
-		Init(fleetTypes)
+		Init(fleetTypes).
+		Init(gitSyncTypes).
+		Init(resourceQuotaRequestTypes)
 
 	TokenSchemas = factory.Schemas(&Version).
 			Init(tokens)
@@ -61,6 +63,30 @@ func fleetTypes(schemas *types.Schemas) *types.Schemas {
 	return schemas.MustImport(&Version, v3.FleetWorkspace{})
 }
 
+func gitSyncTypes(schemas *types.Schemas) *types.Schemas {
+	return schemas.MustImport(&Version, v3.GitSync{})
+}
+
+func resourceQuotaRequestTypes(schemas *types.Schemas) *types.Schemas {
+	return schemas.
+		AddMapperForType(&Version, v3.ResourceQuotaRequest{},
+			&m.Embed{Field: "status"},
+		).
+		MustImport(&Version, v3.ResourceQuotaRequestReviewInput{}).
+		MustImportAndCustomize(&Version, v3.ResourceQuotaRequest{}, func(schema *types.Schema) {
+			schema.ResourceActions = map[string]types.Action{
+				"approve": {
+					Input:  "resourceQuotaRequestReviewInput",
+					Output: "resourceQuotaRequest",
+				},
+				"deny": {
+					Input:  "resourceQuotaRequestReviewInput",
+					Output: "resourceQuotaRequest",
+				},
+			}
+		})
+}
+
 func rkeTypes(schemas *types.Schemas) *types.Schemas {
 	return schemas.AddMapperForType(&Version, rketypes.BaseService{}, m.Drop{Field: "image"}).
 		AddMapperForType(&Version, v1.Taint{},
@@ -240,10 +266,16 @@ func clusterTypes(schemas *types.Schemas) *types.Schemas {
 		MustImport(&Version, v3.RotateCertificateInput{}).
 		MustImport(&Version, v3.RotateCertificateOutput{}).
 		MustImport(&Version, v3.ImportYamlOutput{}).
+		MustImport(&Version, v3.ImportBundleInput{}).
+		MustImport(&Version, v3.ImportBundleOutput{}).
 		MustImport(&Version, v3.ExportOutput{}).
 		MustImport(&Version, v3.MonitoringInput{}).
 		MustImport(&Version, v3.MonitoringOutput{}).
 		MustImport(&Version, v3.RestoreFromEtcdBackupInput{}).
+		MustImport(&Version, v3.RestoreDryRunInput{}).
+		MustImport(&Version, v3.RestoreDryRunOutput{}).
+		MustImport(&Version, v3.ClusterUtilizationInput{}).
+		MustImport(&Version, v3.ClusterUtilizationOutput{}).
 		MustImport(&Version, v3.SaveAsTemplateInput{}).
 		MustImport(&Version, v3.SaveAsTemplateOutput{}).
 		MustImportAndCustomize(&Version, rketypes.ETCDService{}, func(schema *types.Schema) {
@@ -271,6 +303,10 @@ func clusterTypes(schemas *types.Schemas) *types.Schemas {
 			schema.ResourceActions[v3.ClusterActionExportYaml] = types.Action{
 				Output: "exportOutput",
 			}
+			schema.ResourceActions[v3.ClusterActionImportBundle] = types.Action{
+				Input:  "importBundleInput",
+				Output: "importBundleOutput",
+			}
 			schema.ResourceActions[v3.ClusterActionEnableMonitoring] = types.Action{
 				Input: "monitoringInput",
 			}
@@ -285,6 +321,14 @@ func clusterTypes(schemas *types.Schemas) *types.Schemas {
 			schema.ResourceActions[v3.ClusterActionRestoreFromEtcdBackup] = types.Action{
 				Input: "restoreFromEtcdBackupInput",
 			}
+			schema.ResourceActions[v3.ClusterActionRestoreDryRun] = types.Action{
+				Input:  "restoreDryRunInput",
+				Output: "restoreDryRunOutput",
+			}
+			schema.ResourceActions[v3.ClusterActionQueryUtilization] = types.Action{
+				Input:  "clusterUtilizationInput",
+				Output: "clusterUtilizationOutput",
+			}
 			schema.ResourceActions[v3.ClusterActionRotateCertificates] = types.Action{
 				Input:  "rotateCertificateInput",
 				Output: "rotateCertificateOutput",
@@ -316,6 +360,8 @@ func authzTypes(schemas *types.Schemas) *types.Schemas {
 		).
 		MustImport(&Version, v3.SetPodSecurityPolicyTemplateInput{}).
 		MustImport(&Version, v3.ImportYamlOutput{}).
+		MustImport(&Version, v3.ImportBundleInput{}).
+		MustImport(&Version, v3.ImportBundleOutput{}).
 		MustImport(&Version, v3.MonitoringInput{}).
 		MustImport(&Version, v3.MonitoringOutput{}).
 		MustImportAndCustomize(&Version, v3.Project{}, func(schema *types.Schema) {
@@ -603,7 +649,25 @@ func authnTypes(schemas *types.Schemas) *types.Schemas {
 			schema.ResourceMethods = []string{http.MethodGet, http.MethodPut}
 		}).
 		MustImport(&Version, v3.GoogleOauthConfigApplyInput{}).
-		MustImport(&Version, v3.GoogleOauthConfigTestOutput{})
+		MustImport(&Version, v3.GoogleOauthConfigTestOutput{}).
+		// OIDC Config
+		MustImportAndCustomize(&Version, v3.OIDCConfig{}, func(schema *types.Schema) {
+			schema.BaseType = "authConfig"
+			schema.ResourceActions = map[string]types.Action{
+				"disable": {},
+				"configureTest": {
+					Input:  "oidcConfig",
+					Output: "oidcConfigTestOutput",
+				},
+				"testAndApply": {
+					Input: "oidcConfigApplyInput",
+				},
+			}
+			schema.CollectionMethods = []string{}
+			schema.ResourceMethods = []string{http.MethodGet, http.MethodPut}
+		}).
+		MustImport(&Version, v3.OIDCConfigApplyInput{}).
+		MustImport(&Version, v3.OIDCConfigTestOutput{})
 }
 
 func configSchema(schema *types.Schema) {
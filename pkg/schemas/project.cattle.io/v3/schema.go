@@ -859,6 +859,9 @@ func pipelineTypes(schema *types.Schemas) *types.Schemas {
 		MustImport(&Version, v3.GitlabApplyInput{}).
 		MustImport(&Version, v3.BitbucketCloudApplyInput{}).
 		MustImport(&Version, v3.BitbucketServerApplyInput{}).
+		MustImport(&Version, v3.GiteaApplyInput{}).
+		MustImport(&Version, v3.GitApplyInput{}).
+		MustImport(&Version, v3.GitLoginInput{}).
 		MustImport(&Version, v3.BitbucketServerRequestLoginInput{}).
 		MustImport(&Version, v3.BitbucketServerRequestLoginOutput{}).
 		MustImportAndCustomize(&Version, v3.SourceCodeProvider{}, func(schema *types.Schema) {
@@ -867,6 +870,18 @@ func pipelineTypes(schema *types.Schemas) *types.Schemas {
 		MustImportAndCustomize(&Version, v3.GithubProvider{}, baseProviderCustomizeFunc).
 		MustImportAndCustomize(&Version, v3.GitlabProvider{}, baseProviderCustomizeFunc).
 		MustImportAndCustomize(&Version, v3.BitbucketCloudProvider{}, baseProviderCustomizeFunc).
+		MustImportAndCustomize(&Version, v3.GiteaProvider{}, baseProviderCustomizeFunc).
+		MustImportAndCustomize(&Version, v3.GitProvider{}, func(schema *types.Schema) {
+			schema.BaseType = "sourceCodeProvider"
+			schema.ResourceActions = map[string]types.Action{
+				"login": {
+					Input:  "gitLoginInput",
+					Output: "sourceCodeCredential",
+				},
+			}
+			schema.CollectionMethods = []string{}
+			schema.ResourceMethods = []string{http.MethodGet}
+		}).
 		MustImportAndCustomize(&Version, v3.BitbucketServerProvider{}, func(schema *types.Schema) {
 			schema.BaseType = "sourceCodeProvider"
 			schema.ResourceActions = map[string]types.Action{
@@ -917,6 +932,28 @@ func pipelineTypes(schema *types.Schemas) *types.Schemas {
 			}
 			schema.CollectionMethods = []string{}
 			schema.ResourceMethods = []string{http.MethodGet, http.MethodPut}
+		}).
+		MustImportAndCustomize(&Version, v3.GiteaPipelineConfig{}, func(schema *types.Schema) {
+			schema.BaseType = "sourceCodeProviderConfig"
+			schema.ResourceActions = map[string]types.Action{
+				"disable": {},
+				"testAndApply": {
+					Input: "giteaApplyInput",
+				},
+			}
+			schema.CollectionMethods = []string{}
+			schema.ResourceMethods = []string{http.MethodGet, http.MethodPut}
+		}).
+		MustImportAndCustomize(&Version, v3.GitPipelineConfig{}, func(schema *types.Schema) {
+			schema.BaseType = "sourceCodeProviderConfig"
+			schema.ResourceActions = map[string]types.Action{
+				"disable": {},
+				"testAndApply": {
+					Input: "gitApplyInput",
+				},
+			}
+			schema.CollectionMethods = []string{}
+			schema.ResourceMethods = []string{http.MethodGet, http.MethodPut}
 		}).MustImportAndCustomize(&Version, v3.BitbucketServerPipelineConfig{}, func(schema *types.Schema) {
 		schema.BaseType = "sourceCodeProviderConfig"
 		schema.ResourceActions = map[string]types.Action{
@@ -947,8 +984,9 @@ func pipelineTypes(schema *types.Schemas) *types.Schemas {
 		}).
 		MustImportAndCustomize(&Version, v3.PipelineExecution{}, func(schema *types.Schema) {
 			schema.ResourceActions = map[string]types.Action{
-				"stop":  {},
-				"rerun": {},
+				"stop":    {},
+				"rerun":   {},
+				"approve": {},
 			}
 		}).
 		MustImportAndCustomize(&Version, v3.PipelineSetting{}, func(schema *types.Schema) {
@@ -21,7 +21,10 @@
 
 	AgentImage                        = NewSetting("agent-image", "rancher/rancher-agent:master-head")
 	AuthImage                         = NewSetting("auth-image", v32.ToolsSystemImages.AuthSystemImages.KubeAPIAuth)
-	AuthTokenMaxTTLMinutes            = NewSetting("auth-token-max-ttl-minutes", "0") // never expire
+	AuthTokenMaxTTLMinutes            = NewSetting("auth-token-max-ttl-minutes", "0")        // never expire
+	AuthTokenMaxTTLMinutesCluster     = NewSetting("auth-token-max-ttl-minutes-cluster", "") // defaults to auth-token-max-ttl-minutes
+	AuthTokenMaxTTLMinutesScoped      = NewSetting("auth-token-max-ttl-minutes-scoped", "")  // defaults to auth-token-max-ttl-minutes
+	AuthTokenMaxIdleDays              = NewSetting("auth-token-max-idle-days", "0")          // never disable idle tokens
 	AuthorizationCacheTTLSeconds      = NewSetting("authorization-cache-ttl-seconds", "10")
 	AuthorizationDenyCacheTTLSeconds  = NewSetting("authorization-deny-cache-ttl-seconds", "10")
 	AzureGroupCacheSize               = NewSetting("azure-group-cache-size", "10000")
@@ -34,6 +37,7 @@
 	EngineISOURL                      = NewSetting("engine-iso-url", "https://releases.rancher.com/os/latest/rancheros-vmware.iso")
 	EngineNewestVersion               = NewSetting("engine-newest-version", "v17.12.0")
 	EngineSupportedRange              = NewSetting("engine-supported-range", "~v1.11.2 || ~v1.12.0 || ~v1.13.0 || ~v17.03.0 || ~v17.06.0 || ~v17.09.0 || ~v18.06.0 || ~v18.09.0 || ~v19.03.0 ")
+	EtcdBackupVerifyIntervalHours     = NewSetting("etcd-backup-verify-interval-hours", "24") // 0 disables the verification of etcd backups
 	FirstLogin                        = NewSetting("first-login", "true")
 	GlobalRegistryEnabled             = NewSetting("global-registry-enabled", "false")
 	GithubProxyAPIURL                 = NewSetting("github-proxy-api-url", "https://api.github.com")
@@ -95,6 +99,7 @@
 	RestrictedDefaultAdmin            = NewSetting("restricted-default-admin", "false") // When bootstrapping the admin for the first time, give them the global role restricted-admin
 	EKSUpstreamRefreshCron            = NewSetting("eks-refresh-cron", "*/5 * * * *")
 	HideLocalCluster                  = NewSetting("hide-local-cluster", "false")
+	TrustedProxies                    = NewSetting("trusted-proxies", "") // addresses and CIDRs of the proxies whose X-Forwarded-For is trusted
 )
 
 func FullShellImage() string {
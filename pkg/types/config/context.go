@@ -10,6 +10,7 @@
 	"github.com/rancher/norman/store/proxy"
 	"github.com/rancher/norman/types"
 	"github.com/rancher/rancher/pkg/catalog/manager"
+	"github.com/rancher/rancher/pkg/encryptedstore"
 	apiregistrationv1 "github.com/rancher/rancher/pkg/generated/norman/apiregistration.k8s.io/v1"
 	appsv1 "github.com/rancher/rancher/pkg/generated/norman/apps/v1"
 	autoscaling "github.com/rancher/rancher/pkg/generated/norman/autoscaling/v2beta2"
@@ -63,6 +64,8 @@ type ScaledContext struct {
 	UserManager       user.Manager
 	PeerManager       peermanager.PeerManager
 	CatalogManager    manager.CatalogManager
+	// SecretsEncryptionKeys encrypt the secrets of the stores of node and cluster drivers.
+	SecretsEncryptionKeys encryptedstore.KeyProviders
 
 	Management managementv3.Interface
 	Project    projectv3.Interface
@@ -85,6 +88,7 @@ func (c *ScaledContext) NewManagementContext() (*ManagementContext, error) {
 	mgmt.Dialer = c.Dialer
 	mgmt.UserManager = c.UserManager
 	mgmt.CatalogManager = c.CatalogManager
+	mgmt.SecretsEncryptionKeys = c.SecretsEncryptionKeys
 	c.managementContext = mgmt
 	return mgmt, nil
 }
@@ -183,6 +187,8 @@ type ManagementContext struct {
 	Dialer            dialer.Factory
 	UserManager       user.Manager
 	CatalogManager    manager.CatalogManager
+	// SecretsEncryptionKeys encrypt the secrets of the stores of node and cluster drivers.
+	SecretsEncryptionKeys encryptedstore.KeyProviders
 
 	Management managementv3.Interface
 	Project    projectv3.Interface
@@ -0,0 +1,206 @@
+package utilization
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	KindCluster  = "cluster"
+	KindNodePool = "nodepool"
+	KindProject  = "project"
+)
+
+type tierSpec struct {
+	name string
+	step time.Duration
+	size int
+}
+
+// tiers are the resolutions a series keeps its samples at, every sample is added to each tier so a coarser tier is the
+// downsampled history of a finer one and is kept longer. Only coarse tiers are kept, a series is stored in a config map.
+var tiers = []tierSpec{
+	{name: "1h", step: time.Hour, size: 24 * 14},
+	{name: "1d", step: 24 * time.Hour, size: 400},
+}
+
+// Series is the utilization history of a cluster, a node pool or a project. The values of a point are in the order of
+// Metrics, a point recorded before a metric was added has fewer values.
+type Series struct {
+	Metrics []string         `json:"metrics"`
+	Tiers   map[string]*tier `json:"tiers"`
+	// Sampled is the time of the last sample, in seconds
+	Sampled int64 `json:"sampled,omitempty"`
+}
+
+type tier struct {
+	Points []point `json:"points"`
+}
+
+// point aggregates the samples of a period of a tier: the average and the maximum of each metric over Count samples.
+type point struct {
+	Time  int64     `json:"t"`
+	Count int       `json:"n"`
+	Avg   []float64 `json:"avg"`
+	Max   []float64 `json:"max"`
+}
+
+// Point is a point of a query, the average and the maximum of each metric over the period starting at Time.
+type Point struct {
+	Time    time.Time
+	Average map[string]float64
+	Max     map[string]float64
+}
+
+// Add records a sample in every tier of the series. A sample that is not newer than the last sample is dropped, so
+// recording a sample again has no effect, and false is returned.
+func (s *Series) Add(at time.Time, values map[string]float64) bool {
+	if at.Unix() <= s.Sampled {
+		return false
+	}
+	s.Sampled = at.Unix()
+
+	var names []string
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if s.metricIndex(name) < 0 {
+			s.Metrics = append(s.Metrics, name)
+		}
+	}
+	sample := make([]float64, len(s.Metrics))
+	for i, name := range s.Metrics {
+		sample[i] = values[name]
+	}
+
+	if s.Tiers == nil {
+		s.Tiers = map[string]*tier{}
+	}
+	for _, spec := range tiers {
+		t := s.Tiers[spec.name]
+		if t == nil {
+			t = &tier{}
+			s.Tiers[spec.name] = t
+		}
+		t.add(spec, at, sample)
+	}
+	return true
+}
+
+// Last returns the time of the period of the last sample, it is zero when the series is empty.
+func (s *Series) Last() time.Time {
+	t := s.Tiers[tiers[0].name]
+	if t == nil || len(t.Points) == 0 {
+		return time.Time{}
+	}
+	return time.Unix(t.Points[len(t.Points)-1].Time, 0).UTC()
+}
+
+// Query returns the points of the tier of the resolution between from and to. When the resolution is empty the finest
+// tier that still holds from is used, the resolution used is returned along with the points.
+func (s *Series) Query(from, to time.Time, resolution string, now time.Time) (string, []Point, error) {
+	spec, err := pickTier(from, resolution, now)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var result []Point
+	t := s.Tiers[spec.name]
+	if t == nil {
+		return spec.name, result, nil
+	}
+	start := from.Truncate(spec.step).Unix()
+	for _, p := range t.Points {
+		if p.Time < start || p.Time > to.Unix() {
+			continue
+		}
+		result = append(result, s.toPoint(p))
+	}
+	return spec.name, result, nil
+}
+
+func (s *Series) toPoint(p point) Point {
+	result := Point{
+		Time:    time.Unix(p.Time, 0).UTC(),
+		Average: map[string]float64{},
+		Max:     map[string]float64{},
+	}
+	for i, name := range s.Metrics {
+		if i >= len(p.Avg) {
+			break
+		}
+		result.Average[name] = p.Avg[i]
+		result.Max[name] = p.Max[i]
+	}
+	return result
+}
+
+func (s *Series) metricIndex(name string) int {
+	for i, metric := range s.Metrics {
+		if metric == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (t *tier) add(spec tierSpec, at time.Time, sample []float64) {
+	period := at.Truncate(spec.step).Unix()
+	n := len(t.Points)
+	switch {
+	case n > 0 && t.Points[n-1].Time == period:
+		t.Points[n-1].merge(sample)
+	case n > 0 && t.Points[n-1].Time > period:
+		return
+	default:
+		t.Points = append(t.Points, point{
+			Time:  period,
+			Count: 1,
+			Avg:   append([]float64(nil), sample...),
+			Max:   append([]float64(nil), sample...),
+		})
+	}
+
+	oldest := period - int64(spec.size-1)*int64(spec.step/time.Second)
+	i := 0
+	for i < len(t.Points) && t.Points[i].Time < oldest {
+		i++
+	}
+	t.Points = t.Points[i:]
+}
+
+func (p *point) merge(sample []float64) {
+	for i, value := range sample {
+		if i >= len(p.Avg) {
+			// the metric was added during the period
+			p.Avg = append(p.Avg, value)
+			p.Max = append(p.Max, value)
+			continue
+		}
+		p.Avg[i] += (value - p.Avg[i]) / float64(p.Count+1)
+		if value > p.Max[i] {
+			p.Max[i] = value
+		}
+	}
+	p.Count++
+}
+
+func pickTier(from time.Time, resolution string, now time.Time) (tierSpec, error) {
+	if resolution != "" {
+		for _, spec := range tiers {
+			if spec.name == resolution {
+				return spec, nil
+			}
+		}
+		return tierSpec{}, fmt.Errorf("invalid resolution %s", resolution)
+	}
+	for _, spec := range tiers {
+		if !now.Add(-spec.step * time.Duration(spec.size-1)).Truncate(spec.step).After(from) {
+			return spec, nil
+		}
+	}
+	return tiers[len(tiers)-1], nil
+}
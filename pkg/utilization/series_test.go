@@ -0,0 +1,85 @@
+package utilization
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeriesAdd(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := &Series{}
+	assert.True(s.Add(start, map[string]float64{"cpu.requested": 1}))
+	assert.True(s.Add(start.Add(5*time.Minute), map[string]float64{"cpu.requested": 3, "pods.requested": 4}))
+	assert.True(s.Add(start.Add(time.Hour), map[string]float64{"cpu.requested": 2}))
+
+	assert.Equal([]string{"cpu.requested", "pods.requested"}, s.Metrics)
+	assert.Len(s.Tiers["1h"].Points, 2)
+	assert.Len(s.Tiers["1d"].Points, 1)
+	assert.Equal(start.Add(time.Hour), s.Last())
+
+	_, points, err := s.Query(start, start.Add(time.Hour), "1h", start.Add(time.Hour))
+	assert.Nil(err)
+	assert.Len(points, 2)
+	assert.Equal(2.0, points[0].Average["cpu.requested"])
+	assert.Equal(3.0, points[0].Max["cpu.requested"])
+	assert.Equal(4.0, points[0].Max["pods.requested"])
+
+	_, points, err = s.Query(start, start.Add(time.Hour), "1d", start.Add(time.Hour))
+	assert.Nil(err)
+	assert.Len(points, 1)
+	assert.Equal(2.0, points[0].Average["cpu.requested"])
+	assert.Equal(3, s.Tiers["1d"].Points[0].Count)
+
+	// a sample that is not newer than the last one is dropped
+	assert.False(s.Add(start.Add(time.Hour), map[string]float64{"cpu.requested": 100}))
+	assert.False(s.Add(start, map[string]float64{"cpu.requested": 100}))
+	assert.Equal(3.0, s.Tiers["1h"].Points[0].Max[0])
+	assert.Equal(2.0, s.Tiers["1h"].Points[1].Max[0])
+}
+
+func TestSeriesRetention(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := &Series{}
+	for i := 0; i < 400; i++ {
+		s.Add(start.Add(time.Duration(i)*time.Hour), map[string]float64{"cpu.requested": float64(i)})
+	}
+	points := s.Tiers["1h"].Points
+	assert.Len(points, 336)
+	assert.Equal(start.Add(64*time.Hour).Unix(), points[0].Time)
+	assert.Len(s.Tiers["1d"].Points, 17)
+}
+
+func TestSeriesQueryResolution(t *testing.T) {
+	assert := assert.New(t)
+
+	now := time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)
+	s := &Series{}
+	s.Add(now.Add(-time.Hour), map[string]float64{"cpu.requested": 1})
+
+	resolution, points, err := s.Query(now.Add(-2*time.Hour), now, "", now)
+	assert.Nil(err)
+	assert.Equal("1h", resolution)
+	assert.Len(points, 1)
+
+	resolution, _, err = s.Query(now.Add(-7*24*time.Hour), now, "", now)
+	assert.Nil(err)
+	assert.Equal("1h", resolution)
+
+	resolution, _, err = s.Query(now.Add(-90*24*time.Hour), now, "", now)
+	assert.Nil(err)
+	assert.Equal("1d", resolution)
+
+	_, _, err = s.Query(now.Add(-time.Hour), now, "10m", now)
+	assert.NotNil(err)
+
+	resolution, points, err = (&Series{}).Query(now.Add(-time.Hour), now, "1h", now)
+	assert.Nil(err)
+	assert.Equal("1h", resolution)
+	assert.Len(points, 0)
+}
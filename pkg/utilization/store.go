@@ -0,0 +1,169 @@
+package utilization
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// kindLabel is set on the config maps of the store to the kind of object their history is of
+	kindLabel = "management.cattle.io/utilization"
+	seriesKey = "series"
+)
+
+// Store keeps the utilization history of the clusters, their node pools and their projects. Each history is a series
+// kept in a config map of the namespace of the cluster on the management cluster, so it is removed along with the
+// cluster, and along with its node pool or project through its owner reference. Samples are merged in memory and
+// written once per period of the finest tier, the history of the current period is stored once it ends.
+type Store struct {
+	configMaps v1.ConfigMapInterface
+
+	mu sync.Mutex
+	// pending are the series samples are recorded into, by namespace and name of their config map
+	pending map[string]*pendingSeries
+}
+
+type pendingSeries struct {
+	series *Series
+	// stored is the period of the last sample that was written to the config map
+	stored time.Time
+}
+
+func NewStore(configMaps v1.ConfigMapInterface) *Store {
+	return &Store{
+		configMaps: configMaps,
+		pending:    map[string]*pendingSeries{},
+	}
+}
+
+// Get returns the history of an object of the cluster, it is empty when nothing was recorded yet.
+func (s *Store) Get(clusterName, kind, name string) (*Series, error) {
+	cm, err := s.configMaps.GetNamespaced(clusterName, configMapName(kind, name), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return &Series{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return decode(cm)
+}
+
+// Record adds a sample to the history of an object of the cluster, the owner is set on the config map when the history
+// is created. A sample recorded again is ignored.
+func (s *Store) Record(clusterName, kind, name string, owner *metav1.OwnerReference, at time.Time, values map[string]float64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := clusterName + "/" + configMapName(kind, name)
+	pending, ok := s.pending[key]
+	if !ok {
+		cm, err := s.configMaps.GetNamespaced(clusterName, configMapName(kind, name), metav1.GetOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		series := &Series{}
+		if err == nil {
+			if series, err = decode(cm); err != nil {
+				return err
+			}
+		}
+		pending = &pendingSeries{series: series, stored: series.Last()}
+		s.pending[key] = pending
+	}
+
+	if !pending.series.Add(at, values) {
+		return nil
+	}
+	period := pending.series.Last()
+	if period.Equal(pending.stored) {
+		return nil
+	}
+	if err := s.store(clusterName, kind, name, owner, pending.series); err != nil {
+		// the config map is read again on the next sample
+		delete(s.pending, key)
+		return err
+	}
+	pending.stored = period
+	return nil
+}
+
+// Forget drops the samples of the objects of the cluster that are not stored yet.
+func (s *Store) Forget(clusterName string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key := range s.pending {
+		if strings.HasPrefix(key, clusterName+"/") {
+			delete(s.pending, key)
+		}
+	}
+}
+
+func (s *Store) store(clusterName, kind, name string, owner *metav1.OwnerReference, series *Series) error {
+	cm, err := s.configMaps.GetNamespaced(clusterName, configMapName(kind, name), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      configMapName(kind, name),
+				Namespace: clusterName,
+				Labels: map[string]string{
+					kindLabel: kind,
+				},
+			},
+		}
+		if owner != nil {
+			cm.OwnerReferences = []metav1.OwnerReference{*owner}
+		}
+		if err := encode(cm, series); err != nil {
+			return err
+		}
+		_, err = s.configMaps.Create(cm)
+		return err
+	} else if err != nil {
+		return err
+	}
+
+	cm = cm.DeepCopy()
+	if err := encode(cm, series); err != nil {
+		return err
+	}
+	_, err = s.configMaps.Update(cm)
+	return err
+}
+
+func configMapName(kind, name string) string {
+	if kind == KindCluster {
+		return "utilization-" + KindCluster
+	}
+	return fmt.Sprintf("utilization-%s-%s", kind, name)
+}
+
+func decode(cm *corev1.ConfigMap) (*Series, error) {
+	series := &Series{}
+	data := cm.Data[seriesKey]
+	if data == "" {
+		return series, nil
+	}
+	if err := json.Unmarshal([]byte(data), series); err != nil {
+		return nil, fmt.Errorf("failed to decode utilization history %s/%s: %v", cm.Namespace, cm.Name, err)
+	}
+	return series, nil
+}
+
+func encode(cm *corev1.ConfigMap, series *Series) error {
+	data, err := json.Marshal(series)
+	if err != nil {
+		return err
+	}
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[seriesKey] = string(data)
+	return nil
+}
@@ -0,0 +1,69 @@
+package utilization
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rancher/rancher/pkg/generated/norman/core/v1/fakes"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestStoreRecord(t *testing.T) {
+	assert := assert.New(t)
+
+	var stored *corev1.ConfigMap
+	writes := 0
+	configMaps := &fakes.ConfigMapInterfaceMock{
+		GetNamespacedFunc: func(namespace string, name string, opts metav1.GetOptions) (*corev1.ConfigMap, error) {
+			if stored == nil {
+				return nil, apierrors.NewNotFound(schema.GroupResource{}, name)
+			}
+			return stored, nil
+		},
+		CreateFunc: func(cm *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+			writes++
+			stored = cm
+			return cm, nil
+		},
+		UpdateFunc: func(cm *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+			writes++
+			stored = cm
+			return cm, nil
+		},
+	}
+	s := NewStore(configMaps)
+	owner := &metav1.OwnerReference{Kind: "NodePool", Name: "np-abcde"}
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Nil(s.Record("c-abcde", KindNodePool, "np-abcde", owner, start, map[string]float64{"cpu.requested": 1}))
+	assert.Equal(1, writes)
+	assert.Equal("utilization-nodepool-np-abcde", stored.Name)
+	assert.Equal([]metav1.OwnerReference{*owner}, stored.OwnerReferences)
+
+	// samples of the same hour are kept in memory, a sample recorded again is ignored
+	assert.Nil(s.Record("c-abcde", KindNodePool, "np-abcde", owner, start.Add(5*time.Minute), map[string]float64{"cpu.requested": 3}))
+	assert.Nil(s.Record("c-abcde", KindNodePool, "np-abcde", owner, start.Add(5*time.Minute), map[string]float64{"cpu.requested": 3}))
+	assert.Equal(1, writes)
+
+	assert.Nil(s.Record("c-abcde", KindNodePool, "np-abcde", owner, start.Add(time.Hour), map[string]float64{"cpu.requested": 2}))
+	assert.Equal(2, writes)
+
+	series, err := s.Get("c-abcde", KindNodePool, "np-abcde")
+	assert.Nil(err)
+	_, points, err := series.Query(start, start.Add(time.Hour), "1h", start.Add(time.Hour))
+	assert.Nil(err)
+	assert.Len(points, 2)
+	assert.Equal(2.0, points[0].Average["cpu.requested"])
+	assert.Equal(3.0, points[0].Max["cpu.requested"])
+	assert.Equal(2, series.Tiers["1h"].Points[0].Count)
+
+	// a new store reads the series back from the config map
+	s = NewStore(configMaps)
+	assert.Nil(s.Record("c-abcde", KindNodePool, "np-abcde", owner, start.Add(time.Hour), map[string]float64{"cpu.requested": 2}))
+	assert.Nil(s.Record("c-abcde", KindNodePool, "np-abcde", owner, start.Add(time.Hour+5*time.Minute), map[string]float64{"cpu.requested": 2}))
+	assert.Equal(2, writes)
+}
@@ -52,7 +52,7 @@ def test_generic_initial_conditions(admin_mc, remove_resource):
     assert cluster.conditions[2].type == 'Waiting'
     assert cluster.conditions[2].status == 'Unknown'
 
-    assert 'exportYaml' not in cluster.actions
+    assert 'exportYaml' in cluster.actions
 
 
 def test_eks_cluster_immutable_subnets(admin_mc, remove_resource):